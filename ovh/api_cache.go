@@ -0,0 +1,130 @@
+package ovh
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// apiCacheablePaths match the API objects which don't change during a
+// terraform operation unless terraform changes them itself. Their GET
+// responses are cached by apiCacheTransport.
+var apiCacheablePaths = []*regexp.Regexp{
+	regexp.MustCompile(`^/1\.0/cloud/project/[^/]+$`),
+	regexp.MustCompile(`^/1\.0/.+/serviceInfos$`),
+	regexp.MustCompile(`^/1\.0/domain/zone/[^/]+$`),
+	regexp.MustCompile(`^/1\.0/order/catalog/public/[^/]+$`),
+}
+
+type apiCacheEntry struct {
+	path       string
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+// apiCacheTransport caches the successful GET responses of the cacheable
+// API paths for the lifetime of the provider, i.e. a single terraform
+// operation. Any other request on a cached path, or on one of its
+// sub paths, drops the cached response.
+type apiCacheTransport struct {
+	transport http.RoundTripper
+
+	mu      sync.Mutex
+	entries map[string]*apiCacheEntry
+}
+
+func newApiCacheTransport(transport http.RoundTripper) *apiCacheTransport {
+	return &apiCacheTransport{
+		transport: transport,
+		entries:   map[string]*apiCacheEntry{},
+	}
+}
+
+func apiCacheable(path string) bool {
+	for _, r := range apiCacheablePaths {
+		if r.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *apiCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := req.URL.Path
+
+	if req.Method != "GET" {
+		t.invalidate(path)
+		return t.transport.RoundTrip(req)
+	}
+
+	if !apiCacheable(path) {
+		return t.transport.RoundTrip(req)
+	}
+
+	key := req.Method + " " + req.URL.String()
+
+	t.mu.Lock()
+	entry, ok := t.entries[key]
+	t.mu.Unlock()
+
+	if ok {
+		log.Printf("[DEBUG] Using cached response of %s", key)
+		return entry.response(req), nil
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	entry = &apiCacheEntry{
+		path:       path,
+		statusCode: resp.StatusCode,
+		header:     resp.Header,
+		body:       body,
+	}
+
+	t.mu.Lock()
+	t.entries[key] = entry
+	t.mu.Unlock()
+
+	return entry.response(req), nil
+}
+
+// invalidate drops the cached responses of the path and of its parents.
+func (t *apiCacheTransport) invalidate(path string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key, entry := range t.entries {
+		if path == entry.path || strings.HasPrefix(path, entry.path+"/") {
+			log.Printf("[DEBUG] Dropping cached response of %s", key)
+			delete(t.entries, key)
+		}
+	}
+}
+
+func (e *apiCacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(e.statusCode),
+		StatusCode:    e.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header,
+		Body:          ioutil.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,69 @@
+package ovh
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestApiCacheTransport(t *testing.T) {
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls[r.Method+" "+r.URL.Path]++
+		w.Write([]byte(`{"path":"` + r.URL.Path + `"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newApiCacheTransport(http.DefaultTransport)}
+
+	do := func(method, path string) string {
+		req, err := http.NewRequest(method, server.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(body)
+	}
+
+	zone := "/1.0/domain/zone/example.com"
+	for i := 0; i < 3; i++ {
+		if body := do("GET", zone); !strings.Contains(body, zone) {
+			t.Fatalf("unexpected body %s", body)
+		}
+	}
+	if calls["GET "+zone] != 1 {
+		t.Fatalf("expected 1 call on %s, got %d", zone, calls["GET "+zone])
+	}
+
+	// tasks are never cached
+	task := "/1.0/domain/zone/example.com/task/1"
+	do("GET", task)
+	do("GET", task)
+	if calls["GET "+task] != 2 {
+		t.Fatalf("expected 2 calls on %s, got %d", task, calls["GET "+task])
+	}
+
+	// a write on a sub path drops the cached zone
+	do("POST", zone+"/refresh")
+	do("GET", zone)
+	if calls["GET "+zone] != 2 {
+		t.Fatalf("expected 2 calls on %s, got %d", zone, calls["GET "+zone])
+	}
+
+	// a write on another path keeps it
+	do("PUT", "/1.0/domain/zone/example.org")
+	do("GET", zone)
+	if calls["GET "+zone] != 2 {
+		t.Fatalf("expected 2 calls on %s, got %d", zone, calls["GET "+zone])
+	}
+}
@@ -0,0 +1,84 @@
+package ovh
+
+import (
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type CdnDedicatedDomain struct {
+	Domain string `json:"domain"`
+	Cname  string `json:"cname"`
+	Status string `json:"status"`
+	Type   string `json:"type"`
+}
+
+func (d *CdnDedicatedDomain) String() string {
+	return fmt.Sprintf("CdnDomain[domain: %s, cname: %s, status: %s, type: %s]", d.Domain, d.Cname, d.Status, d.Type)
+}
+
+type CdnDedicatedDomainCreateOpts struct {
+	Domain string `json:"domain"`
+}
+
+type CdnDedicatedDomainUpdateOpts struct {
+	Status string `json:"status"`
+}
+
+type CdnDedicatedBackend struct {
+	Ip string `json:"ip"`
+}
+
+type CdnDedicatedBackendCreateOpts struct {
+	Ip string `json:"ip"`
+}
+
+type CdnDedicatedCacheRule struct {
+	CacheRuleId int64  `json:"cacheRuleId"`
+	Domain      string `json:"domain"`
+	CacheType   string `json:"cacheType"`
+	FileMatch   string `json:"fileMatch"`
+	FileType    string `json:"fileType"`
+	Status      string `json:"status"`
+	Ttl         int    `json:"ttl"`
+}
+
+func (r *CdnDedicatedCacheRule) String() string {
+	return fmt.Sprintf("CdnCacheRule[id: %d, fileType: %s, fileMatch: %s, cacheType: %s, ttl: %d]", r.CacheRuleId, r.FileType, r.FileMatch, r.CacheType, r.Ttl)
+}
+
+type CdnDedicatedCacheRuleCreateOpts struct {
+	CacheType string `json:"cacheType"`
+	FileMatch string `json:"fileMatch"`
+	FileType  string `json:"fileType"`
+	Ttl       int    `json:"ttl"`
+}
+
+type CdnDedicatedCacheRuleUpdateOpts struct {
+	CacheType string `json:"cacheType"`
+	Status    string `json:"status"`
+	Ttl       int    `json:"ttl"`
+}
+
+type CdnDedicatedTask struct {
+	TaskId   int64  `json:"taskId"`
+	Function string `json:"function"`
+	Status   string `json:"status"`
+	Comment  string `json:"comment"`
+}
+
+func (t *CdnDedicatedTask) String() string {
+	return fmt.Sprintf("CdnTask[id: %d, function: %s, status: %s]", t.TaskId, t.Function, t.Status)
+}
+
+// cdnDedicatedTaskWait waits for a task of a CDN domain to be done.
+func cdnDedicatedTaskWait(c *ovh.Client, serviceName, domain string, taskId int64) error {
+	// a removed domain takes its tasks with it
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/cdn/dedicated/%s/domains/%s/tasks/%d", serviceName, domain, taskId),
+		Description: fmt.Sprintf("task %d on cdn %s domain %s", taskId, serviceName, domain),
+		Pending:     []string{"todo", "doing"},
+		Purged:      "done",
+	}).Wait(c)
+	return err
+}
@@ -0,0 +1,105 @@
+package ovh
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// OVHClientV2 calls the v2 API (ex: IAM) with the credentials and
+// http client of the v1 client. The vendored go-ovh client can only
+// target the 1.0 API root.
+type OVHClientV2 struct {
+	client   *ovh.Client
+	endpoint string
+}
+
+func newOVHClientV2(client *ovh.Client, endpoint string) *OVHClientV2 {
+	return &OVHClientV2{
+		client:   client,
+		endpoint: strings.TrimSuffix(endpoint, "/1.0") + "/v2",
+	}
+}
+
+// Get is a wrapper for the GET method
+func (c *OVHClientV2) Get(path string, resType interface{}) error {
+	return c.CallAPI("GET", path, nil, resType)
+}
+
+// Post is a wrapper for the POST method
+func (c *OVHClientV2) Post(path string, reqBody, resType interface{}) error {
+	return c.CallAPI("POST", path, reqBody, resType)
+}
+
+// Put is a wrapper for the PUT method
+func (c *OVHClientV2) Put(path string, reqBody, resType interface{}) error {
+	return c.CallAPI("PUT", path, reqBody, resType)
+}
+
+// Delete is a wrapper for the DELETE method
+func (c *OVHClientV2) Delete(path string, resType interface{}) error {
+	return c.CallAPI("DELETE", path, nil, resType)
+}
+
+// CallAPI signs the request the same way the v1 client does and
+// unmarshals the response, returning *ovh.APIError on API errors.
+func (c *OVHClientV2) CallAPI(method, path string, reqBody, resType interface{}) error {
+	var body []byte
+	var err error
+
+	if reqBody != nil {
+		body, err = json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+	}
+
+	target := c.endpoint + path
+	req, err := http.NewRequest(method, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json;charset=utf-8")
+	}
+	req.Header.Add("X-Ovh-Application", c.client.AppKey)
+	req.Header.Add("Accept", "application/json")
+
+	timeDelta, err := c.client.TimeDelta()
+	if err != nil {
+		return err
+	}
+
+	timestamp := time.Now().Add(-timeDelta).Unix()
+
+	req.Header.Add("X-Ovh-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Add("X-Ovh-Consumer", c.client.ConsumerKey)
+
+	h := sha1.New()
+	h.Write([]byte(fmt.Sprintf("%s+%s+%s+%s+%s+%d",
+		c.client.AppSecret,
+		c.client.ConsumerKey,
+		method,
+		target,
+		body,
+		timestamp,
+	)))
+	req.Header.Add("X-Ovh-Signature", fmt.Sprintf("$1$%x", h.Sum(nil)))
+
+	c.client.Client.Timeout = c.client.Timeout
+
+	response, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	return c.client.UnmarshalResponse(response, resType)
+}
@@ -0,0 +1,59 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// PublicCloudOperation is returned by the asynchronous calls of the region
+// scoped public cloud API. The id of the created object is set once the
+// operation completed, on the operation or on one of its sub operations.
+type PublicCloudOperation struct {
+	Id            string                  `json:"id"`
+	Action        string                  `json:"action"`
+	Status        string                  `json:"status"`
+	ResourceId    *string                 `json:"resourceId"`
+	SubOperations []*PublicCloudOperation `json:"subOperations"`
+}
+
+func (o *PublicCloudOperation) String() string {
+	return fmt.Sprintf("PublicCloudOperation[id: %s, action: %s, status: %s]", o.Id, o.Action, o.Status)
+}
+
+// createdResourceId returns the id of the object created by the operation,
+// which is set either on the operation or on its first sub operation.
+func (o *PublicCloudOperation) createdResourceId() string {
+	if o.ResourceId != nil && *o.ResourceId != "" {
+		return *o.ResourceId
+	}
+
+	for _, sub := range o.SubOperations {
+		if id := sub.createdResourceId(); id != "" {
+			return id
+		}
+	}
+
+	return ""
+}
+
+// cloudProjectOperationWait waits for an operation of a public cloud project
+// to complete, and returns its final state.
+func cloudProjectOperationWait(c *ovh.Client, projectId, operationId string, timeout time.Duration) (*PublicCloudOperation, error) {
+	r, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/cloud/project/%s/operation/%s", projectId, operationId),
+		Description: fmt.Sprintf("operation %s on public cloud project %s", operationId, projectId),
+		Task:        &PublicCloudOperation{},
+		Pending:     []string{"created", "in-progress", "unknown"},
+		Target:      []string{"completed"},
+		Timeout:     timeout,
+		Delay:       2 * time.Second,
+		MinTimeout:  2 * time.Second,
+	}).Wait(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.(*PublicCloudOperation), nil
+}
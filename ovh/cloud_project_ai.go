@@ -0,0 +1,340 @@
+package ovh
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type CloudProjectAiResources struct {
+	Cpu    int    `json:"cpu,omitempty"`
+	Gpu    int    `json:"gpu,omitempty"`
+	Flavor string `json:"flavor,omitempty"`
+}
+
+type CloudProjectAiDataStore struct {
+	Alias     string `json:"alias"`
+	Container string `json:"container"`
+	Prefix    string `json:"prefix,omitempty"`
+}
+
+type CloudProjectAiVolume struct {
+	DataStore  *CloudProjectAiDataStore `json:"dataStore"`
+	MountPath  string                   `json:"mountPath"`
+	Permission string                   `json:"permission"`
+	Cache      bool                     `json:"cache"`
+}
+
+type CloudProjectAiStatus struct {
+	State string `json:"state"`
+	Url   string `json:"url"`
+	Info  *struct {
+		Message string `json:"message"`
+	} `json:"info"`
+}
+
+// message returns the reason of the state of a notebook or job, if any.
+func (s *CloudProjectAiStatus) message() string {
+	if s.Info == nil {
+		return ""
+	}
+	return s.Info.Message
+}
+
+type CloudProjectAiNotebookEnv struct {
+	EditorId         string `json:"editorId"`
+	FrameworkId      string `json:"frameworkId"`
+	FrameworkVersion string `json:"frameworkVersion,omitempty"`
+}
+
+type CloudProjectAiNotebookSpec struct {
+	Name          string                    `json:"name"`
+	Region        string                    `json:"region"`
+	Labels        map[string]string         `json:"labels,omitempty"`
+	Env           CloudProjectAiNotebookEnv `json:"env"`
+	Resources     CloudProjectAiResources   `json:"resources"`
+	Volumes       []CloudProjectAiVolume    `json:"volumes,omitempty"`
+	UnsecureHttp  bool                      `json:"unsecureHttp"`
+	SshPublicKeys []string                  `json:"sshPublicKeys,omitempty"`
+}
+
+func (s *CloudProjectAiNotebookSpec) String() string {
+	return fmt.Sprintf("name: %s, region: %s, framework: %s %s, editor: %s, flavor: %s", s.Name, s.Region, s.Env.FrameworkId, s.Env.FrameworkVersion, s.Env.EditorId, s.Resources.Flavor)
+}
+
+type CloudProjectAiNotebook struct {
+	Id        string                     `json:"id"`
+	CreatedAt string                     `json:"createdAt"`
+	Spec      CloudProjectAiNotebookSpec `json:"spec"`
+	Status    CloudProjectAiStatus       `json:"status"`
+}
+
+func (n *CloudProjectAiNotebook) String() string {
+	return fmt.Sprintf("AiNotebook[id: %s, name: %s, state: %s]", n.Id, n.Spec.Name, n.Status.State)
+}
+
+type CloudProjectAiJobEnvVar struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type CloudProjectAiJobSpec struct {
+	Name            string                    `json:"name"`
+	Region          string                    `json:"region"`
+	Image           string                    `json:"image"`
+	Command         []string                  `json:"command,omitempty"`
+	EnvVars         []CloudProjectAiJobEnvVar `json:"envVars,omitempty"`
+	Labels          map[string]string         `json:"labels,omitempty"`
+	Resources       CloudProjectAiResources   `json:"resources"`
+	Volumes         []CloudProjectAiVolume    `json:"volumes,omitempty"`
+	Timeout         int                       `json:"timeout,omitempty"`
+	DefaultHttpPort int                       `json:"defaultHttpPort,omitempty"`
+	UnsecureHttp    bool                      `json:"unsecureHttp"`
+	SshPublicKeys   []string                  `json:"sshPublicKeys,omitempty"`
+}
+
+func (s *CloudProjectAiJobSpec) String() string {
+	return fmt.Sprintf("name: %s, region: %s, image: %s, flavor: %s", s.Name, s.Region, s.Image, s.Resources.Flavor)
+}
+
+type CloudProjectAiJob struct {
+	Id        string                `json:"id"`
+	CreatedAt string                `json:"createdAt"`
+	Spec      CloudProjectAiJobSpec `json:"spec"`
+	Status    CloudProjectAiStatus  `json:"status"`
+}
+
+func (j *CloudProjectAiJob) String() string {
+	return fmt.Sprintf("AiJob[id: %s, name: %s, state: %s]", j.Id, j.Spec.Name, j.Status.State)
+}
+
+// cloudProjectAiSchema returns the attributes shared by the AI notebooks and
+// jobs: the compute resources and the object storage volumes.
+func cloudProjectAiSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"flavor": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"cpu": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"gpu"},
+		},
+		"gpu": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"cpu"},
+		},
+		"labels": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"unsecure_http": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+		"ssh_public_keys": {
+			Type:     schema.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"volume": {
+			Type:     schema.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"container": {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+					},
+					"alias": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ForceNew:         true,
+						DiffSuppressFunc: suppressRegionDiff,
+					},
+					"prefix": {
+						Type:     schema.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+					"mount_path": {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+					},
+					"permission": {
+						Type:     schema.TypeString,
+						Optional: true,
+						ForceNew: true,
+						Default:  "RO",
+						ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+							err := validateStringEnum(v.(string), []string{"RO", "RW", "RWD"})
+							if err != nil {
+								errors = append(errors, err)
+							}
+							return
+						},
+					},
+					"cache": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		// Computed
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func cloudProjectAiResourcesFromSchema(d *schema.ResourceData) CloudProjectAiResources {
+	return CloudProjectAiResources{
+		Flavor: d.Get("flavor").(string),
+		Cpu:    d.Get("cpu").(int),
+		Gpu:    d.Get("gpu").(int),
+	}
+}
+
+func cloudProjectAiLabelsFromSchema(d *schema.ResourceData) map[string]string {
+	labels := map[string]string{}
+	for k, v := range d.Get("labels").(map[string]interface{}) {
+		labels[k] = v.(string)
+	}
+	return labels
+}
+
+func cloudProjectAiStringsFromList(list []interface{}) []string {
+	s := make([]string, len(list))
+	for i, v := range list {
+		s[i] = v.(string)
+	}
+	return s
+}
+
+// cloudProjectAiVolumesFromList builds the volumes of a notebook or job from
+// the volume blocks of its configuration.
+func cloudProjectAiVolumesFromList(list []interface{}) []CloudProjectAiVolume {
+	volumes := make([]CloudProjectAiVolume, len(list))
+	for i, v := range list {
+		volume := v.(map[string]interface{})
+		volumes[i] = CloudProjectAiVolume{
+			DataStore: &CloudProjectAiDataStore{
+				Alias:     normalizeRegion(volume["alias"].(string)),
+				Container: volume["container"].(string),
+				Prefix:    volume["prefix"].(string),
+			},
+			MountPath:  volume["mount_path"].(string),
+			Permission: volume["permission"].(string),
+			Cache:      volume["cache"].(bool),
+		}
+	}
+	return volumes
+}
+
+func cloudProjectAiVolumesToList(volumes []CloudProjectAiVolume) []interface{} {
+	list := make([]interface{}, 0, len(volumes))
+	for _, v := range volumes {
+		if v.DataStore == nil {
+			continue
+		}
+		list = append(list, map[string]interface{}{
+			"container":  v.DataStore.Container,
+			"alias":      v.DataStore.Alias,
+			"prefix":     v.DataStore.Prefix,
+			"mount_path": v.MountPath,
+			"permission": v.Permission,
+			"cache":      v.Cache,
+		})
+	}
+	return list
+}
+
+// cloudProjectAiSetComputed sets the attributes shared by the notebooks and
+// jobs read from the API.
+func cloudProjectAiSetComputed(d *schema.ResourceData, resources CloudProjectAiResources, labels map[string]string, volumes []CloudProjectAiVolume, status CloudProjectAiStatus, createdAt string) {
+	d.Set("flavor", resources.Flavor)
+	d.Set("cpu", resources.Cpu)
+	d.Set("gpu", resources.Gpu)
+	d.Set("labels", cloudProjectAiUserLabels(labels))
+	d.Set("volume", cloudProjectAiVolumesToList(volumes))
+	d.Set("state", status.State)
+	d.Set("url", status.Url)
+	d.Set("created_at", createdAt)
+}
+
+// cloudProjectAiUserLabels returns the labels set by the user: the platform
+// adds its own ones, prefixed by "ovh/".
+func cloudProjectAiUserLabels(labels map[string]string) map[string]string {
+	user := map[string]string{}
+	for k, v := range labels {
+		if !strings.HasPrefix(k, "ovh/") {
+			user[k] = v
+		}
+	}
+	return user
+}
+
+// cloudProjectAiStatusTask reads the status of a notebook or job for a
+// taskPoller.
+type cloudProjectAiStatusTask struct {
+	Status CloudProjectAiStatus `json:"status"`
+}
+
+func (t *cloudProjectAiStatusTask) TaskStatus() string {
+	return t.Status.State
+}
+
+func (t *cloudProjectAiStatusTask) taskComment() string {
+	return t.Status.message()
+}
+
+// cloudProjectAiStateWait waits for a notebook or job to reach one of the
+// target states, failing as soon as it reaches one of the failed states. A
+// notebook or job which can't be found anymore is reported as "DELETED".
+func cloudProjectAiStateWait(c *ovh.Client, projectId, kind, id string, pending, target, failed []string, timeout time.Duration) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/cloud/project/%s/ai/%s/%s", projectId, kind, id),
+		Description: fmt.Sprintf("AI %s %s", kind, id),
+		Task:        &cloudProjectAiStatusTask{},
+		Pending:     pending,
+		Target:      target,
+		Failed:      failed,
+		Purged:      "DELETED",
+		Timeout:     timeout,
+		MinTimeout:  5 * time.Second,
+	}).Wait(c)
+	return err
+}
@@ -0,0 +1,57 @@
+package ovh
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCloudProjectAiVolumes(t *testing.T) {
+	list := []interface{}{
+		map[string]interface{}{
+			"container":  "datasets",
+			"alias":      "gra",
+			"prefix":     "mnist/",
+			"mount_path": "/workspace/data",
+			"permission": "RO",
+			"cache":      true,
+		},
+	}
+
+	volumes := cloudProjectAiVolumesFromList(list)
+	if len(volumes) != 1 {
+		t.Fatalf("expected 1 volume, got %d", len(volumes))
+	}
+	if volumes[0].DataStore.Alias != "GRA" || volumes[0].DataStore.Container != "datasets" || !volumes[0].Cache {
+		t.Errorf("unexpected volume %+v", volumes[0])
+	}
+
+	back := cloudProjectAiVolumesToList(volumes)
+	expected := map[string]interface{}{
+		"container":  "datasets",
+		"alias":      "GRA",
+		"prefix":     "mnist/",
+		"mount_path": "/workspace/data",
+		"permission": "RO",
+		"cache":      true,
+	}
+	if !reflect.DeepEqual(back[0], expected) {
+		t.Errorf("expected %v, got %v", expected, back[0])
+	}
+}
+
+func TestCloudProjectAiUserLabels(t *testing.T) {
+	labels := map[string]string{
+		"team":         "data",
+		"ovh/id":       "d1b6ad1c",
+		"ovh/type":     "notebook",
+		"ovhcloud-env": "prod",
+	}
+
+	expected := map[string]string{
+		"team":         "data",
+		"ovhcloud-env": "prod",
+	}
+	if got := cloudProjectAiUserLabels(labels); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
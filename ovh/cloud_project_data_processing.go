@@ -0,0 +1,152 @@
+package ovh
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type CloudProjectDataProcessingEngineParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type CloudProjectDataProcessingJobCreateOpts struct {
+	Name             string                                      `json:"name"`
+	Region           string                                      `json:"region"`
+	Engine           string                                      `json:"engine"`
+	EngineVersion    string                                      `json:"engineVersion"`
+	ContainerName    string                                      `json:"containerName"`
+	EngineParameters []CloudProjectDataProcessingEngineParameter `json:"engineParameters"`
+}
+
+func (opts *CloudProjectDataProcessingJobCreateOpts) String() string {
+	return fmt.Sprintf("name: %s, region: %s, engine: %s %s, container: %s", opts.Name, opts.Region, opts.Engine, opts.EngineVersion, opts.ContainerName)
+}
+
+type CloudProjectDataProcessingJob struct {
+	Id               string                                      `json:"id"`
+	Name             string                                      `json:"name"`
+	Region           string                                      `json:"region"`
+	Engine           string                                      `json:"engine"`
+	EngineVersion    string                                      `json:"engineVersion"`
+	ContainerName    string                                      `json:"containerName"`
+	EngineParameters []CloudProjectDataProcessingEngineParameter `json:"engineParameters"`
+	Status           string                                      `json:"status"`
+	CreationDate     string                                      `json:"creationDate"`
+	StartDate        *string                                     `json:"startDate"`
+	EndDate          *string                                     `json:"endDate"`
+}
+
+func (j *CloudProjectDataProcessingJob) String() string {
+	return fmt.Sprintf("DataProcessingJob[id: %s, name: %s, status: %s]", j.Id, j.Name, j.Status)
+}
+
+// Parameters returns the engine parameters of the job by name.
+func (j *CloudProjectDataProcessingJob) Parameters() map[string]string {
+	params := map[string]string{}
+	for _, p := range j.EngineParameters {
+		params[p.Name] = p.Value
+	}
+	return params
+}
+
+// cloudProjectDataProcessingJobEndedStatuses are the statuses of the jobs
+// which are over, successfully or not.
+var cloudProjectDataProcessingJobEndedStatuses = []string{"COMPLETED", "FAILED", "TERMINATED"}
+
+func cloudProjectDataProcessingJobEnded(status string) bool {
+	for _, s := range cloudProjectDataProcessingJobEndedStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// cloudProjectDataProcessingMemoryOverhead returns the memory overhead, in MB,
+// Spark reserves by default for a driver or an executor given its memory.
+func cloudProjectDataProcessingMemoryOverhead(memory int) int {
+	overhead := memory / 10
+	if overhead < 384 {
+		return 384
+	}
+	return overhead
+}
+
+// cloudProjectDataProcessingSparkParameters builds the engine parameters of a
+// Spark job from its configuration.
+func cloudProjectDataProcessingSparkParameters(d *schema.ResourceData) ([]CloudProjectDataProcessingEngineParameter, error) {
+	jobType := d.Get("job_type").(string)
+	mainClassName := d.Get("main_class_name").(string)
+	if jobType == "java" && mainClassName == "" {
+		return nil, fmt.Errorf("main_class_name is required for java jobs")
+	}
+	if jobType == "python" && mainClassName != "" {
+		return nil, fmt.Errorf("main_class_name can't be set on python jobs")
+	}
+
+	driverMemory := d.Get("driver_memory").(int)
+	driverMemoryOverhead := d.Get("driver_memory_overhead").(int)
+	if driverMemoryOverhead == 0 {
+		driverMemoryOverhead = cloudProjectDataProcessingMemoryOverhead(driverMemory)
+	}
+	executorMemory := d.Get("executor_memory").(int)
+	executorMemoryOverhead := d.Get("executor_memory_overhead").(int)
+	if executorMemoryOverhead == 0 {
+		executorMemoryOverhead = cloudProjectDataProcessingMemoryOverhead(executorMemory)
+	}
+
+	params := []CloudProjectDataProcessingEngineParameter{
+		{Name: "main_application_code", Value: d.Get("main_application_code").(string)},
+		{Name: "job_type", Value: jobType},
+		{Name: "driver_cores", Value: strconv.Itoa(d.Get("driver_cores").(int))},
+		{Name: "driver_memory", Value: strconv.Itoa(driverMemory)},
+		{Name: "driver_memory_overhead", Value: strconv.Itoa(driverMemoryOverhead)},
+		{Name: "executor_num", Value: strconv.Itoa(d.Get("executor_num").(int))},
+		{Name: "executor_cores", Value: strconv.Itoa(d.Get("executor_cores").(int))},
+		{Name: "executor_memory", Value: strconv.Itoa(executorMemory)},
+		{Name: "executor_memory_overhead", Value: strconv.Itoa(executorMemoryOverhead)},
+	}
+	if mainClassName != "" {
+		params = append(params, CloudProjectDataProcessingEngineParameter{Name: "main_class_name", Value: mainClassName})
+	}
+
+	// the arguments are given to the API as a comma separated list
+	args := []string{}
+	for _, a := range d.Get("arguments").([]interface{}) {
+		if strings.Contains(a.(string), ",") {
+			return nil, fmt.Errorf("arguments can't contain commas: %q", a)
+		}
+		args = append(args, a.(string))
+	}
+	if len(args) > 0 {
+		params = append(params, CloudProjectDataProcessingEngineParameter{Name: "arguments", Value: strings.Join(args, ",")})
+	}
+
+	return params, nil
+}
+
+func (j *CloudProjectDataProcessingJob) TaskStatus() string {
+	return j.Status
+}
+
+// cloudProjectDataProcessingJobWait waits for a data processing job to reach
+// one of the target statuses.
+func cloudProjectDataProcessingJobWait(c *ovh.Client, projectId, id string, pending, target []string, timeout time.Duration) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/cloud/project/%s/dataProcessing/jobs/%s", projectId, id),
+		Description: fmt.Sprintf("data processing job %s", id),
+		Task:        &CloudProjectDataProcessingJob{},
+		Pending:     pending,
+		Target:      target,
+		Timeout:     timeout,
+		MinTimeout:  5 * time.Second,
+	}).Wait(c)
+	return err
+}
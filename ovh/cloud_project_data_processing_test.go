@@ -0,0 +1,83 @@
+package ovh
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestCloudProjectDataProcessingMemoryOverhead(t *testing.T) {
+	cases := map[int]int{
+		512:   384,
+		4096:  409,
+		16384: 1638,
+	}
+	for memory, expected := range cases {
+		if got := cloudProjectDataProcessingMemoryOverhead(memory); got != expected {
+			t.Errorf("memory %d: expected overhead %d, got %d", memory, expected, got)
+		}
+	}
+}
+
+func TestCloudProjectDataProcessingSparkParameters(t *testing.T) {
+	r := resourceCloudDataProcessingJob()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"main_application_code":    "wordcount.py",
+		"arguments":                []interface{}{"input.txt", "output"},
+		"executor_num":             2,
+		"executor_memory_overhead": 512,
+	})
+	params, err := cloudProjectDataProcessingSparkParameters(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []CloudProjectDataProcessingEngineParameter{
+		{Name: "main_application_code", Value: "wordcount.py"},
+		{Name: "job_type", Value: "python"},
+		{Name: "driver_cores", Value: "1"},
+		{Name: "driver_memory", Value: "4096"},
+		{Name: "driver_memory_overhead", Value: "409"},
+		{Name: "executor_num", Value: "2"},
+		{Name: "executor_cores", Value: "1"},
+		{Name: "executor_memory", Value: "4096"},
+		{Name: "executor_memory_overhead", Value: "512"},
+		{Name: "arguments", Value: "input.txt,output"},
+	}
+	if !reflect.DeepEqual(params, expected) {
+		t.Errorf("expected %v, got %v", expected, params)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"main_application_code": "wordcount.jar",
+		"job_type":              "java",
+	})
+	if _, err := cloudProjectDataProcessingSparkParameters(d); err == nil {
+		t.Errorf("expected an error for a java job without main class")
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"main_application_code": "wordcount.py",
+		"arguments":             []interface{}{"a,b"},
+	})
+	if _, err := cloudProjectDataProcessingSparkParameters(d); err == nil {
+		t.Errorf("expected an error for an argument with a comma")
+	}
+}
+
+func TestCloudProjectDataProcessingJobEnded(t *testing.T) {
+	if !cloudProjectDataProcessingJobEnded("TERMINATED") || cloudProjectDataProcessingJobEnded("CANCELLING") {
+		t.Errorf("unexpected ended statuses")
+	}
+
+	j := &CloudProjectDataProcessingJob{
+		EngineParameters: []CloudProjectDataProcessingEngineParameter{
+			{Name: "job_type", Value: "java"},
+		},
+	}
+	if j.Parameters()["job_type"] != "java" {
+		t.Errorf("unexpected parameters %v", j.Parameters())
+	}
+}
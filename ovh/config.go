@@ -11,11 +11,19 @@ import (
 )
 
 type Config struct {
-	Endpoint          string
-	ApplicationKey    string
-	ApplicationSecret string
-	ConsumerKey       string
-	OVHClient         *ovh.Client
+	Endpoint                   string
+	ApplicationKey             string
+	ApplicationSecret          string
+	ConsumerKey                string
+	DisableApiCache            bool
+	ValidateServiceNames       bool
+	CheckDomainZonePropagation bool
+	DisallowDeprecated         bool
+	ExtraUserAgent             string
+	OVHClient                  *ovh.Client
+	OVHClientV2                *OVHClientV2
+
+	domainZoneBatcher *domainZoneBatcher
 }
 
 type OvhAuthCurrentCredential struct {
@@ -69,6 +77,12 @@ func (c *Config) loadAndValidate() error {
 	}
 
 	httpClient.Transport = logging.NewTransport("OVH", httpClient.Transport)
+	httpClient.Transport = newUserAgentTransport(httpClient.Transport, userAgent(c.ExtraUserAgent))
+
+	// the cache lives as long as the provider, i.e. one terraform operation
+	if !c.DisableApiCache {
+		httpClient.Transport = newApiCacheTransport(httpClient.Transport)
+	}
 
 	var cred OvhAuthCurrentCredential
 	err = targetClient.Get("/auth/currentCredential", &cred)
@@ -78,6 +92,8 @@ func (c *Config) loadAndValidate() error {
 
 	log.Printf("[DEBUG] Logged in on OVH API")
 	c.OVHClient = targetClient
+	c.OVHClientV2 = newOVHClientV2(targetClient, ovh.Endpoints[c.Endpoint])
+	c.domainZoneBatcher = newDomainZoneBatcher(targetClient)
 
 	return nil
 }
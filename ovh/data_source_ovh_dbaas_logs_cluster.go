@@ -0,0 +1,105 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDbaasLogsCluster() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDbaasLogsClusterRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			"cluster_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_unlocked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"direct_input_allowed_networks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"query_allowed_networks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDbaasLogsClusterRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	ids := []string{}
+	if v, ok := d.GetOk("cluster_id"); ok {
+		ids = append(ids, v.(string))
+	} else {
+		endpoint := fmt.Sprintf("/dbaas/logs/%s/cluster", serviceName)
+		if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+			return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+		}
+	}
+
+	var cluster *DbaasLogsCluster
+	for _, id := range ids {
+		r := &DbaasLogsCluster{}
+		endpoint := fmt.Sprintf("/dbaas/logs/%s/cluster/%s", serviceName, id)
+		if err := config.OVHClient.Get(endpoint, r); err != nil {
+			return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+		}
+
+		// without an explicit id, the default cluster of the service is used
+		if len(ids) == 1 || r.IsDefault {
+			cluster = r
+			break
+		}
+	}
+
+	if cluster == nil {
+		return fmt.Errorf("No default cluster found for logs service %s", serviceName)
+	}
+
+	log.Printf("[DEBUG] Read logs %s cluster %s", serviceName, cluster)
+
+	d.SetId(cluster.ClusterId)
+	d.Set("cluster_id", cluster.ClusterId)
+	d.Set("cluster_type", cluster.ClusterType)
+	d.Set("hostname", cluster.Hostname)
+	d.Set("is_default", cluster.IsDefault)
+	d.Set("is_unlocked", cluster.IsUnlocked)
+	d.Set("region", cluster.Region)
+	d.Set("direct_input_allowed_networks", cluster.DirectInputAllowedNetworks)
+	d.Set("query_allowed_networks", cluster.QueryAllowedNetworks)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccDbaasLogsClusterDatasourceConfig = `
+data "ovh_dbaas_logs_cluster" "cluster" {
+  service_name = "%s"
+}
+`
+
+func TestAccDbaasLogsClusterDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DBAAS_LOGS_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckDbaasLogsPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDbaasLogsClusterDatasourceConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_dbaas_logs_cluster.cluster", "cluster_id"),
+					resource.TestCheckResourceAttrSet("data.ovh_dbaas_logs_cluster.cluster", "hostname"),
+					resource.TestCheckResourceAttr("data.ovh_dbaas_logs_cluster.cluster", "is_default", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDbaasLogsPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// logs data platform is an optional product
+	// these resources are tested only if env var `OVH_DBAAS_LOGS_SERVICE`
+	// is set
+	if os.Getenv("OVH_DBAAS_LOGS_SERVICE") == "" {
+		t.Skip("OVH_DBAAS_LOGS_SERVICE must be set to test logs data platform")
+	}
+}
@@ -0,0 +1,68 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDbaasLogsInputEngine() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDbaasLogsInputEngineRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"is_deprecated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func dataSourceDbaasLogsInputEngineRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+	isDeprecated := d.Get("is_deprecated").(bool)
+
+	ids := []string{}
+	endpoint := "/dbaas/logs/input/engine"
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	engines := make([]*DbaasLogsInputEngine, len(ids))
+	err := fetchConcurrently(len(ids), func(i int) error {
+		r := &DbaasLogsInputEngine{}
+		engineEndpoint := fmt.Sprintf("%s/%s", endpoint, ids[i])
+		if err := config.OVHClient.Get(engineEndpoint, r); err != nil {
+			return fmt.Errorf("Error calling %s:\n\t %q", engineEndpoint, err)
+		}
+		engines[i] = r
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range engines {
+		log.Printf("[DEBUG] Read logs input engine %s", r)
+
+		if strings.EqualFold(r.Name, name) && r.SoftwareVersion == version && r.IsDeprecated == isDeprecated {
+			d.SetId(r.EngineId)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("No logs input engine found with name %s, version %s and is_deprecated %t", name, version, isDeprecated)
+}
@@ -0,0 +1,29 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccDbaasLogsInputEngineDatasourceConfig = `
+data "ovh_dbaas_logs_input_engine" "logstash" {
+  name    = "logstash"
+  version = "6.x"
+}
+`
+
+func TestAccDbaasLogsInputEngineDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbaasLogsInputEngineDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_dbaas_logs_input_engine.logstash", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,129 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDedicatedCeph() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDedicatedCephRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"ceph_mons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ceph_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"crush_tunables": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"users": {
+				Type:      schema.TypeList,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mds_caps": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mon_caps": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"osd_caps": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedCephRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &DedicatedCeph{}
+	endpoint := fmt.Sprintf("/dedicated/ceph/%s", serviceName)
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Read ceph %s", r)
+
+	users := []*DedicatedCephUser{}
+	endpoint = fmt.Sprintf("/dedicated/ceph/%s/user", serviceName)
+	if err := config.OVHClient.Get(endpoint, &users); err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(serviceName)
+	d.Set("ceph_mons", r.CephMons)
+	d.Set("ceph_version", r.CephVersion)
+	d.Set("crush_tunables", r.CrushTunables)
+	d.Set("label", r.Label)
+	d.Set("region", r.Region)
+	d.Set("size", r.Size)
+	d.Set("state", r.State)
+	d.Set("status", r.Status)
+
+	usersList := make([]map[string]interface{}, len(users))
+	for i, user := range users {
+		usersList[i] = map[string]interface{}{
+			"name":     user.Name,
+			"key":      user.Key,
+			"mds_caps": user.MdsCaps,
+			"mon_caps": user.MonCaps,
+			"osd_caps": user.OsdCaps,
+		}
+	}
+	if err := d.Set("users", usersList); err != nil {
+		return fmt.Errorf("Error setting users of ceph %s: %s", serviceName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDedicatedCephDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CEPH_SERVICE")
+	config := fmt.Sprintf(testAccDedicatedCephDatasourceConfig_Basic, serviceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckDedicatedCephPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ovh_dedicated_ceph.ceph", "id", serviceName),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_dedicated_ceph.ceph", "ceph_mons.#"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_dedicated_ceph.ceph", "ceph_version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDedicatedCephPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// dedicated ceph is an optional product
+	// this resource is tested only if env var `OVH_CEPH_SERVICE`
+	// is set
+	if os.Getenv("OVH_CEPH_SERVICE") == "" {
+		t.Skip("OVH_CEPH_SERVICE must be set to test dedicated ceph")
+	}
+}
+
+const testAccDedicatedCephDatasourceConfig_Basic = `
+data "ovh_dedicated_ceph" "ceph" {
+  service_name = "%s"
+}
+`
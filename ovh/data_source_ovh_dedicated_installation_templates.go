@@ -0,0 +1,42 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDedicatedInstallationTemplates() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDedicatedInstallationTemplatesRead,
+		Schema: map[string]*schema.Schema{
+			// Computed
+			"templates": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedInstallationTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Will list dedicated installation templates")
+
+	templates := []string{}
+	endpoint := "/dedicated/installationTemplate"
+	err := config.OVHClient.Get(endpoint, &templates)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(hashcode.Strings(templates))
+	d.Set("templates", templates)
+
+	return nil
+}
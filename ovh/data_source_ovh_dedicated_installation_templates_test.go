@@ -0,0 +1,26 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccDedicatedInstallationTemplatesDatasourceConfig = `
+data "ovh_dedicated_installation_templates" "templates" {}
+`
+
+func TestAccDedicatedInstallationTemplatesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedInstallationTemplatesDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_dedicated_installation_templates.templates", "templates.#"),
+				),
+			},
+		},
+	})
+}
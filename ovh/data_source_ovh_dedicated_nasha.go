@@ -0,0 +1,80 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDedicatedNasha() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDedicatedNashaRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"custom_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datacenter": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disk_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitored": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"zpool_capacity": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"zpool_size": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"can_create_partition": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedNashaRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &DedicatedNasha{}
+	endpoint := fmt.Sprintf("/dedicated/nasha/%s", serviceName)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Read nasha %s", r)
+
+	d.SetId(serviceName)
+	d.Set("custom_name", r.CustomName)
+	d.Set("datacenter", r.Datacenter)
+	d.Set("disk_type", r.DiskType)
+	d.Set("ip", r.Ip)
+	d.Set("monitored", r.Monitored)
+	d.Set("zpool_capacity", r.ZpoolCapacity)
+	d.Set("zpool_size", r.ZpoolSize)
+	d.Set("can_create_partition", r.CanCreatePartition)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDedicatedNashaDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_NASHA_SERVICE")
+	config := fmt.Sprintf(testAccDedicatedNashaDatasourceConfig_Basic, serviceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckDedicatedNashaPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ovh_dedicated_nasha.nas", "id", serviceName),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_dedicated_nasha.nas", "ip"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_dedicated_nasha.nas", "zpool_size"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDedicatedNashaPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// NAS-HA is an optional product
+	// this resource is tested only if env var `OVH_NASHA_SERVICE`
+	// is set
+	if os.Getenv("OVH_NASHA_SERVICE") == "" {
+		t.Skip("OVH_NASHA_SERVICE must be set to test NAS-HA")
+	}
+}
+
+const testAccDedicatedNashaDatasourceConfig_Basic = `
+data "ovh_dedicated_nasha" "nas" {
+  service_name = "%s"
+}
+`
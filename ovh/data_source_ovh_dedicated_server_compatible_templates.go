@@ -0,0 +1,54 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDedicatedServerCompatibleTemplates() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDedicatedServerCompatibleTemplatesRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"ovh": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"personal": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedServerCompatibleTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will list templates compatible with dedicated server %s", serviceName)
+
+	r := &DedicatedServerCompatibleTemplates{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/install/compatibleTemplates", serviceName)
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(serviceName)
+	d.Set("ovh", r.Ovh)
+	d.Set("personal", r.Personal)
+
+	return nil
+}
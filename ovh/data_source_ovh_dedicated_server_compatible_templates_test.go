@@ -0,0 +1,33 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccDedicatedServerCompatibleTemplatesDatasourceConfig = `
+data "ovh_dedicated_server_compatible_templates" "templates" {
+  service_name = "%s"
+}
+`
+
+func TestAccDedicatedServerCompatibleTemplatesDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckDedicatedServerPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerCompatibleTemplatesDatasourceConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_dedicated_server_compatible_templates.templates", "id", serviceName),
+					resource.TestCheckResourceAttrSet("data.ovh_dedicated_server_compatible_templates.templates", "ovh.#"),
+				),
+			},
+		},
+	})
+}
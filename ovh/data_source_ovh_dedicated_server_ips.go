@@ -0,0 +1,180 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDedicatedServerIps() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDedicatedServerIpsRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"dedicated", "failover", "vrack"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"blocks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ipv4_blocks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ipv6_blocks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"country": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedServerIpsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf("/dedicated/server/%s/ips", serviceName)
+	routed := []string{}
+	if err := config.OVHClient.Get(endpoint, &routed); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+	sort.Strings(routed)
+
+	ips := make([]*OvhIp, len(routed))
+	err := fetchConcurrently(len(routed), func(i int) error {
+		r := &OvhIp{}
+		endpoint := fmt.Sprintf("/ip/%s", strings.Replace(routed[i], "/", "%2F", 1))
+		if err := config.OVHClient.Get(endpoint, r); err != nil {
+			return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+		}
+
+		log.Printf("[DEBUG] Read dedicated server %s %s", serviceName, r)
+		ips[i] = r
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	ipType := d.Get("type").(string)
+	blocks := []string{}
+	ipv4Blocks := []string{}
+	ipv6Blocks := []string{}
+	details := []interface{}{}
+	for i, r := range ips {
+		if ipType != "" && r.Type != ipType {
+			continue
+		}
+
+		ip, version, err := dedicatedServerIpBlockAddress(routed[i])
+		if err != nil {
+			return err
+		}
+
+		blocks = append(blocks, routed[i])
+		if version == 4 {
+			ipv4Blocks = append(ipv4Blocks, routed[i])
+		} else {
+			ipv6Blocks = append(ipv6Blocks, routed[i])
+		}
+
+		details = append(details, map[string]interface{}{
+			"block":       routed[i],
+			"ip":          ip,
+			"version":     version,
+			"type":        r.Type,
+			"description": r.Description,
+			"country":     r.Country,
+		})
+	}
+
+	if ipType != "" {
+		d.SetId(fmt.Sprintf("%s/%s", serviceName, ipType))
+	} else {
+		d.SetId(serviceName)
+	}
+	d.Set("blocks", blocks)
+	d.Set("ipv4_blocks", ipv4Blocks)
+	d.Set("ipv6_blocks", ipv6Blocks)
+	d.Set("details", details)
+
+	return nil
+}
+
+// dedicatedServerIpBlockAddress returns the first address of an ip block,
+// which is the one to use for single ip blocks such as failover ips, and
+// its ip version.
+func dedicatedServerIpBlockAddress(block string) (string, int, error) {
+	if !strings.Contains(block, "/") {
+		block = block + "/32"
+		if strings.Contains(block, ":") {
+			block = strings.Replace(block, "/32", "/128", 1)
+		}
+	}
+
+	ip, _, err := net.ParseCIDR(block)
+	if err != nil {
+		return "", 0, fmt.Errorf("Invalid ip block %s: %s", block, err)
+	}
+
+	if ip.To4() != nil {
+		return ip.String(), 4, nil
+	}
+	return ip.String(), 6, nil
+}
@@ -0,0 +1,63 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccDedicatedServerIpsDatasourceConfig = `
+data "ovh_dedicated_server_ips" "ips" {
+  service_name = "%s"
+}
+`
+
+func TestAccDedicatedServerIpsDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckDedicatedServerPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerIpsDatasourceConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_dedicated_server_ips.ips", "id", serviceName),
+					resource.TestCheckResourceAttrSet("data.ovh_dedicated_server_ips.ips", "details.0.block"),
+					resource.TestCheckResourceAttrSet("data.ovh_dedicated_server_ips.ips", "details.0.type"),
+				),
+			},
+		},
+	})
+}
+
+func TestDedicatedServerIpBlockAddress(t *testing.T) {
+	cases := []struct {
+		block   string
+		ip      string
+		version int
+	}{
+		{"192.0.2.8/29", "192.0.2.8", 4},
+		{"192.0.2.42/32", "192.0.2.42", 4},
+		{"192.0.2.42", "192.0.2.42", 4},
+		{"2001:db8:1:2::/64", "2001:db8:1:2::", 6},
+		{"2001:db8::1", "2001:db8::1", 6},
+	}
+
+	for _, c := range cases {
+		ip, version, err := dedicatedServerIpBlockAddress(c.block)
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", c.block, err)
+			continue
+		}
+		if ip != c.ip || version != c.version {
+			t.Errorf("%s: expected %s (v%d), got %s (v%d)", c.block, c.ip, c.version, ip, version)
+		}
+	}
+
+	if _, _, err := dedicatedServerIpBlockAddress("not-an-ip"); err == nil {
+		t.Errorf("expected an error for an invalid block")
+	}
+}
@@ -0,0 +1,95 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDedicatedServerNetworkInterfaceControllers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDedicatedServerNetworkInterfaceControllersRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"link_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"isolated", "private", "private_lag", "provisioning", "public", "public_lag"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"controllers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mac": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"link_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"virtual_network_interface": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedServerNetworkInterfaceControllersRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf("/dedicated/server/%s/networkInterfaceController", serviceName)
+	if linkType := d.Get("link_type").(string); linkType != "" {
+		endpoint = fmt.Sprintf("%s?linkType=%s", endpoint, url.QueryEscape(linkType))
+	}
+
+	macs := []string{}
+	if err := config.OVHClient.Get(endpoint, &macs); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	controllers := make([]interface{}, len(macs))
+	err := fetchConcurrently(len(macs), func(i int) error {
+		r := &DedicatedServerNetworkInterfaceController{}
+		endpoint := fmt.Sprintf("/dedicated/server/%s/networkInterfaceController/%s", serviceName, macs[i])
+		if err := config.OVHClient.Get(endpoint, r); err != nil {
+			return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+		}
+
+		log.Printf("[DEBUG] Read dedicated server %s %s", serviceName, r)
+
+		controllers[i] = map[string]interface{}{
+			"mac":                       r.Mac,
+			"link_type":                 r.LinkType,
+			"virtual_network_interface": r.VirtualNetworkInterface,
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serviceName)
+	d.Set("controllers", controllers)
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccDedicatedServerNetworkInterfaceControllersDatasourceConfig = `
+data "ovh_dedicated_server_network_interface_controllers" "nics" {
+  service_name = "%s"
+  link_type    = "public"
+}
+`
+
+func TestAccDedicatedServerNetworkInterfaceControllersDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckDedicatedServerPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerNetworkInterfaceControllersDatasourceConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_dedicated_server_network_interface_controllers.nics", "id", serviceName),
+					resource.TestCheckResourceAttr("data.ovh_dedicated_server_network_interface_controllers.nics", "controllers.0.link_type", "public"),
+					resource.TestCheckResourceAttrSet("data.ovh_dedicated_server_network_interface_controllers.nics", "controllers.0.mac"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,132 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDedicatedServers() *schema.Resource {
+	s := serviceListFilterSchema()
+
+	// Computed
+	s["service_names"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s["servers"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"service_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"display_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"datacenter": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"reverse": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"ip": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"commercial_range": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rack": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"os": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceDedicatedServersRead,
+		Schema: s,
+	}
+}
+
+func dataSourceDedicatedServersRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	filter, err := serviceListFilterFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	names := []string{}
+	endpoint := "/dedicated/server"
+	if err := config.OVHClient.Get(endpoint, &names); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+	sort.Strings(names)
+
+	servers := make([]*DedicatedServer, len(names))
+	err = fetchConcurrently(len(names), func(i int) error {
+		r := &DedicatedServer{}
+		endpoint := fmt.Sprintf("/dedicated/server/%s", names[i])
+		if err := config.OVHClient.Get(endpoint, r); err != nil {
+			return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+		}
+
+		servers[i] = r
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Will filter %d dedicated servers with %s", len(servers), filter)
+
+	serviceNames := []string{}
+	details := []interface{}{}
+	for _, s := range servers {
+		if !filter.MatchDatacenter(s.Datacenter) ||
+			!filter.MatchDisplayName(s.DisplayName()) ||
+			!filter.MatchReverse([]string{s.Reverse}) {
+			continue
+		}
+
+		serviceNames = append(serviceNames, s.Name)
+		details = append(details, map[string]interface{}{
+			"service_name":     s.Name,
+			"display_name":     s.DisplayName(),
+			"datacenter":       s.Datacenter,
+			"reverse":          s.Reverse,
+			"ip":               s.Ip,
+			"commercial_range": s.CommercialRange,
+			"state":            s.State,
+			"rack":             s.Rack,
+			"os":               s.Os,
+		})
+	}
+
+	d.SetId(hashcode.Strings(serviceNames))
+	d.Set("service_names", serviceNames)
+	d.Set("servers", details)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccDedicatedServersDatasourceConfig = `
+data "ovh_dedicated_servers" "all" {}
+
+data "ovh_dedicated_servers" "datacenter" {
+  datacenter = "${data.ovh_dedicated_servers.all.servers.0.datacenter}"
+}
+
+data "ovh_dedicated_servers" "none" {
+  reverse_regex = "^does-not-exist\\."
+}
+`
+
+func TestAccDedicatedServersDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckDedicatedServerPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedServersDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_dedicated_servers.all", "servers.0.reverse"),
+					resource.TestCheckResourceAttrSet("data.ovh_dedicated_servers.datacenter", "service_names.0"),
+					resource.TestCheckResourceAttr("data.ovh_dedicated_servers.none", "service_names.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,180 @@
+package ovh
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// DomainZoneDnssec is the DNSSEC status of a zone.
+type DomainZoneDnssec struct {
+	Status string `json:"status"`
+}
+
+// DomainDsRecord is a DNSSEC key published at the registry for a domain.
+type DomainDsRecord struct {
+	Id        int64  `json:"id"`
+	Tag       int    `json:"tag"`
+	Algorithm int    `json:"algorithm"`
+	Flags     int    `json:"flags"`
+	PublicKey string `json:"publicKey"`
+	Status    string `json:"status"`
+}
+
+func (r *DomainDsRecord) String() string {
+	return fmt.Sprintf("DsRecord[id: %d, tag: %d, algorithm: %d, flags: %d, status: %s]", r.Id, r.Tag, r.Algorithm, r.Flags, r.Status)
+}
+
+func dataSourceDomainZoneDnssec() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDomainZoneDnssecRead,
+		Schema: map[string]*schema.Schema{
+			"zone_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tag": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"algorithm": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"flags": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"public_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"digest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ds_record": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dnssecDsDigest computes the SHA-256 digest of the DS record of a DNSKEY,
+// as defined by RFC 4034 and RFC 4509.
+func dnssecDsDigest(zoneName string, flags, algorithm int, publicKey string) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.Replace(publicKey, " ", "", -1))
+	if err != nil {
+		return "", fmt.Errorf("Public key is not base64 encoded: %q", err)
+	}
+
+	// the owner name in canonical wire format, followed by the DNSKEY rdata:
+	// flags, protocol (always 3) and algorithm, then the key itself
+	buf := []byte{}
+	for _, label := range strings.Split(strings.TrimSuffix(strings.ToLower(zoneName), "."), ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return "", fmt.Errorf("%s is not a valid zone name", zoneName)
+		}
+		buf = append(buf, byte(len(label)))
+		buf = append(buf, label...)
+	}
+	buf = append(buf, 0)
+
+	rdata := make([]byte, 4)
+	binary.BigEndian.PutUint16(rdata, uint16(flags))
+	rdata[2] = 3
+	rdata[3] = byte(algorithm)
+	buf = append(buf, rdata...)
+	buf = append(buf, key...)
+
+	digest := sha256.Sum256(buf)
+	return strings.ToUpper(hex.EncodeToString(digest[:])), nil
+}
+
+func dataSourceDomainZoneDnssecRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zoneName := d.Get("zone_name").(string)
+
+	dnssec := &DomainZoneDnssec{}
+	endpoint := fmt.Sprintf("/domain/zone/%s/dnssec", zoneName)
+	if err := config.OVHClient.Get(endpoint, dnssec); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	// the keys are only known when the domain itself is registered at OVH
+	ids := []int64{}
+	endpoint = fmt.Sprintf("/domain/%s/dsRecord", zoneName)
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		if apiErr, ok := err.(*ovh.APIError); !ok || apiErr.Code != 404 {
+			return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+		}
+		log.Printf("[DEBUG] Domain %s is not registered at OVH, no DS record to read", zoneName)
+	}
+
+	keys := make([]interface{}, len(ids))
+	err := fetchConcurrently(len(ids), func(i int) error {
+		r := &DomainDsRecord{}
+		endpoint := fmt.Sprintf("/domain/%s/dsRecord/%d", zoneName, ids[i])
+		if err := config.OVHClient.Get(endpoint, r); err != nil {
+			return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+		}
+
+		log.Printf("[DEBUG] Read domain %s %s", zoneName, r)
+
+		digest, err := dnssecDsDigest(zoneName, r.Flags, r.Algorithm, r.PublicKey)
+		if err != nil {
+			return fmt.Errorf("Error computing the digest of DS record %d of %s: %q", r.Id, zoneName, err)
+		}
+
+		keys[i] = map[string]interface{}{
+			"id":         int(r.Id),
+			"tag":        r.Tag,
+			"algorithm":  r.Algorithm,
+			"flags":      r.Flags,
+			"public_key": r.PublicKey,
+			"status":     r.Status,
+			"digest":     digest,
+			"ds_record":  fmt.Sprintf("%d %d 2 %s", r.Tag, r.Algorithm, digest),
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(zoneName)
+	d.Set("status", dnssec.Status)
+	d.Set("keys", keys)
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccDomainZoneDnssecDatasourceConfig = `
+data "ovh_domain_zone_dnssec" "dnssec" {
+  zone_name = "%s"
+}
+`
+
+func TestAccDomainZoneDnssecDataSource_basic(t *testing.T) {
+	zoneName := os.Getenv("OVH_ZONE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccCheckDomainZoneExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainZoneDnssecDatasourceConfig, zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_domain_zone_dnssec.dnssec", "id", zoneName),
+					resource.TestCheckResourceAttrSet("data.ovh_domain_zone_dnssec.dnssec", "status"),
+				),
+			},
+		},
+	})
+}
+
+func TestDnssecDsDigest(t *testing.T) {
+	// example of RFC 4509, section 2.3
+	publicKey := "AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZ" +
+		"DRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9Xzc" +
+		"nOf+EPbtG9DMBmADjFDc2w/rljwvFw=="
+	expected := "D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A"
+
+	for _, zoneName := range []string{"dskey.example.com", "DSKEY.example.com."} {
+		digest, err := dnssecDsDigest(zoneName, 256, 5, publicKey)
+		if err != nil {
+			t.Fatalf("dnssecDsDigest(%s) returned an error: %q", zoneName, err)
+		}
+		if digest != expected {
+			t.Errorf("dnssecDsDigest(%s) = %s, want %s", zoneName, digest, expected)
+		}
+	}
+
+	if _, err := dnssecDsDigest("dskey.example.com", 256, 5, "not base64"); err == nil {
+		t.Errorf("dnssecDsDigest should fail on a key which is not base64 encoded")
+	}
+}
@@ -0,0 +1,202 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// emailDomainSpfInclude is the SPF mechanism authorizing the MX Plan servers
+// to send emails for a domain.
+const emailDomainSpfInclude = "include:mx.ovh.com"
+
+func dataSourceEmailDomain() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEmailDomainRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"check_dns_zone": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"allowed_account_size": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"mx_filter": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mx_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"spf_include": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recommended_spf": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceEmailDomainRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	r := &EmailDomain{}
+	endpoint := fmt.Sprintf("/email/domain/%s", domain)
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Read %s", r)
+
+	var mxFilter string
+	endpoint = fmt.Sprintf("/email/domain/%s/dnsMXFilter", domain)
+	if err := config.OVHClient.Get(endpoint, &mxFilter); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	mxRecords := []string{}
+	endpoint = fmt.Sprintf("/email/domain/%s/dnsMXRecord", domain)
+	if err := config.OVHClient.Get(endpoint, &mxRecords); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+	sort.Strings(mxRecords)
+
+	if d.Get("check_dns_zone").(bool) {
+		zone := d.Get("zone").(string)
+		if zone == "" {
+			zone = domain
+		}
+		if err := emailDomainCheckDnsZone(config, zone, mxRecords); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(domain)
+	d.Set("status", r.Status)
+	d.Set("offer", r.Offer)
+	d.Set("creation_date", r.CreationDate)
+	d.Set("allowed_account_size", r.AllowedAccountSize)
+	d.Set("mx_filter", mxFilter)
+	d.Set("mx_records", mxRecords)
+	d.Set("spf_include", emailDomainSpfInclude)
+	d.Set("recommended_spf", fmt.Sprintf("v=spf1 %s ~all", emailDomainSpfInclude))
+
+	return nil
+}
+
+// emailDomainCheckDnsZone checks that the apex of an OVH managed DNS zone has
+// the MX and SPF records required by its email domain.
+func emailDomainCheckDnsZone(config *Config, zone string, mxRecords []string) error {
+	targets := map[string][]string{}
+	for _, fieldType := range []string{"MX", "TXT"} {
+		ids := []int{}
+		endpoint := fmt.Sprintf("/domain/zone/%s/record?fieldType=%s&subDomain=", zone, fieldType)
+		if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+			return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+		}
+
+		records := make([]*OvhDomainZoneRecord, len(ids))
+		err := fetchConcurrently(len(ids), func(i int) error {
+			r := &OvhDomainZoneRecord{}
+			endpoint := fmt.Sprintf("/domain/zone/%s/record/%d", zone, ids[i])
+			if err := config.OVHClient.Get(endpoint, r); err != nil {
+				return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+			}
+			records[i] = r
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, r := range records {
+			targets[fieldType] = append(targets[fieldType], r.Target)
+		}
+	}
+
+	problems := emailDomainDnsZoneProblems(zone, mxRecords, targets["MX"], targets["TXT"])
+	if len(problems) > 0 {
+		return fmt.Errorf("The DNS zone %s doesn't match its email domain:\n\t%s", zone, strings.Join(problems, "\n\t"))
+	}
+
+	return nil
+}
+
+// emailDomainDnsZoneProblems returns what's missing from the MX and TXT
+// targets of the apex of a zone for its email domain to work: the MX records
+// required by the email domain, and an SPF record allowing its servers.
+func emailDomainDnsZoneProblems(zone string, mxRecords, zoneMx, zoneTxt []string) []string {
+	problems := []string{}
+
+	hosts := map[string]bool{}
+	for _, target := range zoneMx {
+		hosts[domainZoneRecordTargetName(zone, emailDomainMxHost(target))] = true
+	}
+	for _, mx := range mxRecords {
+		host := strings.TrimSuffix(strings.ToLower(emailDomainMxHost(mx)), ".") + "."
+		if !hosts[host] {
+			problems = append(problems, fmt.Sprintf("missing MX record to %s", host))
+		}
+	}
+
+	spf := ""
+	for _, target := range zoneTxt {
+		value := domainZoneTxtValue(target)
+		if strings.HasPrefix(strings.ToLower(value), "v=spf1") {
+			spf = value
+			break
+		}
+	}
+	switch {
+	case spf == "":
+		problems = append(problems, fmt.Sprintf("missing SPF record, e.g. \"v=spf1 %s ~all\"", emailDomainSpfInclude))
+	case !strings.Contains(strings.ToLower(spf), emailDomainSpfInclude):
+		problems = append(problems, fmt.Sprintf("SPF record %q doesn't contain %s", spf, emailDomainSpfInclude))
+	}
+
+	return problems
+}
+
+// emailDomainMxHost returns the host of a MX value, which may be prefixed by
+// its priority, e.g. "1 mx1.mail.ovh.net.".
+func emailDomainMxHost(mx string) string {
+	fields := strings.Fields(mx)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[len(fields)-1]
+}
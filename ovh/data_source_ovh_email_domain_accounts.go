@@ -0,0 +1,47 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceEmailDomainAccounts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEmailDomainAccountsRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"accounts": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceEmailDomainAccountsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	log.Printf("[DEBUG] Will list email accounts of domain %s", domain)
+
+	accounts := []string{}
+	endpoint := fmt.Sprintf("/email/domain/%s/account", domain)
+	err := config.OVHClient.Get(endpoint, &accounts)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(domain)
+	d.Set("accounts", accounts)
+
+	return nil
+}
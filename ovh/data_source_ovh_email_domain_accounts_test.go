@@ -0,0 +1,44 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccEmailDomainAccountsDatasourceConfig = `
+data "ovh_email_domain_accounts" "accounts" {
+  domain = "%s"
+}
+`
+
+func TestAccEmailDomainAccountsDataSource_basic(t *testing.T) {
+	domain := os.Getenv("OVH_EMAIL_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckEmailDomainPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccEmailDomainAccountsDatasourceConfig, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_email_domain_accounts.accounts", "id", domain),
+					resource.TestCheckResourceAttrSet("data.ovh_email_domain_accounts.accounts", "accounts.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEmailDomainPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// email domain is an optional product
+	// these resources are tested only if env var `OVH_EMAIL_DOMAIN`
+	// is set
+	if os.Getenv("OVH_EMAIL_DOMAIN") == "" {
+		t.Skip("OVH_EMAIL_DOMAIN must be set to test email domain")
+	}
+}
@@ -0,0 +1,78 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccEmailDomainDatasourceConfig = `
+data "ovh_email_domain" "domain" {
+  domain = "%s"
+}
+`
+
+func TestAccEmailDomainDataSource_basic(t *testing.T) {
+	domain := os.Getenv("OVH_EMAIL_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckEmailDomainPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccEmailDomainDatasourceConfig, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_email_domain.domain", "id", domain),
+					resource.TestCheckResourceAttrSet("data.ovh_email_domain.domain", "status"),
+					resource.TestCheckResourceAttrSet("data.ovh_email_domain.domain", "mx_records.0"),
+					resource.TestCheckResourceAttr("data.ovh_email_domain.domain", "spf_include", "include:mx.ovh.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestEmailDomainDnsZoneProblems(t *testing.T) {
+	mxRecords := []string{"mx1.mail.ovh.net", "mx2.mail.ovh.net."}
+
+	cases := []struct {
+		name     string
+		zoneMx   []string
+		zoneTxt  []string
+		problems int
+	}{
+		{
+			"valid",
+			[]string{"1 mx1.mail.ovh.net.", "5 MX2.mail.ovh.net."},
+			[]string{"\"google-site-verification=xxx\"", "\"v=spf1 include:mx.ovh.com ~all\""},
+			0,
+		},
+		{
+			"relative mx",
+			[]string{"1 mx1.mail.ovh.net", "5 mx2.mail.ovh.net."},
+			[]string{"v=spf1 include:mx.ovh.com -all"},
+			1,
+		},
+		{
+			"no spf",
+			[]string{"1 mx1.mail.ovh.net.", "5 mx2.mail.ovh.net."},
+			nil,
+			1,
+		},
+		{
+			"foreign mx and spf",
+			[]string{"10 mail.example.org."},
+			[]string{"\"v=spf1 include:_spf.example.org ~all\""},
+			3,
+		},
+	}
+
+	for _, c := range cases {
+		problems := emailDomainDnsZoneProblems("example.com", mxRecords, c.zoneMx, c.zoneTxt)
+		if len(problems) != c.problems {
+			t.Errorf("%s: expected %d problems, got %v", c.name, c.problems, problems)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceEmailExchange() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEmailExchangeRead,
+		Schema: map[string]*schema.Schema{
+			"organization_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_send_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_receive_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceEmailExchangeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	organizationName := d.Get("organization_name").(string)
+	serviceName := d.Get("service_name").(string)
+
+	r := &EmailExchangeService{}
+	endpoint := fmt.Sprintf("/email/exchange/%s/service/%s", organizationName, serviceName)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Read exchange %s", r)
+
+	d.SetId(fmt.Sprintf("%s/%s", organizationName, serviceName))
+	d.Set("domain", r.Domain)
+	d.Set("display_name", r.DisplayName)
+	d.Set("hostname", r.Hostname)
+	d.Set("offer", r.Offer)
+	d.Set("state", r.State)
+	d.Set("max_send_size", r.MaxSendSize)
+	d.Set("max_receive_size", r.MaxReceiveSize)
+
+	return nil
+}
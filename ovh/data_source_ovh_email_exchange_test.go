@@ -0,0 +1,47 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccEmailExchangeDatasourceConfig = `
+data "ovh_email_exchange" "exchange" {
+  organization_name = "%s"
+  service_name      = "%s"
+}
+`
+
+func TestAccEmailExchangeDataSource_basic(t *testing.T) {
+	organizationName := os.Getenv("OVH_EMAIL_EXCHANGE_ORGANIZATION")
+	serviceName := os.Getenv("OVH_EMAIL_EXCHANGE_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckEmailExchangePreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccEmailExchangeDatasourceConfig, organizationName, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_email_exchange.exchange", "domain"),
+					resource.TestCheckResourceAttrSet("data.ovh_email_exchange.exchange", "hostname"),
+					resource.TestCheckResourceAttrSet("data.ovh_email_exchange.exchange", "offer"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEmailExchangePreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// exchange is an optional product
+	// these resources are tested only if env vars `OVH_EMAIL_EXCHANGE_ORGANIZATION`
+	// and `OVH_EMAIL_EXCHANGE_SERVICE` are set
+	if os.Getenv("OVH_EMAIL_EXCHANGE_ORGANIZATION") == "" || os.Getenv("OVH_EMAIL_EXCHANGE_SERVICE") == "" {
+		t.Skip("OVH_EMAIL_EXCHANGE_ORGANIZATION and OVH_EMAIL_EXCHANGE_SERVICE must be set to test exchange")
+	}
+}
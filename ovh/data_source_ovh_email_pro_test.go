@@ -0,0 +1,45 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccEmailProDatasourceConfig = `
+data "ovh_email_pro" "pro" {
+  service_name = "%s"
+}
+`
+
+func TestAccEmailProDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_EMAIL_PRO_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckEmailProPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccEmailProDatasourceConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_email_pro.pro", "id", serviceName),
+					resource.TestCheckResourceAttrSet("data.ovh_email_pro.pro", "domain"),
+					resource.TestCheckResourceAttrSet("data.ovh_email_pro.pro", "hostname"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEmailProPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// email pro is an optional product
+	// these resources are tested only if env var `OVH_EMAIL_PRO_SERVICE`
+	// is set
+	if os.Getenv("OVH_EMAIL_PRO_SERVICE") == "" {
+		t.Skip("OVH_EMAIL_PRO_SERVICE must be set to test email pro")
+	}
+}
@@ -0,0 +1,144 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceHostingWeb() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceHostingWebRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datacenter": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_ipv6": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hosting_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hosting_ipv6": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"primary_login": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"home": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_system": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"quota_size": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"quota_used": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"php_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"support": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceHostingWebRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &HostingWeb{}
+	endpoint := fmt.Sprintf("/hosting/web/%s", serviceName)
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Read web hosting %s", r)
+
+	phpVersions := make([]interface{}, len(r.PhpVersions))
+	for i, v := range r.PhpVersions {
+		phpVersions[i] = map[string]interface{}{
+			"version": v.Version,
+			"support": v.Support,
+		}
+	}
+
+	d.SetId(serviceName)
+	d.Set("display_name", r.DisplayName)
+	d.Set("offer", r.Offer)
+	d.Set("state", r.State)
+	d.Set("datacenter", r.Datacenter)
+	d.Set("cluster", r.Cluster)
+	d.Set("cluster_ip", r.ClusterIp)
+	d.Set("cluster_ipv6", r.ClusterIpv6)
+	d.Set("hosting_ip", r.HostingIp)
+	d.Set("hosting_ipv6", r.HostingIpv6)
+	d.Set("primary_login", r.PrimaryLogin)
+	d.Set("home", r.Home)
+	d.Set("operating_system", r.OperatingSystem)
+	d.Set("quota_size", hostingWebQuotaString(r.QuotaSize))
+	d.Set("quota_used", hostingWebQuotaString(r.QuotaUsed))
+	d.Set("php_versions", phpVersions)
+
+	return nil
+}
+
+// hostingWebQuotaString formats a quota the way the control panel shows it,
+// e.g. "100 GB".
+func hostingWebQuotaString(q *HostingWebQuota) string {
+	if q == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s %s", strconv.FormatFloat(q.Value, 'f', -1, 64), q.Unit)
+}
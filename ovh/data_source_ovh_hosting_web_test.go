@@ -0,0 +1,52 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccHostingWebDatasourceConfig = `
+data "ovh_hosting_web" "hosting" {
+  service_name = "%s"
+}
+`
+
+func TestAccHostingWebDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_HOSTING_WEB_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckHostingWebPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccHostingWebDatasourceConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_hosting_web.hosting", "id", serviceName),
+					resource.TestCheckResourceAttrSet("data.ovh_hosting_web.hosting", "offer"),
+					resource.TestCheckResourceAttrSet("data.ovh_hosting_web.hosting", "cluster"),
+					resource.TestCheckResourceAttrSet("data.ovh_hosting_web.hosting", "php_versions.0.version"),
+				),
+			},
+		},
+	})
+}
+
+func TestHostingWebQuotaString(t *testing.T) {
+	cases := []struct {
+		quota    *HostingWebQuota
+		expected string
+	}{
+		{nil, ""},
+		{&HostingWebQuota{Unit: "GB", Value: 100}, "100 GB"},
+		{&HostingWebQuota{Unit: "MB", Value: 12.5}, "12.5 MB"},
+	}
+
+	for _, c := range cases {
+		if got := hostingWebQuotaString(c.quota); got != c.expected {
+			t.Errorf("%v: expected %q, got %q", c.quota, c.expected, got)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type IamReferenceAction struct {
+	Action       string   `json:"action"`
+	Categories   []string `json:"categories"`
+	Description  string   `json:"description"`
+	ResourceType string   `json:"resourceType"`
+}
+
+func dataSourceIamReferenceActions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIamReferenceActionsRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"categories": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIamReferenceActionsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	resourceType := d.Get("type").(string)
+
+	log.Printf("[DEBUG] Will read iam reference actions of %s", resourceType)
+
+	actions := []IamReferenceAction{}
+	endpoint := fmt.Sprintf("/iam/reference/action?resourceType=%s", url.QueryEscape(resourceType))
+	err := config.OVHClientV2.Get(endpoint, &actions)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	mapActions := make([]map[string]interface{}, len(actions))
+	for i, action := range actions {
+		mapActions[i] = map[string]interface{}{
+			"action":        action.Action,
+			"categories":    action.Categories,
+			"description":   action.Description,
+			"resource_type": action.ResourceType,
+		}
+	}
+
+	d.SetId(resourceType)
+	d.Set("actions", mapActions)
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccIamReferenceActionsDatasourceConfig = `
+data "ovh_iam_reference_actions" "vps" {
+  type = "vps"
+}
+`
+
+func TestAccIamReferenceActionsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIamReferenceActionsDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_iam_reference_actions.vps", "actions.#"),
+					resource.TestCheckResourceAttr("data.ovh_iam_reference_actions.vps", "actions.0.resource_type", "vps"),
+				),
+			},
+		},
+	})
+}
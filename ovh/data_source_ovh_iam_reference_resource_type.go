@@ -0,0 +1,42 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceIamReferenceResourceType() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIamReferenceResourceTypeRead,
+		Schema: map[string]*schema.Schema{
+			// Computed
+			"types": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceIamReferenceResourceTypeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Will read iam reference resource types")
+
+	types := []string{}
+	endpoint := "/iam/reference/resource/type"
+	err := config.OVHClientV2.Get(endpoint, &types)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(hashcode.Strings(types))
+	d.Set("types", types)
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccIamReferenceResourceTypeDatasourceConfig = `
+data "ovh_iam_reference_resource_type" "types" {}
+`
+
+func TestAccIamReferenceResourceTypeDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIamReferenceResourceTypeDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_iam_reference_resource_type.types", "types.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,90 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type IamResource struct {
+	Id          string `json:"id"`
+	Urn         string `json:"urn"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	Type        string `json:"type"`
+	Owner       string `json:"owner"`
+}
+
+func dataSourceIamResource() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIamResourceRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			"urn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIamResourceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	query := url.Values{}
+	query.Set("resourceName", d.Get("name").(string))
+	if v, ok := d.GetOk("type"); ok {
+		query.Set("resourceType", v.(string))
+	}
+
+	endpoint := fmt.Sprintf("/iam/resource?%s", query.Encode())
+
+	log.Printf("[DEBUG] Will read iam resource %s", endpoint)
+
+	resources := []IamResource{}
+	err := config.OVHClientV2.Get(endpoint, &resources)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	if len(resources) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(resources) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	r := resources[0]
+
+	d.SetId(r.Id)
+	d.Set("name", r.Name)
+	d.Set("type", r.Type)
+	d.Set("urn", r.Urn)
+	d.Set("display_name", r.DisplayName)
+	d.Set("owner", r.Owner)
+
+	return nil
+}
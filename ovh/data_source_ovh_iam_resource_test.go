@@ -0,0 +1,31 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+var testAccIamResourceDatasourceConfig = fmt.Sprintf(`
+data "ovh_iam_resource" "iplb" {
+  name = "%s"
+}
+`, os.Getenv("OVH_IPLB_SERVICE"))
+
+func TestAccIamResourceDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIamResourceDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_iam_resource.iplb", "urn"),
+					resource.TestCheckResourceAttrSet("data.ovh_iam_resource.iplb", "type"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceIpBlocks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIpBlocksRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{
+						"cdn",
+						"cloud",
+						"dedicated",
+						"failover",
+						"hosted_ssl",
+						"housing",
+						"loadBalancing",
+						"mail",
+						"overthebox",
+						"pcc",
+						"pci",
+						"private",
+						"vpn",
+						"vps",
+						"vrack",
+						"xdsl",
+					})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"routed_to_service_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed
+			"blocks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceIpBlocksRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	query := url.Values{}
+	if v, ok := d.GetOk("type"); ok {
+		query.Set("type", v.(string))
+	}
+	if v, ok := d.GetOk("routed_to_service_name"); ok {
+		query.Set("routedTo.serviceName", v.(string))
+	}
+	if v, ok := d.GetOk("description"); ok {
+		query.Set("description", v.(string))
+	}
+
+	endpoint := "/ip"
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	log.Printf("[DEBUG] Will list ip blocks: %s", endpoint)
+
+	blocks := make([]string, 0)
+	err := config.OVHClient.Get(endpoint, &blocks)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	sort.Strings(blocks)
+
+	d.SetId(hashcode.Strings([]string{endpoint, strings.Join(blocks, ",")}))
+	d.Set("blocks", blocks)
+
+	log.Printf("[DEBUG] Read ip blocks %v", blocks)
+	return nil
+}
@@ -0,0 +1,47 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccIpBlocksDatasourceConfig = `
+data "ovh_ip_blocks" "blocks" {}
+`
+
+func TestAccIpBlocksDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpBlocksDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIpBlocksContains("data.ovh_ip_blocks.blocks", os.Getenv("OVH_IP_BLOCK")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIpBlocksContains(n, block string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "blocks.") && k != "blocks.#" && v == block {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("IP block %s not found in %s", block, n)
+	}
+}
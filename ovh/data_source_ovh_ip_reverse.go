@@ -0,0 +1,83 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceIpReverse() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIpReverseRead,
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ipreverse": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIp(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"reverse": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIpReverseRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Get("ip").(string)
+	ipReverse := d.Get("ipreverse").(string)
+
+	if ipReverse == "" {
+		ipAddr, ipNet, _ := net.ParseCIDR(ip)
+		prefixSize, _ := ipNet.Mask.Size()
+
+		if ipAddr.To4() != nil && prefixSize != 32 {
+			return fmt.Errorf("ipreverse must be set if ip (%s) is not a /32", ip)
+		} else if ipAddr.To4() == nil && prefixSize != 128 {
+			return fmt.Errorf("ipreverse must be set if ip (%s) is not a /128", ip)
+		}
+
+		ipReverse = ipAddr.String()
+	}
+
+	reverse := &OvhIpReverse{}
+	endpoint := fmt.Sprintf("/ip/%s/reverse/%s", strings.Replace(ip, "/", "%2F", 1), ipReverse)
+
+	log.Printf("[DEBUG] Will read ip reverse %s", endpoint)
+
+	err := config.OVHClient.Get(endpoint, reverse)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", ip, reverse.IpReverse))
+	d.Set("ipreverse", reverse.IpReverse)
+	d.Set("reverse", reverse.Reverse)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+var testAccIpReverseDatasourceConfig = fmt.Sprintf(`
+resource "ovh_ip_reverse" "reverse" {
+  ip        = "%s"
+  ipreverse = "%s"
+  reverse   = "%s"
+}
+
+data "ovh_ip_reverse" "reverse" {
+  ip        = "${ovh_ip_reverse.reverse.ip}"
+  ipreverse = "${ovh_ip_reverse.reverse.ipreverse}"
+}
+`, os.Getenv("OVH_IP_BLOCK"), os.Getenv("OVH_IP"), os.Getenv("OVH_IP_REVERSE"))
+
+func TestAccIpReverseDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckIpReversePreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpReverseDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_ip_reverse.reverse", "reverse", os.Getenv("OVH_IP_REVERSE")),
+				),
+			},
+		},
+	})
+}
@@ -168,16 +168,24 @@ func dataSourceIpLoadbalancingRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error calling /ipLoadbalancing:\n\t %q", err)
 	}
 
-	filtered_iplbs := []*IpLoadbalancing{}
-
-	for _, serviceName := range response {
+	iplbs := make([]*IpLoadbalancing, len(response))
+	err = fetchConcurrently(len(response), func(i int) error {
 		iplb := &IpLoadbalancing{}
-		err := config.OVHClient.Get(fmt.Sprintf("/ipLoadbalancing/%s", serviceName), &iplb)
+		err := config.OVHClient.Get(fmt.Sprintf("/ipLoadbalancing/%s", response[i]), iplb)
 
 		if err != nil {
-			return fmt.Errorf("Error calling /ipLoadbalancing/%s:\n\t %q", serviceName, err)
+			return fmt.Errorf("Error calling /ipLoadbalancing/%s:\n\t %q", response[i], err)
 		}
+		iplbs[i] = iplb
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	filtered_iplbs := []*IpLoadbalancing{}
 
+	for _, iplb := range iplbs {
 		if v, ok := d.GetOk("ipv6"); ok && v.(string) != iplb.IPv6 {
 			continue
 		}
@@ -0,0 +1,241 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// IpLoadbalancingFarmServerStatus is the subset of a farm server holding its
+// administrative status and the result of its last health checks.
+type IpLoadbalancingFarmServerStatus struct {
+	ServerId    int                          `json:"serverId"`
+	DisplayName *string                      `json:"displayName"`
+	Address     string                       `json:"address"`
+	Port        *int                         `json:"port"`
+	Status      string                       `json:"status"`
+	ServerState []IpLoadbalancingServerState `json:"serverState"`
+}
+
+func (s *IpLoadbalancingFarmServerStatus) String() string {
+	return fmt.Sprintf("FarmServer[id: %d, address: %s, status: %s, states: %v]", s.ServerId, s.Address, s.Status, s.ServerState)
+}
+
+// IpLoadbalancingServerState is the health of a farm server as seen from
+// one zone of the load balancer.
+type IpLoadbalancingServerState struct {
+	Zone              string  `json:"zone"`
+	Status            string  `json:"status"`
+	CheckStatus       *string `json:"checkStatus"`
+	CheckCode         *string `json:"checkCode"`
+	CheckTime         *int64  `json:"checkTime"`
+	LastCheckContent  *string `json:"lastCheckContent"`
+	LastCheckDuration *int64  `json:"lastCheckDuration"`
+}
+
+func dataSourceIpLoadbalancingFarmServerStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIpLoadbalancingFarmServerStatusRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"farm_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "tcp",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"tcp", "http", "udp"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"servers_up": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"servers_down": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"states": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"zone": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"check_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"check_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"check_time": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"last_check_content": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_check_duration": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ipLoadbalancingFarmServerUp tells if a server is active and reported UP by
+// every zone that checked it.
+func ipLoadbalancingFarmServerUp(s *IpLoadbalancingFarmServerStatus) bool {
+	if s.Status != "active" || len(s.ServerState) == 0 {
+		return false
+	}
+
+	for _, state := range s.ServerState {
+		if state.Status != "UP" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func dataSourceIpLoadbalancingFarmServerStatusRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	farmType := d.Get("type").(string)
+	farmId := d.Get("farm_id").(int)
+
+	ids := []int{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/%s/farm/%d/server", serviceName, farmType, farmId)
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	servers := make([]*IpLoadbalancingFarmServerStatus, len(ids))
+	err := fetchConcurrently(len(ids), func(i int) error {
+		r := &IpLoadbalancingFarmServerStatus{}
+		serverEndpoint := fmt.Sprintf("%s/%d", endpoint, ids[i])
+		if err := config.OVHClient.Get(serverEndpoint, r); err != nil {
+			return fmt.Errorf("Error calling Get %s:\n\t %q", serverEndpoint, err)
+		}
+
+		log.Printf("[DEBUG] Read iploadbalancing %s %s", serviceName, r)
+		servers[i] = r
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	serversUp := 0
+	result := make([]interface{}, len(servers))
+	for i, s := range servers {
+		states := make([]interface{}, len(s.ServerState))
+		for j, state := range s.ServerState {
+			obj := map[string]interface{}{
+				"zone":   state.Zone,
+				"status": state.Status,
+			}
+			if state.CheckStatus != nil {
+				obj["check_status"] = *state.CheckStatus
+			}
+			if state.CheckCode != nil {
+				obj["check_code"] = *state.CheckCode
+			}
+			if state.CheckTime != nil {
+				obj["check_time"] = int(*state.CheckTime)
+			}
+			if state.LastCheckContent != nil {
+				obj["last_check_content"] = *state.LastCheckContent
+			}
+			if state.LastCheckDuration != nil {
+				obj["last_check_duration"] = int(*state.LastCheckDuration)
+			}
+			states[j] = obj
+		}
+
+		up := ipLoadbalancingFarmServerUp(s)
+		if up {
+			serversUp++
+		}
+
+		server := map[string]interface{}{
+			"server_id": s.ServerId,
+			"address":   s.Address,
+			"status":    s.Status,
+			"up":        up,
+			"states":    states,
+		}
+		if s.DisplayName != nil {
+			server["display_name"] = *s.DisplayName
+		}
+		if s.Port != nil {
+			server["port"] = *s.Port
+		}
+		result[i] = server
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", serviceName, farmType, farmId))
+	d.Set("servers", result)
+	d.Set("servers_up", serversUp)
+	d.Set("servers_down", len(servers)-serversUp)
+
+	return nil
+}
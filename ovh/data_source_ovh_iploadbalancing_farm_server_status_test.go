@@ -0,0 +1,76 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccIpLoadbalancingFarmServerStatusDatasourceConfig = `
+resource "ovh_iploadbalancing_tcp_farm" "testacc" {
+  service_name = "%s"
+  display_name = "%s"
+  port         = 8080
+  zone         = "all"
+}
+
+resource "ovh_iploadbalancing_tcp_farm_server" "testacc" {
+  service_name = "${ovh_iploadbalancing_tcp_farm.testacc.service_name}"
+  farm_id      = "${ovh_iploadbalancing_tcp_farm.testacc.id}"
+  address      = "198.51.100.11"
+  port         = 80
+  status       = "inactive"
+}
+
+data "ovh_iploadbalancing_farm_server_status" "status" {
+  service_name = "${ovh_iploadbalancing_tcp_farm_server.testacc.service_name}"
+  farm_id      = "${ovh_iploadbalancing_tcp_farm_server.testacc.farm_id}"
+}
+`
+
+func TestAccIpLoadbalancingFarmServerStatusDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_IPLB_SERVICE")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCheckIpLoadbalancingExists(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingFarmServerStatusDatasourceConfig, serviceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_iploadbalancing_farm_server_status.status", "servers.#", "1"),
+					resource.TestCheckResourceAttr("data.ovh_iploadbalancing_farm_server_status.status", "servers.0.address", "198.51.100.11"),
+					resource.TestCheckResourceAttr("data.ovh_iploadbalancing_farm_server_status.status", "servers.0.status", "inactive"),
+					resource.TestCheckResourceAttr("data.ovh_iploadbalancing_farm_server_status.status", "servers.0.up", "false"),
+					resource.TestCheckResourceAttr("data.ovh_iploadbalancing_farm_server_status.status", "servers_up", "0"),
+					resource.TestCheckResourceAttr("data.ovh_iploadbalancing_farm_server_status.status", "servers_down", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestIpLoadbalancingFarmServerUp(t *testing.T) {
+	cases := []struct {
+		server *IpLoadbalancingFarmServerStatus
+		up     bool
+	}{
+		{&IpLoadbalancingFarmServerStatus{Status: "active"}, false},
+		{&IpLoadbalancingFarmServerStatus{Status: "inactive", ServerState: []IpLoadbalancingServerState{{Zone: "gra", Status: "UP"}}}, false},
+		{&IpLoadbalancingFarmServerStatus{Status: "active", ServerState: []IpLoadbalancingServerState{{Zone: "gra", Status: "UP"}, {Zone: "rbx", Status: "UP"}}}, true},
+		{&IpLoadbalancingFarmServerStatus{Status: "active", ServerState: []IpLoadbalancingServerState{{Zone: "gra", Status: "UP"}, {Zone: "rbx", Status: "DOWN"}}}, false},
+	}
+
+	for _, c := range cases {
+		if up := ipLoadbalancingFarmServerUp(c.server); up != c.up {
+			t.Errorf("ipLoadbalancingFarmServerUp(%s) = %t, want %t", c.server, up, c.up)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// IpLoadbalancingSsl is a certificate installed on a load balancer.
+type IpLoadbalancingSsl struct {
+	Id          int      `json:"id"`
+	DisplayName *string  `json:"displayName"`
+	Type        string   `json:"type"`
+	Serial      string   `json:"serial"`
+	Subject     string   `json:"subject"`
+	San         []string `json:"san"`
+	Fingerprint string   `json:"fingerprint"`
+	ExpireDate  string   `json:"expireDate"`
+}
+
+func (s *IpLoadbalancingSsl) String() string {
+	return fmt.Sprintf("Ssl[id: %d, type: %s, serial: %s, subject: %s, expireDate: %s]", s.Id, s.Type, s.Serial, s.Subject, s.ExpireDate)
+}
+
+func dataSourceIpLoadbalancingSsl() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIpLoadbalancingSslRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"built", "custom", "external"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"serial": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"next_expire_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"serial": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"san": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"fingerprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expire_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ipLoadbalancingSslNextExpireDate returns the expiry date of the
+// certificate expiring first, or "" when there is none.
+func ipLoadbalancingSslNextExpireDate(certificates []*IpLoadbalancingSsl) (string, error) {
+	next := ""
+	var nextTime time.Time
+	for _, c := range certificates {
+		t, err := time.Parse(time.RFC3339, c.ExpireDate)
+		if err != nil {
+			return "", fmt.Errorf("invalid expiry date %q of certificate %d: %s", c.ExpireDate, c.Id, err)
+		}
+		if next == "" || t.Before(nextTime) {
+			next, nextTime = c.ExpireDate, t
+		}
+	}
+	return next, nil
+}
+
+func dataSourceIpLoadbalancingSslRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	query := url.Values{}
+	for _, key := range []string{"type", "serial", "fingerprint"} {
+		if v, ok := d.GetOk(key); ok {
+			query.Set(key, v.(string))
+		}
+	}
+
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/ssl", serviceName)
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	ids := []int{}
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+	sort.Ints(ids)
+
+	certificates := make([]*IpLoadbalancingSsl, len(ids))
+	err := fetchConcurrently(len(ids), func(i int) error {
+		r := &IpLoadbalancingSsl{}
+		sslEndpoint := fmt.Sprintf("/ipLoadbalancing/%s/ssl/%d", serviceName, ids[i])
+		if err := config.OVHClient.Get(sslEndpoint, r); err != nil {
+			return fmt.Errorf("Error calling Get %s:\n\t %q", sslEndpoint, err)
+		}
+
+		log.Printf("[DEBUG] Read iploadbalancing %s %s", serviceName, r)
+		certificates[i] = r
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	next, err := ipLoadbalancingSslNextExpireDate(certificates)
+	if err != nil {
+		return err
+	}
+
+	idStrings := make([]string, len(certificates))
+	result := make([]interface{}, len(certificates))
+	for i, c := range certificates {
+		idStrings[i] = fmt.Sprintf("%d", c.Id)
+
+		obj := map[string]interface{}{
+			"id":          c.Id,
+			"type":        c.Type,
+			"serial":      c.Serial,
+			"subject":     c.Subject,
+			"san":         c.San,
+			"fingerprint": c.Fingerprint,
+			"expire_date": c.ExpireDate,
+		}
+		if c.DisplayName != nil {
+			obj["display_name"] = *c.DisplayName
+		}
+		result[i] = obj
+	}
+
+	d.SetId(hashcode.Strings(append([]string{serviceName}, idStrings...)))
+	d.Set("ids", ids)
+	d.Set("next_expire_date", next)
+	if err := d.Set("certificates", result); err != nil {
+		return fmt.Errorf("Error setting certificates of %s: %s", serviceName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccIpLoadbalancingSslDatasourceConfig = `
+data "ovh_iploadbalancing_ssl" "certs" {
+  service_name = "%s"
+}
+`
+
+func TestAccIpLoadbalancingSslDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_IPLB_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCheckIpLoadbalancingExists(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingSslDatasourceConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_iploadbalancing_ssl.certs", "ids.#"),
+					resource.TestCheckResourceAttrSet("data.ovh_iploadbalancing_ssl.certs", "certificates.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestIpLoadbalancingSslNextExpireDate(t *testing.T) {
+	next, err := ipLoadbalancingSslNextExpireDate(nil)
+	if err != nil || next != "" {
+		t.Errorf("expected no date without certificates, got %q, %v", next, err)
+	}
+
+	certificates := []*IpLoadbalancingSsl{
+		{Id: 1, ExpireDate: "2027-03-01T00:00:00+01:00"},
+		{Id: 2, ExpireDate: "2026-12-24T10:00:00+01:00"},
+		// earlier than the second certificate once the timezones are applied
+		{Id: 3, ExpireDate: "2026-12-24T10:30:00+02:00"},
+	}
+	next, err = ipLoadbalancingSslNextExpireDate(certificates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != "2026-12-24T10:30:00+02:00" {
+		t.Errorf("expected the date of certificate 3, got %q", next)
+	}
+
+	certificates = append(certificates, &IpLoadbalancingSsl{Id: 4, ExpireDate: "soon"})
+	if _, err := ipLoadbalancingSslNextExpireDate(certificates); err == nil {
+		t.Error("expected an error for an invalid date")
+	}
+}
@@ -0,0 +1,156 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceMeApiCredentials() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMeApiCredentialsRead,
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"expired", "pendingValidation", "refused", "validated"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"application_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			// Computed
+			"credentials": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"credential_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"application_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ovh_support": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"creation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expiration": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_use": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rules": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"method": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func formatCredentialTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func dataSourceMeApiCredentialsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	query := url.Values{}
+	if v, ok := d.GetOk("status"); ok {
+		query.Set("status", v.(string))
+	}
+	if v, ok := d.GetOk("application_id"); ok {
+		query.Set("applicationId", strconv.Itoa(v.(int)))
+	}
+
+	endpoint := "/me/api/credential"
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	log.Printf("[DEBUG] Will list api credentials: %s", endpoint)
+
+	ids := []int64{}
+	err := config.OVHClient.Get(endpoint, &ids)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	credentials := make([]map[string]interface{}, len(ids))
+	idStrings := make([]string, len(ids))
+	for i, id := range ids {
+		cred := &OvhAuthCurrentCredential{}
+		endpoint := fmt.Sprintf("/me/api/credential/%d", id)
+		if err := config.OVHClient.Get(endpoint, cred); err != nil {
+			return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+		}
+
+		rules := make([]map[string]interface{}, len(cred.Rules))
+		for j, rule := range cred.Rules {
+			rules[j] = map[string]interface{}{
+				"method": rule.Method,
+				"path":   rule.Path,
+			}
+		}
+
+		credentials[i] = map[string]interface{}{
+			"credential_id":  cred.CredentialId,
+			"application_id": cred.ApplicationId,
+			"status":         cred.Status,
+			"ovh_support":    cred.OvhSupport,
+			"creation":       formatCredentialTime(cred.Creation),
+			"expiration":     formatCredentialTime(cred.Expiration),
+			"last_use":       formatCredentialTime(cred.LastUse),
+			"rules":          rules,
+		}
+		idStrings[i] = strconv.FormatInt(id, 10)
+	}
+
+	d.SetId(hashcode.Strings(append([]string{endpoint}, idStrings...)))
+	d.Set("credentials", credentials)
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccMeApiCredentialsDatasourceConfig = `
+data "ovh_me_api_credentials" "validated" {
+  status = "validated"
+}
+`
+
+func TestAccMeApiCredentialsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeApiCredentialsDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					// at least the credential used by the tests
+					resource.TestCheckResourceAttrSet("data.ovh_me_api_credentials.validated", "credentials.0.credential_id"),
+					resource.TestCheckResourceAttr("data.ovh_me_api_credentials.validated", "credentials.0.status", "validated"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,290 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type MeBill struct {
+	BillId          string     `json:"billId"`
+	Date            string     `json:"date"`
+	OrderId         int64      `json:"orderId"`
+	PdfUrl          string     `json:"pdfUrl"`
+	Url             string     `json:"url"`
+	PriceWithTax    OrderPrice `json:"priceWithTax"`
+	PriceWithoutTax OrderPrice `json:"priceWithoutTax"`
+	Tax             OrderPrice `json:"tax"`
+}
+
+func (b *MeBill) String() string {
+	return fmt.Sprintf("Bill[id: %s, date: %s, priceWithoutTax: %s]", b.BillId, b.Date, b.PriceWithoutTax.Text)
+}
+
+type MeBillDetail struct {
+	BillDetailId string     `json:"billDetailId"`
+	Description  string     `json:"description"`
+	Domain       string     `json:"domain"`
+	PeriodStart  string     `json:"periodStart"`
+	PeriodEnd    string     `json:"periodEnd"`
+	Quantity     string     `json:"quantity"`
+	UnitPrice    OrderPrice `json:"unitPrice"`
+	TotalPrice   OrderPrice `json:"totalPrice"`
+}
+
+// validateMeDate checks that a date filter is either a day (2006-01-02) or
+// a full RFC 3339 date, which are the formats the API accepts.
+func validateMeDate(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := time.Parse("2006-01-02", value); err == nil {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %q is neither a YYYY-MM-DD nor a RFC 3339 date", k, value))
+	}
+	return
+}
+
+func dataSourceMeBills() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMeBillsRead,
+		Schema: map[string]*schema.Schema{
+			"date_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMeDate,
+			},
+			"date_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMeDate,
+			},
+			"with_details": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Computed
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total_without_tax": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"total_with_tax": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"bills": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bill_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"order_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"pdf_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"price_without_tax": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"price_with_tax": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"tax": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"details": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bill_detail_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"service_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"period_start": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"period_end": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"quantity": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"unit_price": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"total_price": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMeBillsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	query := url.Values{}
+	if v, ok := d.GetOk("date_from"); ok {
+		query.Set("date.from", v.(string))
+	}
+	if v, ok := d.GetOk("date_to"); ok {
+		query.Set("date.to", v.(string))
+	}
+
+	endpoint := "/me/bill"
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	ids := []string{}
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+	sort.Strings(ids)
+
+	withDetails := d.Get("with_details").(bool)
+	bills := make([]*MeBill, len(ids))
+	details := make([][]*MeBillDetail, len(ids))
+	err := fetchConcurrently(len(ids), func(i int) error {
+		r := &MeBill{}
+		endpoint := fmt.Sprintf("/me/bill/%s", ids[i])
+		if err := config.OVHClient.Get(endpoint, r); err != nil {
+			return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+		}
+		bills[i] = r
+
+		if withDetails {
+			billDetails, err := meBillDetails(config, ids[i])
+			if err != nil {
+				return err
+			}
+			details[i] = billDetails
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Read %d bills from %s", len(bills), endpoint)
+
+	currencyCode := ""
+	totalWithoutTax := 0.0
+	totalWithTax := 0.0
+	result := make([]interface{}, len(bills))
+	for i, bill := range bills {
+		currencyCode = bill.PriceWithoutTax.CurrencyCode
+		totalWithoutTax += bill.PriceWithoutTax.Value
+		totalWithTax += bill.PriceWithTax.Value
+
+		billDetails := make([]interface{}, len(details[i]))
+		for j, detail := range details[i] {
+			billDetails[j] = map[string]interface{}{
+				"bill_detail_id": detail.BillDetailId,
+				"description":    detail.Description,
+				"service_name":   detail.Domain,
+				"period_start":   detail.PeriodStart,
+				"period_end":     detail.PeriodEnd,
+				"quantity":       detail.Quantity,
+				"unit_price":     detail.UnitPrice.Value,
+				"total_price":    detail.TotalPrice.Value,
+			}
+		}
+
+		result[i] = map[string]interface{}{
+			"bill_id":           bill.BillId,
+			"date":              bill.Date,
+			"order_id":          int(bill.OrderId),
+			"pdf_url":           bill.PdfUrl,
+			"url":               bill.Url,
+			"price_without_tax": bill.PriceWithoutTax.Value,
+			"price_with_tax":    bill.PriceWithTax.Value,
+			"tax":               bill.Tax.Value,
+			"details":           billDetails,
+		}
+	}
+
+	d.SetId(hashcode.Strings([]string{endpoint, strings.Join(ids, ",")}))
+	d.Set("currency_code", currencyCode)
+	d.Set("total_without_tax", totalWithoutTax)
+	d.Set("total_with_tax", totalWithTax)
+
+	if err := d.Set("bills", result); err != nil {
+		return fmt.Errorf("Error setting bills: %s", err)
+	}
+
+	return nil
+}
+
+func meBillDetails(config *Config, billId string) ([]*MeBillDetail, error) {
+	ids := []string{}
+	endpoint := fmt.Sprintf("/me/bill/%s/details", billId)
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return nil, fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+	sort.Strings(ids)
+
+	details := make([]*MeBillDetail, len(ids))
+	err := fetchConcurrently(len(ids), func(i int) error {
+		r := &MeBillDetail{}
+		endpoint := fmt.Sprintf("/me/bill/%s/details/%s", billId, ids[i])
+		if err := config.OVHClient.Get(endpoint, r); err != nil {
+			return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+		}
+		details[i] = r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
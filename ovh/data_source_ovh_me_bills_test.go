@@ -0,0 +1,48 @@
+package ovh
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccMeBillsDatasourceConfig = `
+data "ovh_me_bills" "bills" {
+  date_from    = "%s"
+  with_details = true
+}
+`
+
+func TestAccMeBillsDataSource_basic(t *testing.T) {
+	from := time.Now().AddDate(0, -3, 0).Format("2006-01-02")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMeBillsDatasourceConfig, from),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_me_bills.bills", "bills.#"),
+					resource.TestCheckResourceAttrSet("data.ovh_me_bills.bills", "total_without_tax"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateMeDate(t *testing.T) {
+	for _, v := range []string{"2019-06-01", "2019-06-01T00:00:00Z", "2019-06-01T10:00:00+02:00"} {
+		if _, errs := validateMeDate(v, "date_from"); len(errs) > 0 {
+			t.Errorf("%q should be valid: %v", v, errs)
+		}
+	}
+
+	for _, v := range []string{"", "2019-13-01", "01/06/2019", "2019-06-01 10:00"} {
+		if _, errs := validateMeDate(v, "date_from"); len(errs) == 0 {
+			t.Errorf("%q should be invalid", v)
+		}
+	}
+}
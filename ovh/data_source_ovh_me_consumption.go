@@ -0,0 +1,174 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type MeConsumptionElement struct {
+	PlanCode   string     `json:"planCode"`
+	PlanFamily string     `json:"planFamily"`
+	Quantity   float64    `json:"quantity"`
+	Price      OrderPrice `json:"price"`
+}
+
+type MeConsumption struct {
+	ServiceId  int64                  `json:"serviceId"`
+	BeginDate  string                 `json:"beginDate"`
+	EndDate    string                 `json:"endDate"`
+	LastUpdate string                 `json:"lastUpdate"`
+	Price      OrderPrice             `json:"price"`
+	Elements   []MeConsumptionElement `json:"elements"`
+}
+
+func (c *MeConsumption) String() string {
+	return fmt.Sprintf("Consumption[serviceId: %d, begin: %s, end: %s, price: %s]", c.ServiceId, c.BeginDate, c.EndDate, c.Price.Text)
+}
+
+func dataSourceMeConsumption() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMeConsumptionRead,
+		Schema: map[string]*schema.Schema{
+			"begin_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMeDate,
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMeDate,
+			},
+
+			// Computed
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"begin_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_update": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"elements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"plan_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"plan_family": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"quantity": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"price": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMeConsumptionRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	beginDate := d.Get("begin_date").(string)
+	endDate := d.Get("end_date").(string)
+
+	// without a period, the consumption of the current billing period is
+	// returned. Past periods are read from the history.
+	endpoint := "/me/consumption/usage/current"
+	if beginDate != "" || endDate != "" {
+		if beginDate == "" || endDate == "" {
+			return fmt.Errorf("begin_date and end_date must be set together")
+		}
+
+		query := url.Values{}
+		query.Set("beginDate", beginDate)
+		query.Set("endDate", endDate)
+		endpoint = fmt.Sprintf("/me/consumption/usage/history?%s", query.Encode())
+	}
+
+	consumptions := []*MeConsumption{}
+	if err := config.OVHClient.Get(endpoint, &consumptions); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Read consumption of %d services from %s", len(consumptions), endpoint)
+
+	currencyCode := ""
+	total := 0.0
+	services := make([]interface{}, len(consumptions))
+	for i, c := range consumptions {
+		currencyCode = c.Price.CurrencyCode
+		total += c.Price.Value
+
+		elements := make([]interface{}, len(c.Elements))
+		for j, e := range c.Elements {
+			elements[j] = map[string]interface{}{
+				"plan_code":   e.PlanCode,
+				"plan_family": e.PlanFamily,
+				"quantity":    e.Quantity,
+				"price":       e.Price.Value,
+			}
+		}
+
+		services[i] = map[string]interface{}{
+			"service_id":  int(c.ServiceId),
+			"begin_date":  c.BeginDate,
+			"end_date":    c.EndDate,
+			"last_update": c.LastUpdate,
+			"price":       c.Price.Value,
+			"elements":    elements,
+		}
+	}
+
+	d.SetId(endpoint)
+	d.Set("currency_code", currencyCode)
+	d.Set("total", total)
+
+	if err := d.Set("services", services); err != nil {
+		return fmt.Errorf("Error setting consumption services: %s", err)
+	}
+
+	return nil
+}
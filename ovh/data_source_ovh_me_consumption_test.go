@@ -0,0 +1,27 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccMeConsumptionDatasourceConfig = `
+data "ovh_me_consumption" "current" {}
+`
+
+func TestAccMeConsumptionDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeConsumptionDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_me_consumption.current", "id", "/me/consumption/usage/current"),
+					resource.TestCheckResourceAttrSet("data.ovh_me_consumption.current", "services.#"),
+				),
+			},
+		},
+	})
+}
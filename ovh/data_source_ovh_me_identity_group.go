@@ -0,0 +1,70 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceMeIdentityGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMeIdentityGroupRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_group": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"creation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_update": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"urn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMeIdentityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Will read identity group %s", name)
+
+	r := &MeIdentityGroup{}
+	endpoint := fmt.Sprintf("/me/identity/group/%s", name)
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(r.Name)
+	d.Set("description", r.Description)
+	d.Set("role", r.Role)
+	d.Set("default_group", r.DefaultGroup)
+	d.Set("creation", r.Creation)
+	d.Set("last_update", r.LastUpdate)
+	d.Set("urn", r.Urn)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package ovh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccMeIdentityGroupDatasourceConfig = `
+resource "ovh_me_identity_group" "group" {
+  name = "%s"
+  role = "REGULAR"
+}
+
+data "ovh_me_identity_group" "group" {
+  name = "${ovh_me_identity_group.group.name}"
+}
+`
+
+func TestAccMeIdentityGroupDataSource_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMeIdentityGroupDatasourceConfig, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_me_identity_group.group", "role", "REGULAR"),
+					resource.TestCheckResourceAttrSet("data.ovh_me_identity_group.group", "urn"),
+				),
+			},
+		},
+	})
+}
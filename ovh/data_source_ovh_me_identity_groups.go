@@ -0,0 +1,42 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceMeIdentityGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMeIdentityGroupsRead,
+		Schema: map[string]*schema.Schema{
+			// Computed
+			"groups": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceMeIdentityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Will list identity groups")
+
+	groups := []string{}
+	endpoint := "/me/identity/group"
+	err := config.OVHClient.Get(endpoint, &groups)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(hashcode.Strings(groups))
+	d.Set("groups", groups)
+
+	return nil
+}
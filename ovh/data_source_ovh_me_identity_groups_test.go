@@ -0,0 +1,26 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccMeIdentityGroupsDatasourceConfig = `
+data "ovh_me_identity_groups" "groups" {}
+`
+
+func TestAccMeIdentityGroupsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeIdentityGroupsDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_me_identity_groups.groups", "groups.#"),
+				),
+			},
+		},
+	})
+}
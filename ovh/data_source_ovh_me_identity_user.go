@@ -0,0 +1,80 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceMeIdentityUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMeIdentityUserRead,
+		Schema: map[string]*schema.Schema{
+			"login": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_update": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"password_last_update": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"urn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMeIdentityUserRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	login := d.Get("login").(string)
+
+	log.Printf("[DEBUG] Will read identity user %s", login)
+
+	r := &MeIdentityUser{}
+	endpoint := fmt.Sprintf("/me/identity/user/%s", login)
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(r.Login)
+	d.Set("email", r.Email)
+	d.Set("group", r.Group)
+	d.Set("description", r.Description)
+	d.Set("status", r.Status)
+	d.Set("creation", r.Creation)
+	d.Set("last_update", r.LastUpdate)
+	d.Set("password_last_update", r.PasswordLastUpdate)
+	d.Set("urn", r.Urn)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package ovh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccMeIdentityUserDatasourceConfig = `
+resource "ovh_me_identity_user" "user" {
+  login    = "%s"
+  email    = "%s@example.com"
+  password = "%s"
+}
+
+data "ovh_me_identity_user" "user" {
+  login = "${ovh_me_identity_user.user.login}"
+}
+`
+
+func TestAccMeIdentityUserDataSource_basic(t *testing.T) {
+	login := acctest.RandomWithPrefix(test_prefix)
+	password := fmt.Sprintf("Tf-%s!9", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMeIdentityUserDatasourceConfig, login, login, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_me_identity_user.user", "email", fmt.Sprintf("%s@example.com", login)),
+					resource.TestCheckResourceAttrSet("data.ovh_me_identity_user.user", "urn"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceMeIdentityUsers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMeIdentityUsersRead,
+		Schema: map[string]*schema.Schema{
+			// Computed
+			"users": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceMeIdentityUsersRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Will list identity users")
+
+	users := []string{}
+	endpoint := "/me/identity/user"
+	err := config.OVHClient.Get(endpoint, &users)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(hashcode.Strings(users))
+	d.Set("users", users)
+
+	return nil
+}
@@ -83,23 +83,31 @@ func dataSourceMePaymentmeanBankaccountRead(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return fmt.Errorf("Error getting Bank Account list:\n\t %q", err)
 	}
-	filtered_bank_accounts := []*BankAccount{}
-	for _, account_id := range bank_account_ids {
-		bank_account := BankAccount{}
-		err = config.OVHClient.Get(
-			fmt.Sprintf("/me/paymentMean/bankAccount/%d", account_id),
-			&bank_account,
+	bank_accounts := make([]*BankAccount, len(bank_account_ids))
+	err = fetchConcurrently(len(bank_account_ids), func(i int) error {
+		bank_account := &BankAccount{}
+		err := config.OVHClient.Get(
+			fmt.Sprintf("/me/paymentMean/bankAccount/%d", bank_account_ids[i]),
+			bank_account,
 		)
 		if err != nil {
-			return fmt.Errorf("Error getting Bank Account %d:\n\t %q", account_id, err)
+			return fmt.Errorf("Error getting Bank Account %d:\n\t %q", bank_account_ids[i], err)
 		}
+		bank_accounts[i] = bank_account
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	filtered_bank_accounts := []*BankAccount{}
+	for _, bank_account := range bank_accounts {
 		if use_default && bank_account.Default == false {
 			continue
 		}
 		if !description_regexp.MatchString(bank_account.Description) {
 			continue
 		}
-		filtered_bank_accounts = append(filtered_bank_accounts, &bank_account)
+		filtered_bank_accounts = append(filtered_bank_accounts, bank_account)
 	}
 	if len(filtered_bank_accounts) < 1 {
 		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
@@ -85,16 +85,24 @@ func dataSourceMePaymentmeanCreditcardRead(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return fmt.Errorf("Error getting Credit Cards list:\n\t %q", err)
 	}
-	filtered_credit_cards := []*CreditCard{}
-	for _, card_id := range credit_card_ids {
-		credit_card := CreditCard{}
-		err = config.OVHClient.Get(
-			fmt.Sprintf("/me/paymentMean/creditCard/%d", card_id),
-			&credit_card,
+	credit_cards := make([]*CreditCard, len(credit_card_ids))
+	err = fetchConcurrently(len(credit_card_ids), func(i int) error {
+		credit_card := &CreditCard{}
+		err := config.OVHClient.Get(
+			fmt.Sprintf("/me/paymentMean/creditCard/%d", credit_card_ids[i]),
+			credit_card,
 		)
 		if err != nil {
-			return fmt.Errorf("Error getting Credit Card %d:\n\t %q", card_id, err)
+			return fmt.Errorf("Error getting Credit Card %d:\n\t %q", credit_card_ids[i], err)
 		}
+		credit_cards[i] = credit_card
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	filtered_credit_cards := []*CreditCard{}
+	for _, credit_card := range credit_cards {
 		if use_default && credit_card.Default == false {
 			continue
 		}
@@ -113,7 +121,7 @@ func dataSourceMePaymentmeanCreditcardRead(d *schema.ResourceData, meta interfac
 		if !description_regexp.MatchString(credit_card.Description) {
 			continue
 		}
-		filtered_credit_cards = append(filtered_credit_cards, &credit_card)
+		filtered_credit_cards = append(filtered_credit_cards, credit_card)
 	}
 	if len(filtered_credit_cards) < 1 {
 		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
@@ -0,0 +1,149 @@
+package ovh
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type DeferredPaymentAccount struct {
+	Description  string `json:"description"`
+	Default      bool   `json:"defaultPaymentMean"`
+	State        string `json:"state"`
+	Id           int    `json:"id"`
+	Label        string `json:"label"`
+	CreationDate string `json:"creationDate"`
+}
+
+func dataSourceMePaymentmeanDeferred() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMePaymentmeanDeferredRead,
+		Schema: map[string]*schema.Schema{
+			"description_regexp": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Default:  ".*",
+			},
+			"use_default": {
+				Type:     schema.TypeBool,
+				ForceNew: true,
+				Optional: true,
+				Default:  false,
+			},
+			"use_oldest": {
+				Type:     schema.TypeBool,
+				ForceNew: true,
+				Optional: true,
+				Default:  false,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			// Computed
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMePaymentmeanDeferredRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	state, state_ok := d.GetOk("state")
+	description_regexp := regexp.MustCompile(d.Get("description_regexp").(string))
+	use_oldest := d.Get("use_oldest").(bool)
+	use_default := d.Get("use_default").(bool)
+	var the_deferred_account *DeferredPaymentAccount
+	var deferred_account_ids []int
+	endpoint := "/me/paymentMean/deferredPaymentAccount"
+	if state_ok {
+		endpoint = fmt.Sprintf("%s?state=%s", endpoint, state)
+	}
+	err := config.OVHClient.Get(
+		endpoint,
+		&deferred_account_ids,
+	)
+
+	if err != nil {
+		return fmt.Errorf("Error getting Deferred Payment Account list:\n\t %q", err)
+	}
+	deferred_accounts := make([]*DeferredPaymentAccount, len(deferred_account_ids))
+	err = fetchConcurrently(len(deferred_account_ids), func(i int) error {
+		deferred_account := &DeferredPaymentAccount{}
+		err := config.OVHClient.Get(
+			fmt.Sprintf("/me/paymentMean/deferredPaymentAccount/%d", deferred_account_ids[i]),
+			deferred_account,
+		)
+		if err != nil {
+			return fmt.Errorf("Error getting Deferred Payment Account %d:\n\t %q", deferred_account_ids[i], err)
+		}
+		deferred_accounts[i] = deferred_account
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	filtered_deferred_accounts := []*DeferredPaymentAccount{}
+	for _, deferred_account := range deferred_accounts {
+		if use_default && deferred_account.Default == false {
+			continue
+		}
+		if !description_regexp.MatchString(deferred_account.Description) {
+			continue
+		}
+		filtered_deferred_accounts = append(filtered_deferred_accounts, deferred_account)
+	}
+	if len(filtered_deferred_accounts) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+	if len(filtered_deferred_accounts) > 1 {
+		if use_oldest {
+			sort.Slice(filtered_deferred_accounts, func(i, j int) bool {
+				return (*filtered_deferred_accounts[i]).CreationDate < (*filtered_deferred_accounts[j]).CreationDate
+			})
+			the_deferred_account = filtered_deferred_accounts[0]
+		}
+		if use_default {
+			match := false
+			for _, deferred_account := range filtered_deferred_accounts {
+				if (*deferred_account).Default {
+					match = true
+					the_deferred_account = deferred_account
+					break
+				}
+			}
+			if match == false {
+				return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+			}
+		}
+	}
+	if len(filtered_deferred_accounts) == 1 {
+		the_deferred_account = filtered_deferred_accounts[0]
+	}
+	if the_deferred_account == nil {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+	// Set data
+	d.Set("description", (*the_deferred_account).Description)
+	d.Set("state", (*the_deferred_account).State)
+	d.Set("default", (*the_deferred_account).Default)
+	d.Set("label", (*the_deferred_account).Label)
+
+	d.SetId(fmt.Sprintf("%d", (*the_deferred_account).Id))
+	return nil
+}
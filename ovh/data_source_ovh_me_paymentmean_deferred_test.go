@@ -0,0 +1,37 @@
+package ovh
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccMePaymentmeanDeferredDataSource_basic(t *testing.T) {
+	// ovh deferred payment account payment mean is not mandatory
+	// this datasource is tested only if env var `OVH_TEST_DEFERRED_PAYMENT_ACCOUNT`
+	// is set to "1"
+	v := os.Getenv("OVH_TEST_DEFERRED_PAYMENT_ACCOUNT")
+	if v == "1" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccMePaymentmeanDeferredDatasourceConfig,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.ovh_me_paymentmean_deferred.dpa", "state", "valid"),
+					),
+				},
+			},
+		})
+	}
+}
+
+const testAccMePaymentmeanDeferredDatasourceConfig = `
+data "ovh_me_paymentmean_deferred" "dpa" {
+ state      = "valid"
+ use_oldest = true
+}
+`
@@ -0,0 +1,151 @@
+package ovh
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type Paypal struct {
+	Description  string `json:"description"`
+	Default      bool   `json:"defaultPaymentMean"`
+	State        string `json:"state"`
+	Id           int    `json:"id"`
+	AgreementId  string `json:"agreementId"`
+	Email        string `json:"email"`
+	CreationDate string `json:"creationDate"`
+	LastUseDate  string `json:"lastUseDate"`
+}
+
+func dataSourceMePaymentmeanPaypal() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMePaymentmeanPaypalRead,
+		Schema: map[string]*schema.Schema{
+			"description_regexp": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Default:  ".*",
+			},
+			"use_default": {
+				Type:     schema.TypeBool,
+				ForceNew: true,
+				Optional: true,
+				Default:  false,
+			},
+			"use_oldest": {
+				Type:     schema.TypeBool,
+				ForceNew: true,
+				Optional: true,
+				Default:  false,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			// Computed
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMePaymentmeanPaypalRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	state, state_ok := d.GetOk("state")
+	description_regexp := regexp.MustCompile(d.Get("description_regexp").(string))
+	use_oldest := d.Get("use_oldest").(bool)
+	use_default := d.Get("use_default").(bool)
+	var the_paypal *Paypal
+	var paypal_ids []int
+	endpoint := "/me/paymentMean/paypal"
+	if state_ok {
+		endpoint = fmt.Sprintf("%s?state=%s", endpoint, state)
+	}
+	err := config.OVHClient.Get(
+		endpoint,
+		&paypal_ids,
+	)
+
+	if err != nil {
+		return fmt.Errorf("Error getting Paypal account list:\n\t %q", err)
+	}
+	paypals := make([]*Paypal, len(paypal_ids))
+	err = fetchConcurrently(len(paypal_ids), func(i int) error {
+		paypal := &Paypal{}
+		err := config.OVHClient.Get(
+			fmt.Sprintf("/me/paymentMean/paypal/%d", paypal_ids[i]),
+			paypal,
+		)
+		if err != nil {
+			return fmt.Errorf("Error getting Paypal account %d:\n\t %q", paypal_ids[i], err)
+		}
+		paypals[i] = paypal
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	filtered_paypals := []*Paypal{}
+	for _, paypal := range paypals {
+		if use_default && paypal.Default == false {
+			continue
+		}
+		if !description_regexp.MatchString(paypal.Description) {
+			continue
+		}
+		filtered_paypals = append(filtered_paypals, paypal)
+	}
+	if len(filtered_paypals) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+	if len(filtered_paypals) > 1 {
+		if use_oldest {
+			sort.Slice(filtered_paypals, func(i, j int) bool {
+				return (*filtered_paypals[i]).CreationDate < (*filtered_paypals[j]).CreationDate
+			})
+			the_paypal = filtered_paypals[0]
+		}
+		if use_default {
+			match := false
+			for _, paypal := range filtered_paypals {
+				if (*paypal).Default {
+					match = true
+					the_paypal = paypal
+					break
+				}
+			}
+			if match == false {
+				return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+			}
+		}
+	}
+	if len(filtered_paypals) == 1 {
+		the_paypal = filtered_paypals[0]
+	}
+	if the_paypal == nil {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+	// Set data
+	d.Set("description", (*the_paypal).Description)
+	d.Set("state", (*the_paypal).State)
+	d.Set("default", (*the_paypal).Default)
+	d.Set("email", (*the_paypal).Email)
+
+	d.SetId(fmt.Sprintf("%d", (*the_paypal).Id))
+	return nil
+}
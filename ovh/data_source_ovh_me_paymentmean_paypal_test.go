@@ -0,0 +1,37 @@
+package ovh
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccMePaymentmeanPaypalDataSource_basic(t *testing.T) {
+	// ovh paypal payment mean is not mandatory
+	// this datasource is tested only if env var `OVH_TEST_PAYPAL`
+	// is set to "1"
+	v := os.Getenv("OVH_TEST_PAYPAL")
+	if v == "1" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccMePaymentmeanPaypalDatasourceConfig,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.ovh_me_paymentmean_paypal.pp", "state", "valid"),
+					),
+				},
+			},
+		})
+	}
+}
+
+const testAccMePaymentmeanPaypalDatasourceConfig = `
+data "ovh_me_paymentmean_paypal" "pp" {
+ state      = "valid"
+ use_oldest = true
+}
+`
@@ -0,0 +1,188 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func orderPriceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"currency_code": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"text": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"value": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func orderPriceToSchema(p OrderPrice) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"currency_code": p.CurrencyCode,
+			"text":          p.Text,
+			"value":         p.Value,
+		},
+	}
+}
+
+func dataSourceOrder() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOrderRead,
+		Schema: map[string]*schema.Schema{
+			"order_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			// Computed
+			"date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pdf_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bill_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"prices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"with_tax":    orderPriceSchema(),
+						"without_tax": orderPriceSchema(),
+						"tax":         orderPriceSchema(),
+					},
+				},
+			},
+			"details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"order_detail_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quantity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOrderRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orderId := int64(d.Get("order_id").(int))
+
+	order, err := orderGet(config.OVHClient, orderId)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Read order %s", order)
+
+	status, err := orderStatus(config.OVHClient, orderId)
+	if err != nil {
+		return err
+	}
+
+	details, err := orderDetails(config.OVHClient, orderId)
+	if err != nil {
+		return err
+	}
+
+	// the associated object only exists once the order has been paid
+	associated := &MeOrderAssociatedObject{}
+	endpoint := fmt.Sprintf("/me/order/%d/associatedObject", orderId)
+	if err := config.OVHClient.Get(endpoint, associated); err != nil {
+		if apiErr, ok := err.(*ovh.APIError); !ok || apiErr.Code != 404 {
+			return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+		}
+	}
+
+	d.SetId(strconv.FormatInt(orderId, 10))
+	d.Set("date", order.Date)
+	d.Set("expiration_date", order.ExpirationDate)
+	d.Set("pdf_url", order.PdfUrl)
+	d.Set("url", order.Url)
+	d.Set("status", status)
+
+	if associated.Type == "Bill" {
+		d.Set("bill_id", associated.Id)
+	} else {
+		d.Set("bill_id", "")
+	}
+
+	prices := []map[string]interface{}{}
+	if order.Prices != nil {
+		prices = append(prices, map[string]interface{}{
+			"with_tax":    orderPriceToSchema(order.Prices.WithTax),
+			"without_tax": orderPriceToSchema(order.Prices.WithoutTax),
+			"tax":         orderPriceToSchema(order.Prices.Tax),
+		})
+	}
+	if err := d.Set("prices", prices); err != nil {
+		return fmt.Errorf("Error setting prices of order %d: %s", orderId, err)
+	}
+
+	detailsList := make([]map[string]interface{}, len(details))
+	for i, detail := range details {
+		detailsList[i] = map[string]interface{}{
+			"order_detail_id": int(detail.OrderDetailId),
+			"description":     detail.Description,
+			"domain":          detail.Domain,
+			"quantity":        detail.Quantity,
+		}
+	}
+	if err := d.Set("details", detailsList); err != nil {
+		return fmt.Errorf("Error setting details of order %d: %s", orderId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOrderCart() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOrderCartRead,
+		Schema: map[string]*schema.Schema{
+			"ovh_subsidiary": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"expire": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			"cart_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOrderCartRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ovhSubsidiary := d.Get("ovh_subsidiary").(string)
+	if ovhSubsidiary == "" {
+		subsidiary, err := meOvhSubsidiary(config.OVHClient)
+		if err != nil {
+			return err
+		}
+		ovhSubsidiary = subsidiary
+	}
+
+	params := &OrderCartCreateOpts{
+		OvhSubsidiary: ovhSubsidiary,
+		Description:   d.Get("description").(string),
+		Expire:        d.Get("expire").(string),
+	}
+
+	cart, err := orderCartCreate(config.OVHClient, params)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Read order cart %s", cart)
+
+	d.SetId(cart.CartId)
+	d.Set("ovh_subsidiary", ovhSubsidiary)
+	d.Set("cart_id", cart.CartId)
+	d.Set("expire", cart.Expire)
+	d.Set("read_only", cart.ReadOnly)
+
+	items := make([]int, len(cart.Items))
+	for i, item := range cart.Items {
+		items[i] = int(item)
+	}
+	if err := d.Set("items", items); err != nil {
+		return fmt.Errorf("Error setting items of cart %s: %s", cart.CartId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOrderCartProduct() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOrderCartProductRead,
+		Schema: map[string]*schema.Schema{
+			"cart_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"product": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"result": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"plan_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prices": orderCartProductPricesSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// orderCartProductPricesSchema is the computed schema of the pricings
+// of a product plan.
+func orderCartProductPricesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"capacities": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"duration": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"interval": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"maximum_quantity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"maximum_repeat": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"minimum_quantity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"minimum_repeat": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"price_in_ucents": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"pricing_mode": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"pricing_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"price": orderPriceSchema(),
+			},
+		},
+	}
+}
+
+func orderCartProductPricesToSchema(prices []OrderCartGenericProductPricing) []map[string]interface{} {
+	r := make([]map[string]interface{}, len(prices))
+	for i, p := range prices {
+		r[i] = map[string]interface{}{
+			"capacities":       p.Capacities,
+			"description":      p.Description,
+			"duration":         p.Duration,
+			"interval":         p.Interval,
+			"maximum_quantity": p.MaximumQuantity,
+			"maximum_repeat":   p.MaximumRepeat,
+			"minimum_quantity": p.MinimumQuantity,
+			"minimum_repeat":   p.MinimumRepeat,
+			"price_in_ucents":  int(p.PriceInUcents),
+			"pricing_mode":     p.PricingMode,
+			"pricing_type":     p.PricingType,
+			"price":            orderPriceToSchema(p.Price),
+		}
+	}
+	return r
+}
+
+func dataSourceOrderCartProductRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	cartId := d.Get("cart_id").(string)
+	product := d.Get("product").(string)
+
+	log.Printf("[DEBUG] Will read products %s of order cart %s", product, cartId)
+
+	products, err := orderCartProducts(config.OVHClient, cartId, product)
+	if err != nil {
+		return err
+	}
+
+	result := make([]map[string]interface{}, len(products))
+	for i, p := range products {
+		result[i] = map[string]interface{}{
+			"plan_code":    p.PlanCode,
+			"product_name": p.ProductName,
+			"product_type": p.ProductType,
+			"prices":       orderCartProductPricesToSchema(p.Prices),
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cartId, product))
+	if err := d.Set("result", result); err != nil {
+		return fmt.Errorf("Error setting products %s of cart %s: %s", product, cartId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOrderCartProductPlan() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOrderCartProductPlanRead,
+		Schema: map[string]*schema.Schema{
+			"cart_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"product": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"plan_code": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"price_capacity": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "renew",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{
+						"consumption",
+						"detach",
+						"downgrade",
+						"dynamic",
+						"installation",
+						"renew",
+						"upgrade",
+					})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"product_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"prices":         orderCartProductPricesSchema(),
+			"selected_price": orderCartProductPricesSchema(),
+		},
+	}
+}
+
+func dataSourceOrderCartProductPlanRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	cartId := d.Get("cart_id").(string)
+	product := d.Get("product").(string)
+	planCode := d.Get("plan_code").(string)
+	priceCapacity := d.Get("price_capacity").(string)
+
+	log.Printf("[DEBUG] Will read plan %s of products %s of order cart %s", planCode, product, cartId)
+
+	products, err := orderCartProducts(config.OVHClient, cartId, product)
+	if err != nil {
+		return err
+	}
+
+	var plan *OrderCartGenericProductDefinition
+	for _, p := range products {
+		if p.PlanCode == planCode {
+			plan = p
+			break
+		}
+	}
+
+	if plan == nil {
+		return fmt.Errorf("Plan %s not found in products %s of cart %s", planCode, product, cartId)
+	}
+
+	selected := []OrderCartGenericProductPricing{}
+	for _, price := range plan.Prices {
+		for _, capacity := range price.Capacities {
+			if capacity == priceCapacity {
+				selected = append(selected, price)
+				break
+			}
+		}
+	}
+
+	if len(selected) < 1 {
+		return fmt.Errorf("No %s price found for plan %s of products %s", priceCapacity, planCode, product)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", cartId, product, planCode))
+	d.Set("product_name", plan.ProductName)
+	d.Set("product_type", plan.ProductType)
+
+	if err := d.Set("prices", orderCartProductPricesToSchema(plan.Prices)); err != nil {
+		return fmt.Errorf("Error setting prices of plan %s: %s", planCode, err)
+	}
+
+	if err := d.Set("selected_price", orderCartProductPricesToSchema(selected[:1])); err != nil {
+		return fmt.Errorf("Error setting selected price of plan %s: %s", planCode, err)
+	}
+
+	return nil
+}
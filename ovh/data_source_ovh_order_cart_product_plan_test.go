@@ -0,0 +1,37 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccOrderCartProductPlanDatasourceConfig = `
+data "ovh_order_cart" "cart" {
+  description = "terraform acceptance tests"
+}
+
+data "ovh_order_cart_product_plan" "vrack" {
+  cart_id        = "${data.ovh_order_cart.cart.id}"
+  product        = "vrack"
+  plan_code      = "vrack"
+  price_capacity = "renew"
+}
+`
+
+func TestAccOrderCartProductPlanDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrderCartProductPlanDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_order_cart_product_plan.vrack", "selected_price.#", "1"),
+					resource.TestCheckResourceAttrSet("data.ovh_order_cart_product_plan.vrack", "selected_price.0.pricing_mode"),
+					resource.TestCheckResourceAttrSet("data.ovh_order_cart_product_plan.vrack", "prices.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccOrderCartProductDatasourceConfig = `
+data "ovh_order_cart" "cart" {
+  description = "terraform acceptance tests"
+}
+
+data "ovh_order_cart_product" "vrack" {
+  cart_id = "${data.ovh_order_cart.cart.id}"
+  product = "vrack"
+}
+`
+
+func TestAccOrderCartProductDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrderCartProductDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_order_cart_product.vrack", "result.#"),
+					resource.TestCheckResourceAttr("data.ovh_order_cart_product.vrack", "result.0.plan_code", "vrack"),
+				),
+			},
+		},
+	})
+}
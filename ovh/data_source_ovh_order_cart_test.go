@@ -0,0 +1,30 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccOrderCartDatasourceConfig = `
+data "ovh_order_cart" "cart" {
+  description = "terraform acceptance tests"
+}
+`
+
+func TestAccOrderCartDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrderCartDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_order_cart.cart", "cart_id"),
+					resource.TestCheckResourceAttrSet("data.ovh_order_cart.cart", "ovh_subsidiary"),
+					resource.TestCheckResourceAttr("data.ovh_order_cart.cart", "read_only", "false"),
+				),
+			},
+		},
+	})
+}
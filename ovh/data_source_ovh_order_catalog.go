@@ -0,0 +1,313 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOrderCatalog() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOrderCatalogRead,
+		Schema: map[string]*schema.Schema{
+			"product": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ovh_subsidiary": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"plan_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed
+			"catalog_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tax_rate": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"plan_codes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"plans":  orderCatalogPlansSchema(),
+			"addons": orderCatalogPlansSchema(),
+		},
+	}
+}
+
+func orderCatalogPlansSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"plan_code": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"invoice_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"product": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"pricing_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"pricings": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"capacities": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"commitment": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"description": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"interval": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"interval_unit": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"mode": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"price_in_ucents": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"price": {
+								Type:     schema.TypeFloat,
+								Computed: true,
+							},
+							"tax": {
+								Type:     schema.TypeFloat,
+								Computed: true,
+							},
+							"type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"configurations": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"is_custom": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"is_mandatory": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"values": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"addon_families": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"mandatory": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"addons": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// orderCatalogUcents converts the catalog prices, which are expressed in
+// hundred millionths of the currency, to the currency itself.
+func orderCatalogUcents(v int64) float64 {
+	return float64(v) / 100000000
+}
+
+// orderCatalogPlanAddons returns the addons of the catalog which can be
+// ordered with a plan.
+func orderCatalogPlanAddons(catalog *OrderCatalog, plan *OrderCatalogPlan) []OrderCatalogPlan {
+	codes := map[string]bool{}
+	for _, family := range plan.AddonFamilies {
+		for _, addon := range family.Addons {
+			codes[addon] = true
+		}
+	}
+
+	addons := []OrderCatalogPlan{}
+	for _, addon := range catalog.Addons {
+		if codes[addon.PlanCode] {
+			addons = append(addons, addon)
+		}
+	}
+	return addons
+}
+
+func orderCatalogPlansToSchema(plans []OrderCatalogPlan) []interface{} {
+	r := make([]interface{}, len(plans))
+	for i, plan := range plans {
+		pricings := make([]interface{}, len(plan.Pricings))
+		for j, p := range plan.Pricings {
+			pricings[j] = map[string]interface{}{
+				"capacities":      p.Capacities,
+				"commitment":      p.Commitment,
+				"description":     p.Description,
+				"interval":        p.Interval,
+				"interval_unit":   p.IntervalUnit,
+				"mode":            p.Mode,
+				"price_in_ucents": int(p.Price),
+				"price":           orderCatalogUcents(p.Price),
+				"tax":             orderCatalogUcents(p.Tax),
+				"type":            p.Type,
+			}
+		}
+
+		configurations := make([]interface{}, len(plan.Configurations))
+		for j, c := range plan.Configurations {
+			configurations[j] = map[string]interface{}{
+				"name":         c.Name,
+				"is_custom":    c.IsCustom,
+				"is_mandatory": c.IsMandatory,
+				"values":       c.Values,
+			}
+		}
+
+		families := make([]interface{}, len(plan.AddonFamilies))
+		for j, f := range plan.AddonFamilies {
+			families[j] = map[string]interface{}{
+				"name":      f.Name,
+				"mandatory": f.Mandatory,
+				"addons":    f.Addons,
+			}
+		}
+
+		r[i] = map[string]interface{}{
+			"plan_code":      plan.PlanCode,
+			"invoice_name":   plan.InvoiceName,
+			"product":        plan.Product,
+			"pricing_type":   plan.PricingType,
+			"pricings":       pricings,
+			"configurations": configurations,
+			"addon_families": families,
+		}
+	}
+	return r
+}
+
+func dataSourceOrderCatalogRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	product := d.Get("product").(string)
+	ovhSubsidiary := d.Get("ovh_subsidiary").(string)
+	if ovhSubsidiary == "" {
+		subsidiary, err := meOvhSubsidiary(config.OVHClient)
+		if err != nil {
+			return err
+		}
+		ovhSubsidiary = subsidiary
+	}
+
+	r := &OrderCatalog{}
+	endpoint := fmt.Sprintf(
+		"/order/catalog/public/%s?ovhSubsidiary=%s",
+		url.PathEscape(product),
+		url.QueryEscape(ovhSubsidiary),
+	)
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Read catalog %d of %s with %d plans", r.CatalogId, product, len(r.Plans))
+
+	plans := r.Plans
+	addons := r.Addons
+	planCodes := make([]string, len(r.Plans))
+	for i, plan := range r.Plans {
+		planCodes[i] = plan.PlanCode
+	}
+
+	if planCode := d.Get("plan_code").(string); planCode != "" {
+		plans = nil
+		for _, plan := range r.Plans {
+			if plan.PlanCode == planCode {
+				plans = append(plans, plan)
+				break
+			}
+		}
+		if len(plans) == 0 {
+			return fmt.Errorf("Plan %s not found in the %s catalog of %s. Available plans: %v", planCode, product, ovhSubsidiary, planCodes)
+		}
+		addons = orderCatalogPlanAddons(r, &plans[0])
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", ovhSubsidiary, product, r.CatalogId))
+	d.Set("ovh_subsidiary", ovhSubsidiary)
+	d.Set("catalog_id", int(r.CatalogId))
+	d.Set("currency_code", r.Locale.CurrencyCode)
+	d.Set("tax_rate", r.Locale.TaxRate)
+	d.Set("plan_codes", planCodes)
+
+	if err := d.Set("plans", orderCatalogPlansToSchema(plans)); err != nil {
+		return fmt.Errorf("Error setting plans of the %s catalog: %s", product, err)
+	}
+
+	if err := d.Set("addons", orderCatalogPlansToSchema(addons)); err != nil {
+		return fmt.Errorf("Error setting addons of the %s catalog: %s", product, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccOrderCatalogDatasourceConfig = `
+data "ovh_order_catalog" "vrack" {
+  product   = "vrack"
+  plan_code = "vrack"
+}
+`
+
+func TestAccOrderCatalogDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrderCatalogDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_order_catalog.vrack", "plans.#", "1"),
+					resource.TestCheckResourceAttr("data.ovh_order_catalog.vrack", "plans.0.plan_code", "vrack"),
+					resource.TestCheckResourceAttrSet("data.ovh_order_catalog.vrack", "plans.0.pricings.0.mode"),
+					resource.TestCheckResourceAttrSet("data.ovh_order_catalog.vrack", "currency_code"),
+				),
+			},
+		},
+	})
+}
+
+func TestOrderCatalogPlanAddons(t *testing.T) {
+	catalog := &OrderCatalog{
+		Addons: []OrderCatalogPlan{
+			{PlanCode: "bandwidth-1000"},
+			{PlanCode: "bandwidth-10000"},
+			{PlanCode: "backup-500"},
+		},
+	}
+	plan := &OrderCatalogPlan{
+		PlanCode: "server",
+		AddonFamilies: []OrderCatalogAddonFamily{
+			{Name: "bandwidth", Addons: []string{"bandwidth-1000", "bandwidth-10000"}},
+		},
+	}
+
+	addons := orderCatalogPlanAddons(catalog, plan)
+	if len(addons) != 2 || addons[0].PlanCode != "bandwidth-1000" || addons[1].PlanCode != "bandwidth-10000" {
+		t.Errorf("unexpected addons %v", addons)
+	}
+
+	if v := orderCatalogUcents(1299000000); v != 12.99 {
+		t.Errorf("expected 12.99, got %v", v)
+	}
+}
@@ -0,0 +1,43 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+var testAccOrderDatasourceConfig = fmt.Sprintf(`
+data "ovh_order" "order" {
+  order_id = %s
+}
+`, os.Getenv("OVH_TEST_ORDER_ID"))
+
+func TestAccOrderDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckOrderPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrderDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_order.order", "id", os.Getenv("OVH_TEST_ORDER_ID")),
+					resource.TestCheckResourceAttrSet("data.ovh_order.order", "date"),
+					resource.TestCheckResourceAttrSet("data.ovh_order.order", "status"),
+					resource.TestCheckResourceAttrSet("data.ovh_order.order", "details.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrderPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// the account may have no order at all
+	// this datasource is tested only if env var `OVH_TEST_ORDER_ID` is set
+	if os.Getenv("OVH_TEST_ORDER_ID") == "" {
+		t.Skip("OVH_TEST_ORDER_ID must be set to test the order datasource")
+	}
+}
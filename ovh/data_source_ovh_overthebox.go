@@ -0,0 +1,69 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOverTheBox() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOverTheBoxRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"customer_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"release_channel": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tunnel_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_upgrade": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"socks_proxy_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOverTheBoxRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &OverTheBox{}
+	endpoint := fmt.Sprintf("/overTheBox/%s", serviceName)
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Read overthebox %s", r)
+
+	d.SetId(serviceName)
+	d.Set("customer_description", r.CustomerDescription)
+	d.Set("status", r.Status)
+	d.Set("release_channel", r.ReleaseChannel)
+	d.Set("tunnel_mode", r.TunnelMode)
+	d.Set("auto_upgrade", r.AutoUpgrade)
+	d.Set("socks_proxy_enabled", r.SOCKSProxyEnabled)
+
+	return nil
+}
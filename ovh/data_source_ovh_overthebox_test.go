@@ -0,0 +1,45 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccOverTheBoxDatasourceConfig = `
+data "ovh_overthebox" "otb" {
+  service_name = "%s"
+}
+`
+
+func TestAccOverTheBoxDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_OVERTHEBOX_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckOverTheBoxPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccOverTheBoxDatasourceConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_overthebox.otb", "id", serviceName),
+					resource.TestCheckResourceAttrSet("data.ovh_overthebox.otb", "status"),
+					resource.TestCheckResourceAttrSet("data.ovh_overthebox.otb", "release_channel"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOverTheBoxPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// overthebox is an optional product
+	// these resources are tested only if env var `OVH_OVERTHEBOX_SERVICE`
+	// is set
+	if os.Getenv("OVH_OVERTHEBOX_SERVICE") == "" {
+		t.Skip("OVH_OVERTHEBOX_SERVICE must be set to test overthebox")
+	}
+}
@@ -0,0 +1,128 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOvhCloudConnect() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOvhCloudConnectRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bandwidth": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"interface_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"pop": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port_quantity": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provider_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vrack": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datacenters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOvhCloudConnectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &OvhCloudConnect{}
+	endpoint := fmt.Sprintf("/ovhCloudConnect/%s", serviceName)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Read ovhcloud connect %s", r)
+
+	ids := []int64{}
+	endpoint = fmt.Sprintf("/ovhCloudConnect/%s/datacenter", serviceName)
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	datacenters := make([]map[string]interface{}, len(ids))
+	for i, id := range ids {
+		dc := &OvhCloudConnectDatacenter{}
+		dcEndpoint := fmt.Sprintf("%s/%d", endpoint, id)
+		if err := config.OVHClient.Get(dcEndpoint, dc); err != nil {
+			return fmt.Errorf("Error calling %s:\n\t %q", dcEndpoint, err)
+		}
+
+		datacenters[i] = map[string]interface{}{
+			"id":   dc.Id,
+			"name": dc.Name,
+		}
+	}
+
+	d.SetId(serviceName)
+	d.Set("uuid", r.Uuid)
+	d.Set("description", r.Description)
+	d.Set("bandwidth", r.Bandwidth)
+	d.Set("interface_list", r.InterfaceList)
+	d.Set("pop", r.Pop)
+	d.Set("port_quantity", r.PortQuantity)
+	d.Set("product", r.Product)
+	d.Set("provider_name", r.Provider)
+	d.Set("status", r.Status)
+	d.Set("vrack", r.Vrack)
+	d.Set("datacenters", datacenters)
+
+	return nil
+}
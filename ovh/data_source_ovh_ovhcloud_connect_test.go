@@ -0,0 +1,46 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccOvhCloudConnectDatasourceConfig = `
+data "ovh_ovhcloud_connect" "occ" {
+  service_name = "%s"
+}
+`
+
+func TestAccOvhCloudConnectDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_OVHCLOUD_CONNECT_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckOvhCloudConnectPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccOvhCloudConnectDatasourceConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_ovhcloud_connect.occ", "id", serviceName),
+					resource.TestCheckResourceAttrSet("data.ovh_ovhcloud_connect.occ", "uuid"),
+					resource.TestCheckResourceAttrSet("data.ovh_ovhcloud_connect.occ", "pop"),
+					resource.TestCheckResourceAttrSet("data.ovh_ovhcloud_connect.occ", "interface_list.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOvhCloudConnectPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// ovhcloud connect is an optional product
+	// these resources are tested only if env var `OVH_OVHCLOUD_CONNECT_SERVICE`
+	// is set
+	if os.Getenv("OVH_OVHCLOUD_CONNECT_SERVICE") == "" {
+		t.Skip("OVH_OVHCLOUD_CONNECT_SERVICE must be set to test ovhcloud connect")
+	}
+}
@@ -0,0 +1,102 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourcePublicCloudFailoverIps() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePublicCloudFailoverIpsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", nil),
+			},
+
+			// Computed
+			"failover_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"routed_to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"continent_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"geoloc": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sub_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"progress": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePublicCloudFailoverIpsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	log.Printf("[DEBUG] Will list failover ips of public cloud project %s", projectId)
+
+	ips := []*PublicCloudFailoverIpResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/ip/failover", projectId)
+	if err := config.OVHClient.Get(endpoint, &ips); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	failoverIps := make([]interface{}, len(ips))
+	for i, ip := range ips {
+		log.Printf("[DEBUG] Read public cloud project %s %s", projectId, ip)
+
+		failoverIps[i] = map[string]interface{}{
+			"id":             ip.Id,
+			"ip":             ip.Ip,
+			"block":          ip.Block,
+			"routed_to":      ip.RoutedTo,
+			"status":         ip.Status,
+			"continent_code": ip.ContinentCode,
+			"geoloc":         ip.Geoloc,
+			"sub_type":       ip.SubType,
+			"progress":       ip.Progress,
+		}
+	}
+
+	d.SetId(projectId)
+	d.Set("failover_ips", failoverIps)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccPublicCloudFailoverIpsDatasourceConfig = `
+data "ovh_cloud_failover_ips" "ips" {
+  project_id = "%s"
+}
+`
+
+func TestAccPublicCloudFailoverIpsDataSource_basic(t *testing.T) {
+	projectId := os.Getenv("OVH_PUBLIC_CLOUD")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCheckPublicCloudExists(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccPublicCloudFailoverIpsDatasourceConfig, projectId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_cloud_failover_ips.ips", "id", projectId),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_failover_ips.ips", "failover_ips.#"),
+				),
+			},
+		},
+	})
+}
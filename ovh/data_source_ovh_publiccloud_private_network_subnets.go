@@ -0,0 +1,149 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourcePublicCloudPrivateNetworkSubnets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePublicCloudPrivateNetworkSubnetsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", nil),
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"gateway_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_pools": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"network": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"dhcp": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"start": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"end": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// publicCloudPrivateNetworkSubnetInRegion tells whether one of the ip pools
+// of the subnet is located in region. An empty region matches every subnet.
+func publicCloudPrivateNetworkSubnetInRegion(s *PublicCloudPrivateNetworksResponse, region string) bool {
+	if region == "" {
+		return true
+	}
+	for _, p := range s.IPPools {
+		if normalizeRegion(p.Region) == normalizeRegion(region) {
+			return true
+		}
+	}
+	return false
+}
+
+func dataSourcePublicCloudPrivateNetworkSubnetsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+	networkId := d.Get("network_id").(string)
+	region := d.Get("region").(string)
+
+	log.Printf("[DEBUG] Will list subnets of private network %s/%s", projectId, networkId)
+
+	subnets := []*PublicCloudPrivateNetworksResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s/subnet", projectId, networkId)
+	if err := config.OVHClient.Get(endpoint, &subnets); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	ids := []string{}
+	details := []interface{}{}
+	for _, s := range subnets {
+		if !publicCloudPrivateNetworkSubnetInRegion(s, region) {
+			continue
+		}
+
+		pools := make([]interface{}, len(s.IPPools))
+		for i, p := range s.IPPools {
+			pools[i] = map[string]interface{}{
+				"network": p.Network,
+				"region":  p.Region,
+				"dhcp":    p.Dhcp,
+				"start":   p.Start,
+				"end":     p.End,
+			}
+		}
+
+		ids = append(ids, s.Id)
+		details = append(details, map[string]interface{}{
+			"id":         s.Id,
+			"cidr":       s.Cidr,
+			"gateway_ip": s.GatewayIp,
+			"ip_pools":   pools,
+		})
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	d.Set("ids", ids)
+	if err := d.Set("subnets", details); err != nil {
+		return fmt.Errorf("Error setting subnets of network %s/%s: %s", projectId, networkId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccPublicCloudPrivateNetworkSubnetsDatasourceConfig = `
+resource "ovh_vrack_cloudproject" "attach" {
+  vrack_id   = "%s"
+  project_id = "%s"
+}
+
+data "ovh_cloud_regions" "regions" {
+  project_id = "${ovh_vrack_cloudproject.attach.project_id}"
+}
+
+resource "ovh_cloud_network_private" "network" {
+  project_id = "${ovh_vrack_cloudproject.attach.project_id}"
+  vlan_id    = 0
+  name       = "%s"
+  regions    = ["${data.ovh_cloud_regions.regions.names}"]
+}
+
+resource "ovh_cloud_network_private_subnet" "subnet" {
+  project_id = "${ovh_cloud_network_private.network.project_id}"
+  network_id = "${ovh_cloud_network_private.network.id}"
+  region     = "${element(sort(data.ovh_cloud_regions.regions.names), 0)}"
+  start      = "192.168.168.100"
+  end        = "192.168.168.200"
+  network    = "192.168.168.0/24"
+  dhcp       = true
+}
+
+data "ovh_cloud_network_private_subnets" "subnets" {
+  project_id = "${ovh_cloud_network_private_subnet.subnet.project_id}"
+  network_id = "${ovh_cloud_network_private_subnet.subnet.network_id}"
+  region     = "${ovh_cloud_network_private_subnet.subnet.region}"
+}
+`
+
+func TestAccPublicCloudPrivateNetworkSubnetsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudPrivateNetworkSubnetPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudPrivateNetworkSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					testAccPublicCloudPrivateNetworkSubnetsDatasourceConfig,
+					os.Getenv("OVH_VRACK"),
+					os.Getenv("OVH_PUBLIC_CLOUD"),
+					test_prefix+"-private-net-subnets-ds",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_cloud_network_private_subnets.subnets", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ovh_cloud_network_private_subnets.subnets", "ids.0",
+						"ovh_cloud_network_private_subnet.subnet", "id",
+					),
+					resource.TestCheckResourceAttr("data.ovh_cloud_network_private_subnets.subnets", "subnets.0.cidr", "192.168.168.0/24"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_network_private_subnets.subnets", "subnets.0.ip_pools.0.start", "192.168.168.100"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_network_private_subnets.subnets", "subnets.0.ip_pools.0.dhcp", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestPublicCloudPrivateNetworkSubnetInRegion(t *testing.T) {
+	subnet := &PublicCloudPrivateNetworksResponse{
+		IPPools: []*IPPool{
+			{Region: "GRA5"},
+		},
+	}
+
+	cases := []struct {
+		region   string
+		expected bool
+	}{
+		{"", true},
+		{"GRA5", true},
+		{"gra5", true},
+		{"SBG5", false},
+	}
+
+	for _, c := range cases {
+		if got := publicCloudPrivateNetworkSubnetInRegion(subnet, c.region); got != c.expected {
+			t.Errorf("region %q: expected %v, got %v", c.region, c.expected, got)
+		}
+	}
+}
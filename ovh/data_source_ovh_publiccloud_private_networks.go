@@ -0,0 +1,166 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourcePublicCloudPrivateNetworks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePublicCloudPrivateNetworksRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", nil),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateServiceListFilterRegex,
+			},
+			"vlan_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"networks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vlan_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"regions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"regions_openstack_ids": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// publicCloudPrivateNetworkFilter selects private networks by name, vlan id
+// and region. Unset criteria match every network.
+type publicCloudPrivateNetworkFilter struct {
+	Name   *regexp.Regexp
+	VlanId *int
+	Region string
+}
+
+func (f *publicCloudPrivateNetworkFilter) Match(n *PublicCloudPrivateNetworkResponse) bool {
+	if f.Name != nil && !f.Name.MatchString(n.Name) {
+		return false
+	}
+	if f.VlanId != nil && *f.VlanId != n.Vlanid {
+		return false
+	}
+	if f.Region == "" {
+		return true
+	}
+	for _, r := range n.Regions {
+		if normalizeRegion(r.Region) == normalizeRegion(f.Region) {
+			return true
+		}
+	}
+	return false
+}
+
+func dataSourcePublicCloudPrivateNetworksRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	filter := &publicCloudPrivateNetworkFilter{
+		Region: d.Get("region").(string),
+	}
+	if v, ok := d.GetOk("name_regex"); ok {
+		filter.Name = regexp.MustCompile(v.(string))
+	}
+	// vlan_id 0 is the untagged network and a legitimate filter value
+	if v, ok := d.GetOkExists("vlan_id"); ok {
+		vlanId := v.(int)
+		filter.VlanId = &vlanId
+	}
+
+	log.Printf("[DEBUG] Will list private networks of public cloud project %s", projectId)
+
+	networks := []*PublicCloudPrivateNetworkResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/network/private", projectId)
+	if err := config.OVHClient.Get(endpoint, &networks); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	ids := []string{}
+	details := []interface{}{}
+	for _, n := range networks {
+		if !filter.Match(n) {
+			continue
+		}
+
+		regions := make([]string, len(n.Regions))
+		openstackIds := make(map[string]interface{})
+		for i, r := range n.Regions {
+			regions[i] = r.Region
+			openstackIds[r.Region] = r.OpenstackId
+		}
+
+		ids = append(ids, n.Id)
+		details = append(details, map[string]interface{}{
+			"id":                    n.Id,
+			"name":                  n.Name,
+			"vlan_id":               n.Vlanid,
+			"status":                n.Status,
+			"type":                  n.Type,
+			"regions":               regions,
+			"regions_openstack_ids": openstackIds,
+		})
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	d.Set("ids", ids)
+	if err := d.Set("networks", details); err != nil {
+		return fmt.Errorf("Error setting networks of project %s: %s", projectId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccPublicCloudPrivateNetworksDatasourceConfig = `
+resource "ovh_vrack_cloudproject" "attach" {
+  vrack_id   = "%s"
+  project_id = "%s"
+}
+
+data "ovh_cloud_regions" "regions" {
+  project_id = "${ovh_vrack_cloudproject.attach.project_id}"
+}
+
+resource "ovh_cloud_network_private" "network" {
+  project_id = "${ovh_vrack_cloudproject.attach.project_id}"
+  vlan_id    = 0
+  name       = "%s"
+  regions    = ["${data.ovh_cloud_regions.regions.names}"]
+}
+
+data "ovh_cloud_network_privates" "networks" {
+  project_id = "${ovh_cloud_network_private.network.project_id}"
+  name_regex = "^${ovh_cloud_network_private.network.name}$"
+  vlan_id    = 0
+}
+`
+
+func TestAccPublicCloudPrivateNetworksDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudPrivateNetworkPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudPrivateNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					testAccPublicCloudPrivateNetworksDatasourceConfig,
+					os.Getenv("OVH_VRACK"),
+					os.Getenv("OVH_PUBLIC_CLOUD"),
+					test_prefix+"-private-nets-ds",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_cloud_network_privates.networks", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ovh_cloud_network_privates.networks", "ids.0",
+						"ovh_cloud_network_private.network", "id",
+					),
+					resource.TestCheckResourceAttr("data.ovh_cloud_network_privates.networks", "networks.0.name", test_prefix+"-private-nets-ds"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_network_privates.networks", "networks.0.vlan_id", "0"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_network_privates.networks", "networks.0.regions_openstack_ids.%"),
+				),
+			},
+		},
+	})
+}
+
+func TestPublicCloudPrivateNetworkFilter(t *testing.T) {
+	network := &PublicCloudPrivateNetworkResponse{
+		Name:   "backend",
+		Vlanid: 0,
+		Regions: []*PublicCloudPrivateNetworkRegion{
+			{Region: "GRA5"},
+			{Region: "SBG5"},
+		},
+	}
+	vlan0, vlan42 := 0, 42
+
+	cases := []struct {
+		filter   publicCloudPrivateNetworkFilter
+		expected bool
+	}{
+		{publicCloudPrivateNetworkFilter{}, true},
+		{publicCloudPrivateNetworkFilter{Name: regexp.MustCompile("^back")}, true},
+		{publicCloudPrivateNetworkFilter{Name: regexp.MustCompile("^front")}, false},
+		{publicCloudPrivateNetworkFilter{VlanId: &vlan0}, true},
+		{publicCloudPrivateNetworkFilter{VlanId: &vlan42}, false},
+		{publicCloudPrivateNetworkFilter{Region: "sbg5"}, true},
+		{publicCloudPrivateNetworkFilter{Region: "BHS5"}, false},
+		{publicCloudPrivateNetworkFilter{Name: regexp.MustCompile("end$"), VlanId: &vlan42}, false},
+	}
+
+	for i, c := range cases {
+		if got := c.filter.Match(network); got != c.expected {
+			t.Errorf("case %d: expected %v, got %v", i, c.expected, got)
+		}
+	}
+}
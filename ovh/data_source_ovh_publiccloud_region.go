@@ -42,6 +42,12 @@ func dataSourcePublicCloudRegion() *schema.Resource {
 				},
 			},
 
+			"services_up": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"continentCode": {
 				Type:       schema.TypeString,
 				Computed:   true,
@@ -100,6 +106,7 @@ func dataSourcePublicCloudRegionRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.Set("services", services)
+	d.Set("services_up", publicCloudRegionServicesUp(response))
 
 	d.Partial(false)
 	d.SetId(fmt.Sprintf("%s_%s", projectId, name))
@@ -3,6 +3,7 @@ package ovh
 import (
 	"fmt"
 	"log"
+	"sort"
 
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -17,12 +18,60 @@ func dataSourcePublicCloudRegions() *schema.Resource {
 				ForceNew:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", nil),
 			},
+			"has_services_up": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed
 			"names": {
 				Type:     schema.TypeSet,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			"regions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"continent_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datacenter_location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"services": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"services_up": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -42,6 +91,41 @@ func dataSourcePublicCloudRegionsRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
 	}
 
+	sort.Strings(names)
+
+	regions := make([]*PublicCloudRegionResponse, len(names))
+	err = fetchConcurrently(len(names), func(i int) error {
+		region := &PublicCloudRegionResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/region/%s", projectId, names[i])
+		if err := config.OVHClient.Get(endpoint, region); err != nil {
+			return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+		}
+		regions[i] = region
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	wanted := []string{}
+	for _, v := range d.Get("has_services_up").([]interface{}) {
+		wanted = append(wanted, v.(string))
+	}
+
+	names = make([]string, 0)
+	details := make([]interface{}, 0)
+	for _, region := range regions {
+		if !publicCloudRegionHasServicesUp(region, wanted) {
+			continue
+		}
+
+		names = append(names, region.Name)
+		details = append(details, publicCloudRegionToSchema(region))
+	}
+
+	if err := d.Set("regions", details); err != nil {
+		return fmt.Errorf("Error setting regions of project %s: %s", projectId, err)
+	}
 	d.Set("names", names)
 	d.Partial(false)
 	d.SetId(projectId)
@@ -49,3 +133,50 @@ func dataSourcePublicCloudRegionsRead(d *schema.ResourceData, meta interface{})
 	log.Printf("[DEBUG] Read Public Cloud Regions %s", names)
 	return nil
 }
+
+func publicCloudRegionToSchema(region *PublicCloudRegionResponse) map[string]interface{} {
+	services := make([]interface{}, len(region.Services))
+	for i, s := range region.Services {
+		services[i] = map[string]interface{}{
+			"name":   s.Name,
+			"status": s.Status,
+		}
+	}
+
+	return map[string]interface{}{
+		"name":                region.Name,
+		"continent_code":      region.ContinentCode,
+		"datacenter_location": region.DatacenterLocation,
+		"services":            services,
+		"services_up":         publicCloudRegionServicesUp(region),
+	}
+}
+
+// publicCloudRegionServicesUp returns the sorted names of the UP services
+// of a region.
+func publicCloudRegionServicesUp(region *PublicCloudRegionResponse) []string {
+	servicesUp := make([]string, 0)
+	for _, s := range region.Services {
+		if s.Status == "UP" {
+			servicesUp = append(servicesUp, s.Name)
+		}
+	}
+	sort.Strings(servicesUp)
+	return servicesUp
+}
+
+// publicCloudRegionHasServicesUp returns whether all the given services of a
+// region are UP. A service the region doesn't provide is not UP.
+func publicCloudRegionHasServicesUp(region *PublicCloudRegionResponse, services []string) bool {
+	status := map[string]string{}
+	for _, s := range region.Services {
+		status[s.Name] = s.Status
+	}
+
+	for _, name := range services {
+		if status[name] != "UP" {
+			return false
+		}
+	}
+	return true
+}
@@ -24,6 +24,76 @@ func TestAccPublicCloudRegionsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccPublicCloudRegionsDataSource_servicesUp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccCheckPublicCloudExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublicCloudRegionsServicesUpDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccPublicCloudRegionsDatasource("data.ovh_cloud_regions.regions"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_regions.regions", "names.#"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_regions.regions", "regions.0.continent_code"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_regions.regions", "regions.0.datacenter_location"),
+				),
+			},
+		},
+	})
+}
+
+func TestPublicCloudRegionHasServicesUp(t *testing.T) {
+	region := &PublicCloudRegionResponse{
+		Name: "GRA5",
+		Services: []PublicCloudServiceStatusResponse{
+			{Name: "network", Status: "UP"},
+			{Name: "instance", Status: "UP"},
+			{Name: "image", Status: "DOWN"},
+		},
+	}
+
+	cases := []struct {
+		services []string
+		expected bool
+	}{
+		{nil, true},
+		{[]string{"network"}, true},
+		{[]string{"network", "instance"}, true},
+		{[]string{"network", "image"}, false},
+		{[]string{"storage"}, false},
+	}
+
+	for _, c := range cases {
+		if up := publicCloudRegionHasServicesUp(region, c.services); up != c.expected {
+			t.Errorf("services %v: expected %v, got %v", c.services, c.expected, up)
+		}
+	}
+}
+
+func TestPublicCloudRegionToSchema(t *testing.T) {
+	region := &PublicCloudRegionResponse{
+		Name:               "GRA5",
+		ContinentCode:      "EU",
+		DatacenterLocation: "GRA",
+		Services: []PublicCloudServiceStatusResponse{
+			{Name: "network", Status: "UP"},
+			{Name: "image", Status: "DOWN"},
+			{Name: "instance", Status: "UP"},
+		},
+	}
+
+	r := publicCloudRegionToSchema(region)
+	if r["continent_code"] != "EU" || r["datacenter_location"] != "GRA" {
+		t.Errorf("unexpected location %v", r)
+	}
+	if len(r["services"].([]interface{})) != 3 {
+		t.Errorf("expected 3 services, got %v", r["services"])
+	}
+	if up := r["services_up"].([]string); len(up) != 2 || up[0] != "instance" || up[1] != "network" {
+		t.Errorf("expected instance and network to be up, got %v", up)
+	}
+}
+
 func testAccPublicCloudRegionsDatasource(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -45,3 +115,10 @@ data "ovh_publiccloud_regions" "regions" {
   project_id = "%s"
 }
 `, os.Getenv("OVH_PUBLIC_CLOUD"))
+
+var testAccPublicCloudRegionsServicesUpDatasourceConfig = fmt.Sprintf(`
+data "ovh_cloud_regions" "regions" {
+  project_id      = "%s"
+  has_services_up = ["network", "instance"]
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"))
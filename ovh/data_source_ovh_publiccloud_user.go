@@ -0,0 +1,127 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourcePublicCloudUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePublicCloudUserRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", nil),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permissions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePublicCloudUserRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+	description := d.Get("description").(string)
+	username := d.Get("username").(string)
+
+	if description == "" && username == "" {
+		return fmt.Errorf("One of description or username must be set")
+	}
+
+	log.Printf("[DEBUG] Will read public cloud users of project: %s", projectId)
+
+	users := []*PublicCloudUserResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/user", projectId)
+	if err := config.OVHClient.Get(endpoint, &users); err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	matches := []*PublicCloudUserResponse{}
+	for _, u := range users {
+		if description != "" && u.Description != description {
+			continue
+		}
+		if username != "" && u.Username != username {
+			continue
+		}
+		matches = append(matches, u)
+	}
+
+	if len(matches) == 0 {
+		return fmt.Errorf("No user found in project %s with description %q and username %q", projectId, description, username)
+	}
+
+	if len(matches) > 1 {
+		return fmt.Errorf("%d users found in project %s with description %q and username %q, use more specific criteria", len(matches), projectId, description, username)
+	}
+
+	r := matches[0]
+	log.Printf("[DEBUG] Read public cloud user %s", r)
+
+	roles := make([]map[string]interface{}, len(r.Roles))
+	for i, role := range r.Roles {
+		roles[i] = map[string]interface{}{
+			"id":          role.Id,
+			"name":        role.Name,
+			"description": role.Description,
+			"permissions": role.Permissions,
+		}
+	}
+
+	d.SetId(strconv.Itoa(r.Id))
+	d.Set("description", r.Description)
+	d.Set("username", r.Username)
+	d.Set("status", r.Status)
+	d.Set("creation_date", r.CreationDate)
+	d.Set("roles", roles)
+
+	return nil
+}
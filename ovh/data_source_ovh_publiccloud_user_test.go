@@ -0,0 +1,43 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccPublicCloudUserDataSourceConfig = `
+resource "ovh_cloud_user" "user" {
+  project_id  = "%s"
+  description = "%s"
+}
+
+data "ovh_cloud_user" "user" {
+  project_id  = "${ovh_cloud_user.user.project_id}"
+  description = "${ovh_cloud_user.user.description}"
+}
+`
+
+func TestAccPublicCloudUserDataSource_basic(t *testing.T) {
+	description := acctest.RandomWithPrefix(test_prefix)
+	config := fmt.Sprintf(testAccPublicCloudUserDataSourceConfig, os.Getenv("OVH_PUBLIC_CLOUD"), description)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckPublicCloudUserPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ovh_cloud_user.user", "id", "ovh_cloud_user.user", "id"),
+					resource.TestCheckResourceAttrPair("data.ovh_cloud_user.user", "username", "ovh_cloud_user.user", "username"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_user.user", "description", description),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_user.user", "status"),
+				),
+			},
+		},
+	})
+}
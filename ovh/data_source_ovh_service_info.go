@@ -0,0 +1,147 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceServiceInfo() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceServiceInfoRead,
+		Schema: map[string]*schema.Schema{
+			"route": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"service_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engaged_up_to": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"renewal_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"can_delete_at_expiration": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"possible_renew_period": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"contact_admin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_billing": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_tech": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"renew": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automatic": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"delete_at_expiration": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"forced": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"manual_payment": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceInfoRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	route := d.Get("route").(string)
+	serviceName := d.Get("service_name").(string)
+
+	r, err := serviceInfosGet(config.OVHClient, route, serviceName)
+	if err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", serviceInfosEndpoint(route, serviceName), err)
+	}
+
+	log.Printf("[DEBUG] Read service infos of %s: %s", serviceName, r)
+
+	d.SetId(fmt.Sprintf("/%s/%s", strings.Trim(route, "/"), serviceName))
+	d.Set("service_id", int(r.ServiceId))
+	d.Set("status", r.Status)
+	d.Set("creation", r.Creation)
+	d.Set("expiration", r.Expiration)
+	d.Set("engaged_up_to", r.EngagedUpTo)
+	d.Set("renewal_type", r.RenewalType)
+	d.Set("can_delete_at_expiration", r.CanDeleteAtExpiration)
+	d.Set("possible_renew_period", r.PossibleRenewPeriod)
+	d.Set("contact_admin", r.ContactAdmin)
+	d.Set("contact_billing", r.ContactBilling)
+	d.Set("contact_tech", r.ContactTech)
+
+	renew := []map[string]interface{}{}
+	if r.Renew != nil {
+		period := 0
+		if r.Renew.Period != nil {
+			period = *r.Renew.Period
+		}
+		renew = append(renew, map[string]interface{}{
+			"automatic":            r.Renew.Automatic,
+			"delete_at_expiration": r.Renew.DeleteAtExpiration,
+			"forced":               r.Renew.Forced,
+			"manual_payment":       r.Renew.ManualPayment,
+			"period":               period,
+		})
+	}
+	if err := d.Set("renew", renew); err != nil {
+		return fmt.Errorf("Error setting renew of %s: %s", serviceName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccServiceInfoDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_VRACK")
+	config := fmt.Sprintf(testAccServiceInfoDatasourceConfig_Basic, serviceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccCheckVRackExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ovh_service_info.vrack", "id", fmt.Sprintf("/vrack/%s", serviceName)),
+					resource.TestCheckResourceAttr(
+						"data.ovh_service_info.vrack", "status", "ok"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_service_info.vrack", "expiration"),
+					resource.TestCheckResourceAttr(
+						"data.ovh_service_info.vrack", "renew.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccServiceInfoDatasourceConfig_Basic = `
+data "ovh_service_info" "vrack" {
+  route        = "/vrack"
+  service_name = "%s"
+}
+`
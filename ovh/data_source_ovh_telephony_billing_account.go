@@ -0,0 +1,102 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTelephonyBillingAccount() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTelephonyBillingAccountRead,
+		Schema: map[string]*schema.Schema{
+			"billing_account": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trusted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"hidden_external_number": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"override_displayed_number": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"current_outplan": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"allowed_outplan": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"lines": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"numbers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceTelephonyBillingAccountRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	billingAccount := d.Get("billing_account").(string)
+
+	r := &TelephonyBillingAccount{}
+	endpoint := fmt.Sprintf("/telephony/%s", billingAccount)
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Read telephony billing account %s", r)
+
+	lines := []string{}
+	endpoint = fmt.Sprintf("/telephony/%s/line", billingAccount)
+	if err := config.OVHClient.Get(endpoint, &lines); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	numbers := []string{}
+	endpoint = fmt.Sprintf("/telephony/%s/number", billingAccount)
+	if err := config.OVHClient.Get(endpoint, &numbers); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(billingAccount)
+	d.Set("description", r.Description)
+	d.Set("status", r.Status)
+	d.Set("trusted", r.Trusted)
+	d.Set("hidden_external_number", r.HiddenExternalNumber)
+	d.Set("override_displayed_number", r.OverrideDisplayedNumber)
+	if r.CurrentOutplan != nil {
+		d.Set("current_outplan", r.CurrentOutplan.Value)
+	}
+	if r.AllowedOutplan != nil {
+		d.Set("allowed_outplan", r.AllowedOutplan.Value)
+	}
+	d.Set("lines", lines)
+	d.Set("numbers", numbers)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccTelephonyBillingAccountDatasourceConfig = `
+data "ovh_telephony_billing_account" "ba" {
+  billing_account = "%s"
+}
+`
+
+func TestAccTelephonyBillingAccountDataSource_basic(t *testing.T) {
+	billingAccount := os.Getenv("OVH_TELEPHONY_BILLING_ACCOUNT")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckTelephonyPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccTelephonyBillingAccountDatasourceConfig, billingAccount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_telephony_billing_account.ba", "id", billingAccount),
+					resource.TestCheckResourceAttrSet("data.ovh_telephony_billing_account.ba", "status"),
+					resource.TestCheckResourceAttrSet("data.ovh_telephony_billing_account.ba", "lines.#"),
+					resource.TestCheckResourceAttrSet("data.ovh_telephony_billing_account.ba", "numbers.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTelephonyPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// telephony is an optional product
+	// these resources are tested only if env var `OVH_TELEPHONY_BILLING_ACCOUNT`
+	// is set
+	if os.Getenv("OVH_TELEPHONY_BILLING_ACCOUNT") == "" {
+		t.Skip("OVH_TELEPHONY_BILLING_ACCOUNT must be set to test telephony")
+	}
+}
+
+func testAccCheckTelephonyLinePreCheck(t *testing.T) {
+	testAccCheckTelephonyPreCheck(t)
+
+	if os.Getenv("OVH_TELEPHONY_LINE") == "" {
+		t.Skip("OVH_TELEPHONY_LINE must be set to test telephony lines")
+	}
+}
+
+func testAccCheckTelephonyNumberPreCheck(t *testing.T) {
+	testAccCheckTelephonyPreCheck(t)
+
+	if os.Getenv("OVH_TELEPHONY_NUMBER") == "" {
+		t.Skip("OVH_TELEPHONY_NUMBER must be set to test telephony numbers")
+	}
+}
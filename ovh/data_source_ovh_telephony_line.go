@@ -0,0 +1,65 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTelephonyLine() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTelephonyLineRead,
+		Schema: map[string]*schema.Schema{
+			"billing_account": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"simultaneous_lines": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"offers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceTelephonyLineRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	billingAccount := d.Get("billing_account").(string)
+	serviceName := d.Get("service_name").(string)
+
+	r := &TelephonyLine{}
+	endpoint := fmt.Sprintf("/telephony/%s/line/%s", billingAccount, serviceName)
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Read telephony line %s", r)
+
+	d.SetId(fmt.Sprintf("%s/%s", billingAccount, serviceName))
+	d.Set("description", r.Description)
+	d.Set("service_type", r.ServiceType)
+	d.Set("simultaneous_lines", r.SimultaneousLines)
+	d.Set("offers", r.Offers)
+
+	return nil
+}
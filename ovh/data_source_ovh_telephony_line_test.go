@@ -0,0 +1,35 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccTelephonyLineDatasourceConfig = `
+data "ovh_telephony_line" "line" {
+  billing_account = "%s"
+  service_name    = "%s"
+}
+`
+
+func TestAccTelephonyLineDataSource_basic(t *testing.T) {
+	billingAccount := os.Getenv("OVH_TELEPHONY_BILLING_ACCOUNT")
+	serviceName := os.Getenv("OVH_TELEPHONY_LINE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckTelephonyLinePreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccTelephonyLineDatasourceConfig, billingAccount, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_telephony_line.line", "id", fmt.Sprintf("%s/%s", billingAccount, serviceName)),
+					resource.TestCheckResourceAttrSet("data.ovh_telephony_line.line", "service_type"),
+				),
+			},
+		},
+	})
+}
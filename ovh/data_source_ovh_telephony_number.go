@@ -0,0 +1,66 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTelephonyNumber() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTelephonyNumberRead,
+		Schema: map[string]*schema.Schema{
+			"billing_account": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"feature_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"part_of_pool": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTelephonyNumberRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	billingAccount := d.Get("billing_account").(string)
+	serviceName := d.Get("service_name").(string)
+
+	r := &TelephonyNumber{}
+	endpoint := fmt.Sprintf("/telephony/%s/number/%s", billingAccount, serviceName)
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Read telephony number %s", r)
+
+	d.SetId(fmt.Sprintf("%s/%s", billingAccount, serviceName))
+	d.Set("description", r.Description)
+	d.Set("feature_type", r.FeatureType)
+	d.Set("service_type", r.ServiceType)
+	if r.PartOfPool != nil {
+		d.Set("part_of_pool", int(*r.PartOfPool))
+	}
+
+	return nil
+}
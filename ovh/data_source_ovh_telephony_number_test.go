@@ -0,0 +1,35 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccTelephonyNumberDatasourceConfig = `
+data "ovh_telephony_number" "number" {
+  billing_account = "%s"
+  service_name    = "%s"
+}
+`
+
+func TestAccTelephonyNumberDataSource_basic(t *testing.T) {
+	billingAccount := os.Getenv("OVH_TELEPHONY_BILLING_ACCOUNT")
+	serviceName := os.Getenv("OVH_TELEPHONY_NUMBER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckTelephonyNumberPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccTelephonyNumberDatasourceConfig, billingAccount, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_telephony_number.number", "id", fmt.Sprintf("%s/%s", billingAccount, serviceName)),
+					resource.TestCheckResourceAttrSet("data.ovh_telephony_number.number", "feature_type"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,48 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceVps() *schema.Resource {
+	s := vpsComputedSchema()
+	s["service_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s["display_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	s["netboot_mode"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceVpsRead,
+		Schema: s,
+	}
+}
+
+func dataSourceVpsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	vps, datacenter, ips, err := vpsDetails(config.OVHClient, serviceName)
+	if err != nil {
+		return fmt.Errorf("Error reading vps %s:\n\t %q", serviceName, err)
+	}
+
+	log.Printf("[DEBUG] Read vps %s", vps)
+
+	d.SetId(serviceName)
+	d.Set("display_name", vps.DisplayName)
+	d.Set("netboot_mode", vps.NetbootMode)
+	vpsSetComputed(d, vps, datacenter, ips)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccVpsDatasourceConfig = `
+data "ovh_vps" "vps" {
+  service_name = "%s"
+}
+`
+
+func TestAccVpsDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_VPS_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckVpsPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccVpsDatasourceConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_vps.vps", "id", serviceName),
+					resource.TestCheckResourceAttrSet("data.ovh_vps.vps", "model"),
+					resource.TestCheckResourceAttrSet("data.ovh_vps.vps", "datacenter"),
+					resource.TestCheckResourceAttrSet("data.ovh_vps.vps", "state"),
+					resource.TestCheckResourceAttrSet("data.ovh_vps.vps", "ips.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpsPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// vps is an optional product
+	// these resources are tested only if env var `OVH_VPS_SERVICE`
+	// is set
+	if os.Getenv("OVH_VPS_SERVICE") == "" {
+		t.Skip("OVH_VPS_SERVICE must be set to test vps")
+	}
+}
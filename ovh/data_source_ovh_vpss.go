@@ -0,0 +1,165 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceVpss() *schema.Resource {
+	s := serviceListFilterSchema()
+
+	// Computed
+	s["service_names"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s["vpss"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"service_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"display_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"datacenter": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"zone": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"model": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"ips": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceVpssRead,
+		Schema: s,
+	}
+}
+
+type vpsListItem struct {
+	Vps        *Vps
+	Datacenter *VpsDatacenter
+	Ips        []string
+}
+
+func dataSourceVpssRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	filter, err := serviceListFilterFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	names := []string{}
+	endpoint := "/vps"
+	if err := config.OVHClient.Get(endpoint, &names); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+	sort.Strings(names)
+
+	items := make([]*vpsListItem, len(names))
+	err = fetchConcurrently(len(names), func(i int) error {
+		vps, datacenter, ips, err := vpsDetails(config.OVHClient, names[i])
+		if err != nil {
+			return fmt.Errorf("Error reading vps %s:\n\t %q", names[i], err)
+		}
+
+		sort.Strings(ips)
+		items[i] = &vpsListItem{Vps: vps, Datacenter: datacenter, Ips: ips}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Will filter %d vps with %s", len(items), filter)
+
+	matching := []*vpsListItem{}
+	for _, item := range items {
+		if filter.MatchDatacenter(item.Datacenter.Name) && filter.MatchDisplayName(item.Vps.DisplayName) {
+			matching = append(matching, item)
+		}
+	}
+
+	// Reverses are only exposed per IP, so only fetch them when filtering
+	// on them.
+	if filter.Reverse != nil {
+		reverses := make([][]string, len(matching))
+		err = fetchConcurrently(len(matching), func(i int) error {
+			for _, ip := range matching[i].Ips {
+				r := &VpsIp{}
+				endpoint := fmt.Sprintf("/vps/%s/ips/%s", matching[i].Vps.Name, ip)
+				if err := config.OVHClient.Get(endpoint, r); err != nil {
+					return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+				}
+				if r.Reverse != "" {
+					reverses[i] = append(reverses[i], r.Reverse)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		filtered := []*vpsListItem{}
+		for i, item := range matching {
+			if filter.MatchReverse(reverses[i]) {
+				filtered = append(filtered, item)
+			}
+		}
+		matching = filtered
+	}
+
+	serviceNames := []string{}
+	details := []interface{}{}
+	for _, item := range matching {
+		model := ""
+		if item.Vps.Model != nil {
+			model = item.Vps.Model.Name
+		}
+
+		serviceNames = append(serviceNames, item.Vps.Name)
+		details = append(details, map[string]interface{}{
+			"service_name": item.Vps.Name,
+			"display_name": item.Vps.DisplayName,
+			"datacenter":   item.Datacenter.Name,
+			"zone":         item.Vps.Zone,
+			"model":        model,
+			"state":        item.Vps.State,
+			"ips":          item.Ips,
+		})
+	}
+
+	d.SetId(hashcode.Strings(serviceNames))
+	d.Set("service_names", serviceNames)
+	d.Set("vpss", details)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccVpssDatasourceConfig = `
+data "ovh_vps" "vps" {
+  service_name = "%s"
+}
+
+data "ovh_vpss" "datacenter" {
+  datacenter = "${data.ovh_vps.vps.datacenter}"
+}
+
+data "ovh_vpss" "none" {
+  display_name_regex = "^$"
+  reverse_regex      = "^does-not-exist\\."
+}
+`
+
+func TestAccVpssDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_VPS_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckVpsPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccVpssDatasourceConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_vpss.datacenter", "service_names.0"),
+					resource.TestCheckResourceAttrSet("data.ovh_vpss.datacenter", "vpss.0.datacenter"),
+					resource.TestCheckResourceAttr("data.ovh_vpss.none", "service_names.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,92 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceVRack() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVRackRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVRackRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	log.Printf("[DEBUG] Will list available vrack services")
+
+	serviceNames := []string{}
+	if v, ok := d.GetOk("service_name"); ok {
+		serviceNames = append(serviceNames, v.(string))
+	} else {
+		err := config.OVHClient.Get("/vrack", &serviceNames)
+		if err != nil {
+			return fmt.Errorf("Error calling /vrack:\n\t %q", err)
+		}
+	}
+
+	vracks := make([]*VRack, len(serviceNames))
+	err := fetchConcurrently(len(serviceNames), func(i int) error {
+		vrack := &VRack{}
+		err := config.OVHClient.Get(fmt.Sprintf("/vrack/%s", serviceNames[i]), vrack)
+		if err != nil {
+			return fmt.Errorf("Error calling /vrack/%s:\n\t %q", serviceNames[i], err)
+		}
+		vracks[i] = vrack
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	filteredServiceNames := []string{}
+	filteredVRacks := []*VRack{}
+
+	for i, vrack := range vracks {
+		serviceName := serviceNames[i]
+
+		if v, ok := d.GetOk("name"); ok && v.(string) != vrack.Name {
+			continue
+		}
+
+		filteredServiceNames = append(filteredServiceNames, serviceName)
+		filteredVRacks = append(filteredVRacks, vrack)
+	}
+
+	if len(filteredVRacks) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(filteredVRacks) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	d.SetId(filteredServiceNames[0])
+	d.Set("service_name", filteredServiceNames[0])
+	d.Set("name", filteredVRacks[0].Name)
+	d.Set("description", filteredVRacks[0].Description)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceVRackServices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVRackServicesRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_VRACK_ID", nil),
+			},
+
+			// Computed
+			"cloud_projects": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"dedicated_servers": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"dedicated_server_interfaces": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ips": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ip_loadbalancings": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+// vrackServicesEndpoints maps the exported attributes to the
+// vrack sub endpoints listing the attached services.
+var vrackServicesEndpoints = map[string]string{
+	"cloud_projects":              "cloudProject",
+	"dedicated_servers":           "dedicatedServer",
+	"dedicated_server_interfaces": "dedicatedServerInterface",
+	"ips":                         "ip",
+	"ip_loadbalancings":           "ipLoadbalancing",
+}
+
+func dataSourceVRackServicesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will read services attached to vrack %s", serviceName)
+
+	for attr, path := range vrackServicesEndpoints {
+		endpoint := fmt.Sprintf("/vrack/%s/%s", serviceName, path)
+		services := make([]string, 0)
+
+		err := config.OVHClient.Get(endpoint, &services)
+		if err != nil {
+			return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+		}
+
+		log.Printf("[DEBUG] Read vrack %s %s: %v", serviceName, path, services)
+		d.Set(attr, services)
+	}
+
+	d.SetId(serviceName)
+
+	return nil
+}
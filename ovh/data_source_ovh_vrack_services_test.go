@@ -0,0 +1,42 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccVRackServicesDataSource_basic(t *testing.T) {
+	vrack := os.Getenv("OVH_VRACK")
+	project := os.Getenv("OVH_PUBLIC_CLOUD")
+	config := fmt.Sprintf(testAccVRackServicesDatasourceConfig, vrack, project)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckVRackPublicCloudAttachmentPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ovh_vrack_services.services", "id", vrack),
+					resource.TestCheckResourceAttr(
+						"data.ovh_vrack_services.services", "cloud_projects.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVRackServicesDatasourceConfig = `
+resource "ovh_vrack_cloudproject" "attach" {
+  vrack_id   = "%s"
+  project_id = "%s"
+}
+
+data "ovh_vrack_services" "services" {
+  service_name = "${ovh_vrack_cloudproject.attach.vrack_id}"
+}
+`
@@ -0,0 +1,38 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccVRackDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_VRACK")
+	config := fmt.Sprintf(testAccVRackDatasourceConfig_Basic, serviceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccCheckVRackExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ovh_vrack.vrack", "service_name", serviceName),
+					resource.TestCheckResourceAttr(
+						"data.ovh_vrack.vrack", "id", serviceName),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_vrack.vrack", "name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVRackDatasourceConfig_Basic = `
+data "ovh_vrack" "vrack" {
+  service_name = "%s"
+}
+`
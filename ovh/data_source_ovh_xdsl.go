@@ -0,0 +1,96 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceXdsl() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceXdslRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed
+			"access_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"lns_rate_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"nb_ip": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"pairs_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceXdslRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &Xdsl{}
+	endpoint := fmt.Sprintf("/xdsl/%s", serviceName)
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Read xdsl %s", r)
+
+	ips := []string{}
+	endpoint = fmt.Sprintf("/xdsl/%s/ips", serviceName)
+	if err := config.OVHClient.Get(endpoint, &ips); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(serviceName)
+	d.Set("access_name", r.AccessName)
+	d.Set("access_type", r.AccessType)
+	d.Set("description", r.Description)
+	d.Set("ipv6_enabled", r.Ipv6Enabled)
+	d.Set("lns_rate_limit", r.LnsRateLimit)
+	d.Set("nb_ip", r.NbIp)
+	d.Set("pairs_number", r.PairsNumber)
+	d.Set("role", r.Role)
+	d.Set("status", r.Status)
+	d.Set("ips", ips)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccXdslDatasourceConfig = `
+data "ovh_xdsl" "xdsl" {
+  service_name = "%s"
+}
+`
+
+func TestAccXdslDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_XDSL_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckXdslPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccXdslDatasourceConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_xdsl.xdsl", "id", serviceName),
+					resource.TestCheckResourceAttrSet("data.ovh_xdsl.xdsl", "access_name"),
+					resource.TestCheckResourceAttrSet("data.ovh_xdsl.xdsl", "access_type"),
+					resource.TestCheckResourceAttrSet("data.ovh_xdsl.xdsl", "status"),
+					resource.TestCheckResourceAttrSet("data.ovh_xdsl.xdsl", "ips.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckXdslPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// xdsl is an optional product
+	// these resources are tested only if env var `OVH_XDSL_SERVICE`
+	// is set
+	if os.Getenv("OVH_XDSL_SERVICE") == "" {
+		t.Skip("OVH_XDSL_SERVICE must be set to test xdsl")
+	}
+}
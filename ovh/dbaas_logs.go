@@ -0,0 +1,291 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type DbaasLogsCluster struct {
+	ClusterId                  string   `json:"clusterId"`
+	ClusterType                string   `json:"clusterType"`
+	Hostname                   string   `json:"hostname"`
+	IsDefault                  bool     `json:"isDefault"`
+	IsUnlocked                 bool     `json:"isUnlocked"`
+	Region                     string   `json:"region"`
+	DirectInputAllowedNetworks []string `json:"directInputAllowedNetworks"`
+	QueryAllowedNetworks       []string `json:"queryAllowedNetworks"`
+	DirectInputPEM             string   `json:"directInputPEM"`
+	DedicatedInputPEM          string   `json:"dedicatedInputPEM"`
+}
+
+func (c *DbaasLogsCluster) String() string {
+	return fmt.Sprintf("DbaasLogsCluster[id: %s, hostname: %s, type: %s, default: %t]", c.ClusterId, c.Hostname, c.ClusterType, c.IsDefault)
+}
+
+type DbaasLogsInputEngine struct {
+	EngineId        string `json:"engineId"`
+	Name            string `json:"name"`
+	SoftwareVersion string `json:"softwareVersion"`
+	IsDeprecated    bool   `json:"isDeprecated"`
+}
+
+func (e *DbaasLogsInputEngine) String() string {
+	return fmt.Sprintf("DbaasLogsInputEngine[id: %s, name: %s, version: %s, deprecated: %t]", e.EngineId, e.Name, e.SoftwareVersion, e.IsDeprecated)
+}
+
+type DbaasLogsInput struct {
+	InputId               string `json:"inputId"`
+	Title                 string `json:"title"`
+	Description           string `json:"description"`
+	EngineId              string `json:"engineId"`
+	StreamId              string `json:"streamId"`
+	ExposedPort           string `json:"exposedPort"`
+	Hostname              string `json:"hostname"`
+	PublicAddress         string `json:"publicAddress"`
+	NbInstance            int    `json:"nbInstance"`
+	SingleInstanceEnabled bool   `json:"singleInstanceEnabled"`
+	IsRestartRequired     bool   `json:"isRestartRequired"`
+	Status                string `json:"status"`
+}
+
+func (i *DbaasLogsInput) String() string {
+	return fmt.Sprintf("DbaasLogsInput[id: %s, title: %s, engine: %s, status: %s]", i.InputId, i.Title, i.EngineId, i.Status)
+}
+
+type DbaasLogsInputOpts struct {
+	Title                 string `json:"title"`
+	Description           string `json:"description"`
+	EngineId              string `json:"engineId"`
+	StreamId              string `json:"streamId"`
+	ExposedPort           string `json:"exposedPort,omitempty"`
+	NbInstance            int    `json:"nbInstance,omitempty"`
+	SingleInstanceEnabled bool   `json:"singleInstanceEnabled"`
+}
+
+type DbaasLogsAllowedNetwork struct {
+	AllowedNetworkId string `json:"allowedNetworkId"`
+	Network          string `json:"network"`
+}
+
+type DbaasLogsAllowedNetworkCreateOpts struct {
+	Network string `json:"network"`
+}
+
+type DbaasLogsGraylogStream struct {
+	StreamId           string `json:"streamId"`
+	Title              string `json:"title"`
+	Description        string `json:"description"`
+	WriteToken         string `json:"writeToken"`
+	IndexingEnabled    bool   `json:"indexingEnabled"`
+	ColdStorageEnabled bool   `json:"coldStorageEnabled"`
+	IsEditable         bool   `json:"isEditable"`
+	IsShareable        bool   `json:"isShareable"`
+	CanAlert           bool   `json:"canAlert"`
+	NbArchive          int64  `json:"nbArchive"`
+}
+
+func (s *DbaasLogsGraylogStream) String() string {
+	return fmt.Sprintf("DbaasLogsGraylogStream[id: %s, title: %s, indexing: %t]", s.StreamId, s.Title, s.IndexingEnabled)
+}
+
+type DbaasLogsGraylogStreamOpts struct {
+	Title              string `json:"title"`
+	Description        string `json:"description"`
+	IndexingEnabled    bool   `json:"indexingEnabled"`
+	ColdStorageEnabled bool   `json:"coldStorageEnabled"`
+}
+
+type DbaasLogsElasticsearchIndex struct {
+	IndexId            string `json:"indexId"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	NbShard            int    `json:"nbShard"`
+	AlertNotifyEnabled bool   `json:"alertNotifyEnabled"`
+	IsEditable         bool   `json:"isEditable"`
+	MaxSize            int64  `json:"maxSize"`
+	CurrentSize        int64  `json:"currentSize"`
+}
+
+func (i *DbaasLogsElasticsearchIndex) String() string {
+	return fmt.Sprintf("DbaasLogsElasticsearchIndex[id: %s, name: %s, shards: %d]", i.IndexId, i.Name, i.NbShard)
+}
+
+type DbaasLogsElasticsearchIndexCreateOpts struct {
+	Suffix             string `json:"suffix"`
+	Description        string `json:"description"`
+	NbShard            int    `json:"nbShard,omitempty"`
+	AlertNotifyEnabled bool   `json:"alertNotifyEnabled"`
+}
+
+type DbaasLogsElasticsearchIndexUpdateOpts struct {
+	Description        string `json:"description"`
+	AlertNotifyEnabled bool   `json:"alertNotifyEnabled"`
+}
+
+type DbaasLogsElasticsearchAlias struct {
+	AliasId     string `json:"aliasId"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsEditable  bool   `json:"isEditable"`
+	NbIndex     int    `json:"nbIndex"`
+	NbStream    int    `json:"nbStream"`
+}
+
+func (a *DbaasLogsElasticsearchAlias) String() string {
+	return fmt.Sprintf("DbaasLogsElasticsearchAlias[id: %s, name: %s]", a.AliasId, a.Name)
+}
+
+type DbaasLogsElasticsearchAliasCreateOpts struct {
+	Suffix      string `json:"suffix"`
+	Description string `json:"description"`
+}
+
+type DbaasLogsElasticsearchAliasUpdateOpts struct {
+	Description string `json:"description"`
+}
+
+type DbaasLogsElasticsearchAliasIndexOpts struct {
+	IndexId string `json:"indexId"`
+}
+
+type DbaasLogsElasticsearchAliasStreamOpts struct {
+	StreamId string `json:"streamId"`
+}
+
+type DbaasLogsGraylogStreamAlert struct {
+	AlertId                    string `json:"alertId"`
+	Title                      string `json:"title"`
+	ConditionType              string `json:"conditionType"`
+	Backlog                    int    `json:"backlog"`
+	Grace                      int    `json:"grace"`
+	RepeatNotificationsEnabled bool   `json:"repeatNotificationsEnabled"`
+	Field                      string `json:"field"`
+	Value                      string `json:"value"`
+	ConstraintType             string `json:"constraintType"`
+	Threshold                  int    `json:"threshold"`
+	ThresholdType              string `json:"thresholdType"`
+	Time                       int    `json:"time"`
+	QueryFilter                string `json:"queryFilter"`
+}
+
+func (a *DbaasLogsGraylogStreamAlert) String() string {
+	return fmt.Sprintf("DbaasLogsGraylogStreamAlert[id: %s, title: %s, condition: %s]", a.AlertId, a.Title, a.ConditionType)
+}
+
+type DbaasLogsGraylogStreamAlertOpts struct {
+	Title                      string `json:"title"`
+	ConditionType              string `json:"conditionType"`
+	Backlog                    int    `json:"backlog"`
+	Grace                      int    `json:"grace"`
+	RepeatNotificationsEnabled bool   `json:"repeatNotificationsEnabled"`
+	Field                      string `json:"field,omitempty"`
+	Value                      string `json:"value,omitempty"`
+	ConstraintType             string `json:"constraintType,omitempty"`
+	Threshold                  *int   `json:"threshold,omitempty"`
+	ThresholdType              string `json:"thresholdType,omitempty"`
+	Time                       *int   `json:"time,omitempty"`
+	QueryFilter                string `json:"queryFilter,omitempty"`
+}
+
+type DbaasLogsRole struct {
+	RoleId       string `json:"roleId"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	NbMember     int    `json:"nbMember"`
+	NbPermission int    `json:"nbPermission"`
+}
+
+func (r *DbaasLogsRole) String() string {
+	return fmt.Sprintf("DbaasLogsRole[id: %s, name: %s, members: %d, permissions: %d]", r.RoleId, r.Name, r.NbMember, r.NbPermission)
+}
+
+type DbaasLogsRoleOpts struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type DbaasLogsRoleMemberCreateOpts struct {
+	Username string `json:"username"`
+	Note     string `json:"note,omitempty"`
+}
+
+type DbaasLogsRolePermission struct {
+	PermissionId   string  `json:"permissionId"`
+	PermissionType *string `json:"permissionType"`
+	StreamId       *string `json:"streamId"`
+	IndexId        *string `json:"indexId"`
+	AliasId        *string `json:"aliasId"`
+	DashboardId    *string `json:"dashboardId"`
+}
+
+func (p *DbaasLogsRolePermission) String() string {
+	kind, targetId := p.Target()
+	return fmt.Sprintf("DbaasLogsRolePermission[id: %s, %s: %s]", p.PermissionId, kind, targetId)
+}
+
+// Target returns the kind of the object the permission is granted on, and
+// its id.
+func (p *DbaasLogsRolePermission) Target() (string, string) {
+	switch {
+	case p.StreamId != nil:
+		return "stream", *p.StreamId
+	case p.IndexId != nil:
+		return "index", *p.IndexId
+	case p.AliasId != nil:
+		return "alias", *p.AliasId
+	case p.DashboardId != nil:
+		return "dashboard", *p.DashboardId
+	}
+	return "", ""
+}
+
+type DbaasLogsRolePermissionCreateOpts struct {
+	StreamId       string `json:"streamId,omitempty"`
+	IndexId        string `json:"indexId,omitempty"`
+	AliasId        string `json:"aliasId,omitempty"`
+	DashboardId    string `json:"dashboardId,omitempty"`
+	PermissionType string `json:"permissionType,omitempty"`
+}
+
+// DbaasLogsOperation is returned by all the asynchronous calls of the
+// logs data platform. The id of the created object is set once the
+// operation succeeded.
+type DbaasLogsOperation struct {
+	OperationId string  `json:"operationId"`
+	State       string  `json:"state"`
+	AliasId     *string `json:"aliasId"`
+	AlertId     *string `json:"alertId"`
+	IndexId     *string `json:"indexId"`
+	InputId     *string `json:"inputId"`
+	RoleId      *string `json:"roleId"`
+	StreamId    *string `json:"streamId"`
+}
+
+func (o *DbaasLogsOperation) String() string {
+	return fmt.Sprintf("DbaasLogsOperation[id: %s, state: %s]", o.OperationId, o.State)
+}
+
+func (o *DbaasLogsOperation) TaskStatus() string {
+	return o.State
+}
+
+// dbaasLogsOperationWait waits for an operation of a logs data platform
+// service to succeed, and returns its final state.
+func dbaasLogsOperationWait(c *ovh.Client, serviceName, operationId string) (*DbaasLogsOperation, error) {
+	r, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/dbaas/logs/%s/operation/%s", serviceName, operationId),
+		Description: fmt.Sprintf("operation %s on logs %s", operationId, serviceName),
+		Task:        &DbaasLogsOperation{},
+		Pending:     []string{"PENDING", "RECEIVED", "STARTED", "RETRY"},
+		Target:      []string{"SUCCESS"},
+		Failed:      []string{"FAILURE", "REVOKED"},
+		Delay:       5 * time.Second,
+	}).Wait(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.(*DbaasLogsOperation), nil
+}
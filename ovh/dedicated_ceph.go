@@ -0,0 +1,135 @@
+package ovh
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type DedicatedCeph struct {
+	ServiceName   string   `json:"serviceName"`
+	CephMons      []string `json:"cephMons"`
+	CephVersion   string   `json:"cephVersion"`
+	CrushTunables string   `json:"crushTunables"`
+	Label         string   `json:"label"`
+	Region        string   `json:"region"`
+	Size          float64  `json:"size"`
+	State         string   `json:"state"`
+	Status        string   `json:"status"`
+}
+
+func (c *DedicatedCeph) String() string {
+	return fmt.Sprintf("Ceph[serviceName: %s, label: %s, crushTunables: %s, state: %s]", c.ServiceName, c.Label, c.CrushTunables, c.State)
+}
+
+type DedicatedCephUpdateOpts struct {
+	CrushTunables string `json:"crushTunables"`
+	Label         string `json:"label"`
+}
+
+type DedicatedCephUser struct {
+	Name    string `json:"name"`
+	Key     string `json:"key"`
+	MdsCaps string `json:"mdsCaps"`
+	MonCaps string `json:"monCaps"`
+	OsdCaps string `json:"osdCaps"`
+}
+
+type DedicatedCephPool struct {
+	Name              string `json:"name"`
+	PoolType          string `json:"poolType"`
+	ReplicaCount      int    `json:"replicaCount"`
+	MinActiveReplicas int    `json:"minActiveReplicas"`
+	Backup            bool   `json:"backup"`
+}
+
+func (p *DedicatedCephPool) String() string {
+	return fmt.Sprintf("CephPool[name: %s, type: %s, replicas: %d]", p.Name, p.PoolType, p.ReplicaCount)
+}
+
+type DedicatedCephPoolCreateOpts struct {
+	PoolName string `json:"poolName"`
+}
+
+type DedicatedCephAcl struct {
+	Id      int64  `json:"id"`
+	Family  string `json:"family"`
+	Network string `json:"network"`
+	Netmask string `json:"netmask"`
+}
+
+func (a *DedicatedCephAcl) String() string {
+	return fmt.Sprintf("CephAcl[id: %d, network: %s, netmask: %s]", a.Id, a.Network, a.Netmask)
+}
+
+type DedicatedCephAclCreateOpts struct {
+	AclList []string `json:"aclList"`
+}
+
+type DedicatedCephTask struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	State      string `json:"state"`
+	CreateDate string `json:"createDate"`
+	FinishDate string `json:"finishDate"`
+}
+
+// DedicatedCephTaskSteps is a dedicated ceph task, returned by the API as a
+// list of steps.
+type DedicatedCephTaskSteps []*DedicatedCephTask
+
+// TaskStatus returns the status of the task: it is done once all its steps
+// are, and failed as soon as one of them failed.
+func (s *DedicatedCephTaskSteps) TaskStatus() string {
+	status := "DONE"
+	for _, step := range *s {
+		switch step.State {
+		case "DONE":
+		case "FAILED", "CANCELED":
+			return step.State
+		default:
+			status = "PENDING"
+		}
+	}
+	return status
+}
+
+func (s *DedicatedCephTaskSteps) taskComment() string {
+	for _, step := range *s {
+		if step.State == "FAILED" || step.State == "CANCELED" {
+			return fmt.Sprintf("step %s is %s", step.Name, step.State)
+		}
+	}
+	return ""
+}
+
+// cephTaskWait waits for a dedicated ceph task to be done.
+func cephTaskWait(c *ovh.Client, serviceName, taskId string) (apiTaskStatus, error) {
+	return (&taskPoller{
+		Endpoint:    fmt.Sprintf("/dedicated/ceph/%s/task/%s", serviceName, taskId),
+		Description: fmt.Sprintf("task %s on ceph %s", taskId, serviceName),
+		Task:        &DedicatedCephTaskSteps{},
+		Pending:     []string{"PENDING"},
+		Target:      []string{"DONE"},
+		Failed:      []string{"FAILED", "CANCELED"},
+	}).Wait(c)
+}
+
+// cephAclCidr returns the CIDR notation of a ceph acl, used to match the
+// acls created from terraform.
+func cephAclCidr(acl *DedicatedCephAcl) string {
+	ip := net.ParseIP(acl.Network)
+	mask := net.ParseIP(acl.Netmask)
+	if ip == nil || mask == nil {
+		return fmt.Sprintf("%s/%s", acl.Network, acl.Netmask)
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+		mask = mask.To4()
+	}
+
+	ipNet := &net.IPNet{IP: ip, Mask: net.IPMask(mask)}
+	return ipNet.String()
+}
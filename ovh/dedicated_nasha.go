@@ -0,0 +1,97 @@
+package ovh
+
+import (
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type DedicatedNasha struct {
+	ServiceName        string  `json:"serviceName"`
+	CustomName         string  `json:"customName"`
+	Datacenter         string  `json:"datacenter"`
+	DiskType           string  `json:"diskType"`
+	Ip                 string  `json:"ip"`
+	Monitored          bool    `json:"monitored"`
+	ZpoolCapacity      float64 `json:"zpoolCapacity"`
+	ZpoolSize          float64 `json:"zpoolSize"`
+	CanCreatePartition bool    `json:"canCreatePartition"`
+}
+
+func (n *DedicatedNasha) String() string {
+	return fmt.Sprintf("Nasha[serviceName: %s, customName: %s, datacenter: %s, ip: %s]", n.ServiceName, n.CustomName, n.Datacenter, n.Ip)
+}
+
+type DedicatedNashaPartition struct {
+	PartitionName        string  `json:"partitionName"`
+	PartitionDescription string  `json:"partitionDescription"`
+	Protocol             string  `json:"protocol"`
+	Size                 int     `json:"size"`
+	Capacity             float64 `json:"partitionCapacity"`
+	UsedBySnapshots      float64 `json:"usedBySnapshots"`
+}
+
+func (p *DedicatedNashaPartition) String() string {
+	return fmt.Sprintf("NashaPartition[name: %s, protocol: %s, size: %d]", p.PartitionName, p.Protocol, p.Size)
+}
+
+type DedicatedNashaPartitionCreateOpts struct {
+	PartitionName        string `json:"partitionName"`
+	PartitionDescription string `json:"partitionDescription,omitempty"`
+	Protocol             string `json:"protocol"`
+	Size                 int    `json:"size"`
+}
+
+func (p *DedicatedNashaPartitionCreateOpts) String() string {
+	return fmt.Sprintf("NashaPartitionCreateOpts[name: %s, protocol: %s, size: %d]", p.PartitionName, p.Protocol, p.Size)
+}
+
+type DedicatedNashaPartitionUpdateOpts struct {
+	PartitionDescription string `json:"partitionDescription"`
+	Protocol             string `json:"protocol"`
+	Size                 int    `json:"size"`
+}
+
+type DedicatedNashaPartitionAccess struct {
+	AccessId int64  `json:"accessId"`
+	Ip       string `json:"ip"`
+	Type     string `json:"type"`
+}
+
+func (a *DedicatedNashaPartitionAccess) String() string {
+	return fmt.Sprintf("NashaPartitionAccess[ip: %s, type: %s]", a.Ip, a.Type)
+}
+
+type DedicatedNashaPartitionAccessCreateOpts struct {
+	Ip   string `json:"ip"`
+	Type string `json:"type"`
+}
+
+type DedicatedNashaPartitionSnapshot struct {
+	Type string `json:"type"`
+}
+
+type DedicatedNashaPartitionSnapshotCreateOpts struct {
+	SnapshotType string `json:"snapshotType"`
+}
+
+type DedicatedNashaTask struct {
+	TaskId    int    `json:"taskId"`
+	Operation string `json:"operation"`
+	Status    string `json:"status"`
+	Details   string `json:"details"`
+}
+
+func (t *DedicatedNashaTask) String() string {
+	return fmt.Sprintf("NashaTask[id: %d, operation: %s, status: %s]", t.TaskId, t.Operation, t.Status)
+}
+
+// nashaTaskWait waits for a NAS-HA task to be done.
+func nashaTaskWait(c *ovh.Client, serviceName string, taskId int) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/dedicated/nasha/%s/task/%d", serviceName, taskId),
+		Description: fmt.Sprintf("task %d on nasha %s", taskId, serviceName),
+		Purged:      "done",
+	}).Wait(c)
+	return err
+}
@@ -0,0 +1,228 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type DedicatedServerIam struct {
+	DisplayName string `json:"displayName"`
+}
+
+type DedicatedServer struct {
+	Name            string              `json:"name"`
+	Datacenter      string              `json:"datacenter"`
+	Reverse         string              `json:"reverse"`
+	Ip              string              `json:"ip"`
+	CommercialRange string              `json:"commercialRange"`
+	State           string              `json:"state"`
+	Rack            string              `json:"rack"`
+	Os              string              `json:"os"`
+	Iam             *DedicatedServerIam `json:"iam"`
+}
+
+func (s *DedicatedServer) String() string {
+	return fmt.Sprintf("DedicatedServer[name: %s, datacenter: %s, reverse: %s, state: %s]", s.Name, s.Datacenter, s.Reverse, s.State)
+}
+
+// DisplayName returns the name given to the server in the control panel,
+// or its service name when it has none.
+func (s *DedicatedServer) DisplayName() string {
+	if s.Iam != nil && s.Iam.DisplayName != "" {
+		return s.Iam.DisplayName
+	}
+	return s.Name
+}
+
+type DedicatedServerTask struct {
+	TaskId   int64  `json:"taskId"`
+	Function string `json:"function"`
+	Status   string `json:"status"`
+	Comment  string `json:"comment"`
+}
+
+func (t *DedicatedServerTask) String() string {
+	return fmt.Sprintf("DedicatedServerTask[id: %d, function: %s, status: %s]", t.TaskId, t.Function, t.Status)
+}
+
+type DedicatedServerVirtualMac struct {
+	MacAddress string `json:"macAddress"`
+	Type       string `json:"type"`
+}
+
+func (v *DedicatedServerVirtualMac) String() string {
+	return fmt.Sprintf("VirtualMac[macAddress: %s, type: %s]", v.MacAddress, v.Type)
+}
+
+type DedicatedServerVirtualMacCreateOpts struct {
+	IpAddress          string `json:"ipAddress"`
+	Type               string `json:"type"`
+	VirtualMachineName string `json:"virtualMachineName"`
+}
+
+func (v *DedicatedServerVirtualMacCreateOpts) String() string {
+	return fmt.Sprintf("ipAddress: %s, type: %s, virtualMachineName: %s", v.IpAddress, v.Type, v.VirtualMachineName)
+}
+
+type DedicatedServerVirtualMacAddress struct {
+	IpAddress          string `json:"ipAddress"`
+	VirtualMachineName string `json:"virtualMachineName"`
+}
+
+func (v *DedicatedServerVirtualMacAddress) String() string {
+	return fmt.Sprintf("VirtualMacAddress[ipAddress: %s, virtualMachineName: %s]", v.IpAddress, v.VirtualMachineName)
+}
+
+type DedicatedServerVirtualMacAddressCreateOpts struct {
+	IpAddress          string `json:"ipAddress"`
+	VirtualMachineName string `json:"virtualMachineName"`
+}
+
+func (v *DedicatedServerVirtualMacAddressCreateOpts) String() string {
+	return fmt.Sprintf("ipAddress: %s, virtualMachineName: %s", v.IpAddress, v.VirtualMachineName)
+}
+
+// dedicatedServerTaskWait waits for a task on a dedicated server to be done.
+func dedicatedServerTaskWait(c *ovh.Client, serviceName string, taskId int64, timeout time.Duration) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/dedicated/server/%s/task/%d", serviceName, taskId),
+		Description: fmt.Sprintf("task %d on dedicated server %s", taskId, serviceName),
+		Failed:      []string{"customerError", "ovhError", "cancelled"},
+		Purged:      "done",
+		Timeout:     timeout,
+	}).Wait(c)
+	return err
+}
+
+// dedicatedServerVirtualMacByIp returns the virtual MAC of the dedicated
+// server the ip is associated with, or an empty string.
+func dedicatedServerVirtualMacByIp(c *ovh.Client, serviceName, ip string) (string, error) {
+	macs := []string{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/virtualMac", serviceName)
+	if err := c.Get(endpoint, &macs); err != nil {
+		return "", fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	for _, mac := range macs {
+		ips := []string{}
+		endpoint := fmt.Sprintf("/dedicated/server/%s/virtualMac/%s/virtualAddress", serviceName, mac)
+		if err := c.Get(endpoint, &ips); err != nil {
+			return "", fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+		}
+
+		for _, i := range ips {
+			if i == ip {
+				return mac, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+type DedicatedServerSecondaryDnsDomain struct {
+	Domain       string `json:"domain"`
+	Dns          string `json:"dns"`
+	IpMaster     string `json:"ipMaster"`
+	CreationDate string `json:"creationDate"`
+}
+
+func (s *DedicatedServerSecondaryDnsDomain) String() string {
+	return fmt.Sprintf("SecondaryDnsDomain[domain: %s, dns: %s, ipMaster: %s]", s.Domain, s.Dns, s.IpMaster)
+}
+
+type DedicatedServerSecondaryDnsDomainCreateOpts struct {
+	Domain string `json:"domain"`
+	Ip     string `json:"ip,omitempty"`
+}
+
+type DedicatedServerSecondaryDnsDomainUpdateOpts struct {
+	IpMaster string `json:"ipMaster"`
+}
+
+type DedicatedServerIpmiAccessCreateOpts struct {
+	Type      string `json:"type"`
+	Ttl       int    `json:"ttl"`
+	IpToAllow string `json:"ipToAllow,omitempty"`
+	SshKey    string `json:"sshKey,omitempty"`
+}
+
+func (a *DedicatedServerIpmiAccessCreateOpts) String() string {
+	return fmt.Sprintf("type: %s, ttl: %d, ipToAllow: %s", a.Type, a.Ttl, a.IpToAllow)
+}
+
+type DedicatedServerIpmiAccess struct {
+	Value      string `json:"value"`
+	Expiration string `json:"expiration"`
+}
+
+type DedicatedServerNetworkInterfaceController struct {
+	Mac                     string `json:"mac"`
+	LinkType                string `json:"linkType"`
+	VirtualNetworkInterface string `json:"virtualNetworkInterface"`
+}
+
+func (n *DedicatedServerNetworkInterfaceController) String() string {
+	return fmt.Sprintf("NetworkInterfaceController[mac: %s, linkType: %s, virtualNetworkInterface: %s]", n.Mac, n.LinkType, n.VirtualNetworkInterface)
+}
+
+type DedicatedServerVirtualNetworkInterface struct {
+	Uuid                        string   `json:"uuid"`
+	Name                        string   `json:"name"`
+	Mode                        string   `json:"mode"`
+	Vrack                       string   `json:"vrack"`
+	Enabled                     bool     `json:"enabled"`
+	NetworkInterfaceControllers []string `json:"networkInterfaceController"`
+}
+
+func (v *DedicatedServerVirtualNetworkInterface) String() string {
+	return fmt.Sprintf("VirtualNetworkInterface[uuid: %s, name: %s, mode: %s]", v.Uuid, v.Name, v.Mode)
+}
+
+type DedicatedServerOlaAggregationOpts struct {
+	Name                     string   `json:"name"`
+	VirtualNetworkInterfaces []string `json:"virtualNetworkInterfaces"`
+}
+
+type DedicatedServerOlaResetOpts struct {
+	VirtualNetworkInterface string `json:"virtualNetworkInterface"`
+}
+
+type DedicatedServerCompatibleTemplates struct {
+	Ovh      []string `json:"ovh"`
+	Personal []string `json:"personal"`
+}
+
+type DedicatedServerMonitoring struct {
+	Monitoring     bool `json:"monitoring"`
+	NoIntervention bool `json:"noIntervention"`
+}
+
+func (m *DedicatedServerMonitoring) String() string {
+	return fmt.Sprintf("Monitoring[monitoring: %v, noIntervention: %v]", m.Monitoring, m.NoIntervention)
+}
+
+type DedicatedServerEmailAlert struct {
+	AlertId  int64  `json:"alertId,omitempty"`
+	Email    string `json:"email"`
+	Language string `json:"language"`
+}
+
+func (a *DedicatedServerEmailAlert) String() string {
+	return fmt.Sprintf("EmailAlert[id: %d, email: %s, language: %s]", a.AlertId, a.Email, a.Language)
+}
+
+type DedicatedServerSmsAlert struct {
+	AlertId       int64  `json:"alertId,omitempty"`
+	PhoneNumberTo string `json:"phoneNumberTo"`
+	SmsAccount    string `json:"smsAccount"`
+	FromHour      *int   `json:"fromHour,omitempty"`
+	ToHour        *int   `json:"toHour,omitempty"`
+	Language      string `json:"language"`
+}
+
+func (a *DedicatedServerSmsAlert) String() string {
+	return fmt.Sprintf("SmsAlert[id: %d, phoneNumberTo: %s, smsAccount: %s, language: %s]", a.AlertId, a.PhoneNumberTo, a.SmsAccount, a.Language)
+}
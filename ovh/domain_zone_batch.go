@@ -0,0 +1,134 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/ovh/go-ovh/ovh"
+)
+
+const (
+	// domainZoneWorkers is the number of record writes sent concurrently to
+	// the API for a given zone
+	domainZoneWorkers = 4
+
+	// domainZoneRefreshDelay is how long a zone refresh waits for other record
+	// changes to join it, and domainZoneRefreshMaxDelay bounds that wait when
+	// changes keep coming
+	domainZoneRefreshDelay    = 2 * time.Second
+	domainZoneRefreshMaxDelay = 20 * time.Second
+
+	domainZoneWriteTimeout = 2 * time.Minute
+)
+
+// domainZoneBatcher bounds the concurrent record writes made on each zone and
+// coalesces their zone refreshes: when a module creates hundreds of records,
+// the writes go through a small pool of workers and a single refresh is
+// shared by all the changes made while it was pending.
+type domainZoneBatcher struct {
+	client *ovh.Client
+
+	mu        sync.Mutex
+	workers   map[string]chan struct{}
+	refreshes map[string]*domainZoneRefresh
+}
+
+// domainZoneRefresh is a pending refresh of a zone and its result.
+type domainZoneRefresh struct {
+	timer   *time.Timer
+	created time.Time
+	fired   bool
+	done    chan struct{}
+	err     error
+}
+
+func newDomainZoneBatcher(client *ovh.Client) *domainZoneBatcher {
+	return &domainZoneBatcher{
+		client:    client,
+		workers:   make(map[string]chan struct{}),
+		refreshes: make(map[string]*domainZoneRefresh),
+	}
+}
+
+// Write runs an idempotent record write, an update or a deletion, on the
+// zone once a worker is available, and retries it while the API is throttling
+// or failing.
+func (b *domainZoneBatcher) Write(zone string, write func() error) error {
+	return b.do(zone, true, write)
+}
+
+// Create runs a record creation on the zone once a worker is available. It is
+// only retried while the API is throttling: a failure may happen once the
+// record is created, and posting it again would duplicate it.
+func (b *domainZoneBatcher) Create(zone string, create func() error) error {
+	return b.do(zone, false, create)
+}
+
+func (b *domainZoneBatcher) do(zone string, retryFailures bool, write func() error) error {
+	b.mu.Lock()
+	workers, ok := b.workers[zone]
+	if !ok {
+		workers = make(chan struct{}, domainZoneWorkers)
+		b.workers[zone] = workers
+	}
+	b.mu.Unlock()
+
+	workers <- struct{}{}
+	defer func() { <-workers }()
+
+	return resource.Retry(domainZoneWriteTimeout, func() *resource.RetryError {
+		err := write()
+		if err == nil {
+			return nil
+		}
+		if apiErr, ok := err.(*ovh.APIError); ok && (apiErr.Code == 429 || (retryFailures && apiErr.Code >= 500)) {
+			log.Printf("[DEBUG] Retrying write on zone %s: %s", zone, err)
+			return resource.RetryableError(err)
+		}
+		return resource.NonRetryableError(err)
+	})
+}
+
+// Refresh applies the pending changes of the zone, and returns once a refresh
+// started after the call is done.
+func (b *domainZoneBatcher) Refresh(zone string) error {
+	b.mu.Lock()
+	r, ok := b.refreshes[zone]
+	if !ok {
+		r = &domainZoneRefresh{
+			created: time.Now(),
+			done:    make(chan struct{}),
+		}
+		b.refreshes[zone] = r
+		r.timer = time.AfterFunc(domainZoneRefreshDelay, func() { b.run(zone, r) })
+	} else if time.Since(r.created) < domainZoneRefreshMaxDelay {
+		r.timer.Reset(domainZoneRefreshDelay)
+	}
+	b.mu.Unlock()
+
+	<-r.done
+	return r.err
+}
+
+func (b *domainZoneBatcher) run(zone string, r *domainZoneRefresh) {
+	b.mu.Lock()
+	// the timer may fire again if it was reset while firing
+	if r.fired {
+		b.mu.Unlock()
+		return
+	}
+	r.fired = true
+	delete(b.refreshes, zone)
+	b.mu.Unlock()
+
+	log.Printf("[INFO] Refresh OVH Zone: %s", zone)
+
+	endpoint := fmt.Sprintf("/domain/zone/%s/refresh", zone)
+	if err := b.client.Post(endpoint, nil, nil); err != nil {
+		r.err = fmt.Errorf("Error refresh OVH Zone: %s", err)
+	}
+	close(r.done)
+}
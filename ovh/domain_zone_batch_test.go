@@ -0,0 +1,80 @@
+package ovh
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestDomainZoneBatcher(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	client := newTestOVHClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls[r.Method+" "+r.URL.Path]++
+		n := calls[r.Method+" "+r.URL.Path]
+		mu.Unlock()
+
+		switch {
+		case r.Method == "PUT" && r.URL.Path == "/domain/zone/example.com/record/1" && n == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"message":"unavailable"}`))
+		case r.Method == "POST" && r.URL.Path == "/domain/zone/example.com/record" && n == 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"message":"throttled"}`))
+		case r.Method == "POST" && r.URL.Path == "/domain/zone/example.com/record" && n == 3:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"message":"unavailable"}`))
+		default:
+			w.Write([]byte(`{}`))
+		}
+	})
+	b := newDomainZoneBatcher(client)
+
+	// a failing write is retried
+	err := b.Write("example.com", func() error {
+		return client.Put("/domain/zone/example.com/record/1", nil, nil)
+	})
+	if err != nil {
+		t.Fatalf("write failed: %s", err)
+	}
+	if calls["PUT /domain/zone/example.com/record/1"] != 2 {
+		t.Fatalf("expected 2 calls on record, got %d", calls["PUT /domain/zone/example.com/record/1"])
+	}
+
+	// a throttled creation is retried
+	create := func() error {
+		return client.Post("/domain/zone/example.com/record", nil, nil)
+	}
+	if err := b.Create("example.com", create); err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	if calls["POST /domain/zone/example.com/record"] != 2 {
+		t.Fatalf("expected 2 calls on records, got %d", calls["POST /domain/zone/example.com/record"])
+	}
+
+	// a failing creation isn't, as the record may have been created
+	if err := b.Create("example.com", create); err == nil {
+		t.Fatal("expected create to fail")
+	}
+	if calls["POST /domain/zone/example.com/record"] != 3 {
+		t.Fatalf("expected 3 calls on records, got %d", calls["POST /domain/zone/example.com/record"])
+	}
+
+	// concurrent changes share a single refresh
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := b.Refresh("example.com"); err != nil {
+				t.Errorf("refresh failed: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls["POST /domain/zone/example.com/refresh"] != 1 {
+		t.Fatalf("expected 1 refresh, got %d", calls["POST /domain/zone/example.com/refresh"])
+	}
+}
@@ -0,0 +1,202 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// domainZonePropagationQueryTimeout bounds each query sent to a name server,
+// so that an unresponsive server is retried until the operation timeout.
+const domainZonePropagationQueryTimeout = 10 * time.Second
+
+// domainZoneRecordFqdn returns the absolute name of a record of a zone.
+func domainZoneRecordFqdn(zone, subDomain string) string {
+	if subDomain == "" {
+		return normalizeDomain(zone) + "."
+	}
+	return fmt.Sprintf("%s.%s.", strings.ToLower(subDomain), normalizeDomain(zone))
+}
+
+// domainZoneRecordTargetName returns the absolute form of a name targeted by a
+// record: names without a final dot are relative to the zone.
+func domainZoneRecordTargetName(zone, target string) string {
+	target = strings.ToLower(strings.TrimSpace(target))
+	if strings.HasSuffix(target, ".") {
+		return target
+	}
+	return domainZoneRecordFqdn(zone, target)
+}
+
+// domainZoneTxtValue returns the value of a TXT record target, which may be
+// made of several quoted strings.
+func domainZoneTxtValue(target string) string {
+	target = strings.TrimSpace(target)
+	if !strings.HasPrefix(target, "\"") {
+		return target
+	}
+
+	parts := strings.Split(strings.Trim(target, "\""), "\"")
+	value := ""
+	for i, part := range parts {
+		// odd parts are the blanks between two quoted strings
+		if i%2 == 0 {
+			value += part
+		}
+	}
+	return value
+}
+
+// domainZoneRecordResolvedMatch returns whether one of the values resolved
+// for a record matches its target. Values are in the form returned by
+// domainZoneRecordResolve.
+func domainZoneRecordResolvedMatch(zone, fieldType, target string, values []string) bool {
+	for _, value := range values {
+		switch fieldType {
+		case "A", "AAAA":
+			ip := net.ParseIP(strings.TrimSpace(target))
+			if ip != nil && ip.Equal(net.ParseIP(value)) {
+				return true
+			}
+		case "CNAME", "NS":
+			if strings.ToLower(value) == domainZoneRecordTargetName(zone, target) {
+				return true
+			}
+		case "MX":
+			fields := strings.Fields(target)
+			if len(fields) != 2 {
+				return false
+			}
+			if fmt.Sprintf("%s %s", fields[0], domainZoneRecordTargetName(zone, fields[1])) == strings.ToLower(value) {
+				return true
+			}
+		case "TXT", "SPF", "DKIM", "DMARC":
+			if value == domainZoneTxtValue(target) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// domainZoneRecordResolvable returns whether the propagation of records of a
+// type can be checked.
+func domainZoneRecordResolvable(fieldType string) bool {
+	switch fieldType {
+	case "A", "AAAA", "CNAME", "NS", "MX", "TXT", "SPF", "DKIM", "DMARC":
+		return true
+	}
+	return false
+}
+
+// domainZoneRecordResolve queries a name server for the values of a record.
+func domainZoneRecordResolve(nameServer, fqdn, fieldType string) ([]string, error) {
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			d := net.Dialer{}
+			return d.DialContext(ctx, network, net.JoinHostPort(nameServer, "53"))
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), domainZonePropagationQueryTimeout)
+	defer cancel()
+
+	values := []string{}
+	switch fieldType {
+	case "A", "AAAA":
+		network := "ip4"
+		if fieldType == "AAAA" {
+			network = "ip6"
+		}
+		ips, err := resolver.LookupIP(ctx, network, fqdn)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range ips {
+			values = append(values, ip.String())
+		}
+	case "CNAME":
+		cname, err := resolver.LookupCNAME(ctx, fqdn)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, cname)
+	case "NS":
+		nss, err := resolver.LookupNS(ctx, fqdn)
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range nss {
+			values = append(values, ns.Host)
+		}
+	case "MX":
+		mxs, err := resolver.LookupMX(ctx, fqdn)
+		if err != nil {
+			return nil, err
+		}
+		for _, mx := range mxs {
+			values = append(values, fmt.Sprintf("%d %s", mx.Pref, mx.Host))
+		}
+	default:
+		txts, err := resolver.LookupTXT(ctx, fqdn)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, txts...)
+	}
+
+	return values, nil
+}
+
+// domainZoneRecordPropagationWait waits for every name server of the zone to
+// serve the record.
+func domainZoneRecordPropagationWait(config *Config, record *OvhDomainZoneRecord, zone string, timeout time.Duration) error {
+	if !domainZoneRecordResolvable(record.FieldType) {
+		log.Printf("[WARN] Propagation of %s records can't be checked, skipping check of %s on zone %s", record.FieldType, ovhDomainZoneRecordName(record), zone)
+		return nil
+	}
+
+	dz := &DomainZone{}
+	endpoint := fmt.Sprintf("/domain/zone/%s", zone)
+	if err := config.OVHClient.Get(endpoint, dz); err != nil {
+		return fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	fqdn := domainZoneRecordFqdn(zone, record.SubDomain)
+	pending := append([]string{}, dz.NameServers...)
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		remaining := []string{}
+		for _, ns := range pending {
+			values, err := domainZoneRecordResolve(ns, fqdn, record.FieldType)
+			if err != nil {
+				log.Printf("[DEBUG] Resolving %s %s on %s failed: %s", fqdn, record.FieldType, ns, err)
+				remaining = append(remaining, ns)
+				continue
+			}
+			if !domainZoneRecordResolvedMatch(zone, record.FieldType, record.Target, values) {
+				log.Printf("[DEBUG] %s %s resolved to %v on %s, waiting for %s", fqdn, record.FieldType, values, ns, record.Target)
+				remaining = append(remaining, ns)
+			}
+		}
+
+		pending = remaining
+		if len(pending) > 0 {
+			return resource.RetryableError(fmt.Errorf("%s %s isn't served with target %s by %v yet", fqdn, record.FieldType, record.Target, pending))
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Record %s on zone %s didn't propagate to the OVH name servers: %s", ovhDomainZoneRecordName(record), zone, err)
+	}
+
+	log.Printf("[DEBUG] %s %s is served by %v", fqdn, record.FieldType, dz.NameServers)
+	return nil
+}
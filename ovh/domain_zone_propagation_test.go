@@ -0,0 +1,61 @@
+package ovh
+
+import (
+	"testing"
+)
+
+func TestDomainZoneRecordFqdn(t *testing.T) {
+	cases := map[string]string{
+		"":        "example.com.",
+		"www":     "www.example.com.",
+		"_acme.A": "_acme.a.example.com.",
+	}
+
+	for subDomain, expected := range cases {
+		if fqdn := domainZoneRecordFqdn("Example.com", subDomain); fqdn != expected {
+			t.Errorf("%q: expected %s, got %s", subDomain, expected, fqdn)
+		}
+	}
+}
+
+func TestDomainZoneTxtValue(t *testing.T) {
+	cases := map[string]string{
+		`v=spf1 -all`:                "v=spf1 -all",
+		`"acme-challenge-token"`:     "acme-challenge-token",
+		`"first part" "second part"`: "first partsecond part",
+		` "surrounded by blanks" `:   "surrounded by blanks",
+	}
+
+	for target, expected := range cases {
+		if value := domainZoneTxtValue(target); value != expected {
+			t.Errorf("%q: expected %q, got %q", target, expected, value)
+		}
+	}
+}
+
+func TestDomainZoneRecordResolvedMatch(t *testing.T) {
+	cases := []struct {
+		fieldType string
+		target    string
+		values    []string
+		expected  bool
+	}{
+		{"A", "192.0.2.10", []string{"192.0.2.10"}, true},
+		{"A", "192.0.2.10", []string{"192.0.2.11"}, false},
+		{"AAAA", "2001:db8:0::1", []string{"2001:db8::1"}, true},
+		{"CNAME", "target.example.net.", []string{"target.example.net."}, true},
+		{"CNAME", "www", []string{"www.example.com."}, true},
+		{"CNAME", "www", []string{"www.example.net."}, false},
+		{"MX", "10 mx1.mail.ovh.net.", []string{"10 mx1.mail.ovh.net.", "20 mx2.mail.ovh.net."}, true},
+		{"MX", "10 mx1.mail.ovh.net.", []string{"20 mx1.mail.ovh.net."}, false},
+		{"TXT", `"acme-challenge-token"`, []string{"other", "acme-challenge-token"}, true},
+		{"TXT", `"acme-challenge-token"`, []string{}, false},
+		{"CAA", `0 issue "letsencrypt.org"`, []string{`0 issue "letsencrypt.org"`}, false},
+	}
+
+	for _, c := range cases {
+		if ok := domainZoneRecordResolvedMatch("example.com", c.fieldType, c.target, c.values); ok != c.expected {
+			t.Errorf("%s %s with %v: expected %v, got %v", c.fieldType, c.target, c.values, c.expected, ok)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type EmailDomain struct {
+	Domain             string  `json:"domain"`
+	Status             string  `json:"status"`
+	Offer              string  `json:"offer"`
+	AllowedAccountSize []int64 `json:"allowedAccountSize"`
+	CreationDate       string  `json:"creationDate"`
+	LinkTo             string  `json:"linkTo"`
+}
+
+func (e *EmailDomain) String() string {
+	return fmt.Sprintf("EmailDomain[domain: %s, status: %s, offer: %s]", e.Domain, e.Status, e.Offer)
+}
+
+type EmailDomainAccount struct {
+	AccountName string `json:"accountName"`
+	Domain      string `json:"domain"`
+	Email       string `json:"email"`
+	Description string `json:"description"`
+	Size        int64  `json:"size"`
+	IsBlocked   bool   `json:"isBlocked"`
+	State       string `json:"state"`
+}
+
+func (a *EmailDomainAccount) String() string {
+	return fmt.Sprintf("EmailAccount[email: %s, size: %d, state: %s]", a.Email, a.Size, a.State)
+}
+
+type EmailDomainAccountCreateOpts struct {
+	AccountName string `json:"accountName"`
+	Password    string `json:"password"`
+	Size        int64  `json:"size,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type EmailDomainAccountUpdateOpts struct {
+	Size        int64  `json:"size,omitempty"`
+	Description string `json:"description"`
+}
+
+type EmailDomainAccountChangePasswordOpts struct {
+	Password string `json:"password"`
+}
+
+type EmailDomainRedirection struct {
+	Id   string `json:"id"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+func (r *EmailDomainRedirection) String() string {
+	return fmt.Sprintf("EmailRedirection[id: %s, from: %s, to: %s]", r.Id, r.From, r.To)
+}
+
+type EmailDomainRedirectionCreateOpts struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	LocalCopy bool   `json:"localCopy"`
+}
+
+type EmailDomainRedirectionChangeOpts struct {
+	To string `json:"to"`
+}
+
+// EmailDomainTask is the common part of the tasks returned by the
+// email domain API. Tasks are purged once done.
+type EmailDomainTask struct {
+	Id     int64  `json:"id"`
+	Action string `json:"action"`
+	Date   string `json:"date"`
+}
+
+func (t *EmailDomainTask) String() string {
+	return fmt.Sprintf("EmailDomainTask[id: %d, action: %s, date: %s]", t.Id, t.Action, t.Date)
+}
+
+// TaskStatus returns "pending": the tasks are only known until they are
+// done.
+func (t *EmailDomainTask) TaskStatus() string {
+	return "pending"
+}
+
+// emailDomainTaskWait waits for a task of the given kind (account,
+// redirection, mailinglist, ...) to be done on an email domain.
+func emailDomainTaskWait(c *ovh.Client, domain, kind string, taskId int64) (apiTaskStatus, error) {
+	return (&taskPoller{
+		Endpoint:    fmt.Sprintf("/email/domain/%s/task/%s/%d", domain, kind, taskId),
+		Description: fmt.Sprintf("%s task %d on email domain %s", kind, taskId, domain),
+		Task:        &EmailDomainTask{},
+		Pending:     []string{"pending"},
+		Purged:      "done",
+		Delay:       5 * time.Second,
+	}).Wait(c)
+}
+
+type EmailDomainMailingListOptions struct {
+	ModeratorMessage     bool `json:"moderatorMessage"`
+	SubscribeByModerator bool `json:"subscribeByModerator"`
+	UsersPostOnly        bool `json:"usersPostOnly"`
+}
+
+type EmailDomainMailingList struct {
+	Id            int64                          `json:"id"`
+	Name          string                         `json:"name"`
+	Language      string                         `json:"language"`
+	OwnerEmail    string                         `json:"ownerEmail"`
+	ReplyTo       string                         `json:"replyTo"`
+	NbSubscribers int64                          `json:"nbSubscribers"`
+	Options       *EmailDomainMailingListOptions `json:"options"`
+}
+
+func (m *EmailDomainMailingList) String() string {
+	return fmt.Sprintf("MailingList[name: %s, language: %s, ownerEmail: %s, replyTo: %s]", m.Name, m.Language, m.OwnerEmail, m.ReplyTo)
+}
+
+type EmailDomainMailingListCreateOpts struct {
+	Name       string                         `json:"name"`
+	Language   string                         `json:"language"`
+	OwnerEmail string                         `json:"ownerEmail"`
+	ReplyTo    string                         `json:"replyTo"`
+	Options    *EmailDomainMailingListOptions `json:"options"`
+}
+
+type EmailDomainMailingListUpdateOpts struct {
+	Language   string `json:"language"`
+	OwnerEmail string `json:"ownerEmail"`
+	ReplyTo    string `json:"replyTo"`
+}
+
+type EmailDomainMailingListChangeOptionsOpts struct {
+	Options *EmailDomainMailingListOptions `json:"options"`
+}
+
+type EmailDomainMailingListMemberOpts struct {
+	Email string `json:"email"`
+}
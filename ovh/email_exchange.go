@@ -0,0 +1,82 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type EmailExchangeService struct {
+	Domain         string `json:"domain"`
+	DisplayName    string `json:"displayName"`
+	Hostname       string `json:"hostname"`
+	Offer          string `json:"offer"`
+	State          string `json:"state"`
+	MaxSendSize    int64  `json:"maxSendSize"`
+	MaxReceiveSize int64  `json:"maxReceiveSize"`
+}
+
+func (s *EmailExchangeService) String() string {
+	return fmt.Sprintf("ExchangeService[domain: %s, offer: %s, state: %s]", s.Domain, s.Offer, s.State)
+}
+
+type EmailExchangeAccount struct {
+	PrimaryEmailAddress string `json:"primaryEmailAddress"`
+	Login               string `json:"login"`
+	Domain              string `json:"domain"`
+	DisplayName         string `json:"displayName"`
+	FirstName           string `json:"firstName"`
+	LastName            string `json:"lastName"`
+	AccountLicense      string `json:"accountLicense"`
+	Quota               int64  `json:"quota"`
+	CurrentUsage        int64  `json:"currentUsage"`
+	State               string `json:"state"`
+}
+
+func (a *EmailExchangeAccount) String() string {
+	return fmt.Sprintf("ExchangeAccount[email: %s, license: %s, state: %s]", a.PrimaryEmailAddress, a.AccountLicense, a.State)
+}
+
+type EmailExchangeAccountCreateOpts struct {
+	Login       string `json:"login"`
+	Domain      string `json:"domain"`
+	Password    string `json:"password"`
+	License     string `json:"license"`
+	DisplayName string `json:"displayName,omitempty"`
+	FirstName   string `json:"firstName,omitempty"`
+	LastName    string `json:"lastName,omitempty"`
+}
+
+type EmailExchangeAccountUpdateOpts struct {
+	DisplayName string `json:"displayName"`
+	FirstName   string `json:"firstName"`
+	LastName    string `json:"lastName"`
+	Quota       int64  `json:"quota,omitempty"`
+}
+
+type EmailAccountChangePasswordOpts struct {
+	Password string `json:"password"`
+}
+
+type EmailExchangeTask struct {
+	Id       int64  `json:"id"`
+	Function string `json:"function"`
+	Status   string `json:"status"`
+}
+
+func (t *EmailExchangeTask) String() string {
+	return fmt.Sprintf("ExchangeTask[id: %d, function: %s, status: %s]", t.Id, t.Function, t.Status)
+}
+
+// emailExchangeTaskWait waits for a task of an exchange service to be done.
+func emailExchangeTaskWait(c *ovh.Client, organizationName, serviceName string, taskId int64) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/email/exchange/%s/service/%s/task/%d", organizationName, serviceName, taskId),
+		Description: fmt.Sprintf("task %d on exchange %s/%s", taskId, organizationName, serviceName),
+		Pending:     []string{"todo", "doing"},
+		Purged:      "done",
+		Timeout:     30 * time.Minute,
+	}).Wait(c)
+	return err
+}
@@ -0,0 +1,89 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type EmailPro struct {
+	Domain         string `json:"domain"`
+	DisplayName    string `json:"displayName"`
+	Hostname       string `json:"hostname"`
+	Offer          string `json:"offer"`
+	State          string `json:"state"`
+	MaxSendSize    int64  `json:"maxSendSize"`
+	MaxReceiveSize int64  `json:"maxReceiveSize"`
+}
+
+func (s *EmailPro) String() string {
+	return fmt.Sprintf("EmailPro[domain: %s, offer: %s, state: %s]", s.Domain, s.Offer, s.State)
+}
+
+type EmailProAccount struct {
+	PrimaryEmailAddress string `json:"primaryEmailAddress"`
+	Login               string `json:"login"`
+	Domain              string `json:"domain"`
+	DisplayName         string `json:"displayName"`
+	FirstName           string `json:"firstName"`
+	LastName            string `json:"lastName"`
+	Configured          bool   `json:"configured"`
+	Quota               int64  `json:"quota"`
+	CurrentUsage        int64  `json:"currentUsage"`
+	State               string `json:"state"`
+}
+
+func (a *EmailProAccount) String() string {
+	return fmt.Sprintf("EmailProAccount[email: %s, configured: %t, state: %s]", a.PrimaryEmailAddress, a.Configured, a.State)
+}
+
+type EmailProAccountUpdateOpts struct {
+	Login       string `json:"login"`
+	Domain      string `json:"domain"`
+	DisplayName string `json:"displayName"`
+	FirstName   string `json:"firstName"`
+	LastName    string `json:"lastName"`
+}
+
+type EmailProTask struct {
+	Id       int64  `json:"id"`
+	Function string `json:"function"`
+	Status   string `json:"status"`
+}
+
+func (t *EmailProTask) String() string {
+	return fmt.Sprintf("EmailProTask[id: %d, function: %s, status: %s]", t.Id, t.Function, t.Status)
+}
+
+// emailProTaskWait waits for a task of an email pro service to be done.
+func emailProTaskWait(c *ovh.Client, serviceName string, taskId int64) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/email/pro/%s/task/%d", serviceName, taskId),
+		Description: fmt.Sprintf("task %d on email pro %s", taskId, serviceName),
+		Pending:     []string{"todo", "doing"},
+		Purged:      "done",
+		Timeout:     30 * time.Minute,
+	}).Wait(c)
+	return err
+}
+
+func (a *EmailProAccount) TaskStatus() string {
+	return a.State
+}
+
+// emailProAccountWait waits for an email pro account to be ready once
+// configured.
+func emailProAccountWait(c *ovh.Client, serviceName, email string) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/email/pro/%s/account/%s", serviceName, email),
+		Description: fmt.Sprintf("email pro %s account %s", serviceName, email),
+		Task:        &EmailProAccount{},
+		Pending:     []string{"pending", "configurationPending", "creating", "reopening"},
+		Target:      []string{"ok"},
+		// the account is renamed asynchronously
+		Purged:  "pending",
+		Timeout: 30 * time.Minute,
+	}).Wait(c)
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"net"
+	"sync"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/ovh/go-ovh/ovh"
@@ -118,3 +119,40 @@ func stringsFromSchema(d *schema.ResourceData, id string) []string {
 	}
 	return xs
 }
+
+// maxConcurrentRequests bounds the number of API calls made in parallel
+// when fetching the details of listed items.
+const maxConcurrentRequests = 8
+
+// fetchConcurrently calls fetch for each index in [0, n), with at most
+// maxConcurrentRequests calls in flight. fetch is expected to store its
+// result at index i, so that the order of the list is kept. The first
+// error encountered is returned once all the calls are done.
+func fetchConcurrently(n int, fetch func(i int) error) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	sem := make(chan struct{}, maxConcurrentRequests)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fetch(i); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return firstErr
+}
@@ -0,0 +1,127 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type UnitAndValue struct {
+	Unit  string  `json:"unit"`
+	Value float64 `json:"value"`
+}
+
+type HostingPrivateDatabase struct {
+	ServiceName    string        `json:"serviceName"`
+	DisplayName    string        `json:"displayName"`
+	Datacenter     string        `json:"datacenter"`
+	Hostname       string        `json:"hostname"`
+	HostnameFtp    string        `json:"hostnameFtp"`
+	Port           int           `json:"port"`
+	PortFtp        int           `json:"portFtp"`
+	Cpu            int           `json:"cpu"`
+	Ram            *UnitAndValue `json:"ram"`
+	QuotaSize      *UnitAndValue `json:"quotaSize"`
+	QuotaUsed      *UnitAndValue `json:"quotaUsed"`
+	Infrastructure string        `json:"infrastructure"`
+	Offer          string        `json:"offer"`
+	Server         string        `json:"server"`
+	State          string        `json:"state"`
+	Type           string        `json:"type"`
+	Version        string        `json:"version"`
+}
+
+func (p *HostingPrivateDatabase) String() string {
+	return fmt.Sprintf("PrivateDatabase[serviceName: %s, displayName: %s, type: %s, version: %s, state: %s]", p.ServiceName, p.DisplayName, p.Type, p.Version, p.State)
+}
+
+type HostingPrivateDatabaseUpdateOpts struct {
+	DisplayName string `json:"displayName"`
+}
+
+type HostingPrivateDatabaseDatabase struct {
+	DatabaseName string        `json:"databaseName"`
+	CreationDate string        `json:"creationDate"`
+	QuotaUsed    *UnitAndValue `json:"quotaUsed"`
+}
+
+type HostingPrivateDatabaseDatabaseCreateOpts struct {
+	DatabaseName string `json:"databaseName"`
+}
+
+type HostingPrivateDatabaseUser struct {
+	UserName     string `json:"userName"`
+	CreationDate string `json:"creationDate"`
+}
+
+type HostingPrivateDatabaseUserCreateOpts struct {
+	UserName string `json:"userName"`
+	Password string `json:"password"`
+}
+
+type HostingPrivateDatabaseUserChangePasswordOpts struct {
+	Password string `json:"password"`
+}
+
+type HostingPrivateDatabaseUserGrant struct {
+	DatabaseName string `json:"databaseName"`
+	Grant        string `json:"grant"`
+	CreationDate string `json:"creationDate"`
+}
+
+type HostingPrivateDatabaseUserGrantCreateOpts struct {
+	DatabaseName string `json:"databaseName"`
+	Grant        string `json:"grant"`
+}
+
+type HostingPrivateDatabaseUserGrantUpdateOpts struct {
+	Grant string `json:"grant"`
+}
+
+type HostingPrivateDatabaseWhitelist struct {
+	Ip           string `json:"ip"`
+	Name         string `json:"name"`
+	Service      bool   `json:"service"`
+	Sftp         bool   `json:"sftp"`
+	Status       string `json:"status"`
+	CreationDate string `json:"creationDate"`
+}
+
+func (w *HostingPrivateDatabaseWhitelist) String() string {
+	return fmt.Sprintf("PrivateDatabaseWhitelist[ip: %s, name: %s, service: %t, sftp: %t, status: %s]", w.Ip, w.Name, w.Service, w.Sftp, w.Status)
+}
+
+type HostingPrivateDatabaseWhitelistCreateOpts struct {
+	Ip      string `json:"ip"`
+	Name    string `json:"name,omitempty"`
+	Service bool   `json:"service"`
+	Sftp    bool   `json:"sftp"`
+}
+
+type HostingPrivateDatabaseWhitelistUpdateOpts struct {
+	Name    string `json:"name"`
+	Service bool   `json:"service"`
+	Sftp    bool   `json:"sftp"`
+}
+
+type HostingPrivateDatabaseTask struct {
+	Id       int64  `json:"id"`
+	Function string `json:"function"`
+	Status   string `json:"status"`
+}
+
+func (t *HostingPrivateDatabaseTask) String() string {
+	return fmt.Sprintf("PrivateDatabaseTask[id: %d, function: %s, status: %s]", t.Id, t.Function, t.Status)
+}
+
+// privateDatabaseTaskWait waits for a private database task to be done.
+func privateDatabaseTaskWait(c *ovh.Client, serviceName string, taskId int64) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/hosting/privateDatabase/%s/tasks/%d", serviceName, taskId),
+		Description: fmt.Sprintf("task %d on private database %s", taskId, serviceName),
+		Purged:      "done",
+		Delay:       5 * time.Second,
+	}).Wait(c)
+	return err
+}
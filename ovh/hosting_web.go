@@ -0,0 +1,199 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type HostingWebPhpVersion struct {
+	Version string `json:"version"`
+	Support string `json:"support"`
+}
+
+type HostingWebQuota struct {
+	Unit  string  `json:"unit"`
+	Value float64 `json:"value"`
+}
+
+type HostingWeb struct {
+	ServiceName     string                 `json:"serviceName"`
+	DisplayName     string                 `json:"displayName"`
+	Offer           string                 `json:"offer"`
+	State           string                 `json:"state"`
+	Datacenter      string                 `json:"datacenter"`
+	Cluster         string                 `json:"cluster"`
+	ClusterIp       string                 `json:"clusterIp"`
+	ClusterIpv6     string                 `json:"clusterIpv6"`
+	HostingIp       string                 `json:"hostingIp"`
+	HostingIpv6     string                 `json:"hostingIpv6"`
+	PrimaryLogin    string                 `json:"primaryLogin"`
+	Home            string                 `json:"home"`
+	OperatingSystem string                 `json:"operatingSystem"`
+	QuotaSize       *HostingWebQuota       `json:"quotaSize"`
+	QuotaUsed       *HostingWebQuota       `json:"quotaUsed"`
+	PhpVersions     []HostingWebPhpVersion `json:"phpVersions"`
+}
+
+func (h *HostingWeb) String() string {
+	return fmt.Sprintf("HostingWeb[serviceName: %s, offer: %s, state: %s, cluster: %s]", h.ServiceName, h.Offer, h.State, h.Cluster)
+}
+
+type HostingWebOvhConfig struct {
+	Id            int64  `json:"id"`
+	Path          string `json:"path"`
+	EngineName    string `json:"engineName"`
+	EngineVersion string `json:"engineVersion"`
+	Environment   string `json:"environment"`
+	HttpFirewall  string `json:"httpFirewall"`
+	Container     string `json:"container"`
+	Status        string `json:"status"`
+	FileExist     bool   `json:"fileExist"`
+	Historical    bool   `json:"historical"`
+}
+
+func (c *HostingWebOvhConfig) String() string {
+	return fmt.Sprintf("OvhConfig[id: %d, path: %s, engine: %s %s, environment: %s, httpFirewall: %s, container: %s]", c.Id, c.Path, c.EngineName, c.EngineVersion, c.Environment, c.HttpFirewall, c.Container)
+}
+
+type HostingWebOvhConfigChangeOpts struct {
+	EngineName    string `json:"engineName,omitempty"`
+	EngineVersion string `json:"engineVersion,omitempty"`
+	Environment   string `json:"environment,omitempty"`
+	HttpFirewall  string `json:"httpFirewall,omitempty"`
+	Container     string `json:"container,omitempty"`
+}
+
+// hostingWebOvhConfigByPath returns the current ovhConfig of a path of a web
+// hosting. Changing the configuration historizes the previous one under a new
+// id, so the configuration is always looked up by its path.
+func hostingWebOvhConfigByPath(c *ovh.Client, serviceName, path string) (*HostingWebOvhConfig, error) {
+	ids := []int64{}
+	endpoint := fmt.Sprintf("/hosting/web/%s/ovhConfig?historical=false&path=%s", serviceName, url.QueryEscape(path))
+	if err := c.Get(endpoint, &ids); err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		r := &HostingWebOvhConfig{}
+		endpoint := fmt.Sprintf("/hosting/web/%s/ovhConfig/%d", serviceName, id)
+		if err := c.Get(endpoint, r); err != nil {
+			return nil, err
+		}
+		if !r.Historical && r.Path == path {
+			return r, nil
+		}
+	}
+
+	return nil, nil
+}
+
+type HostingWebModuleListEntry struct {
+	Id       int64    `json:"id"`
+	Name     string   `json:"name"`
+	Version  string   `json:"version"`
+	Branch   string   `json:"branch"`
+	Latest   bool     `json:"latest"`
+	Active   bool     `json:"active"`
+	Language []string `json:"language"`
+}
+
+func (m *HostingWebModuleListEntry) String() string {
+	return fmt.Sprintf("ModuleListEntry[id: %d, name: %s, version: %s, latest: %t, active: %t]", m.Id, m.Name, m.Version, m.Latest, m.Active)
+}
+
+type HostingWebModule struct {
+	Id          int64  `json:"id"`
+	ModuleId    int64  `json:"moduleId"`
+	AdminName   string `json:"adminName"`
+	AdminFolder string `json:"adminFolder"`
+	TargetUrl   string `json:"targetUrl"`
+	Language    string `json:"language"`
+	Path        string `json:"path"`
+	Status      string `json:"status"`
+}
+
+func (m *HostingWebModule) String() string {
+	return fmt.Sprintf("Module[id: %d, moduleId: %d, path: %s, targetUrl: %s, status: %s]", m.Id, m.ModuleId, m.Path, m.TargetUrl, m.Status)
+}
+
+type HostingWebModuleDependency struct {
+	Name     string `json:"name,omitempty"`
+	Password string `json:"password,omitempty"`
+	Prefix   string `json:"prefix,omitempty"`
+	Server   string `json:"server,omitempty"`
+	Port     *int   `json:"port,omitempty"`
+	Type     string `json:"type,omitempty"`
+}
+
+type HostingWebModuleCreateOpts struct {
+	ModuleId      int64                        `json:"moduleId"`
+	Domain        string                       `json:"domain,omitempty"`
+	Path          string                       `json:"path,omitempty"`
+	Language      string                       `json:"language,omitempty"`
+	AdminName     string                       `json:"adminName"`
+	AdminPassword string                       `json:"adminPassword"`
+	Dependencies  []HostingWebModuleDependency `json:"dependencies,omitempty"`
+}
+
+func (o *HostingWebModuleCreateOpts) String() string {
+	return fmt.Sprintf("moduleId: %d, domain: %s, path: %s, language: %s, adminName: %s", o.ModuleId, o.Domain, o.Path, o.Language, o.AdminName)
+}
+
+type HostingWebModulePasswordOpts struct {
+	Password string `json:"password"`
+}
+
+type HostingWebAttachedDomain struct {
+	Domain   string `json:"domain"`
+	Path     string `json:"path"`
+	Ssl      bool   `json:"ssl"`
+	Cdn      string `json:"cdn"`
+	Firewall string `json:"firewall"`
+	OwnLog   string `json:"ownLog"`
+	Status   string `json:"status"`
+}
+
+func (a *HostingWebAttachedDomain) String() string {
+	return fmt.Sprintf("AttachedDomain[domain: %s, path: %s, ssl: %t, cdn: %s, firewall: %s, status: %s]", a.Domain, a.Path, a.Ssl, a.Cdn, a.Firewall, a.Status)
+}
+
+type HostingWebAttachedDomainCreateOpts struct {
+	Domain   string `json:"domain"`
+	Path     string `json:"path"`
+	Ssl      bool   `json:"ssl"`
+	Cdn      string `json:"cdn"`
+	Firewall string `json:"firewall"`
+	OwnLog   string `json:"ownLog,omitempty"`
+}
+
+type HostingWebAttachedDomainUpdateOpts struct {
+	Path     string `json:"path"`
+	Ssl      bool   `json:"ssl"`
+	Cdn      string `json:"cdn"`
+	Firewall string `json:"firewall"`
+	OwnLog   string `json:"ownLog,omitempty"`
+}
+
+type HostingWebTask struct {
+	Id       int64  `json:"id"`
+	Function string `json:"function"`
+	Status   string `json:"status"`
+}
+
+func (t *HostingWebTask) String() string {
+	return fmt.Sprintf("HostingWebTask[id: %d, function: %s, status: %s]", t.Id, t.Function, t.Status)
+}
+
+// hostingWebTaskWait waits for a web hosting task to be done.
+func hostingWebTaskWait(c *ovh.Client, serviceName string, taskId int64) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/hosting/web/%s/tasks/%d", serviceName, taskId),
+		Description: fmt.Sprintf("task %d on web hosting %s", taskId, serviceName),
+		Purged:      "done",
+		Delay:       5 * time.Second,
+	}).Wait(c)
+	return err
+}
@@ -0,0 +1,45 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type IpLoadbalancingZoned struct {
+	Zone string `json:"zone"`
+}
+
+// ipLoadbalancingZonesCompatible returns whether a farm of farmZone can
+// receive the traffic of a frontend of frontendZone: the farm must be in the
+// same zone, or in all the zones.
+func ipLoadbalancingZonesCompatible(frontendZone, farmZone string) bool {
+	farmZone = normalizeZone(farmZone)
+	return farmZone == normalizeZone("all") || farmZone == normalizeZone(frontendZone)
+}
+
+// ipLoadbalancingFarmZoneCheck returns an explicit error when the farm
+// referenced by key doesn't exist, or can't be used from a frontend of
+// frontendZone. farmType is the protocol of the farm, "tcp" or "http".
+func ipLoadbalancingFarmZoneCheck(c *ovh.Client, service, farmType string, farmId int, frontendZone, key string) error {
+	log.Printf("[DEBUG] Will check %s farm %d of %s can be used from zone %s", farmType, farmId, service, frontendZone)
+
+	farm := &IpLoadbalancingZoned{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/%s/farm/%d", service, farmType, farmId)
+	if err := c.Get(endpoint, farm); err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			return fmt.Errorf("%s %d doesn't match any %s farm of %s", key, farmId, farmType, service)
+		}
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	if !ipLoadbalancingZonesCompatible(frontendZone, farm.Zone) {
+		return fmt.Errorf(
+			"%s %d is a farm of zone %q, which can't receive the traffic of zone %q: use a farm of zone %q or \"all\"",
+			key, farmId, farm.Zone, frontendZone, frontendZone,
+		)
+	}
+
+	return nil
+}
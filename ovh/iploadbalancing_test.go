@@ -0,0 +1,26 @@
+package ovh
+
+import (
+	"testing"
+)
+
+func TestIpLoadbalancingZonesCompatible(t *testing.T) {
+	cases := []struct {
+		frontendZone string
+		farmZone     string
+		expected     bool
+	}{
+		{"all", "all", true},
+		{"gra", "all", true},
+		{"gra", "gra", true},
+		{"GRA", "gra", true},
+		{"gra", "bhs", false},
+		{"all", "gra", false},
+	}
+
+	for _, c := range cases {
+		if ok := ipLoadbalancingZonesCompatible(c.frontendZone, c.farmZone); ok != c.expected {
+			t.Errorf("frontend %s, farm %s: expected %v, got %v", c.frontendZone, c.farmZone, c.expected, ok)
+		}
+	}
+}
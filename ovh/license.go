@@ -0,0 +1,296 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// License holds the attributes of the /license/{type} APIs. Some of them
+// are only returned for a single license type.
+type License struct {
+	LicenseId          string `json:"licenseId"`
+	Ip                 string `json:"ip"`
+	Version            string `json:"version"`
+	Status             string `json:"status"`
+	Creation           string `json:"creation"`
+	DeleteAtExpiration bool   `json:"deleteAtExpiration"`
+	Domain             string `json:"domain"`
+
+	// windows
+	SqlVersion string `json:"sqlVersion"`
+
+	// plesk
+	DomainNumber       string `json:"domainNumber"`
+	Antivirus          string `json:"antivirus"`
+	LanguagePackNumber string `json:"languagePackNumber"`
+	Powerpack          bool   `json:"powerpack"`
+	ResellerManagement bool   `json:"resellerManagement"`
+	WordpressToolkit   bool   `json:"wordpressToolkit"`
+}
+
+func (l *License) String() string {
+	return fmt.Sprintf("License[id: %s, ip: %s, version: %s, status: %s]", l.LicenseId, l.Ip, l.Version, l.Status)
+}
+
+// optionValues returns the current values of the options which can be
+// changed by an upgrade order, keyed by their upgrade parameter name.
+func (l *License) optionValues() map[string]string {
+	return map[string]string{
+		"version":            l.Version,
+		"sqlVersion":         l.SqlVersion,
+		"domainNumber":       l.DomainNumber,
+		"antivirus":          l.Antivirus,
+		"languagePackNumber": l.LanguagePackNumber,
+		"powerpack":          fmt.Sprintf("%v", l.Powerpack),
+		"resellerManagement": fmt.Sprintf("%v", l.ResellerManagement),
+		"wordpressToolkit":   fmt.Sprintf("%v", l.WordpressToolkit),
+	}
+}
+
+type LicenseChangeIpOpts struct {
+	DestinationIp string `json:"destinationIp"`
+}
+
+type LicenseTask struct {
+	TaskId int64  `json:"taskId"`
+	Action string `json:"action"`
+	Status string `json:"status"`
+}
+
+func (t *LicenseTask) String() string {
+	return fmt.Sprintf("LicenseTask[id: %d, action: %s, status: %s]", t.TaskId, t.Action, t.Status)
+}
+
+// licenseSchema returns the attributes shared by all license resources.
+func licenseSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ovh_subsidiary": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"plan_code": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"ip": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+				err := validateIpV4(v.(string))
+				if err != nil {
+					errors = append(errors, err)
+				}
+				return
+			},
+		},
+		"version": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+
+		// Computed
+		"service_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"order_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"creation": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// licenseOrder orders a new license bound to the configured ip and
+// sets it as the id of the resource.
+func licenseOrder(d *schema.ResourceData, c *ovh.Client, product string) error {
+	item := &OrderCartItemCreateOpts{
+		PlanCode:    d.Get("plan_code").(string),
+		Duration:    "P1M",
+		PricingMode: "default",
+		Quantity:    1,
+	}
+
+	configuration := map[string]string{
+		"ip": d.Get("ip").(string),
+	}
+
+	log.Printf("[DEBUG] Will order a new %s: %s %v", product, item, configuration)
+
+	order, err := orderProduct(c, d.Get("ovh_subsidiary").(string), product, item, configuration, d.Timeout(schema.TimeoutCreate))
+	if order != nil {
+		d.Set("order_id", order.OrderId)
+	}
+	if err != nil {
+		return fmt.Errorf("Error ordering %s: %s", product, err)
+	}
+
+	serviceName, err := orderServiceName(c, order.OrderId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving %s from order %d: %s", product, order.OrderId, err)
+	}
+
+	log.Printf("[DEBUG] Ordered %s %s with order %d", product, serviceName, order.OrderId)
+
+	d.SetId(serviceName)
+	d.Set("service_name", serviceName)
+
+	return nil
+}
+
+// licenseRead reads a license and sets the shared attributes.
+func licenseRead(d *schema.ResourceData, c *ovh.Client, licenseType string) (*License, error) {
+	r := &License{}
+	endpoint := fmt.Sprintf("/license/%s/%s", licenseType, d.Id())
+
+	if err := c.Get(endpoint, r); err != nil {
+		return nil, CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read %s license %s", licenseType, r)
+
+	d.Set("service_name", d.Id())
+	d.Set("ip", r.Ip)
+	d.Set("version", r.Version)
+	d.Set("status", r.Status)
+	d.Set("creation", r.Creation)
+
+	return r, nil
+}
+
+// licenseUpdate moves the license to the configured ip and upgrades it when
+// one of the options changes. The options map the resource attributes to
+// the parameters of the upgrade order.
+func licenseUpdate(d *schema.ResourceData, c *ovh.Client, licenseType string, options map[string]string) error {
+	if !d.IsNewResource() && d.HasChange("ip") {
+		if err := licenseChangeIp(c, licenseType, d.Id(), d.Get("ip").(string)); err != nil {
+			return err
+		}
+	}
+
+	r := &License{}
+	endpoint := fmt.Sprintf("/license/%s/%s", licenseType, d.Id())
+	if err := c.Get(endpoint, r); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	current := r.optionValues()
+	params := map[string]string{}
+	for attr, param := range options {
+		if !d.HasChange(attr) {
+			continue
+		}
+
+		value := fmt.Sprintf("%v", d.Get(attr))
+		if value == "" || value == current[param] {
+			continue
+		}
+
+		params[param] = value
+	}
+
+	if len(params) == 0 {
+		return nil
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	return licenseUpgrade(c, licenseType, d.Id(), params, timeout)
+}
+
+// licenseChangeIp moves a license to another ip.
+func licenseChangeIp(c *ovh.Client, licenseType, serviceName, ip string) error {
+	params := &LicenseChangeIpOpts{DestinationIp: ip}
+
+	log.Printf("[DEBUG] Will move %s license %s: %v", licenseType, serviceName, params)
+
+	task := &LicenseTask{}
+	endpoint := fmt.Sprintf("/license/%s/%s/changeIp", licenseType, serviceName)
+	if err := c.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return licenseTaskWait(c, licenseType, serviceName, task.TaskId)
+}
+
+// licenseUpgrade orders and pays the upgrade of a license to the given
+// options, then waits for its delivery.
+func licenseUpgrade(c *ovh.Client, licenseType, serviceName string, params map[string]string, timeout time.Duration) error {
+	query := url.Values{}
+	for key, value := range params {
+		query.Set(key, value)
+	}
+
+	durations := []string{}
+	endpoint := fmt.Sprintf("/order/license/%s/%s/upgrade", licenseType, serviceName)
+	if err := c.Get(fmt.Sprintf("%s?%s", endpoint, query.Encode()), &durations); err != nil {
+		return fmt.Errorf("calling Get %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if len(durations) == 0 {
+		return fmt.Errorf("no upgrade available for %s license %s with params %v", licenseType, serviceName, params)
+	}
+
+	log.Printf("[DEBUG] Will upgrade %s license %s for %s: %v", licenseType, serviceName, durations[0], params)
+
+	order := &MeOrder{}
+	endpoint = fmt.Sprintf("%s/%s", endpoint, durations[0])
+	if err := c.Post(endpoint, params, order); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := orderPay(c, order); err != nil {
+		return err
+	}
+
+	return orderWaitForDelivery(c, order.OrderId, timeout)
+}
+
+// licenseTerminate requests the termination of a license.
+func licenseTerminate(d *schema.ResourceData, c *ovh.Client, licenseType string) error {
+	return serviceTerminate(d, c, fmt.Sprintf("/license/%s/%s", licenseType, d.Id()))
+}
+
+// licenseTaskWait waits for a license task to be done.
+func licenseTaskWait(c *ovh.Client, licenseType, serviceName string, taskId int64) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/license/%s/%s/tasks/%d", licenseType, serviceName, taskId),
+		Description: fmt.Sprintf("task %d on %s license %s", taskId, licenseType, serviceName),
+		Pending:     []string{"todo", "doing"},
+		Purged:      "done",
+	}).Wait(c)
+	return err
+}
+
+func licenseExists(licenseType, serviceName string, c *ovh.Client) error {
+	r := &License{}
+	endpoint := fmt.Sprintf("/license/%s/%s", licenseType, serviceName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read %s license: %s", licenseType, r)
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package ovh
+
+import (
+	"fmt"
+)
+
+type MetricsTokenLabel struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type MetricsToken struct {
+	Id          string               `json:"id"`
+	Access      string               `json:"access"`
+	Description string               `json:"description"`
+	Permission  string               `json:"permission"`
+	Labels      []*MetricsTokenLabel `json:"labels"`
+	IsRevoked   bool                 `json:"isRevoked"`
+	CreatedAt   string               `json:"createdAt"`
+	ExpiryAt    *string              `json:"expiryAt"`
+}
+
+func (t *MetricsToken) String() string {
+	return fmt.Sprintf("MetricsToken[id: %s, description: %s, permission: %s, revoked: %t]", t.Id, t.Description, t.Permission, t.IsRevoked)
+}
+
+type MetricsTokenCreateOpts struct {
+	Description string               `json:"description,omitempty"`
+	Permission  string               `json:"permission"`
+	Labels      []*MetricsTokenLabel `json:"labels,omitempty"`
+}
+
+type MetricsTokenUpdateOpts struct {
+	Description string `json:"description"`
+}
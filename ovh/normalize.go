@@ -0,0 +1,82 @@
+package ovh
+
+import (
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// normalizeMac returns the lower case, colon separated notation of a MAC
+// address, or the lower cased value if it can't be parsed.
+func normalizeMac(value string) string {
+	if mac, err := net.ParseMAC(value); err == nil {
+		return mac.String()
+	}
+	return strings.ToLower(value)
+}
+
+// normalizeIp returns the canonical notation of an IP address or an IP block,
+// e.g. "2001:db8::1" for "2001:0DB8:0:0::1", or the value if it can't be
+// parsed.
+func normalizeIp(value string) string {
+	if ip, block, err := net.ParseCIDR(value); err == nil {
+		ones, _ := block.Mask.Size()
+		return (&net.IPNet{IP: ip, Mask: net.CIDRMask(ones, len(block.Mask)*8)}).String()
+	}
+	if ip := net.ParseIP(value); ip != nil {
+		return ip.String()
+	}
+	return value
+}
+
+// normalizeDomain returns the lower case notation of a domain name, without
+// the trailing dot of its fully qualified form.
+func normalizeDomain(value string) string {
+	return strings.TrimSuffix(strings.ToLower(value), ".")
+}
+
+// normalizeRegion returns the upper case notation of a region name.
+func normalizeRegion(value string) string {
+	return strings.ToUpper(value)
+}
+
+// normalizeZone returns the lower case notation of a load balancer zone name.
+func normalizeZone(value string) string {
+	return strings.ToLower(value)
+}
+
+// suppressNormalizedDiff returns a DiffSuppressFunc which ignores the
+// differences between two values having the same normalized form.
+func suppressNormalizedDiff(normalize func(string) string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		return normalize(old) == normalize(new)
+	}
+}
+
+// setNormalized replaces the string attributes given by keys by their
+// normalized form, so that the values sent to the API and stored in the state
+// are the ones the diffs are compared with.
+func setNormalized(d *schema.ResourceData, normalize func(string) string, keys ...string) {
+	for _, key := range keys {
+		if v, ok := d.GetOk(key); ok {
+			d.Set(key, normalize(v.(string)))
+		}
+	}
+}
+
+// normalizedStringHash returns a set hash function which gives the same hash
+// to the string values having the same normalized form.
+func normalizedStringHash(normalize func(string) string) schema.SchemaSetFunc {
+	return func(v interface{}) int {
+		return schema.HashString(normalize(v.(string)))
+	}
+}
+
+var (
+	suppressMacDiff    = suppressNormalizedDiff(normalizeMac)
+	suppressIpDiff     = suppressNormalizedDiff(normalizeIp)
+	suppressDomainDiff = suppressNormalizedDiff(normalizeDomain)
+	suppressRegionDiff = suppressNormalizedDiff(normalizeRegion)
+	suppressZoneDiff   = suppressNormalizedDiff(normalizeZone)
+)
@@ -0,0 +1,56 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		normalize func(string) string
+		values    []string
+	}{
+		{normalizeMac, []string{"02:00:00:ab:cd:ef", "02:00:00:AB:CD:EF", "02-00-00-ab-cd-ef"}},
+		{normalizeIp, []string{"2001:db8::1", "2001:0DB8:0:0::1", "2001:db8:0:0:0:0:0:1"}},
+		{normalizeIp, []string{"2001:db8::/64", "2001:0db8:0000::/64"}},
+		{normalizeIp, []string{"192.0.2.1/32", "192.0.2.1/32"}},
+		{normalizeDomain, []string{"www.example.com", "WWW.Example.com.", "www.example.com."}},
+		{normalizeRegion, []string{"GRA7", "gra7"}},
+		{normalizeZone, []string{"gra", "GRA"}},
+	}
+
+	for _, c := range cases {
+		expected := c.normalize(c.values[0])
+		for _, v := range c.values[1:] {
+			if got := c.normalize(v); got != expected {
+				t.Errorf("expected %s to be normalized as %s, got %s", v, expected, got)
+			}
+		}
+	}
+
+	if normalizeIp("192.0.2.0/24") == normalizeIp("192.0.2.0/25") {
+		t.Errorf("IP blocks of different sizes should differ")
+	}
+}
+
+func TestSetNormalized(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"zone": {
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppressDomainDiff,
+		},
+	}, map[string]interface{}{
+		"zone": "Example.COM.",
+	})
+	d.SetId("1")
+
+	setNormalized(d, normalizeDomain, "zone")
+	if zone := d.Get("zone").(string); zone != "example.com" {
+		t.Errorf("expected the normalized zone to be sent, got %s", zone)
+	}
+	if zone := d.State().Attributes["zone"]; zone != "example.com" {
+		t.Errorf("expected the normalized zone to be stored, got %s", zone)
+	}
+}
@@ -0,0 +1,470 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type OrderCartCreateOpts struct {
+	OvhSubsidiary string `json:"ovhSubsidiary"`
+	Description   string `json:"description,omitempty"`
+	Expire        string `json:"expire,omitempty"`
+}
+
+func (p *OrderCartCreateOpts) String() string {
+	return fmt.Sprintf("CartCreateOpts[ovhSubsidiary: %s, description: %s]", p.OvhSubsidiary, p.Description)
+}
+
+type OrderCart struct {
+	CartId      string  `json:"cartId"`
+	Description string  `json:"description"`
+	Expire      string  `json:"expire"`
+	Items       []int64 `json:"items"`
+	ReadOnly    bool    `json:"readOnly"`
+}
+
+func (c *OrderCart) String() string {
+	return fmt.Sprintf("Cart[id: %s, description: %s, expire: %s, items: %v]", c.CartId, c.Description, c.Expire, c.Items)
+}
+
+type OrderCartItemCreateOpts struct {
+	PlanCode    string `json:"planCode"`
+	Duration    string `json:"duration"`
+	PricingMode string `json:"pricingMode"`
+	Quantity    int    `json:"quantity"`
+}
+
+func (p *OrderCartItemCreateOpts) String() string {
+	return fmt.Sprintf("CartItemCreateOpts[planCode: %s, duration: %s, pricingMode: %s, quantity: %d]", p.PlanCode, p.Duration, p.PricingMode, p.Quantity)
+}
+
+type OrderCartItem struct {
+	ItemId    int64  `json:"itemId"`
+	CartId    string `json:"cartId"`
+	ProductId string `json:"productId"`
+	Duration  string `json:"duration"`
+	Settings  struct {
+		PlanCode    string `json:"planCode"`
+		PricingMode string `json:"pricingMode"`
+		Quantity    int    `json:"quantity"`
+	} `json:"settings"`
+}
+
+type OrderCartItemConfigurationOpts struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+type OrderCartCheckoutOpts struct {
+	AutoPayWithPreferredPaymentMethod bool `json:"autoPayWithPreferredPaymentMethod"`
+	WaiveRetractationPeriod           bool `json:"waiveRetractationPeriod"`
+}
+
+type OrderPrice struct {
+	CurrencyCode string  `json:"currencyCode"`
+	Text         string  `json:"text"`
+	Value        float64 `json:"value"`
+}
+
+type OrderPrices struct {
+	WithTax    OrderPrice `json:"withTax"`
+	WithoutTax OrderPrice `json:"withoutTax"`
+	Tax        OrderPrice `json:"tax"`
+}
+
+type OrderCartGenericProductPricing struct {
+	Capacities      []string   `json:"capacities"`
+	Description     string     `json:"description"`
+	Duration        string     `json:"duration"`
+	Interval        int        `json:"interval"`
+	MaximumQuantity int        `json:"maximumQuantity"`
+	MaximumRepeat   int        `json:"maximumRepeat"`
+	MinimumQuantity int        `json:"minimumQuantity"`
+	MinimumRepeat   int        `json:"minimumRepeat"`
+	Price           OrderPrice `json:"price"`
+	PriceInUcents   int64      `json:"priceInUcents"`
+	PricingMode     string     `json:"pricingMode"`
+	PricingType     string     `json:"pricingType"`
+}
+
+type OrderCartGenericProductDefinition struct {
+	PlanCode    string                           `json:"planCode"`
+	ProductName string                           `json:"productName"`
+	ProductType string                           `json:"productType"`
+	Prices      []OrderCartGenericProductPricing `json:"prices"`
+}
+
+func (p *OrderCartGenericProductDefinition) String() string {
+	return fmt.Sprintf("Product[planCode: %s, productName: %s, productType: %s]", p.PlanCode, p.ProductName, p.ProductType)
+}
+
+type MeOrder struct {
+	OrderId        int64        `json:"orderId"`
+	Date           string       `json:"date"`
+	ExpirationDate string       `json:"expirationDate"`
+	PdfUrl         string       `json:"pdfUrl"`
+	Url            string       `json:"url"`
+	Prices         *OrderPrices `json:"prices"`
+}
+
+func (o *MeOrder) String() string {
+	return fmt.Sprintf("Order[id: %d, date: %s, url: %s]", o.OrderId, o.Date, o.Url)
+}
+
+type MeOrderDetail struct {
+	OrderDetailId int64  `json:"orderDetailId"`
+	Description   string `json:"description"`
+	Domain        string `json:"domain"`
+	Quantity      string `json:"quantity"`
+}
+
+type MeOrderAssociatedObject struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type OrderCatalogPricing struct {
+	Capacities   []string `json:"capacities"`
+	Commitment   int      `json:"commitment"`
+	Description  string   `json:"description"`
+	Interval     int      `json:"interval"`
+	IntervalUnit string   `json:"intervalUnit"`
+	Mode         string   `json:"mode"`
+	Price        int64    `json:"price"`
+	Tax          int64    `json:"tax"`
+	Type         string   `json:"type"`
+}
+
+type OrderCatalogConfiguration struct {
+	Name        string   `json:"name"`
+	IsCustom    bool     `json:"isCustom"`
+	IsMandatory bool     `json:"isMandatory"`
+	Values      []string `json:"values"`
+}
+
+type OrderCatalogAddonFamily struct {
+	Name      string   `json:"name"`
+	Mandatory bool     `json:"mandatory"`
+	Addons    []string `json:"addons"`
+}
+
+type OrderCatalogPlan struct {
+	PlanCode       string                      `json:"planCode"`
+	InvoiceName    string                      `json:"invoiceName"`
+	Product        string                      `json:"product"`
+	PricingType    string                      `json:"pricingType"`
+	Pricings       []OrderCatalogPricing       `json:"pricings"`
+	Configurations []OrderCatalogConfiguration `json:"configurations"`
+	AddonFamilies  []OrderCatalogAddonFamily   `json:"addonFamilies"`
+}
+
+func (p *OrderCatalogPlan) String() string {
+	return fmt.Sprintf("CatalogPlan[planCode: %s, product: %s, pricingType: %s]", p.PlanCode, p.Product, p.PricingType)
+}
+
+type OrderCatalog struct {
+	CatalogId int64 `json:"catalogId"`
+	Locale    struct {
+		CurrencyCode string  `json:"currencyCode"`
+		Subsidiary   string  `json:"subsidiary"`
+		TaxRate      float64 `json:"taxRate"`
+	} `json:"locale"`
+	Plans  []OrderCatalogPlan `json:"plans"`
+	Addons []OrderCatalogPlan `json:"addons"`
+}
+
+type MeSubsidiary struct {
+	OvhSubsidiary string `json:"ovhSubsidiary"`
+}
+
+// meOvhSubsidiary returns the subsidiary of the logged account, which
+// is required to create order carts.
+func meOvhSubsidiary(c *ovh.Client) (string, error) {
+	r := &MeSubsidiary{}
+	endpoint := "/me"
+
+	if err := c.Get(endpoint, r); err != nil {
+		return "", fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	return r.OvhSubsidiary, nil
+}
+
+// orderCartCreate creates a new cart and assigns it to the logged account.
+func orderCartCreate(c *ovh.Client, params *OrderCartCreateOpts) (*OrderCart, error) {
+	r := &OrderCart{}
+
+	log.Printf("[DEBUG] Will create order cart: %s", params)
+
+	endpoint := "/order/cart"
+	if err := c.Post(endpoint, params, r); err != nil {
+		return nil, fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	endpoint = fmt.Sprintf("/order/cart/%s/assign", r.CartId)
+	if err := c.Post(endpoint, nil, nil); err != nil {
+		return nil, fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Created order cart: %s", r)
+	return r, nil
+}
+
+// orderCartProducts lists the plans available for a product line of a cart.
+func orderCartProducts(c *ovh.Client, cartId, product string) ([]*OrderCartGenericProductDefinition, error) {
+	r := []*OrderCartGenericProductDefinition{}
+
+	endpoint := fmt.Sprintf("/order/cart/%s/%s", cartId, product)
+	if err := c.Get(endpoint, &r); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	return r, nil
+}
+
+// orderCartAddItem adds a product item to a cart. product is the product
+// path of the order cart API (ex: "vrack", "ip", "ipLoadbalancing").
+func orderCartAddItem(c *ovh.Client, cartId, product string, params *OrderCartItemCreateOpts) (*OrderCartItem, error) {
+	r := &OrderCartItem{}
+
+	log.Printf("[DEBUG] Will add item to order cart %s/%s: %s", cartId, product, params)
+
+	endpoint := fmt.Sprintf("/order/cart/%s/%s", cartId, product)
+	if err := c.Post(endpoint, params, r); err != nil {
+		return nil, fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	return r, nil
+}
+
+// orderCartItemConfigure sets a configuration label on a cart item.
+func orderCartItemConfigure(c *ovh.Client, cartId string, itemId int64, label, value string) error {
+	params := &OrderCartItemConfigurationOpts{Label: label, Value: value}
+
+	log.Printf("[DEBUG] Will configure item %d of order cart %s: %s=%s", itemId, cartId, label, value)
+
+	endpoint := fmt.Sprintf("/order/cart/%s/item/%d/configuration", cartId, itemId)
+	if err := c.Post(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return nil
+}
+
+// orderCartCheckout validates the cart and pays the resulting order
+// with the preferred payment mean of the account.
+func orderCartCheckout(c *ovh.Client, cartId string) (*MeOrder, error) {
+	params := &OrderCartCheckoutOpts{
+		AutoPayWithPreferredPaymentMethod: true,
+		WaiveRetractationPeriod:           true,
+	}
+	r := &MeOrder{}
+
+	log.Printf("[DEBUG] Will checkout order cart %s", cartId)
+
+	endpoint := fmt.Sprintf("/order/cart/%s/checkout", cartId)
+	if err := c.Post(endpoint, params, r); err != nil {
+		return nil, fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Checked out order cart %s: %s", cartId, r)
+	return r, nil
+}
+
+type MeOrderPayOpts struct {
+	PaymentMean   string `json:"paymentMean"`
+	PaymentMeanId int64  `json:"paymentMeanId"`
+}
+
+// orderPay pays an order with the default payment mean of the account.
+// Orders created outside of a cart, such as upgrades, aren't paid
+// automatically.
+func orderPay(c *ovh.Client, order *MeOrder) error {
+	if order.Prices != nil && order.Prices.WithTax.Value == 0 {
+		log.Printf("[DEBUG] Order %d is free, nothing to pay", order.OrderId)
+		return nil
+	}
+
+	paymentMeanType, paymentMean, err := meDefaultPaymentMean(c)
+	if err != nil {
+		return err
+	}
+
+	if paymentMean == nil {
+		return fmt.Errorf("No valid default payment mean found to pay order %d", order.OrderId)
+	}
+
+	params := &MeOrderPayOpts{
+		PaymentMean:   paymentMeanType,
+		PaymentMeanId: paymentMean.Id,
+	}
+
+	log.Printf("[DEBUG] Will pay order %d: %v", order.OrderId, params)
+
+	endpoint := fmt.Sprintf("/me/order/%d/payWithRegisteredPaymentMean", order.OrderId)
+	if err := c.Post(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return nil
+}
+
+// MeOrderStatus is the delivery status of an order.
+type MeOrderStatus string
+
+func (s *MeOrderStatus) TaskStatus() string {
+	return string(*s)
+}
+
+// orderWaitForDelivery waits until the order is delivered.
+func orderWaitForDelivery(c *ovh.Client, orderId int64, timeout time.Duration) error {
+	status := MeOrderStatus("")
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/me/order/%d/status", orderId),
+		Description: fmt.Sprintf("delivery of order %d", orderId),
+		Task:        &status,
+		Pending:     []string{"checking", "delivering", "notPaid", "unknown"},
+		Target:      []string{"delivered"},
+		Timeout:     timeout,
+		Delay:       30 * time.Second,
+		MinTimeout:  10 * time.Second,
+	}).Wait(c)
+	return err
+}
+
+// orderGet returns an order of the logged account.
+func orderGet(c *ovh.Client, orderId int64) (*MeOrder, error) {
+	r := &MeOrder{}
+	endpoint := fmt.Sprintf("/me/order/%d", orderId)
+	if err := c.Get(endpoint, r); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	return r, nil
+}
+
+// orderStatus returns the delivery status of an order.
+func orderStatus(c *ovh.Client, orderId int64) (string, error) {
+	var status string
+	endpoint := fmt.Sprintf("/me/order/%d/status", orderId)
+	if err := c.Get(endpoint, &status); err != nil {
+		return "", fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	return status, nil
+}
+
+// orderDetails returns the details of an order.
+func orderDetails(c *ovh.Client, orderId int64) ([]*MeOrderDetail, error) {
+	detailIds := []int64{}
+	endpoint := fmt.Sprintf("/me/order/%d/details", orderId)
+	if err := c.Get(endpoint, &detailIds); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	details := make([]*MeOrderDetail, len(detailIds))
+	for i, detailId := range detailIds {
+		detail := &MeOrderDetail{}
+		endpoint := fmt.Sprintf("/me/order/%d/details/%d", orderId, detailId)
+		if err := c.Get(endpoint, detail); err != nil {
+			return nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+		}
+		details[i] = detail
+	}
+
+	return details, nil
+}
+
+// orderServiceName returns the service name delivered by a single product order.
+func orderServiceName(c *ovh.Client, orderId int64) (string, error) {
+	details, err := orderDetails(c, orderId)
+	if err != nil {
+		return "", err
+	}
+
+	for _, detail := range details {
+		if detail.Domain != "" && detail.Domain != "*" {
+			return detail.Domain, nil
+		}
+	}
+
+	return "", fmt.Errorf("no service found in details of order %d", orderId)
+}
+
+// orderCustomizeDiff makes the plan of ordering resources fail early when
+// the new orders couldn't be paid.
+func orderCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	config := meta.(*Config)
+	return meDefaultPaymentMeanCheck(config.OVHClient)
+}
+
+// orderProduct runs the whole ordering flow for a single product: cart creation,
+// item configuration, checkout and delivery.
+func orderProduct(c *ovh.Client, ovhSubsidiary, product string, item *OrderCartItemCreateOpts, configuration map[string]string, timeout time.Duration) (*MeOrder, error) {
+	if ovhSubsidiary == "" {
+		subsidiary, err := meOvhSubsidiary(c)
+		if err != nil {
+			return nil, err
+		}
+		ovhSubsidiary = subsidiary
+	}
+
+	cart, err := orderCartCreate(c, &OrderCartCreateOpts{
+		OvhSubsidiary: ovhSubsidiary,
+		Description:   fmt.Sprintf("terraform %s order", product),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cartItem, err := orderCartAddItem(c, cart.CartId, product, item)
+	if err != nil {
+		return nil, err
+	}
+
+	for label, value := range configuration {
+		if err := orderCartItemConfigure(c, cart.CartId, cartItem.ItemId, label, value); err != nil {
+			return nil, err
+		}
+	}
+
+	order, err := orderCartCheckout(c, cart.CartId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := orderWaitForDelivery(c, order.OrderId, timeout); err != nil {
+		return order, err
+	}
+
+	return order, nil
+}
+
+// serviceTerminate requests the termination of the services found at the
+// given API paths (ex: "/vrack/pn-xxx") and removes the resource from the state.
+func serviceTerminate(d *schema.ResourceData, c *ovh.Client, paths ...string) error {
+	for _, path := range paths {
+		endpoint := fmt.Sprintf("%s/terminate", strings.TrimRight(path, "/"))
+		log.Printf("[DEBUG] Will terminate service %s", path)
+		if err := c.Post(endpoint, nil, nil); err != nil {
+			return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+		}
+	}
+
+	// Termination has to be confirmed with the token sent by email
+	// to the account contact. The resource is removed from the state
+	// as terraform can't go any further.
+	log.Printf("[WARN] Termination of %s requested. Please confirm it with the link sent by email.", strings.Join(paths, ", "))
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,32 @@
+package ovh
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestServiceTerminate(t *testing.T) {
+	calls := []string{}
+	client := newTestOVHClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.EscapedPath())
+		w.Write([]byte(`null`))
+	})
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.SetId("pn-12345")
+
+	if err := serviceTerminate(d, client, "/vrack/pn-12345", "/ip/10.0.0.0%2F30"); err != nil {
+		t.Fatalf("terminate failed: %s", err)
+	}
+
+	expected := []string{"POST /vrack/pn-12345/terminate", "POST /ip/10.0.0.0%2F30/terminate"}
+	if fmt.Sprint(calls) != fmt.Sprint(expected) {
+		t.Fatalf("expected calls %v, got %v", expected, calls)
+	}
+	if d.Id() != "" {
+		t.Fatalf("expected resource to be removed from state, got id %q", d.Id())
+	}
+}
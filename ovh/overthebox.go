@@ -0,0 +1,94 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type OverTheBox struct {
+	ServiceName         string `json:"serviceName"`
+	CustomerDescription string `json:"customerDescription"`
+	Status              string `json:"status"`
+	ReleaseChannel      string `json:"releaseChannel"`
+	TunnelMode          string `json:"tunnelMode"`
+	AutoUpgrade         bool   `json:"autoUpgrade"`
+	SOCKSProxyEnabled   bool   `json:"SOCKSProxyEnabled"`
+}
+
+func (o *OverTheBox) String() string {
+	return fmt.Sprintf("OverTheBox[serviceName: %s, status: %s, releaseChannel: %s]", o.ServiceName, o.Status, o.ReleaseChannel)
+}
+
+type OverTheBoxDevice struct {
+	DeviceId      string `json:"deviceId"`
+	Activated     bool   `json:"activated"`
+	LastSeen      string `json:"lastSeen"`
+	PublicIp      string `json:"publicIp"`
+	Version       string `json:"version"`
+	SystemVersion string `json:"systemVersion"`
+}
+
+func (o *OverTheBoxDevice) String() string {
+	return fmt.Sprintf("OverTheBoxDevice[deviceId: %s, activated: %t, publicIp: %s]", o.DeviceId, o.Activated, o.PublicIp)
+}
+
+type OverTheBoxLinkDeviceOpts struct {
+	DeviceId string `json:"deviceId"`
+}
+
+type OverTheBoxRemoteAccessConnectionInfos struct {
+	Ip   string `json:"ip"`
+	Port int    `json:"port"`
+}
+
+type OverTheBoxRemoteAccess struct {
+	RemoteAccessId  string                                 `json:"remoteAccessId"`
+	Status          string                                 `json:"status"`
+	ExposedPort     int                                    `json:"exposedPort"`
+	RemotePort      int                                    `json:"remotePort"`
+	RemoteUserName  string                                 `json:"remoteUserName"`
+	Password        string                                 `json:"password"`
+	PublicKey       string                                 `json:"publicKey"`
+	AllowedIp       string                                 `json:"allowedIp"`
+	Accepted        bool                                   `json:"accepted"`
+	ExpirationDate  string                                 `json:"expirationDate"`
+	CreatedAt       string                                 `json:"createdAt"`
+	ConnectionInfos *OverTheBoxRemoteAccessConnectionInfos `json:"connectionInfos"`
+}
+
+func (o *OverTheBoxRemoteAccess) String() string {
+	return fmt.Sprintf("OverTheBoxRemoteAccess[id: %s, status: %s, exposedPort: %d]", o.RemoteAccessId, o.Status, o.ExposedPort)
+}
+
+type OverTheBoxRemoteAccessCreateOpts struct {
+	ExposedPort    int    `json:"exposedPort"`
+	PublicKey      string `json:"publicKey,omitempty"`
+	AllowedIp      string `json:"allowedIp,omitempty"`
+	ExpirationDate string `json:"expirationDate,omitempty"`
+}
+
+func (o *OverTheBoxRemoteAccessCreateOpts) String() string {
+	return fmt.Sprintf("exposedPort: %d, allowedIp: %s, expirationDate: %s", o.ExposedPort, o.AllowedIp, o.ExpirationDate)
+}
+
+func (o *OverTheBoxRemoteAccess) TaskStatus() string {
+	return o.Status
+}
+
+// overTheBoxRemoteAccessWait waits for a remote access to reach one of the
+// target statuses.
+func overTheBoxRemoteAccessWait(c *ovh.Client, serviceName, remoteAccessId string, pending, target []string) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/overTheBox/%s/remoteAccesses/%s", serviceName, remoteAccessId),
+		Description: fmt.Sprintf("remote access %s on overthebox %s", remoteAccessId, serviceName),
+		Task:        &OverTheBoxRemoteAccess{},
+		Pending:     pending,
+		Target:      target,
+		Purged:      "deleted",
+		Timeout:     10 * time.Minute,
+		Delay:       5 * time.Second,
+	}).Wait(c)
+	return err
+}
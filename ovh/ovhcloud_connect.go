@@ -0,0 +1,112 @@
+package ovh
+
+import (
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type OvhCloudConnect struct {
+	Uuid          string  `json:"uuid"`
+	Description   string  `json:"description"`
+	Bandwidth     string  `json:"bandwidth"`
+	InterfaceList []int64 `json:"interfaceList"`
+	Pop           string  `json:"pop"`
+	PortQuantity  string  `json:"portQuantity"`
+	Product       string  `json:"product"`
+	Provider      string  `json:"provider"`
+	Status        string  `json:"status"`
+	Vrack         string  `json:"vrack"`
+}
+
+func (o *OvhCloudConnect) String() string {
+	return fmt.Sprintf("OvhCloudConnect[uuid: %s, pop: %s, bandwidth: %s, status: %s]", o.Uuid, o.Pop, o.Bandwidth, o.Status)
+}
+
+type OvhCloudConnectDatacenter struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type OvhCloudConnectPopConfig struct {
+	Id              int64  `json:"id"`
+	Type            string `json:"type"`
+	Status          string `json:"status"`
+	InterfaceId     int64  `json:"interfaceId"`
+	CustomerBgpArea int64  `json:"customerBgpArea"`
+	OvhBgpArea      int64  `json:"ovhBgpArea"`
+	Subnet          string `json:"subnet"`
+}
+
+func (p *OvhCloudConnectPopConfig) String() string {
+	return fmt.Sprintf("OvhCloudConnectPopConfig[id: %d, type: %s, interface: %d, status: %s]", p.Id, p.Type, p.InterfaceId, p.Status)
+}
+
+type OvhCloudConnectPopConfigCreateOpts struct {
+	Type            string `json:"type"`
+	InterfaceId     int64  `json:"interfaceId"`
+	CustomerBgpArea int64  `json:"customerBgpArea,omitempty"`
+	OvhBgpArea      int64  `json:"ovhBgpArea,omitempty"`
+	Subnet          string `json:"subnet,omitempty"`
+}
+
+type OvhCloudConnectDatacenterConfig struct {
+	Id           int64  `json:"id"`
+	DatacenterId int64  `json:"datacenterId"`
+	Status       string `json:"status"`
+	OvhBgpArea   int64  `json:"ovhBgpArea"`
+	Subnet       string `json:"subnet"`
+}
+
+func (c *OvhCloudConnectDatacenterConfig) String() string {
+	return fmt.Sprintf("OvhCloudConnectDatacenterConfig[id: %d, datacenter: %d, status: %s]", c.Id, c.DatacenterId, c.Status)
+}
+
+type OvhCloudConnectDatacenterConfigCreateOpts struct {
+	DatacenterId int64  `json:"datacenterId"`
+	OvhBgpArea   int64  `json:"ovhBgpArea,omitempty"`
+	Subnet       string `json:"subnet"`
+}
+
+type OvhCloudConnectDatacenterExtraConfig struct {
+	Id              int64  `json:"id"`
+	Type            string `json:"type"`
+	Status          string `json:"status"`
+	BgpNeighborArea int64  `json:"bgpNeighborArea"`
+	BgpNeighborIp   string `json:"bgpNeighborIp"`
+	NextHop         string `json:"nextHop"`
+	Subnet          string `json:"subnet"`
+}
+
+func (e *OvhCloudConnectDatacenterExtraConfig) String() string {
+	return fmt.Sprintf("OvhCloudConnectDatacenterExtraConfig[id: %d, type: %s, status: %s]", e.Id, e.Type, e.Status)
+}
+
+type OvhCloudConnectDatacenterExtraConfigCreateOpts struct {
+	Type            string `json:"type"`
+	BgpNeighborArea int64  `json:"bgpNeighborArea,omitempty"`
+	BgpNeighborIp   string `json:"bgpNeighborIp,omitempty"`
+	NextHop         string `json:"nextHop,omitempty"`
+	Subnet          string `json:"subnet,omitempty"`
+}
+
+type OvhCloudConnectTask struct {
+	Id         int64  `json:"id"`
+	Function   string `json:"function"`
+	ResourceId int64  `json:"resourceId"`
+	Status     string `json:"status"`
+}
+
+func (t *OvhCloudConnectTask) String() string {
+	return fmt.Sprintf("OvhCloudConnectTask[id: %d, function: %s, resource: %d, status: %s]", t.Id, t.Function, t.ResourceId, t.Status)
+}
+
+// ovhCloudConnectTaskWait waits for an OVHcloud Connect task to be done.
+func ovhCloudConnectTaskWait(c *ovh.Client, serviceName string, taskId int64) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/ovhCloudConnect/%s/task/%d", serviceName, taskId),
+		Description: fmt.Sprintf("task %d on ovhcloud connect %s", taskId, serviceName),
+		Purged:      "done",
+	}).Wait(c)
+	return err
+}
@@ -0,0 +1,90 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// mePaymentMeanTypes are the payment mean routes of the /me/paymentMean API
+var mePaymentMeanTypes = []string{
+	"bankAccount",
+	"creditCard",
+	"deferredPaymentAccount",
+	"paypal",
+}
+
+// MePaymentMean holds the attributes shared by all payment mean types.
+type MePaymentMean struct {
+	Id          int64  `json:"id"`
+	Description string `json:"description"`
+	Default     bool   `json:"defaultPaymentMean"`
+	State       string `json:"state"`
+}
+
+func (p *MePaymentMean) String() string {
+	return fmt.Sprintf("PaymentMean[id: %d, description: %s, default: %v, state: %s]", p.Id, p.Description, p.Default, p.State)
+}
+
+// mePaymentMeanGet returns a payment mean of the logged account.
+func mePaymentMeanGet(c *ovh.Client, paymentMeanType string, id int64) (*MePaymentMean, error) {
+	r := &MePaymentMean{}
+	endpoint := fmt.Sprintf("/me/paymentMean/%s/%d", paymentMeanType, id)
+	if err := c.Get(endpoint, r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// meDefaultPaymentMean returns the type and the attributes of the valid
+// default payment mean of the logged account, if any.
+func meDefaultPaymentMean(c *ovh.Client) (string, *MePaymentMean, error) {
+	for _, paymentMeanType := range mePaymentMeanTypes {
+		ids := []int64{}
+		endpoint := fmt.Sprintf("/me/paymentMean/%s?state=valid", paymentMeanType)
+		if err := c.Get(endpoint, &ids); err != nil {
+			return "", nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+		}
+
+		paymentMeans := make([]*MePaymentMean, len(ids))
+		err := fetchConcurrently(len(ids), func(i int) error {
+			paymentMean, err := mePaymentMeanGet(c, paymentMeanType, ids[i])
+			if err != nil {
+				return fmt.Errorf("calling Get /me/paymentMean/%s/%d:\n\t %q", paymentMeanType, ids[i], err)
+			}
+			paymentMeans[i] = paymentMean
+			return nil
+		})
+		if err != nil {
+			return "", nil, err
+		}
+
+		for _, paymentMean := range paymentMeans {
+			if paymentMean.Default {
+				log.Printf("[DEBUG] Found default payment mean %s: %s", paymentMeanType, paymentMean)
+				return paymentMeanType, paymentMean, nil
+			}
+		}
+	}
+
+	return "", nil, nil
+}
+
+// meDefaultPaymentMeanCheck returns an error unless the logged account has
+// a valid default payment mean, which is required to pay orders.
+func meDefaultPaymentMeanCheck(c *ovh.Client) error {
+	_, paymentMean, err := meDefaultPaymentMean(c)
+	if err != nil {
+		return err
+	}
+
+	if paymentMean != nil {
+		return nil
+	}
+
+	return fmt.Errorf("No valid default payment mean found on the account. " +
+		"A default payment mean is required to order products: register one, " +
+		"or choose one with the ovh_me_paymentmean_default resource.")
+}
@@ -40,15 +40,96 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("OVH_CONSUMER_KEY", ""),
 				Description: descriptions["consumer_key"],
 			},
+			"disable_api_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_DISABLE_API_CACHE", false),
+				Description: descriptions["disable_api_cache"],
+			},
+			"validate_service_names": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_VALIDATE_SERVICE_NAMES", false),
+				Description: descriptions["validate_service_names"],
+			},
+			"check_domain_zone_propagation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CHECK_DOMAIN_ZONE_PROPAGATION", false),
+				Description: descriptions["check_domain_zone_propagation"],
+			},
+			"disallow_deprecated": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_DISALLOW_DEPRECATED", false),
+				Description: descriptions["disallow_deprecated"],
+			},
+			"extra_user_agent": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_EXTRA_USER_AGENT", ""),
+				Description: descriptions["extra_user_agent"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"ovh_cloud_region":               dataSourcePublicCloudRegion(),
-			"ovh_cloud_regions":              dataSourcePublicCloudRegions(),
-			"ovh_domain_zone":                dataSourceDomainZone(),
-			"ovh_iploadbalancing":            dataSourceIpLoadbalancing(),
-			"ovh_me_paymentmean_bankaccount": dataSourceMePaymentmeanBankaccount(),
-			"ovh_me_paymentmean_creditcard":  dataSourceMePaymentmeanCreditcard(),
+			"ovh_cloud_failover_ips":                             dataSourcePublicCloudFailoverIps(),
+			"ovh_cloud_network_private_subnets":                  dataSourcePublicCloudPrivateNetworkSubnets(),
+			"ovh_cloud_network_privates":                         dataSourcePublicCloudPrivateNetworks(),
+			"ovh_cloud_region":                                   dataSourcePublicCloudRegion(),
+			"ovh_cloud_regions":                                  dataSourcePublicCloudRegions(),
+			"ovh_cloud_user":                                     dataSourcePublicCloudUser(),
+			"ovh_dbaas_logs_cluster":                             dataSourceDbaasLogsCluster(),
+			"ovh_dbaas_logs_input_engine":                        dataSourceDbaasLogsInputEngine(),
+			"ovh_dedicated_ceph":                                 dataSourceDedicatedCeph(),
+			"ovh_dedicated_installation_templates":               dataSourceDedicatedInstallationTemplates(),
+			"ovh_dedicated_nasha":                                dataSourceDedicatedNasha(),
+			"ovh_dedicated_server_compatible_templates":          dataSourceDedicatedServerCompatibleTemplates(),
+			"ovh_dedicated_server_ips":                           dataSourceDedicatedServerIps(),
+			"ovh_dedicated_server_network_interface_controllers": dataSourceDedicatedServerNetworkInterfaceControllers(),
+			"ovh_dedicated_servers":                              dataSourceDedicatedServers(),
+			"ovh_domain_zone":                                    dataSourceDomainZone(),
+			"ovh_domain_zone_dnssec":                             dataSourceDomainZoneDnssec(),
+			"ovh_email_domain":                                   dataSourceEmailDomain(),
+			"ovh_email_domain_accounts":                          dataSourceEmailDomainAccounts(),
+			"ovh_email_exchange":                                 dataSourceEmailExchange(),
+			"ovh_email_pro":                                      dataSourceEmailPro(),
+			"ovh_hosting_web":                                    dataSourceHostingWeb(),
+			"ovh_iam_reference_actions":                          dataSourceIamReferenceActions(),
+			"ovh_iam_reference_resource_type":                    dataSourceIamReferenceResourceType(),
+			"ovh_iam_resource":                                   dataSourceIamResource(),
+			"ovh_ip_blocks":                                      dataSourceIpBlocks(),
+			"ovh_ip_reverse":                                     dataSourceIpReverse(),
+			"ovh_iploadbalancing":                                dataSourceIpLoadbalancing(),
+			"ovh_iploadbalancing_farm_server_status":             dataSourceIpLoadbalancingFarmServerStatus(),
+			"ovh_iploadbalancing_ssl":                            dataSourceIpLoadbalancingSsl(),
+			"ovh_me_api_credentials":                             dataSourceMeApiCredentials(),
+			"ovh_me_bills":                                       dataSourceMeBills(),
+			"ovh_me_consumption":                                 dataSourceMeConsumption(),
+			"ovh_me_identity_group":                              dataSourceMeIdentityGroup(),
+			"ovh_me_identity_groups":                             dataSourceMeIdentityGroups(),
+			"ovh_me_identity_user":                               dataSourceMeIdentityUser(),
+			"ovh_me_identity_users":                              dataSourceMeIdentityUsers(),
+			"ovh_me_paymentmean_bankaccount":                     dataSourceMePaymentmeanBankaccount(),
+			"ovh_me_paymentmean_creditcard":                      dataSourceMePaymentmeanCreditcard(),
+			"ovh_me_paymentmean_deferred":                        dataSourceMePaymentmeanDeferred(),
+			"ovh_me_paymentmean_paypal":                          dataSourceMePaymentmeanPaypal(),
+			"ovh_order":                                          dataSourceOrder(),
+			"ovh_order_cart":                                     dataSourceOrderCart(),
+			"ovh_order_cart_product":                             dataSourceOrderCartProduct(),
+			"ovh_order_cart_product_plan":                        dataSourceOrderCartProductPlan(),
+			"ovh_order_catalog":                                  dataSourceOrderCatalog(),
+			"ovh_overthebox":                                     dataSourceOverTheBox(),
+			"ovh_ovhcloud_connect":                               dataSourceOvhCloudConnect(),
+			"ovh_service_info":                                   dataSourceServiceInfo(),
+			"ovh_telephony_billing_account":                      dataSourceTelephonyBillingAccount(),
+			"ovh_telephony_line":                                 dataSourceTelephonyLine(),
+			"ovh_telephony_number":                               dataSourceTelephonyNumber(),
+			"ovh_vps":                                            dataSourceVps(),
+			"ovh_vpss":                                           dataSourceVpss(),
+			"ovh_vrack":                                          dataSourceVRack(),
+			"ovh_vrack_services":                                 dataSourceVRackServices(),
+			"ovh_xdsl":                                           dataSourceXdsl(),
 
 			// Legacy naming schema (publiccloud)
 			"ovh_publiccloud_region": deprecated(dataSourcePublicCloudRegion(),
@@ -58,19 +139,112 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"ovh_iploadbalancing_tcp_farm":        resourceIpLoadbalancingTcpFarm(),
-			"ovh_iploadbalancing_tcp_farm_server": resourceIpLoadbalancingTcpFarmServer(),
-			"ovh_iploadbalancing_tcp_frontend":    resourceIpLoadbalancingTcpFrontend(),
-			"ovh_iploadbalancing_http_route":      resourceIPLoadbalancingRouteHTTP(),
-			"ovh_iploadbalancing_http_route_rule": resourceIPLoadbalancingRouteHTTPRule(),
-			"ovh_iploadbalancing_refresh":         resourceIPLoadbalancingRefresh(),
-			"ovh_domain_zone_record":              resourceOvhDomainZoneRecord(),
-			"ovh_domain_zone_redirection":         resourceOvhDomainZoneRedirection(),
-			"ovh_ip_reverse":                      resourceOvhIpReverse(),
-			"ovh_cloud_network_private":           resourcePublicCloudPrivateNetwork(),
-			"ovh_cloud_network_private_subnet":    resourcePublicCloudPrivateNetworkSubnet(),
-			"ovh_cloud_user":                      resourcePublicCloudUser(),
-			"ovh_vrack_cloudproject":              resourceVRackPublicCloudAttachment(),
+			"ovh_iploadbalancing_tcp_farm":                     resourceIpLoadbalancingTcpFarm(),
+			"ovh_iploadbalancing_tcp_farm_server":              resourceIpLoadbalancingTcpFarmServer(),
+			"ovh_iploadbalancing_tcp_frontend":                 resourceIpLoadbalancingTcpFrontend(),
+			"ovh_iploadbalancing_http_route":                   resourceIPLoadbalancingRouteHTTP(),
+			"ovh_iploadbalancing_http_route_rule":              resourceIPLoadbalancingRouteHTTPRule(),
+			"ovh_iploadbalancing_refresh":                      resourceIPLoadbalancingRefresh(),
+			"ovh_iploadbalancing_quota_alert":                  resourceIpLoadbalancingQuotaAlert(),
+			"ovh_iploadbalancing_frontend_redirect":            resourceIPLoadbalancingFrontendRedirect(),
+			"ovh_domain_zone_record":                           resourceOvhDomainZoneRecord(),
+			"ovh_domain_zone_redirection":                      resourceOvhDomainZoneRedirection(),
+			"ovh_domain_zone_soa":                              resourceOvhDomainZoneSoa(),
+			"ovh_domain_zone_record_acme":                      resourceOvhDomainZoneRecordAcme(),
+			"ovh_ip_reverse":                                   resourceOvhIpReverse(),
+			"ovh_ip_firewall":                                  resourceOvhIpFirewall(),
+			"ovh_ip_firewall_rule":                             resourceOvhIpFirewallRule(),
+			"ovh_ip_mitigation":                                resourceOvhIpMitigation(),
+			"ovh_ip_service":                                   resourceOvhIpService(),
+			"ovh_ip_move":                                      resourceOvhIpMove(),
+			"ovh_ip_failover":                                  resourceOvhIpFailover(),
+			"ovh_cloud_network_private":                        resourcePublicCloudPrivateNetwork(),
+			"ovh_cloud_network_private_subnet":                 resourcePublicCloudPrivateNetworkSubnet(),
+			"ovh_cloud_user":                                   resourcePublicCloudUser(),
+			"ovh_cloud_region_network":                         resourcePublicCloudRegionNetwork(),
+			"ovh_cloud_region_network_subnet":                  resourcePublicCloudRegionNetworkSubnet(),
+			"ovh_cloud_instance":                               resourcePublicCloudInstance(),
+			"ovh_cloud_instance_interface":                     resourcePublicCloudInstanceInterface(),
+			"ovh_cloud_servergroup":                            resourcePublicCloudServerGroup(),
+			"ovh_cloud_ai_notebook":                            resourceCloudAiNotebook(),
+			"ovh_cloud_ai_job":                                 resourceCloudAiJob(),
+			"ovh_cloud_data_processing_job":                    resourceCloudDataProcessingJob(),
+			"ovh_vrack_cloudproject":                           resourceVRackPublicCloudAttachment(),
+			"ovh_me_ssh_key":                                   resourceMeSshKey(),
+			"ovh_me_identity_group":                            resourceMeIdentityGroup(),
+			"ovh_me_identity_user":                             resourceMeIdentityUser(),
+			"ovh_me_api_oauth2_client":                         resourceMeApiOauth2Client(),
+			"ovh_me_api_credential_revocation":                 resourceMeApiCredentialRevocation(),
+			"ovh_me_ipxe_script":                               resourceMeIpxeScript(),
+			"ovh_me_paymentmean_default":                       resourceMePaymentmeanDefault(),
+			"ovh_me_contact":                                   resourceMeContact(),
+			"ovh_iam_policy":                                   resourceIamPolicy(),
+			"ovh_iam_permissions_group":                        resourceIamPermissionsGroup(),
+			"ovh_iam_resource_group":                           resourceIamResourceGroup(),
+			"ovh_service_renew":                                resourceServiceRenew(),
+			"ovh_service_contacts":                             resourceServiceContacts(),
+			"ovh_dedicated_nasha_partition":                    resourceDedicatedNashaPartition(),
+			"ovh_dedicated_nasha_partition_access":             resourceDedicatedNashaPartitionAccess(),
+			"ovh_dedicated_nasha_partition_snapshot":           resourceDedicatedNashaPartitionSnapshot(),
+			"ovh_dedicated_ceph":                               resourceDedicatedCeph(),
+			"ovh_dedicated_ceph_acl":                           resourceDedicatedCephAcl(),
+			"ovh_dedicated_ceph_pool":                          resourceDedicatedCephPool(),
+			"ovh_dedicated_server_virtual_mac":                 resourceDedicatedServerVirtualMac(),
+			"ovh_dedicated_server_virtual_mac_address":         resourceDedicatedServerVirtualMacAddress(),
+			"ovh_dedicated_server_secondary_dns_domain":        resourceDedicatedServerSecondaryDnsDomain(),
+			"ovh_dedicated_server_ipmi_access":                 resourceDedicatedServerIpmiAccess(),
+			"ovh_dedicated_server_ola_aggregation":             resourceDedicatedServerOlaAggregation(),
+			"ovh_dedicated_server_firewall":                    resourceDedicatedServerFirewall(),
+			"ovh_dedicated_server_monitoring":                  resourceDedicatedServerMonitoring(),
+			"ovh_cdn_dedicated_domain":                         resourceCdnDedicatedDomain(),
+			"ovh_cdn_dedicated_domain_backend":                 resourceCdnDedicatedDomainBackend(),
+			"ovh_cdn_dedicated_domain_cache_rule":              resourceCdnDedicatedDomainCacheRule(),
+			"ovh_ssl_gateway":                                  resourceSslGateway(),
+			"ovh_ssl_gateway_domain":                           resourceSslGatewayDomain(),
+			"ovh_ssl_gateway_server":                           resourceSslGatewayServer(),
+			"ovh_hosting_privatedatabase":                      resourceHostingPrivateDatabase(),
+			"ovh_hosting_privatedatabase_database":             resourceHostingPrivateDatabaseDatabase(),
+			"ovh_hosting_privatedatabase_user":                 resourceHostingPrivateDatabaseUser(),
+			"ovh_hosting_privatedatabase_user_grant":           resourceHostingPrivateDatabaseUserGrant(),
+			"ovh_hosting_privatedatabase_whitelist":            resourceHostingPrivateDatabaseWhitelist(),
+			"ovh_hosting_web_attached_domain":                  resourceHostingWebAttachedDomain(),
+			"ovh_hosting_web_ovhconfig":                        resourceHostingWebOvhConfig(),
+			"ovh_hosting_web_module":                           resourceHostingWebModule(),
+			"ovh_email_domain_account":                         resourceEmailDomainAccount(),
+			"ovh_email_domain_redirection":                     resourceEmailDomainRedirection(),
+			"ovh_email_domain_mailing_list":                    resourceEmailDomainMailingList(),
+			"ovh_email_exchange_account":                       resourceEmailExchangeAccount(),
+			"ovh_email_pro_account":                            resourceEmailProAccount(),
+			"ovh_dbaas_logs_input":                             resourceDbaasLogsInput(),
+			"ovh_dbaas_logs_output_elasticsearch_alias":        resourceDbaasLogsOutputElasticsearchAlias(),
+			"ovh_dbaas_logs_output_elasticsearch_index":        resourceDbaasLogsOutputElasticsearchIndex(),
+			"ovh_dbaas_logs_output_graylog_stream":             resourceDbaasLogsOutputGraylogStream(),
+			"ovh_dbaas_logs_output_graylog_stream_alert":       resourceDbaasLogsOutputGraylogStreamAlert(),
+			"ovh_dbaas_logs_role":                              resourceDbaasLogsRole(),
+			"ovh_dbaas_logs_role_permission":                   resourceDbaasLogsRolePermission(),
+			"ovh_metrics_token":                                resourceMetricsToken(),
+			"ovh_ovhcloud_connect_pop_config":                  resourceOvhCloudConnectPopConfig(),
+			"ovh_ovhcloud_connect_pop_datacenter_config":       resourceOvhCloudConnectPopDatacenterConfig(),
+			"ovh_ovhcloud_connect_pop_datacenter_extra_config": resourceOvhCloudConnectPopDatacenterExtraConfig(),
+			"ovh_storage_efs_share":                            resourceStorageEfsShare(),
+			"ovh_storage_efs_share_acl":                        resourceStorageEfsShareAcl(),
+			"ovh_storage_efs_snapshot_policy":                  resourceStorageEfsSnapshotPolicy(),
+			"ovh_license_cpanel":                               resourceLicenseCpanel(),
+			"ovh_license_plesk":                                resourceLicensePlesk(),
+			"ovh_license_sqlserver":                            resourceLicenseSqlServer(),
+			"ovh_license_windows":                              resourceLicenseWindows(),
+			"ovh_vps":                                          resourceVps(),
+			"ovh_xdsl_modem":                                   resourceXdslModem(),
+			"ovh_overthebox_device":                            resourceOverTheBoxDevice(),
+			"ovh_overthebox_remote_access":                     resourceOverTheBoxRemoteAccess(),
+			"ovh_telephony_line_options":                       resourceTelephonyLineOptions(),
+			"ovh_telephony_number_feature_type":                resourceTelephonyNumberFeatureType(),
+
+			// vRack
+			"ovh_vrack_dedicated_server_interface": resourceVRackDedicatedServerInterface(),
+			"ovh_vrack":                            resourceVRack(),
+			"ovh_vrack_ip":                         resourceVRackIp(),
+			"ovh_vrack_iploadbalancing":            resourceVRackIpLoadbalancing(),
 
 			// Legacy naming schema (publiccloud)
 			"ovh_publiccloud_private_network": deprecated(resourcePublicCloudPrivateNetwork(),
@@ -97,6 +271,16 @@ func init() {
 
 		"application_secret": "The OVH API Application Secret.",
 		"consumer_key":       "The OVH API Consumer key.",
+
+		"disable_api_cache": "Disable the cache of the API responses which don't change during an operation.",
+
+		"validate_service_names": "Check at plan time that the services referenced by the resources exist and are accessible.",
+
+		"check_domain_zone_propagation": "Wait for the domain zone records to be served by the OVH name servers after their creation or update.",
+
+		"disallow_deprecated": "Fail the plan when deprecated resources or data sources are used.",
+
+		"extra_user_agent": "A string appended to the User-Agent of the API calls, e.g. to trace the calls of a pipeline.",
 	}
 }
 
@@ -106,7 +290,12 @@ func configureProvider(d *schema.ResourceData) (interface{}, error) {
 		log.Fatal(err)
 	}
 	config := Config{
-		Endpoint: d.Get("endpoint").(string),
+		Endpoint:                   d.Get("endpoint").(string),
+		DisableApiCache:            d.Get("disable_api_cache").(bool),
+		ValidateServiceNames:       d.Get("validate_service_names").(bool),
+		CheckDomainZonePropagation: d.Get("check_domain_zone_propagation").(bool),
+		DisallowDeprecated:         d.Get("disallow_deprecated").(bool),
+		ExtraUserAgent:             d.Get("extra_user_agent").(string),
 	}
 	configFile := fmt.Sprintf("%s/.ovh.conf", userHome)
 	if _, err := os.Stat(configFile); err == nil {
@@ -140,11 +329,43 @@ func configureProvider(d *schema.ResourceData) (interface{}, error) {
 	return &config, nil
 }
 
+// deprecated marks a resource or data source as deprecated: terraform warns
+// when it is used, and the plan fails if the disallow_deprecated provider
+// argument is set. Deprecated resources can still be destroyed.
 func deprecated(r *schema.Resource, msg string) *schema.Resource {
 	r.DeprecationMessage = msg
+
+	// the data sources are read during the plan, the resources are
+	// checked when their diff is computed
+	if r.Create == nil {
+		read := r.Read
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			if err := checkDeprecatedAllowed(meta, msg); err != nil {
+				return err
+			}
+			return read(d, meta)
+		}
+		return r
+	}
+
+	checkDiff := func(d *schema.ResourceDiff, meta interface{}) error {
+		return checkDeprecatedAllowed(meta, msg)
+	}
+	if r.CustomizeDiff != nil {
+		r.CustomizeDiff = customizeDiffs(checkDiff, r.CustomizeDiff)
+	} else {
+		r.CustomizeDiff = checkDiff
+	}
 	return r
 }
 
+func checkDeprecatedAllowed(meta interface{}, msg string) error {
+	if config, ok := meta.(*Config); ok && config.DisallowDeprecated {
+		return fmt.Errorf("deprecated resources are disallowed by the provider configuration: %s", msg)
+	}
+	return nil
+}
+
 // currentUserHome attempts to get current user's home directory
 func currentUserHome() (string, error) {
 	userHome := ""
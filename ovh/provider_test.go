@@ -34,6 +34,33 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestProvider_disallowDeprecated(t *testing.T) {
+	p := Provider().(*schema.Provider)
+	allowed := &Config{}
+	disallowed := &Config{DisallowDeprecated: true}
+
+	for name, r := range p.ResourcesMap {
+		if r.DeprecationMessage == "" {
+			continue
+		}
+		if err := r.CustomizeDiff(nil, disallowed); err == nil {
+			t.Errorf("expected the diff of the deprecated resource %s to fail", name)
+		}
+	}
+
+	d := p.DataSourcesMap["ovh_publiccloud_regions"]
+	if err := d.Read(nil, disallowed); err == nil {
+		t.Errorf("expected the read of the deprecated data source to fail")
+	}
+
+	if err := checkDeprecatedAllowed(allowed, "Use ovh_cloud_user resource instead"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if p.ResourcesMap["ovh_cloud_user"].DeprecationMessage != "" {
+		t.Errorf("ovh_cloud_user must not be deprecated")
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	v := os.Getenv("OVH_ENDPOINT")
 	if v == "" {
@@ -0,0 +1,168 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceCdnDedicatedDomainImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/DOMAIN formatted")
+	}
+	d.Set("service_name", splitId[0])
+	d.Set("domain", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCdnDedicatedDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCdnDedicatedDomainCreate,
+		Read:   resourceCdnDedicatedDomainRead,
+		Update: resourceCdnDedicatedDomainUpdate,
+		Delete: resourceCdnDedicatedDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCdnDedicatedDomainImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "on",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"on", "off"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCdnDedicatedDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &CdnDedicatedDomainCreateOpts{Domain: d.Get("domain").(string)}
+	r := &CdnDedicatedDomain{}
+
+	log.Printf("[DEBUG] Will add domain %s to cdn %s", params.Domain, serviceName)
+
+	endpoint := fmt.Sprintf("/cdn/dedicated/%s/domains", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, params.Domain))
+
+	if r.Status != d.Get("status").(string) {
+		return resourceCdnDedicatedDomainUpdate(d, meta)
+	}
+
+	return resourceCdnDedicatedDomainRead(d, meta)
+}
+
+func resourceCdnDedicatedDomainRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	domain := d.Get("domain").(string)
+
+	r := &CdnDedicatedDomain{}
+	endpoint := fmt.Sprintf("/cdn/dedicated/%s/domains/%s", serviceName, domain)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read cdn %s domain %s", serviceName, r)
+
+	d.Set("status", r.Status)
+	d.Set("cname", r.Cname)
+	d.Set("type", r.Type)
+
+	return nil
+}
+
+func resourceCdnDedicatedDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	domain := d.Get("domain").(string)
+
+	params := &CdnDedicatedDomainUpdateOpts{Status: d.Get("status").(string)}
+
+	log.Printf("[DEBUG] Will update cdn %s domain %s: %v", serviceName, domain, params)
+
+	endpoint := fmt.Sprintf("/cdn/dedicated/%s/domains/%s", serviceName, domain)
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceCdnDedicatedDomainRead(d, meta)
+}
+
+func resourceCdnDedicatedDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	domain := d.Get("domain").(string)
+
+	log.Printf("[DEBUG] Will remove domain %s from cdn %s", domain, serviceName)
+
+	task := &CdnDedicatedTask{}
+	endpoint := fmt.Sprintf("/cdn/dedicated/%s/domains/%s", serviceName, domain)
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := cdnDedicatedTaskWait(config.OVHClient, serviceName, domain, task.TaskId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func cdnDedicatedDomainExists(serviceName, domain string, c *ovh.Client) error {
+	r := &CdnDedicatedDomain{}
+	endpoint := fmt.Sprintf("/cdn/dedicated/%s/domains/%s", serviceName, domain)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read cdn domain: %s", r)
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceCdnDedicatedDomainBackendImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/DOMAIN/IP formatted")
+	}
+	d.Set("service_name", splitId[0])
+	d.Set("domain", splitId[1])
+	d.Set("ip", splitId[2])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCdnDedicatedDomainBackend() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCdnDedicatedDomainBackendCreate,
+		Read:   resourceCdnDedicatedDomainBackendRead,
+		Delete: resourceCdnDedicatedDomainBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCdnDedicatedDomainBackendImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+		},
+	}
+}
+
+func resourceCdnDedicatedDomainBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	domain := d.Get("domain").(string)
+
+	params := &CdnDedicatedBackendCreateOpts{Ip: d.Get("ip").(string)}
+
+	log.Printf("[DEBUG] Will add backend %s to cdn %s domain %s", params.Ip, serviceName, domain)
+
+	endpoint := fmt.Sprintf("/cdn/dedicated/%s/domains/%s/backends", serviceName, domain)
+	if err := config.OVHClient.Post(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", serviceName, domain, params.Ip))
+
+	return resourceCdnDedicatedDomainBackendRead(d, meta)
+}
+
+func resourceCdnDedicatedDomainBackendRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	domain := d.Get("domain").(string)
+	ip := d.Get("ip").(string)
+
+	r := &CdnDedicatedBackend{}
+	endpoint := fmt.Sprintf("/cdn/dedicated/%s/domains/%s/backends/%s", serviceName, domain, ip)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read cdn %s domain %s backend %s", serviceName, domain, r.Ip)
+
+	d.Set("ip", r.Ip)
+
+	return nil
+}
+
+func resourceCdnDedicatedDomainBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	domain := d.Get("domain").(string)
+	ip := d.Get("ip").(string)
+
+	log.Printf("[DEBUG] Will remove backend %s from cdn %s domain %s", ip, serviceName, domain)
+
+	task := &CdnDedicatedTask{}
+	endpoint := fmt.Sprintf("/cdn/dedicated/%s/domains/%s/backends/%s", serviceName, domain, ip)
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := cdnDedicatedTaskWait(config.OVHClient, serviceName, domain, task.TaskId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func cdnDedicatedDomainBackendExists(serviceName, domain, ip string, c *ovh.Client) error {
+	r := &CdnDedicatedBackend{}
+	endpoint := fmt.Sprintf("/cdn/dedicated/%s/domains/%s/backends/%s", serviceName, domain, ip)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read cdn backend: %s", r.Ip)
+
+	return nil
+}
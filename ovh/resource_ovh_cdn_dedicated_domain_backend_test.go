@@ -0,0 +1,87 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccCdnDedicatedDomainBackendConfig = `
+resource "ovh_cdn_dedicated_domain" "domain" {
+  service_name = "%s"
+  domain       = "%s"
+}
+
+resource "ovh_cdn_dedicated_domain_backend" "backend" {
+  service_name = "${ovh_cdn_dedicated_domain.domain.service_name}"
+  domain       = "${ovh_cdn_dedicated_domain.domain.domain}"
+  ip           = "%s"
+}
+`
+
+func TestAccCdnDedicatedDomainBackend_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CDN_SERVICE")
+	domain := fmt.Sprintf("%s.%s", acctest.RandomWithPrefix(test_prefix), os.Getenv("OVH_ZONE"))
+	ip := os.Getenv("OVH_IP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckCdnDedicatedPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCdnDedicatedDomainBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCdnDedicatedDomainBackendConfig, serviceName, domain, ip),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCdnDedicatedDomainBackendExists("ovh_cdn_dedicated_domain_backend.backend", t),
+					resource.TestCheckResourceAttr("ovh_cdn_dedicated_domain_backend.backend", "ip", ip),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCdnDedicatedDomainBackendExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No cdn backend id is set")
+		}
+
+		return cdnDedicatedDomainBackendExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["domain"],
+			rs.Primary.Attributes["ip"],
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckCdnDedicatedDomainBackendDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_cdn_dedicated_domain_backend" {
+			continue
+		}
+
+		err := cdnDedicatedDomainBackendExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["domain"],
+			rs.Primary.Attributes["ip"],
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("cdn backend still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceCdnDedicatedDomainCacheRuleImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/DOMAIN/CACHE_RULE_ID formatted")
+	}
+	d.Set("service_name", splitId[0])
+	d.Set("domain", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCdnDedicatedDomainCacheRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCdnDedicatedDomainCacheRuleCreate,
+		Read:   resourceCdnDedicatedDomainCacheRuleRead,
+		Update: resourceCdnDedicatedDomainCacheRuleUpdate,
+		Delete: resourceCdnDedicatedDomainCacheRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCdnDedicatedDomainCacheRuleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"file_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"extension", "file", "folder"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"file_match": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cache_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "forceCache",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"forceCache", "noCache"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if v.(int) < 0 {
+						errors = append(errors, fmt.Errorf("%q must be positive", k))
+					}
+					return
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "enabled",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"enabled", "disabled"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"cache_rule_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCdnDedicatedDomainCacheRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	domain := d.Get("domain").(string)
+
+	params := &CdnDedicatedCacheRuleCreateOpts{
+		CacheType: d.Get("cache_type").(string),
+		FileMatch: d.Get("file_match").(string),
+		FileType:  d.Get("file_type").(string),
+		Ttl:       d.Get("ttl").(int),
+	}
+	r := &CdnDedicatedCacheRule{}
+
+	log.Printf("[DEBUG] Will create cache rule on cdn %s domain %s: %v", serviceName, domain, params)
+
+	endpoint := fmt.Sprintf("/cdn/dedicated/%s/domains/%s/cacheRules", serviceName, domain)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", serviceName, domain, r.CacheRuleId))
+
+	// cache rules are always created enabled
+	if d.Get("status").(string) != r.Status {
+		return resourceCdnDedicatedDomainCacheRuleUpdate(d, meta)
+	}
+
+	return resourceCdnDedicatedDomainCacheRuleRead(d, meta)
+}
+
+func resourceCdnDedicatedDomainCacheRuleRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	endpoint := cdnDedicatedCacheRuleEndpoint(d.Id())
+
+	r := &CdnDedicatedCacheRule{}
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read cdn cache rule %s", r)
+
+	d.Set("cache_rule_id", int(r.CacheRuleId))
+	d.Set("file_type", r.FileType)
+	d.Set("file_match", r.FileMatch)
+	d.Set("cache_type", r.CacheType)
+	d.Set("ttl", r.Ttl)
+	d.Set("status", r.Status)
+
+	return nil
+}
+
+func resourceCdnDedicatedDomainCacheRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	endpoint := cdnDedicatedCacheRuleEndpoint(d.Id())
+
+	params := &CdnDedicatedCacheRuleUpdateOpts{
+		CacheType: d.Get("cache_type").(string),
+		Status:    d.Get("status").(string),
+		Ttl:       d.Get("ttl").(int),
+	}
+
+	log.Printf("[DEBUG] Will update cdn cache rule %s: %v", d.Id(), params)
+
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceCdnDedicatedDomainCacheRuleRead(d, meta)
+}
+
+func resourceCdnDedicatedDomainCacheRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	domain := d.Get("domain").(string)
+	endpoint := cdnDedicatedCacheRuleEndpoint(d.Id())
+
+	log.Printf("[DEBUG] Will delete cdn cache rule %s", d.Id())
+
+	task := &CdnDedicatedTask{}
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := cdnDedicatedTaskWait(config.OVHClient, serviceName, domain, task.TaskId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// cdnDedicatedCacheRuleEndpoint builds the endpoint of a cache rule from its
+// SERVICE_NAME/DOMAIN/CACHE_RULE_ID id.
+func cdnDedicatedCacheRuleEndpoint(id string) string {
+	splitId := strings.SplitN(id, "/", 3)
+	if len(splitId) != 3 {
+		return fmt.Sprintf("/cdn/dedicated/%s", id)
+	}
+	return fmt.Sprintf("/cdn/dedicated/%s/domains/%s/cacheRules/%s", splitId[0], splitId[1], splitId[2])
+}
+
+func cdnDedicatedDomainCacheRuleExists(id string, c *ovh.Client) error {
+	r := &CdnDedicatedCacheRule{}
+	endpoint := cdnDedicatedCacheRuleEndpoint(id)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read cdn cache rule: %s", r)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccCdnDedicatedDomainCacheRuleConfig = `
+resource "ovh_cdn_dedicated_domain" "domain" {
+  service_name = "%s"
+  domain       = "%s"
+}
+
+resource "ovh_cdn_dedicated_domain_cache_rule" "images" {
+  service_name = "${ovh_cdn_dedicated_domain.domain.service_name}"
+  domain       = "${ovh_cdn_dedicated_domain.domain.domain}"
+  file_type    = "extension"
+  file_match   = "png"
+  ttl          = %d
+}
+`
+
+func TestAccCdnDedicatedDomainCacheRule_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CDN_SERVICE")
+	domain := fmt.Sprintf("%s.%s", acctest.RandomWithPrefix(test_prefix), os.Getenv("OVH_ZONE"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckCdnDedicatedPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCdnDedicatedDomainCacheRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCdnDedicatedDomainCacheRuleConfig, serviceName, domain, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCdnDedicatedDomainCacheRuleExists("ovh_cdn_dedicated_domain_cache_rule.images", t),
+					resource.TestCheckResourceAttr("ovh_cdn_dedicated_domain_cache_rule.images", "ttl", "3600"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCdnDedicatedDomainCacheRuleConfig, serviceName, domain, 86400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCdnDedicatedDomainCacheRuleExists("ovh_cdn_dedicated_domain_cache_rule.images", t),
+					resource.TestCheckResourceAttr("ovh_cdn_dedicated_domain_cache_rule.images", "ttl", "86400"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCdnDedicatedDomainCacheRuleExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No cdn cache rule id is set")
+		}
+
+		return cdnDedicatedDomainCacheRuleExists(rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckCdnDedicatedDomainCacheRuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_cdn_dedicated_domain_cache_rule" {
+			continue
+		}
+
+		err := cdnDedicatedDomainCacheRuleExists(rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("cdn cache rule still exists")
+		}
+	}
+	return nil
+}
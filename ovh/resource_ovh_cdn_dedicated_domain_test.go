@@ -0,0 +1,90 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccCdnDedicatedDomainConfig = `
+resource "ovh_cdn_dedicated_domain" "domain" {
+  service_name = "%s"
+  domain       = "%s"
+  status       = "%s"
+}
+`
+
+func TestAccCdnDedicatedDomain_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CDN_SERVICE")
+	domain := fmt.Sprintf("%s.%s", acctest.RandomWithPrefix(test_prefix), os.Getenv("OVH_ZONE"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckCdnDedicatedPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCdnDedicatedDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCdnDedicatedDomainConfig, serviceName, domain, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCdnDedicatedDomainExists("ovh_cdn_dedicated_domain.domain", t),
+					resource.TestCheckResourceAttr("ovh_cdn_dedicated_domain.domain", "status", "on"),
+					resource.TestCheckResourceAttrSet("ovh_cdn_dedicated_domain.domain", "cname"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCdnDedicatedDomainConfig, serviceName, domain, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCdnDedicatedDomainExists("ovh_cdn_dedicated_domain.domain", t),
+					resource.TestCheckResourceAttr("ovh_cdn_dedicated_domain.domain", "status", "off"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCdnDedicatedPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// CDN dedicated is an optional product
+	// this resource is tested only if env var `OVH_CDN_SERVICE`
+	// is set
+	if os.Getenv("OVH_CDN_SERVICE") == "" {
+		t.Skip("OVH_CDN_SERVICE must be set to test CDN dedicated")
+	}
+}
+
+func testAccCheckCdnDedicatedDomainExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No cdn domain id is set")
+		}
+
+		return cdnDedicatedDomainExists(rs.Primary.Attributes["service_name"], rs.Primary.Attributes["domain"], config.OVHClient)
+	}
+}
+
+func testAccCheckCdnDedicatedDomainDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_cdn_dedicated_domain" {
+			continue
+		}
+
+		err := cdnDedicatedDomainExists(rs.Primary.Attributes["service_name"], rs.Primary.Attributes["domain"], config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("cdn domain still exists")
+		}
+	}
+	return nil
+}
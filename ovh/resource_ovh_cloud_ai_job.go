@@ -0,0 +1,231 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// cloudProjectAiJobEndedStates are the states of the jobs which are over,
+// successfully or not.
+var cloudProjectAiJobEndedStates = []string{"DONE", "FAILED", "ERROR", "INTERRUPTED", "TIMEOUT", "SYNC_FAILED"}
+
+func resourceCloudAiJobImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not OVH_PROJECT_ID/job_id formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("project_id", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudAiJob() *schema.Resource {
+	s := cloudProjectAiSchema()
+	s["project_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", nil),
+	}
+	s["region"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressRegionDiff,
+	}
+	s["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["image"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["command"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s["env"] = &schema.Schema{
+		Type:      schema.TypeMap,
+		Optional:  true,
+		ForceNew:  true,
+		Sensitive: true,
+		Elem:      &schema.Schema{Type: schema.TypeString},
+	}
+	s["timeout"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+		ForceNew: true,
+	}
+	s["default_http_port"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceCloudAiJobCreate,
+		Read:   resourceCloudAiJobRead,
+		Delete: resourceCloudAiJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudAiJobImportState,
+		},
+
+		CustomizeDiff: serviceNameCustomizeDiff("project_id", serviceNameCloudProject),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+// cloudProjectAiJobEnvVars returns the environment variables of a job sorted
+// by name, for the job spec to be stable.
+func cloudProjectAiJobEnvVars(env map[string]interface{}) []CloudProjectAiJobEnvVar {
+	vars := make([]CloudProjectAiJobEnvVar, 0, len(env))
+	for name, value := range env {
+		vars = append(vars, CloudProjectAiJobEnvVar{Name: name, Value: value.(string)})
+	}
+	sort.Slice(vars, func(i, j int) bool { return vars[i].Name < vars[j].Name })
+	return vars
+}
+
+func resourceCloudAiJobCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	setNormalized(d, normalizeRegion, "region")
+
+	projectId := d.Get("project_id").(string)
+
+	params := &CloudProjectAiJobSpec{
+		Name:            d.Get("name").(string),
+		Region:          d.Get("region").(string),
+		Image:           d.Get("image").(string),
+		Command:         cloudProjectAiStringsFromList(d.Get("command").([]interface{})),
+		EnvVars:         cloudProjectAiJobEnvVars(d.Get("env").(map[string]interface{})),
+		Labels:          cloudProjectAiLabelsFromSchema(d),
+		Resources:       cloudProjectAiResourcesFromSchema(d),
+		Volumes:         cloudProjectAiVolumesFromList(d.Get("volume").([]interface{})),
+		Timeout:         d.Get("timeout").(int),
+		DefaultHttpPort: d.Get("default_http_port").(int),
+		UnsecureHttp:    d.Get("unsecure_http").(bool),
+		SshPublicKeys:   cloudProjectAiStringsFromList(d.Get("ssh_public_keys").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Will create AI job: %s", params)
+
+	r := &CloudProjectAiJob{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/ai/job", projectId)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(r.Id)
+
+	// the job is created once it started: it may end long after the apply
+	err := cloudProjectAiStateWait(
+		config.OVHClient, projectId, "job", r.Id,
+		[]string{"QUEUED", "PENDING", "INITIALIZING"},
+		[]string{"RUNNING", "FINALIZING", "DONE"},
+		[]string{"FAILED", "ERROR", "INTERRUPTED", "TIMEOUT", "SYNC_FAILED"},
+		d.Timeout(schema.TimeoutCreate),
+	)
+	if err != nil {
+		return fmt.Errorf("waiting for AI job %s to be running: %s", r.Id, err)
+	}
+
+	return resourceCloudAiJobRead(d, meta)
+}
+
+func resourceCloudAiJobRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	r := &CloudProjectAiJob{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/ai/job/%s", projectId, d.Id())
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read AI job %s", r)
+
+	d.Set("name", r.Spec.Name)
+	d.Set("region", r.Spec.Region)
+	d.Set("image", r.Spec.Image)
+	d.Set("command", r.Spec.Command)
+	env := map[string]string{}
+	for _, v := range r.Spec.EnvVars {
+		env[v.Name] = v.Value
+	}
+	d.Set("env", env)
+	d.Set("timeout", r.Spec.Timeout)
+	d.Set("default_http_port", r.Spec.DefaultHttpPort)
+	d.Set("unsecure_http", r.Spec.UnsecureHttp)
+	cloudProjectAiSetComputed(d, r.Spec.Resources, r.Spec.Labels, r.Spec.Volumes, r.Status, r.CreatedAt)
+
+	return nil
+}
+
+func resourceCloudAiJobDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	r := &CloudProjectAiJob{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/ai/job/%s", projectId, d.Id())
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	// jobs which are not over must be killed before being deleted
+	if !cloudProjectAiJobEnded(r.Status.State) {
+		log.Printf("[DEBUG] Will kill AI job %s", r)
+
+		if err := config.OVHClient.Put(endpoint+"/kill", nil, nil); err != nil {
+			return fmt.Errorf("calling Put %s/kill:\n\t %q", endpoint, err)
+		}
+
+		err := cloudProjectAiStateWait(
+			config.OVHClient, projectId, "job", d.Id(),
+			[]string{"QUEUED", "PENDING", "INITIALIZING", "RUNNING", "INTERRUPTING", "FINALIZING"},
+			cloudProjectAiJobEndedStates,
+			nil,
+			d.Timeout(schema.TimeoutDelete),
+		)
+		if err != nil {
+			return fmt.Errorf("waiting for AI job %s to be interrupted: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Will delete AI job %s", d.Id())
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func cloudProjectAiJobEnded(state string) bool {
+	for _, s := range cloudProjectAiJobEndedStates {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
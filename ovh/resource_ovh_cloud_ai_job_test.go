@@ -0,0 +1,84 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccCloudAiJobConfig = `
+resource "ovh_cloud_ai_job" "job" {
+  project_id = "%s"
+  region     = "%s"
+  name       = "%s"
+  image      = "ubuntu"
+  command    = ["sleep", "3600"]
+  cpu        = 1
+  timeout    = 3600
+
+  env = {
+    MESSAGE = "hello"
+  }
+}
+`
+
+func TestAccCloudAiJob_basic(t *testing.T) {
+	projectId := os.Getenv("OVH_PUBLIC_CLOUD")
+	region := os.Getenv("OVH_CLOUD_AI_REGION")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckCloudAiPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudAiJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudAiJobConfig, projectId, region, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_ai_job.job", "state", "RUNNING"),
+					resource.TestCheckResourceAttr("ovh_cloud_ai_job.job", "command.#", "2"),
+					resource.TestCheckResourceAttr("ovh_cloud_ai_job.job", "env.MESSAGE", "hello"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudAiJobDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_cloud_ai_job" {
+			continue
+		}
+
+		endpoint := fmt.Sprintf("/cloud/project/%s/ai/job/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID)
+		if err := config.OVHClient.Get(endpoint, &CloudProjectAiJob{}); err == nil {
+			return fmt.Errorf("AI job %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestCloudProjectAiJobEnvVars(t *testing.T) {
+	env := map[string]interface{}{
+		"MODEL":  "resnet",
+		"EPOCHS": "10",
+	}
+
+	expected := []CloudProjectAiJobEnvVar{
+		{Name: "EPOCHS", Value: "10"},
+		{Name: "MODEL", Value: "resnet"},
+	}
+	if got := cloudProjectAiJobEnvVars(env); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if !cloudProjectAiJobEnded("TIMEOUT") || cloudProjectAiJobEnded("FINALIZING") {
+		t.Errorf("unexpected ended states")
+	}
+}
@@ -0,0 +1,259 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceCloudAiNotebookImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not OVH_PROJECT_ID/notebook_id formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("project_id", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudAiNotebook() *schema.Resource {
+	s := cloudProjectAiSchema()
+	s["project_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", nil),
+	}
+	s["region"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressRegionDiff,
+	}
+	s["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["framework"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["framework_version"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	}
+	s["editor"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["stopped"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+
+	return &schema.Resource{
+		Create: resourceCloudAiNotebookCreate,
+		Read:   resourceCloudAiNotebookRead,
+		Update: resourceCloudAiNotebookUpdate,
+		Delete: resourceCloudAiNotebookDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudAiNotebookImportState,
+		},
+
+		CustomizeDiff: serviceNameCustomizeDiff("project_id", serviceNameCloudProject),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceCloudAiNotebookCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	setNormalized(d, normalizeRegion, "region")
+
+	projectId := d.Get("project_id").(string)
+
+	params := &CloudProjectAiNotebookSpec{
+		Name:   d.Get("name").(string),
+		Region: d.Get("region").(string),
+		Labels: cloudProjectAiLabelsFromSchema(d),
+		Env: CloudProjectAiNotebookEnv{
+			EditorId:         d.Get("editor").(string),
+			FrameworkId:      d.Get("framework").(string),
+			FrameworkVersion: d.Get("framework_version").(string),
+		},
+		Resources:     cloudProjectAiResourcesFromSchema(d),
+		Volumes:       cloudProjectAiVolumesFromList(d.Get("volume").([]interface{})),
+		UnsecureHttp:  d.Get("unsecure_http").(bool),
+		SshPublicKeys: cloudProjectAiStringsFromList(d.Get("ssh_public_keys").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Will create AI notebook: %s", params)
+
+	r := &CloudProjectAiNotebook{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/ai/notebook", projectId)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(r.Id)
+
+	err := cloudProjectAiStateWait(
+		config.OVHClient, projectId, "notebook", r.Id,
+		[]string{"STARTING", "RESTARTING", "QUEUED"},
+		[]string{"RUNNING"},
+		[]string{"FAILED", "ERROR", "SYNC_FAILED"},
+		d.Timeout(schema.TimeoutCreate),
+	)
+	if err != nil {
+		return fmt.Errorf("waiting for AI notebook %s to be running: %s", r.Id, err)
+	}
+
+	if d.Get("stopped").(bool) {
+		if err := cloudProjectAiNotebookStop(d, meta, schema.TimeoutCreate); err != nil {
+			return err
+		}
+	}
+
+	return resourceCloudAiNotebookRead(d, meta)
+}
+
+func resourceCloudAiNotebookRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	r := &CloudProjectAiNotebook{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/ai/notebook/%s", projectId, d.Id())
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read AI notebook %s", r)
+
+	d.Set("name", r.Spec.Name)
+	d.Set("region", r.Spec.Region)
+	d.Set("framework", r.Spec.Env.FrameworkId)
+	d.Set("framework_version", r.Spec.Env.FrameworkVersion)
+	d.Set("editor", r.Spec.Env.EditorId)
+	d.Set("unsecure_http", r.Spec.UnsecureHttp)
+	d.Set("stopped", r.Status.State == "STOPPED")
+	cloudProjectAiSetComputed(d, r.Spec.Resources, r.Spec.Labels, r.Spec.Volumes, r.Status, r.CreatedAt)
+
+	return nil
+}
+
+func resourceCloudAiNotebookUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("stopped") {
+		if d.Get("stopped").(bool) {
+			if err := cloudProjectAiNotebookStop(d, meta, schema.TimeoutUpdate); err != nil {
+				return err
+			}
+		} else {
+			if err := cloudProjectAiNotebookStart(d, meta); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceCloudAiNotebookRead(d, meta)
+}
+
+func resourceCloudAiNotebookDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	// running notebooks can't be deleted
+	if state := d.Get("state").(string); state != "STOPPED" && state != "FAILED" && state != "ERROR" {
+		if err := cloudProjectAiNotebookStop(d, meta, schema.TimeoutDelete); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Will delete AI notebook %s", d.Id())
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/ai/notebook/%s", projectId, d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	err := cloudProjectAiStateWait(
+		config.OVHClient, projectId, "notebook", d.Id(),
+		[]string{"STOPPED", "DELETING"},
+		[]string{"DELETED"},
+		[]string{"ERROR"},
+		d.Timeout(schema.TimeoutDelete),
+	)
+	if err != nil {
+		return fmt.Errorf("waiting for AI notebook %s to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func cloudProjectAiNotebookStop(d *schema.ResourceData, meta interface{}, timeoutKey string) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	log.Printf("[DEBUG] Will stop AI notebook %s", d.Id())
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/ai/notebook/%s/stop", projectId, d.Id())
+	if err := config.OVHClient.Put(endpoint, nil, nil); err != nil {
+		return fmt.Errorf("calling Put %s:\n\t %q", endpoint, err)
+	}
+
+	err := cloudProjectAiStateWait(
+		config.OVHClient, projectId, "notebook", d.Id(),
+		[]string{"RUNNING", "STARTING", "RESTARTING", "STOPPING", "SYNC_FAILED"},
+		[]string{"STOPPED", "FAILED"},
+		[]string{"ERROR"},
+		d.Timeout(timeoutKey),
+	)
+	if err != nil {
+		return fmt.Errorf("waiting for AI notebook %s to be stopped: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func cloudProjectAiNotebookStart(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	log.Printf("[DEBUG] Will start AI notebook %s", d.Id())
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/ai/notebook/%s/start", projectId, d.Id())
+	if err := config.OVHClient.Put(endpoint, nil, nil); err != nil {
+		return fmt.Errorf("calling Put %s:\n\t %q", endpoint, err)
+	}
+
+	err := cloudProjectAiStateWait(
+		config.OVHClient, projectId, "notebook", d.Id(),
+		[]string{"STOPPED", "STARTING", "RESTARTING", "QUEUED"},
+		[]string{"RUNNING"},
+		[]string{"FAILED", "ERROR", "SYNC_FAILED"},
+		d.Timeout(schema.TimeoutUpdate),
+	)
+	if err != nil {
+		return fmt.Errorf("waiting for AI notebook %s to be running: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccCloudAiNotebookConfig = `
+resource "ovh_cloud_ai_notebook" "notebook" {
+  project_id = "%s"
+  region     = "%s"
+  name       = "%s"
+  framework  = "one-for-all"
+  editor     = "jupyterlab"
+  cpu        = 1
+  stopped    = %t
+
+  labels = {
+    env = "testacc"
+  }
+}
+`
+
+func testAccCheckCloudAiPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccCheckPublicCloudExists(t)
+
+	// AI notebooks and jobs are billed: they are tested only when the
+	// region to use is given
+	if os.Getenv("OVH_CLOUD_AI_REGION") == "" {
+		t.Skip("OVH_CLOUD_AI_REGION must be set to test AI notebooks and jobs")
+	}
+}
+
+func TestAccCloudAiNotebook_basic(t *testing.T) {
+	projectId := os.Getenv("OVH_PUBLIC_CLOUD")
+	region := os.Getenv("OVH_CLOUD_AI_REGION")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckCloudAiPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudAiNotebookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudAiNotebookConfig, projectId, region, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_ai_notebook.notebook", "state", "RUNNING"),
+					resource.TestCheckResourceAttr("ovh_cloud_ai_notebook.notebook", "labels.env", "testacc"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_ai_notebook.notebook", "url"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCloudAiNotebookConfig, projectId, region, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_ai_notebook.notebook", "state", "STOPPED"),
+					resource.TestCheckResourceAttr("ovh_cloud_ai_notebook.notebook", "stopped", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudAiNotebookDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_cloud_ai_notebook" {
+			continue
+		}
+
+		endpoint := fmt.Sprintf("/cloud/project/%s/ai/notebook/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID)
+		if err := config.OVHClient.Get(endpoint, &CloudProjectAiNotebook{}); err == nil {
+			return fmt.Errorf("AI notebook %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
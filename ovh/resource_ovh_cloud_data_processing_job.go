@@ -0,0 +1,280 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceCloudDataProcessingJobImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not OVH_PROJECT_ID/job_id formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("project_id", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudDataProcessingJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudDataProcessingJobCreate,
+		Read:   resourceCloudDataProcessingJobRead,
+		Delete: resourceCloudDataProcessingJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudDataProcessingJobImportState,
+		},
+
+		CustomizeDiff: serviceNameCustomizeDiff("project_id", serviceNameCloudProject),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", nil),
+			},
+			"region": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressRegionDiff,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"container_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"main_application_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"job_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "python",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"python", "java"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"main_class_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"arguments": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"driver_cores": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  1,
+			},
+			"driver_memory": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  4096,
+			},
+			"driver_memory_overhead": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"executor_num": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  1,
+			},
+			"executor_cores": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  1,
+			},
+			"executor_memory": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  4096,
+			},
+			"executor_memory_overhead": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudDataProcessingJobCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	setNormalized(d, normalizeRegion, "region")
+
+	projectId := d.Get("project_id").(string)
+
+	engineParameters, err := cloudProjectDataProcessingSparkParameters(d)
+	if err != nil {
+		return err
+	}
+
+	params := &CloudProjectDataProcessingJobCreateOpts{
+		Name:             d.Get("name").(string),
+		Region:           d.Get("region").(string),
+		Engine:           "spark",
+		EngineVersion:    d.Get("engine_version").(string),
+		ContainerName:    d.Get("container_name").(string),
+		EngineParameters: engineParameters,
+	}
+
+	log.Printf("[DEBUG] Will submit data processing job: %s", params)
+
+	r := &CloudProjectDataProcessingJob{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/dataProcessing/jobs", projectId)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(r.Id)
+
+	// as for the AI jobs, the apply doesn't wait for the job to be over
+	err = cloudProjectDataProcessingJobWait(config.OVHClient, projectId, r.Id,
+		[]string{"PENDING", "SUBMITTED", "UNKNOWN"},
+		[]string{"RUNNING", "COMPLETED"},
+		d.Timeout(schema.TimeoutCreate),
+	)
+	if err != nil {
+		return fmt.Errorf("waiting for data processing job %s to be running: %s", r.Id, err)
+	}
+
+	return resourceCloudDataProcessingJobRead(d, meta)
+}
+
+func resourceCloudDataProcessingJobRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	r := &CloudProjectDataProcessingJob{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/dataProcessing/jobs/%s", projectId, d.Id())
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read data processing job %s", r)
+
+	d.Set("name", r.Name)
+	d.Set("region", r.Region)
+	d.Set("engine_version", r.EngineVersion)
+	d.Set("container_name", r.ContainerName)
+	d.Set("status", r.Status)
+	d.Set("creation_date", r.CreationDate)
+	if r.StartDate != nil {
+		d.Set("start_date", *r.StartDate)
+	}
+	if r.EndDate != nil {
+		d.Set("end_date", *r.EndDate)
+	}
+
+	params := r.Parameters()
+	d.Set("main_application_code", params["main_application_code"])
+	d.Set("job_type", params["job_type"])
+	d.Set("main_class_name", params["main_class_name"])
+	if params["arguments"] != "" {
+		d.Set("arguments", strings.Split(params["arguments"], ","))
+	} else {
+		d.Set("arguments", []string{})
+	}
+	for _, name := range []string{"driver_cores", "driver_memory", "driver_memory_overhead", "executor_num", "executor_cores", "executor_memory", "executor_memory_overhead"} {
+		if v, err := strconv.Atoi(params[name]); err == nil {
+			d.Set(name, v)
+		}
+	}
+
+	return nil
+}
+
+func resourceCloudDataProcessingJobDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	r := &CloudProjectDataProcessingJob{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/dataProcessing/jobs/%s", projectId, d.Id())
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	// the jobs stay in the history of the project: only the ones which are
+	// not over are terminated
+	if !cloudProjectDataProcessingJobEnded(r.Status) {
+		log.Printf("[DEBUG] Will terminate data processing job %s", r)
+
+		if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+			return CheckDeleted(d, err, endpoint)
+		}
+
+		err := cloudProjectDataProcessingJobWait(config.OVHClient, projectId, d.Id(),
+			[]string{"PENDING", "SUBMITTED", "RUNNING", "CANCELLING", "UNKNOWN"},
+			cloudProjectDataProcessingJobEndedStatuses,
+			d.Timeout(schema.TimeoutDelete),
+		)
+		if err != nil {
+			return fmt.Errorf("waiting for data processing job %s to be terminated: %s", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,79 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccCloudDataProcessingJobConfig = `
+resource "ovh_cloud_data_processing_job" "job" {
+  project_id            = "%s"
+  region                = "%s"
+  name                  = "%s"
+  engine_version        = "3.0.1"
+  container_name        = "%s"
+  main_application_code = "wordcount.py"
+  arguments             = ["wordcount.py"]
+  executor_num          = 2
+}
+`
+
+func testAccCheckCloudDataProcessingPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccCheckPublicCloudExists(t)
+
+	// the job runs the code uploaded in the container beforehand
+	if os.Getenv("OVH_CLOUD_DATA_PROCESSING_REGION") == "" || os.Getenv("OVH_CLOUD_DATA_PROCESSING_CONTAINER") == "" {
+		t.Skip("OVH_CLOUD_DATA_PROCESSING_REGION and OVH_CLOUD_DATA_PROCESSING_CONTAINER must be set to test data processing jobs")
+	}
+}
+
+func TestAccCloudDataProcessingJob_basic(t *testing.T) {
+	projectId := os.Getenv("OVH_PUBLIC_CLOUD")
+	region := os.Getenv("OVH_CLOUD_DATA_PROCESSING_REGION")
+	container := os.Getenv("OVH_CLOUD_DATA_PROCESSING_CONTAINER")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckCloudDataProcessingPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudDataProcessingJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudDataProcessingJobConfig, projectId, region, name, container),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_data_processing_job.job", "name", name),
+					resource.TestCheckResourceAttr("ovh_cloud_data_processing_job.job", "job_type", "python"),
+					resource.TestCheckResourceAttr("ovh_cloud_data_processing_job.job", "executor_num", "2"),
+					resource.TestCheckResourceAttr("ovh_cloud_data_processing_job.job", "executor_memory_overhead", "409"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_data_processing_job.job", "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudDataProcessingJobDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_cloud_data_processing_job" {
+			continue
+		}
+
+		// the jobs are kept in the history: they must only be over
+		r := &CloudProjectDataProcessingJob{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/dataProcessing/jobs/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID)
+		if err := config.OVHClient.Get(endpoint, r); err != nil {
+			continue
+		}
+		if !cloudProjectDataProcessingJobEnded(r.Status) {
+			return fmt.Errorf("data processing job %s is still %s", rs.Primary.ID, r.Status)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,336 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDbaasLogsInputImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/INPUT_ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDbaasLogsInput() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDbaasLogsInputCreate,
+		Read:   resourceDbaasLogsInputRead,
+		Update: resourceDbaasLogsInputUpdate,
+		Delete: resourceDbaasLogsInputDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDbaasLogsInputImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"engine_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stream_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"exposed_port": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"nb_instance": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"single_instance_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"allowed_networks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						err := validateIpBlock(v.(string))
+						if err != nil {
+							errors = append(errors, err)
+						}
+						return
+					},
+				},
+				Set: schema.HashString,
+			},
+
+			// Computed
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_restart_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func dbaasLogsInputOpts(d *schema.ResourceData) *DbaasLogsInputOpts {
+	return &DbaasLogsInputOpts{
+		Title:                 d.Get("title").(string),
+		Description:           d.Get("description").(string),
+		EngineId:              d.Get("engine_id").(string),
+		StreamId:              d.Get("stream_id").(string),
+		ExposedPort:           d.Get("exposed_port").(string),
+		NbInstance:            d.Get("nb_instance").(int),
+		SingleInstanceEnabled: d.Get("single_instance_enabled").(bool),
+	}
+}
+
+func resourceDbaasLogsInputCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := dbaasLogsInputOpts(d)
+
+	log.Printf("[DEBUG] Will create logs %s input: %v", serviceName, params)
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/input", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, op); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	op, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId)
+	if err != nil {
+		return err
+	}
+
+	if op.InputId == nil {
+		return fmt.Errorf("no input id returned by operation %s", op.OperationId)
+	}
+
+	d.SetId(*op.InputId)
+	taskSetComputed(d, op.OperationId, op)
+
+	if err := dbaasLogsInputSyncAllowedNetworks(d, config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceDbaasLogsInputRead(d, meta)
+}
+
+func resourceDbaasLogsInputRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &DbaasLogsInput{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/input/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read logs %s input %s", serviceName, r)
+
+	d.Set("title", r.Title)
+	d.Set("description", r.Description)
+	d.Set("engine_id", r.EngineId)
+	d.Set("stream_id", r.StreamId)
+	d.Set("exposed_port", r.ExposedPort)
+	d.Set("nb_instance", r.NbInstance)
+	d.Set("single_instance_enabled", r.SingleInstanceEnabled)
+	d.Set("hostname", r.Hostname)
+	d.Set("public_address", r.PublicAddress)
+	d.Set("is_restart_required", r.IsRestartRequired)
+	d.Set("status", r.Status)
+
+	networks, err := dbaasLogsInputAllowedNetworks(config.OVHClient, serviceName, d.Id())
+	if err != nil {
+		return err
+	}
+
+	allowedNetworks := []string{}
+	for _, network := range networks {
+		allowedNetworks = append(allowedNetworks, network.Network)
+	}
+	d.Set("allowed_networks", allowedNetworks)
+
+	return nil
+}
+
+func resourceDbaasLogsInputUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	if d.HasChange("title") || d.HasChange("description") || d.HasChange("engine_id") ||
+		d.HasChange("stream_id") || d.HasChange("exposed_port") || d.HasChange("nb_instance") ||
+		d.HasChange("single_instance_enabled") {
+		params := dbaasLogsInputOpts(d)
+
+		log.Printf("[DEBUG] Will update logs %s input %s: %v", serviceName, d.Id(), params)
+
+		op := &DbaasLogsOperation{}
+		endpoint := fmt.Sprintf("/dbaas/logs/%s/input/%s", serviceName, d.Id())
+		if err := config.OVHClient.Put(endpoint, params, op); err != nil {
+			return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+		}
+
+		task, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId)
+		if err != nil {
+			return err
+		}
+		taskSetComputed(d, task.OperationId, task)
+	}
+
+	if err := dbaasLogsInputSyncAllowedNetworks(d, config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceDbaasLogsInputRead(d, meta)
+}
+
+func resourceDbaasLogsInputDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will delete logs %s input %s", serviceName, d.Id())
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/input/%s", serviceName, d.Id())
+	if err := config.OVHClient.Delete(endpoint, op); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if _, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dbaasLogsInputAllowedNetworks(c *ovh.Client, serviceName, inputId string) ([]*DbaasLogsAllowedNetwork, error) {
+	ids := []string{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/input/%s/allowedNetwork", serviceName, inputId)
+	if err := c.Get(endpoint, &ids); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	networks := make([]*DbaasLogsAllowedNetwork, len(ids))
+	for i, id := range ids {
+		network := &DbaasLogsAllowedNetwork{}
+		if err := c.Get(fmt.Sprintf("%s/%s", endpoint, id), network); err != nil {
+			return nil, fmt.Errorf("calling Get %s/%s:\n\t %q", endpoint, id, err)
+		}
+		networks[i] = network
+	}
+
+	return networks, nil
+}
+
+// dbaasLogsInputSyncAllowedNetworks adds and removes the networks allowed
+// to reach an input to match the configuration.
+func dbaasLogsInputSyncAllowedNetworks(d *schema.ResourceData, c *ovh.Client) error {
+	serviceName := d.Get("service_name").(string)
+
+	if !d.HasChange("allowed_networks") {
+		return nil
+	}
+
+	o, n := d.GetChange("allowed_networks")
+	oldSet := o.(*schema.Set)
+	newSet := n.(*schema.Set)
+
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/input/%s/allowedNetwork", serviceName, d.Id())
+
+	removed := oldSet.Difference(newSet)
+	if removed.Len() > 0 {
+		networks, err := dbaasLogsInputAllowedNetworks(c, serviceName, d.Id())
+		if err != nil {
+			return err
+		}
+
+		for _, network := range networks {
+			if !removed.Contains(network.Network) {
+				continue
+			}
+
+			log.Printf("[DEBUG] Will remove network %s from logs input %s", network.Network, d.Id())
+
+			op := &DbaasLogsOperation{}
+			if err := c.Delete(fmt.Sprintf("%s/%s", endpoint, network.AllowedNetworkId), op); err != nil {
+				return fmt.Errorf("calling Delete %s/%s:\n\t %q", endpoint, network.AllowedNetworkId, err)
+			}
+
+			if _, err := dbaasLogsOperationWait(c, serviceName, op.OperationId); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, network := range newSet.Difference(oldSet).List() {
+		log.Printf("[DEBUG] Will allow network %s on logs input %s", network, d.Id())
+
+		params := &DbaasLogsAllowedNetworkCreateOpts{Network: network.(string)}
+		op := &DbaasLogsOperation{}
+		if err := c.Post(endpoint, params, op); err != nil {
+			return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+		}
+
+		if _, err := dbaasLogsOperationWait(c, serviceName, op.OperationId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func dbaasLogsInputExists(serviceName, inputId string, c *ovh.Client) error {
+	r := &DbaasLogsInput{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/input/%s", serviceName, inputId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read logs input: %s", r)
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDbaasLogsInputConfig = `
+data "ovh_dbaas_logs_input_engine" "logstash" {
+  name    = "logstash"
+  version = "6.x"
+}
+
+resource "ovh_dbaas_logs_output_graylog_stream" "stream" {
+  service_name = "%s"
+  title        = "%s"
+  description  = "created by terraform"
+}
+
+resource "ovh_dbaas_logs_input" "input" {
+  service_name     = "${ovh_dbaas_logs_output_graylog_stream.stream.service_name}"
+  title            = "%s"
+  description      = "%s"
+  engine_id        = "${data.ovh_dbaas_logs_input_engine.logstash.id}"
+  stream_id        = "${ovh_dbaas_logs_output_graylog_stream.stream.id}"
+  allowed_networks = ["%s"]
+}
+`
+
+func TestAccDbaasLogsInput_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DBAAS_LOGS_SERVICE")
+	title := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckDbaasLogsPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbaasLogsInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDbaasLogsInputConfig, serviceName, title, title, "created by terraform", "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbaasLogsInputExists("ovh_dbaas_logs_input.input", t),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_input.input", "description", "created by terraform"),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_input.input", "allowed_networks.#", "1"),
+					resource.TestCheckResourceAttrSet("ovh_dbaas_logs_input.input", "hostname"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDbaasLogsInputConfig, serviceName, title, title, "updated by terraform", "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbaasLogsInputExists("ovh_dbaas_logs_input.input", t),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_input.input", "description", "updated by terraform"),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_input.input", "allowed_networks.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDbaasLogsInputExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No logs input id is set")
+		}
+
+		return dbaasLogsInputExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckDbaasLogsInputDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dbaas_logs_input" {
+			continue
+		}
+
+		err := dbaasLogsInputExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("logs input still exists")
+		}
+	}
+	return nil
+}
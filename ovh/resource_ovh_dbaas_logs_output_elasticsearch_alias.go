@@ -0,0 +1,275 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDbaasLogsOutputElasticsearchAliasImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/ALIAS_ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDbaasLogsOutputElasticsearchAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDbaasLogsOutputElasticsearchAliasCreate,
+		Read:   resourceDbaasLogsOutputElasticsearchAliasRead,
+		Update: resourceDbaasLogsOutputElasticsearchAliasUpdate,
+		Delete: resourceDbaasLogsOutputElasticsearchAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDbaasLogsOutputElasticsearchAliasImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"suffix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"indexes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"streams": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			// Computed
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_editable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func resourceDbaasLogsOutputElasticsearchAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &DbaasLogsElasticsearchAliasCreateOpts{
+		Suffix:      d.Get("suffix").(string),
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] Will create logs %s elasticsearch alias: %v", serviceName, params)
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/elasticsearch/alias", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, op); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	op, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId)
+	if err != nil {
+		return err
+	}
+
+	if op.AliasId == nil {
+		return fmt.Errorf("no alias id returned by operation %s", op.OperationId)
+	}
+
+	d.SetId(*op.AliasId)
+	taskSetComputed(d, op.OperationId, op)
+
+	for _, kind := range []string{"index", "stream"} {
+		if err := dbaasLogsOutputElasticsearchAliasSync(d, config.OVHClient, kind); err != nil {
+			return err
+		}
+	}
+
+	return resourceDbaasLogsOutputElasticsearchAliasRead(d, meta)
+}
+
+func resourceDbaasLogsOutputElasticsearchAliasRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &DbaasLogsElasticsearchAlias{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/elasticsearch/alias/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read logs %s elasticsearch alias %s", serviceName, r)
+
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+	d.Set("is_editable", r.IsEditable)
+
+	// the suffix isn't returned by the API, it ends the name of the alias
+	if _, ok := d.GetOk("suffix"); !ok {
+		if i := strings.LastIndex(r.Name, "-a-"); i >= 0 {
+			d.Set("suffix", r.Name[i+3:])
+		}
+	}
+
+	indexes := []string{}
+	if err := config.OVHClient.Get(endpoint+"/index", &indexes); err != nil {
+		return fmt.Errorf("calling Get %s/index:\n\t %q", endpoint, err)
+	}
+	d.Set("indexes", indexes)
+
+	streams := []string{}
+	if err := config.OVHClient.Get(endpoint+"/stream", &streams); err != nil {
+		return fmt.Errorf("calling Get %s/stream:\n\t %q", endpoint, err)
+	}
+	d.Set("streams", streams)
+
+	return nil
+}
+
+func resourceDbaasLogsOutputElasticsearchAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	if d.HasChange("description") {
+		params := &DbaasLogsElasticsearchAliasUpdateOpts{
+			Description: d.Get("description").(string),
+		}
+
+		log.Printf("[DEBUG] Will update logs %s elasticsearch alias %s: %v", serviceName, d.Id(), params)
+
+		op := &DbaasLogsOperation{}
+		endpoint := fmt.Sprintf("/dbaas/logs/%s/output/elasticsearch/alias/%s", serviceName, d.Id())
+		if err := config.OVHClient.Put(endpoint, params, op); err != nil {
+			return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+		}
+
+		task, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId)
+		if err != nil {
+			return err
+		}
+		taskSetComputed(d, task.OperationId, task)
+	}
+
+	for _, kind := range []string{"index", "stream"} {
+		if err := dbaasLogsOutputElasticsearchAliasSync(d, config.OVHClient, kind); err != nil {
+			return err
+		}
+	}
+
+	return resourceDbaasLogsOutputElasticsearchAliasRead(d, meta)
+}
+
+func resourceDbaasLogsOutputElasticsearchAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will delete logs %s elasticsearch alias %s", serviceName, d.Id())
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/elasticsearch/alias/%s", serviceName, d.Id())
+	if err := config.OVHClient.Delete(endpoint, op); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if _, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// dbaasLogsOutputElasticsearchAliasSync attaches and detaches the indexes
+// or streams of an alias to match the configuration.
+func dbaasLogsOutputElasticsearchAliasSync(d *schema.ResourceData, c *ovh.Client, kind string) error {
+	serviceName := d.Get("service_name").(string)
+	key := "indexes"
+	if kind == "stream" {
+		key = "streams"
+	}
+
+	if !d.HasChange(key) {
+		return nil
+	}
+
+	o, n := d.GetChange(key)
+	oldSet := o.(*schema.Set)
+	newSet := n.(*schema.Set)
+
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/elasticsearch/alias/%s/%s", serviceName, d.Id(), kind)
+
+	for _, id := range oldSet.Difference(newSet).List() {
+		log.Printf("[DEBUG] Will detach %s %s from logs alias %s", kind, id, d.Id())
+
+		op := &DbaasLogsOperation{}
+		if err := c.Delete(fmt.Sprintf("%s/%s", endpoint, id.(string)), op); err != nil {
+			return fmt.Errorf("calling Delete %s/%s:\n\t %q", endpoint, id.(string), err)
+		}
+
+		if _, err := dbaasLogsOperationWait(c, serviceName, op.OperationId); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range newSet.Difference(oldSet).List() {
+		log.Printf("[DEBUG] Will attach %s %s to logs alias %s", kind, id, d.Id())
+
+		var params interface{} = &DbaasLogsElasticsearchAliasIndexOpts{IndexId: id.(string)}
+		if kind == "stream" {
+			params = &DbaasLogsElasticsearchAliasStreamOpts{StreamId: id.(string)}
+		}
+
+		op := &DbaasLogsOperation{}
+		if err := c.Post(endpoint, params, op); err != nil {
+			return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+		}
+
+		if _, err := dbaasLogsOperationWait(c, serviceName, op.OperationId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func dbaasLogsOutputElasticsearchAliasExists(serviceName, aliasId string, c *ovh.Client) error {
+	r := &DbaasLogsElasticsearchAlias{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/elasticsearch/alias/%s", serviceName, aliasId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read logs elasticsearch alias: %s", r)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDbaasLogsOutputElasticsearchAliasConfig = `
+resource "ovh_dbaas_logs_output_elasticsearch_index" "index" {
+  service_name = "%s"
+  suffix       = "%s"
+  description  = "created by terraform"
+}
+
+resource "ovh_dbaas_logs_output_elasticsearch_alias" "alias" {
+  service_name = "${ovh_dbaas_logs_output_elasticsearch_index.index.service_name}"
+  suffix       = "%s"
+  description  = "created by terraform"
+  indexes      = [%s]
+}
+`
+
+func TestAccDbaasLogsOutputElasticsearchAlias_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DBAAS_LOGS_SERVICE")
+	suffix := acctest.RandString(8)
+	indexId := `"${ovh_dbaas_logs_output_elasticsearch_index.index.id}"`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckDbaasLogsPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbaasLogsOutputElasticsearchAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDbaasLogsOutputElasticsearchAliasConfig, serviceName, suffix, suffix, indexId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbaasLogsOutputElasticsearchAliasExists("ovh_dbaas_logs_output_elasticsearch_alias.alias", t),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_output_elasticsearch_alias.alias", "suffix", suffix),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_output_elasticsearch_alias.alias", "indexes.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDbaasLogsOutputElasticsearchAliasConfig, serviceName, suffix, suffix, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbaasLogsOutputElasticsearchAliasExists("ovh_dbaas_logs_output_elasticsearch_alias.alias", t),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_output_elasticsearch_alias.alias", "indexes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDbaasLogsOutputElasticsearchAliasExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No logs alias id is set")
+		}
+
+		return dbaasLogsOutputElasticsearchAliasExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckDbaasLogsOutputElasticsearchAliasDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dbaas_logs_output_elasticsearch_alias" {
+			continue
+		}
+
+		err := dbaasLogsOutputElasticsearchAliasExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("logs alias still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDbaasLogsOutputElasticsearchIndexImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/INDEX_ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDbaasLogsOutputElasticsearchIndex() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDbaasLogsOutputElasticsearchIndexCreate,
+		Read:   resourceDbaasLogsOutputElasticsearchIndexRead,
+		Update: resourceDbaasLogsOutputElasticsearchIndexUpdate,
+		Delete: resourceDbaasLogsOutputElasticsearchIndexDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDbaasLogsOutputElasticsearchIndexImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"suffix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"nb_shard": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"alert_notify_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Computed
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_editable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"max_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"current_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func resourceDbaasLogsOutputElasticsearchIndexCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &DbaasLogsElasticsearchIndexCreateOpts{
+		Suffix:             d.Get("suffix").(string),
+		Description:        d.Get("description").(string),
+		NbShard:            d.Get("nb_shard").(int),
+		AlertNotifyEnabled: d.Get("alert_notify_enabled").(bool),
+	}
+
+	log.Printf("[DEBUG] Will create logs %s elasticsearch index: %v", serviceName, params)
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/elasticsearch/index", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, op); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	op, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId)
+	if err != nil {
+		return err
+	}
+
+	if op.IndexId == nil {
+		return fmt.Errorf("no index id returned by operation %s", op.OperationId)
+	}
+
+	d.SetId(*op.IndexId)
+	taskSetComputed(d, op.OperationId, op)
+
+	return resourceDbaasLogsOutputElasticsearchIndexRead(d, meta)
+}
+
+func resourceDbaasLogsOutputElasticsearchIndexRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &DbaasLogsElasticsearchIndex{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/elasticsearch/index/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read logs %s elasticsearch index %s", serviceName, r)
+
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+	d.Set("nb_shard", r.NbShard)
+	d.Set("alert_notify_enabled", r.AlertNotifyEnabled)
+	d.Set("is_editable", r.IsEditable)
+	d.Set("max_size", r.MaxSize)
+	d.Set("current_size", r.CurrentSize)
+
+	// the suffix isn't returned by the API, it ends the name of the index
+	if _, ok := d.GetOk("suffix"); !ok {
+		if i := strings.LastIndex(r.Name, "-i-"); i >= 0 {
+			d.Set("suffix", r.Name[i+3:])
+		}
+	}
+
+	return nil
+}
+
+func resourceDbaasLogsOutputElasticsearchIndexUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &DbaasLogsElasticsearchIndexUpdateOpts{
+		Description:        d.Get("description").(string),
+		AlertNotifyEnabled: d.Get("alert_notify_enabled").(bool),
+	}
+
+	log.Printf("[DEBUG] Will update logs %s elasticsearch index %s: %v", serviceName, d.Id(), params)
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/elasticsearch/index/%s", serviceName, d.Id())
+	if err := config.OVHClient.Put(endpoint, params, op); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	task, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId)
+	if err != nil {
+		return err
+	}
+	taskSetComputed(d, task.OperationId, task)
+
+	return resourceDbaasLogsOutputElasticsearchIndexRead(d, meta)
+}
+
+func resourceDbaasLogsOutputElasticsearchIndexDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will delete logs %s elasticsearch index %s", serviceName, d.Id())
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/elasticsearch/index/%s", serviceName, d.Id())
+	if err := config.OVHClient.Delete(endpoint, op); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if _, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dbaasLogsOutputElasticsearchIndexExists(serviceName, indexId string, c *ovh.Client) error {
+	r := &DbaasLogsElasticsearchIndex{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/elasticsearch/index/%s", serviceName, indexId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read logs elasticsearch index: %s", r)
+
+	return nil
+}
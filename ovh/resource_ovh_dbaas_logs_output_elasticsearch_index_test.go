@@ -0,0 +1,80 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDbaasLogsOutputElasticsearchIndexConfig = `
+resource "ovh_dbaas_logs_output_elasticsearch_index" "index" {
+  service_name = "%s"
+  suffix       = "%s"
+  description  = "%s"
+}
+`
+
+func TestAccDbaasLogsOutputElasticsearchIndex_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DBAAS_LOGS_SERVICE")
+	suffix := acctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckDbaasLogsPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbaasLogsOutputElasticsearchIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDbaasLogsOutputElasticsearchIndexConfig, serviceName, suffix, "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbaasLogsOutputElasticsearchIndexExists("ovh_dbaas_logs_output_elasticsearch_index.index", t),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_output_elasticsearch_index.index", "suffix", suffix),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_output_elasticsearch_index.index", "description", "created by terraform"),
+					resource.TestCheckResourceAttrSet("ovh_dbaas_logs_output_elasticsearch_index.index", "name"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDbaasLogsOutputElasticsearchIndexConfig, serviceName, suffix, "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbaasLogsOutputElasticsearchIndexExists("ovh_dbaas_logs_output_elasticsearch_index.index", t),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_output_elasticsearch_index.index", "description", "updated by terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDbaasLogsOutputElasticsearchIndexExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No logs index id is set")
+		}
+
+		return dbaasLogsOutputElasticsearchIndexExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckDbaasLogsOutputElasticsearchIndexDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dbaas_logs_output_elasticsearch_index" {
+			continue
+		}
+
+		err := dbaasLogsOutputElasticsearchIndexExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("logs index still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDbaasLogsOutputGraylogStreamImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/STREAM_ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDbaasLogsOutputGraylogStream() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDbaasLogsOutputGraylogStreamCreate,
+		Read:   resourceDbaasLogsOutputGraylogStreamRead,
+		Update: resourceDbaasLogsOutputGraylogStreamUpdate,
+		Delete: resourceDbaasLogsOutputGraylogStreamDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDbaasLogsOutputGraylogStreamImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"indexing_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"cold_storage_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Computed
+			"write_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"is_editable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_shareable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"can_alert": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func dbaasLogsOutputGraylogStreamOpts(d *schema.ResourceData) *DbaasLogsGraylogStreamOpts {
+	return &DbaasLogsGraylogStreamOpts{
+		Title:              d.Get("title").(string),
+		Description:        d.Get("description").(string),
+		IndexingEnabled:    d.Get("indexing_enabled").(bool),
+		ColdStorageEnabled: d.Get("cold_storage_enabled").(bool),
+	}
+}
+
+func resourceDbaasLogsOutputGraylogStreamCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := dbaasLogsOutputGraylogStreamOpts(d)
+
+	log.Printf("[DEBUG] Will create logs %s graylog stream: %v", serviceName, params)
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/graylog/stream", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, op); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	op, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId)
+	if err != nil {
+		return err
+	}
+
+	if op.StreamId == nil {
+		return fmt.Errorf("no stream id returned by operation %s", op.OperationId)
+	}
+
+	d.SetId(*op.StreamId)
+	taskSetComputed(d, op.OperationId, op)
+
+	return resourceDbaasLogsOutputGraylogStreamRead(d, meta)
+}
+
+func resourceDbaasLogsOutputGraylogStreamRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &DbaasLogsGraylogStream{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/graylog/stream/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read logs %s graylog stream %s", serviceName, r)
+
+	d.Set("title", r.Title)
+	d.Set("description", r.Description)
+	d.Set("indexing_enabled", r.IndexingEnabled)
+	d.Set("cold_storage_enabled", r.ColdStorageEnabled)
+	d.Set("write_token", r.WriteToken)
+	d.Set("is_editable", r.IsEditable)
+	d.Set("is_shareable", r.IsShareable)
+	d.Set("can_alert", r.CanAlert)
+
+	return nil
+}
+
+func resourceDbaasLogsOutputGraylogStreamUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := dbaasLogsOutputGraylogStreamOpts(d)
+
+	log.Printf("[DEBUG] Will update logs %s graylog stream %s: %v", serviceName, d.Id(), params)
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/graylog/stream/%s", serviceName, d.Id())
+	if err := config.OVHClient.Put(endpoint, params, op); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	task, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId)
+	if err != nil {
+		return err
+	}
+	taskSetComputed(d, task.OperationId, task)
+
+	return resourceDbaasLogsOutputGraylogStreamRead(d, meta)
+}
+
+func resourceDbaasLogsOutputGraylogStreamDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will delete logs %s graylog stream %s", serviceName, d.Id())
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/graylog/stream/%s", serviceName, d.Id())
+	if err := config.OVHClient.Delete(endpoint, op); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if _, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dbaasLogsOutputGraylogStreamExists(serviceName, streamId string, c *ovh.Client) error {
+	r := &DbaasLogsGraylogStream{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/graylog/stream/%s", serviceName, streamId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read logs graylog stream: %s", r)
+
+	return nil
+}
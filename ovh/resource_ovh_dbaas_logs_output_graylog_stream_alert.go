@@ -0,0 +1,320 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDbaasLogsOutputGraylogStreamAlertImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/STREAM_ID/ALERT_ID formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("service_name", splitId[0])
+	d.Set("stream_id", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDbaasLogsOutputGraylogStreamAlert() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDbaasLogsOutputGraylogStreamAlertCreate,
+		Read:   resourceDbaasLogsOutputGraylogStreamAlertRead,
+		Update: resourceDbaasLogsOutputGraylogStreamAlertUpdate,
+		Delete: resourceDbaasLogsOutputGraylogStreamAlertDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDbaasLogsOutputGraylogStreamAlertImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stream_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"condition_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"MESSAGE_COUNT", "FIELD_VALUE", "FIELD_CONTENT_VALUE"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"field": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"constraint_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"MEAN", "MIN", "MAX", "SUM", "STDDEV"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"threshold": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"threshold_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"MORE", "LESS", "HIGHER", "LOWER"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"time": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"query_filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"backlog": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"grace": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"repeat_notifications_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func dbaasLogsOutputGraylogStreamAlertOpts(d *schema.ResourceData) *DbaasLogsGraylogStreamAlertOpts {
+	opts := &DbaasLogsGraylogStreamAlertOpts{
+		Title:                      d.Get("title").(string),
+		ConditionType:              d.Get("condition_type").(string),
+		Backlog:                    d.Get("backlog").(int),
+		Grace:                      d.Get("grace").(int),
+		RepeatNotificationsEnabled: d.Get("repeat_notifications_enabled").(bool),
+		Field:                      d.Get("field").(string),
+		Value:                      d.Get("value").(string),
+		ConstraintType:             d.Get("constraint_type").(string),
+		ThresholdType:              d.Get("threshold_type").(string),
+		QueryFilter:                d.Get("query_filter").(string),
+	}
+	if v, ok := d.GetOkExists("threshold"); ok {
+		opts.Threshold = getNilIntPointer(v)
+	}
+	if v, ok := d.GetOkExists("time"); ok {
+		opts.Time = getNilIntPointer(v)
+	}
+	return opts
+}
+
+// dbaasLogsGraylogStreamAlertValidate checks that an alert has the settings
+// required by its condition type, which the API only reports as a generic
+// bad request.
+func dbaasLogsGraylogStreamAlertValidate(opts *DbaasLogsGraylogStreamAlertOpts) error {
+	missing := []string{}
+	require := func(key string, set bool) {
+		if !set {
+			missing = append(missing, key)
+		}
+	}
+
+	thresholdTypes := []string{}
+	switch opts.ConditionType {
+	case "MESSAGE_COUNT":
+		require("threshold", opts.Threshold != nil)
+		require("threshold_type", opts.ThresholdType != "")
+		require("time", opts.Time != nil)
+		thresholdTypes = []string{"MORE", "LESS"}
+	case "FIELD_VALUE":
+		require("field", opts.Field != "")
+		require("constraint_type", opts.ConstraintType != "")
+		require("threshold", opts.Threshold != nil)
+		require("threshold_type", opts.ThresholdType != "")
+		require("time", opts.Time != nil)
+		thresholdTypes = []string{"HIGHER", "LOWER"}
+	case "FIELD_CONTENT_VALUE":
+		require("field", opts.Field != "")
+		require("value", opts.Value != "")
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%s alerts require %s", opts.ConditionType, strings.Join(missing, ", "))
+	}
+
+	if opts.ThresholdType != "" && len(thresholdTypes) > 0 {
+		if err := validateStringEnum(opts.ThresholdType, thresholdTypes); err != nil {
+			return fmt.Errorf("threshold_type of %s alerts: %s", opts.ConditionType, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceDbaasLogsOutputGraylogStreamAlertCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	streamId := d.Get("stream_id").(string)
+
+	params := dbaasLogsOutputGraylogStreamAlertOpts(d)
+	if err := dbaasLogsGraylogStreamAlertValidate(params); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Will create logs %s graylog stream %s alert: %v", serviceName, streamId, params)
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/graylog/stream/%s/alert", serviceName, streamId)
+	if err := config.OVHClient.Post(endpoint, params, op); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	op, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId)
+	if err != nil {
+		return err
+	}
+
+	if op.AlertId == nil {
+		return fmt.Errorf("no alert id returned by operation %s", op.OperationId)
+	}
+
+	d.SetId(*op.AlertId)
+	taskSetComputed(d, op.OperationId, op)
+
+	return resourceDbaasLogsOutputGraylogStreamAlertRead(d, meta)
+}
+
+func resourceDbaasLogsOutputGraylogStreamAlertRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	streamId := d.Get("stream_id").(string)
+
+	r := &DbaasLogsGraylogStreamAlert{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/graylog/stream/%s/alert/%s", serviceName, streamId, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read logs %s graylog stream %s alert %s", serviceName, streamId, r)
+
+	d.Set("title", r.Title)
+	d.Set("condition_type", r.ConditionType)
+	d.Set("field", r.Field)
+	d.Set("value", r.Value)
+	d.Set("constraint_type", r.ConstraintType)
+	d.Set("threshold_type", r.ThresholdType)
+	d.Set("query_filter", r.QueryFilter)
+	d.Set("backlog", r.Backlog)
+	d.Set("grace", r.Grace)
+	d.Set("repeat_notifications_enabled", r.RepeatNotificationsEnabled)
+
+	// the API returns 0 for the settings unused by the condition type
+	if r.ConditionType != "FIELD_CONTENT_VALUE" {
+		d.Set("threshold", r.Threshold)
+		d.Set("time", r.Time)
+	}
+
+	return nil
+}
+
+func resourceDbaasLogsOutputGraylogStreamAlertUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	streamId := d.Get("stream_id").(string)
+
+	params := dbaasLogsOutputGraylogStreamAlertOpts(d)
+	if err := dbaasLogsGraylogStreamAlertValidate(params); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Will update logs %s graylog stream %s alert %s: %v", serviceName, streamId, d.Id(), params)
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/graylog/stream/%s/alert/%s", serviceName, streamId, d.Id())
+	if err := config.OVHClient.Put(endpoint, params, op); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	task, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId)
+	if err != nil {
+		return err
+	}
+	taskSetComputed(d, task.OperationId, task)
+
+	return resourceDbaasLogsOutputGraylogStreamAlertRead(d, meta)
+}
+
+func resourceDbaasLogsOutputGraylogStreamAlertDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	streamId := d.Get("stream_id").(string)
+
+	log.Printf("[DEBUG] Will delete logs %s graylog stream %s alert %s", serviceName, streamId, d.Id())
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/graylog/stream/%s/alert/%s", serviceName, streamId, d.Id())
+	if err := config.OVHClient.Delete(endpoint, op); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if _, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dbaasLogsOutputGraylogStreamAlertExists(serviceName, streamId, alertId string, c *ovh.Client) error {
+	r := &DbaasLogsGraylogStreamAlert{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/output/graylog/stream/%s/alert/%s", serviceName, streamId, alertId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read logs graylog stream alert: %s", r)
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDbaasLogsOutputGraylogStreamAlertConfig = `
+resource "ovh_dbaas_logs_output_graylog_stream" "stream" {
+  service_name = "%s"
+  title        = "%s"
+  description  = "created by terraform"
+}
+
+resource "ovh_dbaas_logs_output_graylog_stream_alert" "alert" {
+  service_name   = "${ovh_dbaas_logs_output_graylog_stream.stream.service_name}"
+  stream_id      = "${ovh_dbaas_logs_output_graylog_stream.stream.id}"
+  title          = "too many errors"
+  condition_type = "MESSAGE_COUNT"
+  threshold      = %d
+  threshold_type = "MORE"
+  time           = 5
+  query_filter   = "level:3"
+  grace          = 10
+}
+`
+
+func TestAccDbaasLogsOutputGraylogStreamAlert_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DBAAS_LOGS_SERVICE")
+	title := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckDbaasLogsPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbaasLogsOutputGraylogStreamAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDbaasLogsOutputGraylogStreamAlertConfig, serviceName, title, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbaasLogsOutputGraylogStreamAlertExists("ovh_dbaas_logs_output_graylog_stream_alert.alert", t),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_output_graylog_stream_alert.alert", "threshold", "100"),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_output_graylog_stream_alert.alert", "query_filter", "level:3"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDbaasLogsOutputGraylogStreamAlertConfig, serviceName, title, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbaasLogsOutputGraylogStreamAlertExists("ovh_dbaas_logs_output_graylog_stream_alert.alert", t),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_output_graylog_stream_alert.alert", "threshold", "50"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDbaasLogsOutputGraylogStreamAlertExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No logs stream alert id is set")
+		}
+
+		return dbaasLogsOutputGraylogStreamAlertExists(rs.Primary.Attributes["service_name"], rs.Primary.Attributes["stream_id"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckDbaasLogsOutputGraylogStreamAlertDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dbaas_logs_output_graylog_stream_alert" {
+			continue
+		}
+
+		err := dbaasLogsOutputGraylogStreamAlertExists(rs.Primary.Attributes["service_name"], rs.Primary.Attributes["stream_id"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("logs stream alert still exists")
+		}
+	}
+	return nil
+}
+
+func TestDbaasLogsGraylogStreamAlertValidate(t *testing.T) {
+	threshold := 10
+	minutes := 5
+
+	cases := []struct {
+		name  string
+		opts  *DbaasLogsGraylogStreamAlertOpts
+		valid bool
+	}{
+		{
+			"message count",
+			&DbaasLogsGraylogStreamAlertOpts{ConditionType: "MESSAGE_COUNT", Threshold: &threshold, ThresholdType: "MORE", Time: &minutes},
+			true,
+		},
+		{
+			"message count without time",
+			&DbaasLogsGraylogStreamAlertOpts{ConditionType: "MESSAGE_COUNT", Threshold: &threshold, ThresholdType: "MORE"},
+			false,
+		},
+		{
+			"message count with a field threshold type",
+			&DbaasLogsGraylogStreamAlertOpts{ConditionType: "MESSAGE_COUNT", Threshold: &threshold, ThresholdType: "HIGHER", Time: &minutes},
+			false,
+		},
+		{
+			"field value",
+			&DbaasLogsGraylogStreamAlertOpts{ConditionType: "FIELD_VALUE", Field: "latency", ConstraintType: "MEAN", Threshold: &threshold, ThresholdType: "HIGHER", Time: &minutes},
+			true,
+		},
+		{
+			"field value without field",
+			&DbaasLogsGraylogStreamAlertOpts{ConditionType: "FIELD_VALUE", ConstraintType: "MEAN", Threshold: &threshold, ThresholdType: "HIGHER", Time: &minutes},
+			false,
+		},
+		{
+			"field content",
+			&DbaasLogsGraylogStreamAlertOpts{ConditionType: "FIELD_CONTENT_VALUE", Field: "status", Value: "500"},
+			true,
+		},
+		{
+			"field content without value",
+			&DbaasLogsGraylogStreamAlertOpts{ConditionType: "FIELD_CONTENT_VALUE", Field: "status"},
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		err := dbaasLogsGraylogStreamAlertValidate(c.opts)
+		if c.valid && err != nil {
+			t.Errorf("%s: unexpected error %s", c.name, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("%s: expected an error", c.name)
+		}
+	}
+}
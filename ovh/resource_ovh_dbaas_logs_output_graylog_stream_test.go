@@ -0,0 +1,80 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDbaasLogsOutputGraylogStreamConfig = `
+resource "ovh_dbaas_logs_output_graylog_stream" "stream" {
+  service_name = "%s"
+  title        = "%s"
+  description  = "%s"
+}
+`
+
+func TestAccDbaasLogsOutputGraylogStream_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DBAAS_LOGS_SERVICE")
+	title := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckDbaasLogsPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbaasLogsOutputGraylogStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDbaasLogsOutputGraylogStreamConfig, serviceName, title, "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbaasLogsOutputGraylogStreamExists("ovh_dbaas_logs_output_graylog_stream.stream", t),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_output_graylog_stream.stream", "title", title),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_output_graylog_stream.stream", "description", "created by terraform"),
+					resource.TestCheckResourceAttrSet("ovh_dbaas_logs_output_graylog_stream.stream", "write_token"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDbaasLogsOutputGraylogStreamConfig, serviceName, title, "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbaasLogsOutputGraylogStreamExists("ovh_dbaas_logs_output_graylog_stream.stream", t),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_output_graylog_stream.stream", "description", "updated by terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDbaasLogsOutputGraylogStreamExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No logs stream id is set")
+		}
+
+		return dbaasLogsOutputGraylogStreamExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckDbaasLogsOutputGraylogStreamDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dbaas_logs_output_graylog_stream" {
+			continue
+		}
+
+		err := dbaasLogsOutputGraylogStreamExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("logs stream still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDbaasLogsRoleImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/ROLE_ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDbaasLogsRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDbaasLogsRoleCreate,
+		Read:   resourceDbaasLogsRoleRead,
+		Update: resourceDbaasLogsRoleUpdate,
+		Delete: resourceDbaasLogsRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDbaasLogsRoleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"members": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func dbaasLogsRoleOpts(d *schema.ResourceData) *DbaasLogsRoleOpts {
+	return &DbaasLogsRoleOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+}
+
+func resourceDbaasLogsRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := dbaasLogsRoleOpts(d)
+
+	log.Printf("[DEBUG] Will create logs %s role: %v", serviceName, params)
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/role", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, op); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	op, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId)
+	if err != nil {
+		return err
+	}
+
+	if op.RoleId == nil {
+		return fmt.Errorf("no role id returned by operation %s", op.OperationId)
+	}
+
+	d.SetId(*op.RoleId)
+	taskSetComputed(d, op.OperationId, op)
+
+	if err := dbaasLogsRoleMembersSync(d, config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceDbaasLogsRoleRead(d, meta)
+}
+
+func resourceDbaasLogsRoleRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &DbaasLogsRole{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/role/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read logs %s role %s", serviceName, r)
+
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+
+	members := []string{}
+	if err := config.OVHClient.Get(endpoint+"/member", &members); err != nil {
+		return fmt.Errorf("calling Get %s/member:\n\t %q", endpoint, err)
+	}
+	d.Set("members", members)
+
+	return nil
+}
+
+func resourceDbaasLogsRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	if d.HasChange("name") || d.HasChange("description") {
+		params := dbaasLogsRoleOpts(d)
+
+		log.Printf("[DEBUG] Will update logs %s role %s: %v", serviceName, d.Id(), params)
+
+		op := &DbaasLogsOperation{}
+		endpoint := fmt.Sprintf("/dbaas/logs/%s/role/%s", serviceName, d.Id())
+		if err := config.OVHClient.Put(endpoint, params, op); err != nil {
+			return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+		}
+
+		task, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId)
+		if err != nil {
+			return err
+		}
+		taskSetComputed(d, task.OperationId, task)
+	}
+
+	if err := dbaasLogsRoleMembersSync(d, config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceDbaasLogsRoleRead(d, meta)
+}
+
+func resourceDbaasLogsRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will delete logs %s role %s", serviceName, d.Id())
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/role/%s", serviceName, d.Id())
+	if err := config.OVHClient.Delete(endpoint, op); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if _, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// dbaasLogsRoleMembersSync adds and removes the members of a role to match
+// the configuration.
+func dbaasLogsRoleMembersSync(d *schema.ResourceData, c *ovh.Client) error {
+	serviceName := d.Get("service_name").(string)
+
+	if !d.HasChange("members") {
+		return nil
+	}
+
+	o, n := d.GetChange("members")
+	oldSet := o.(*schema.Set)
+	newSet := n.(*schema.Set)
+
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/role/%s/member", serviceName, d.Id())
+
+	for _, username := range oldSet.Difference(newSet).List() {
+		log.Printf("[DEBUG] Will remove member %s from logs role %s", username, d.Id())
+
+		op := &DbaasLogsOperation{}
+		if err := c.Delete(fmt.Sprintf("%s/%s", endpoint, username.(string)), op); err != nil {
+			return fmt.Errorf("calling Delete %s/%s:\n\t %q", endpoint, username.(string), err)
+		}
+
+		if _, err := dbaasLogsOperationWait(c, serviceName, op.OperationId); err != nil {
+			return err
+		}
+	}
+
+	for _, username := range newSet.Difference(oldSet).List() {
+		log.Printf("[DEBUG] Will add member %s to logs role %s", username, d.Id())
+
+		params := &DbaasLogsRoleMemberCreateOpts{Username: username.(string)}
+		op := &DbaasLogsOperation{}
+		if err := c.Post(endpoint, params, op); err != nil {
+			return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+		}
+
+		if _, err := dbaasLogsOperationWait(c, serviceName, op.OperationId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func dbaasLogsRoleExists(serviceName, roleId string, c *ovh.Client) error {
+	r := &DbaasLogsRole{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/role/%s", serviceName, roleId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read logs role: %s", r)
+
+	return nil
+}
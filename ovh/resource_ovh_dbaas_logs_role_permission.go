@@ -0,0 +1,278 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// dbaasLogsRolePermissionKinds are the kinds of objects a role can be granted
+// permissions on, with the attribute holding their id.
+var dbaasLogsRolePermissionKinds = map[string]string{
+	"stream":    "stream_id",
+	"index":     "index_id",
+	"alias":     "alias_id",
+	"dashboard": "dashboard_id",
+}
+
+func resourceDbaasLogsRolePermissionImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/ROLE_ID/PERMISSION_ID formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("service_name", splitId[0])
+	d.Set("role_id", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDbaasLogsRolePermission() *schema.Resource {
+	targets := []string{"stream_id", "index_id", "alias_id", "dashboard_id"}
+	conflicts := func(key string) []string {
+		others := []string{}
+		for _, t := range targets {
+			if t != key {
+				others = append(others, t)
+			}
+		}
+		return others
+	}
+
+	return &schema.Resource{
+		Create: resourceDbaasLogsRolePermissionCreate,
+		Read:   resourceDbaasLogsRolePermissionRead,
+		Delete: resourceDbaasLogsRolePermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDbaasLogsRolePermissionImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stream_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: conflicts("stream_id"),
+			},
+			"index_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: conflicts("index_id"),
+			},
+			"alias_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: conflicts("alias_id"),
+			},
+			"dashboard_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: conflicts("dashboard_id"),
+			},
+			"permission_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"READ_ONLY", "READ_WRITE"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+// dbaasLogsRolePermissionOpts returns the kind of object a permission is
+// granted on, and the parameters to grant it.
+func dbaasLogsRolePermissionOpts(d *schema.ResourceData) (string, *DbaasLogsRolePermissionCreateOpts, error) {
+	opts := &DbaasLogsRolePermissionCreateOpts{
+		StreamId:       d.Get("stream_id").(string),
+		IndexId:        d.Get("index_id").(string),
+		AliasId:        d.Get("alias_id").(string),
+		DashboardId:    d.Get("dashboard_id").(string),
+		PermissionType: d.Get("permission_type").(string),
+	}
+
+	kind := ""
+	switch {
+	case opts.StreamId != "":
+		kind = "stream"
+	case opts.IndexId != "":
+		kind = "index"
+	case opts.AliasId != "":
+		kind = "alias"
+	case opts.DashboardId != "":
+		kind = "dashboard"
+	default:
+		return "", nil, fmt.Errorf("one of stream_id, index_id, alias_id or dashboard_id must be set")
+	}
+
+	// only the indexes and the dashboards may be granted in read-write
+	if opts.PermissionType != "" && kind != "index" && kind != "dashboard" {
+		return "", nil, fmt.Errorf("permission_type can't be set on %s permissions", kind)
+	}
+
+	return kind, opts, nil
+}
+
+func resourceDbaasLogsRolePermissionCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	roleId := d.Get("role_id").(string)
+
+	kind, params, err := dbaasLogsRolePermissionOpts(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Will grant logs %s role %s a %s permission: %v", serviceName, roleId, kind, params)
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/role/%s/permission/%s", serviceName, roleId, kind)
+	if err := config.OVHClient.Post(endpoint, params, op); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	op, err = dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId)
+	if err != nil {
+		return err
+	}
+
+	// the operation doesn't return the id of the permission: it's the one
+	// granted on the object.
+	targetId := d.Get(dbaasLogsRolePermissionKinds[kind]).(string)
+	permission, err := dbaasLogsRolePermissionFind(config.OVHClient, serviceName, roleId, kind, targetId)
+	if err != nil {
+		return err
+	}
+	if permission == nil {
+		return fmt.Errorf("%s permission on %s of logs role %s not found", kind, targetId, roleId)
+	}
+
+	d.SetId(permission.PermissionId)
+	taskSetComputed(d, op.OperationId, op)
+
+	return resourceDbaasLogsRolePermissionRead(d, meta)
+}
+
+func resourceDbaasLogsRolePermissionRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	roleId := d.Get("role_id").(string)
+
+	r := &DbaasLogsRolePermission{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/role/%s/permission/%s", serviceName, roleId, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read logs %s role %s %s", serviceName, roleId, r)
+
+	kind, targetId := r.Target()
+	for k, key := range dbaasLogsRolePermissionKinds {
+		if k == kind {
+			d.Set(key, targetId)
+		} else {
+			d.Set(key, "")
+		}
+	}
+	if r.PermissionType != nil {
+		d.Set("permission_type", *r.PermissionType)
+	}
+
+	return nil
+}
+
+func resourceDbaasLogsRolePermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	roleId := d.Get("role_id").(string)
+
+	log.Printf("[DEBUG] Will revoke logs %s role %s permission %s", serviceName, roleId, d.Id())
+
+	op := &DbaasLogsOperation{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/role/%s/permission/%s", serviceName, roleId, d.Id())
+	if err := config.OVHClient.Delete(endpoint, op); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if _, err := dbaasLogsOperationWait(config.OVHClient, serviceName, op.OperationId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// dbaasLogsRolePermissionFind returns the permission of a role on an object,
+// if any.
+func dbaasLogsRolePermissionFind(c *ovh.Client, serviceName, roleId, kind, targetId string) (*DbaasLogsRolePermission, error) {
+	ids := []string{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/role/%s/permission", serviceName, roleId)
+	if err := c.Get(endpoint, &ids); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	permissions := make([]*DbaasLogsRolePermission, len(ids))
+	err := fetchConcurrently(len(ids), func(i int) error {
+		r := &DbaasLogsRolePermission{}
+		endpoint := fmt.Sprintf("/dbaas/logs/%s/role/%s/permission/%s", serviceName, roleId, ids[i])
+		if err := c.Get(endpoint, r); err != nil {
+			return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+		}
+		permissions[i] = r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range permissions {
+		if k, id := p.Target(); k == kind && id == targetId {
+			return p, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func dbaasLogsRolePermissionExists(serviceName, roleId, permissionId string, c *ovh.Client) error {
+	r := &DbaasLogsRolePermission{}
+	endpoint := fmt.Sprintf("/dbaas/logs/%s/role/%s/permission/%s", serviceName, roleId, permissionId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read logs role permission: %s", r)
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDbaasLogsRolePermissionConfig = `
+resource "ovh_dbaas_logs_output_graylog_stream" "stream" {
+  service_name = "%s"
+  title        = "%s"
+  description  = "created by terraform"
+}
+
+resource "ovh_dbaas_logs_role" "role" {
+  service_name = "%s"
+  name         = "%s"
+  description  = "created by terraform"
+}
+
+resource "ovh_dbaas_logs_role_permission" "stream" {
+  service_name = "${ovh_dbaas_logs_role.role.service_name}"
+  role_id      = "${ovh_dbaas_logs_role.role.id}"
+  stream_id    = "${ovh_dbaas_logs_output_graylog_stream.stream.id}"
+}
+`
+
+func TestAccDbaasLogsRolePermission_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DBAAS_LOGS_SERVICE")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckDbaasLogsPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbaasLogsRolePermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDbaasLogsRolePermissionConfig, serviceName, name, serviceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbaasLogsRolePermissionExists("ovh_dbaas_logs_role_permission.stream", t),
+					resource.TestCheckResourceAttrPair(
+						"ovh_dbaas_logs_role_permission.stream", "stream_id",
+						"ovh_dbaas_logs_output_graylog_stream.stream", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDbaasLogsRolePermissionExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No logs role permission id is set")
+		}
+
+		return dbaasLogsRolePermissionExists(rs.Primary.Attributes["service_name"], rs.Primary.Attributes["role_id"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckDbaasLogsRolePermissionDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dbaas_logs_role_permission" {
+			continue
+		}
+
+		err := dbaasLogsRolePermissionExists(rs.Primary.Attributes["service_name"], rs.Primary.Attributes["role_id"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("logs role permission still exists")
+		}
+	}
+	return nil
+}
+
+func TestDbaasLogsRolePermissionTarget(t *testing.T) {
+	streamId := "e4818ec5-0a3c-4b36-a5b4-1b2f3ac4b4b4"
+	readWrite := "READ_WRITE"
+
+	cases := []struct {
+		permission *DbaasLogsRolePermission
+		kind       string
+		id         string
+	}{
+		{&DbaasLogsRolePermission{StreamId: &streamId}, "stream", streamId},
+		{&DbaasLogsRolePermission{IndexId: &streamId, PermissionType: &readWrite}, "index", streamId},
+		{&DbaasLogsRolePermission{DashboardId: &streamId}, "dashboard", streamId},
+		{&DbaasLogsRolePermission{}, "", ""},
+	}
+
+	for _, c := range cases {
+		kind, id := c.permission.Target()
+		if kind != c.kind || id != c.id {
+			t.Errorf("%s: expected %s %s, got %s %s", c.permission, c.kind, c.id, kind, id)
+		}
+	}
+}
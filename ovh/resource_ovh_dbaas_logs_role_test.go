@@ -0,0 +1,79 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDbaasLogsRoleConfig = `
+resource "ovh_dbaas_logs_role" "role" {
+  service_name = "%s"
+  name         = "%s"
+  description  = "%s"
+}
+`
+
+func TestAccDbaasLogsRole_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DBAAS_LOGS_SERVICE")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckDbaasLogsPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbaasLogsRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDbaasLogsRoleConfig, serviceName, name, "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbaasLogsRoleExists("ovh_dbaas_logs_role.role", t),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_role.role", "name", name),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_role.role", "members.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDbaasLogsRoleConfig, serviceName, name, "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbaasLogsRoleExists("ovh_dbaas_logs_role.role", t),
+					resource.TestCheckResourceAttr("ovh_dbaas_logs_role.role", "description", "updated by terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDbaasLogsRoleExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No logs role id is set")
+		}
+
+		return dbaasLogsRoleExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckDbaasLogsRoleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dbaas_logs_role" {
+			continue
+		}
+
+		err := dbaasLogsRoleExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("logs role still exists")
+		}
+	}
+	return nil
+}
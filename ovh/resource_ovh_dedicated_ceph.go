@@ -0,0 +1,171 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDedicatedCeph() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedCephCreate,
+		Read:   resourceDedicatedCephRead,
+		Update: resourceDedicatedCephUpdate,
+		Delete: resourceDedicatedCephDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("service_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"crush_tunables": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{
+						"ARGONAUT",
+						"BOBTAIL",
+						"DEFAULT",
+						"FIREFLY",
+						"HAMMER",
+						"JEWEL",
+						"LEGACY",
+						"OPTIMAL",
+					})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"ceph_mons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ceph_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func resourceDedicatedCephCreate(d *schema.ResourceData, meta interface{}) error {
+	// Ceph clusters can't be created through this resource. They are
+	// ordered, then their settings are managed here.
+	d.SetId(d.Get("service_name").(string))
+
+	return resourceDedicatedCephUpdate(d, meta)
+}
+
+func resourceDedicatedCephRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &DedicatedCeph{}
+	endpoint := fmt.Sprintf("/dedicated/ceph/%s", d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ceph %s", r)
+
+	d.Set("service_name", r.ServiceName)
+	d.Set("label", r.Label)
+	d.Set("crush_tunables", r.CrushTunables)
+	d.Set("ceph_mons", r.CephMons)
+	d.Set("ceph_version", r.CephVersion)
+	d.Set("region", r.Region)
+	d.Set("state", r.State)
+
+	return nil
+}
+
+func resourceDedicatedCephUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Id()
+
+	if d.HasChange("label") || d.HasChange("crush_tunables") {
+		current := &DedicatedCeph{}
+		endpoint := fmt.Sprintf("/dedicated/ceph/%s", serviceName)
+		if err := config.OVHClient.Get(endpoint, current); err != nil {
+			return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+		}
+
+		params := &DedicatedCephUpdateOpts{
+			Label:         current.Label,
+			CrushTunables: current.CrushTunables,
+		}
+		if v, ok := d.GetOk("label"); ok {
+			params.Label = v.(string)
+		}
+		if v, ok := d.GetOk("crush_tunables"); ok {
+			params.CrushTunables = v.(string)
+		}
+
+		log.Printf("[DEBUG] Will update ceph %s: %v", serviceName, params)
+
+		var taskId string
+		if err := config.OVHClient.Put(endpoint, params, &taskId); err != nil {
+			return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+		}
+
+		task, err := cephTaskWait(config.OVHClient, serviceName, taskId)
+		if err != nil {
+			return err
+		}
+		taskSetComputed(d, taskId, task)
+	}
+
+	return resourceDedicatedCephRead(d, meta)
+}
+
+func resourceDedicatedCephDelete(d *schema.ResourceData, meta interface{}) error {
+	// The cluster is left untouched, it's only removed from the state.
+	log.Printf("[DEBUG] Will remove ceph %s from state", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func dedicatedCephExists(serviceName string, c *ovh.Client) error {
+	r := &DedicatedCeph{}
+	endpoint := fmt.Sprintf("/dedicated/ceph/%s", serviceName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ceph: %s", r)
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDedicatedCephAclImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/ACL_ID formatted")
+	}
+	if _, err := strconv.ParseInt(splitId[1], 10, 64); err != nil {
+		return nil, fmt.Errorf("Acl id %s is not an integer: %s", splitId[1], err)
+	}
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDedicatedCephAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedCephAclCreate,
+		Read:   resourceDedicatedCephAclRead,
+		Delete: resourceDedicatedCephAclDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedCephAclImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+				StateFunc: func(v interface{}) string {
+					_, ipNet, err := net.ParseCIDR(v.(string))
+					if err != nil {
+						return v.(string)
+					}
+					return ipNet.String()
+				},
+			},
+
+			// Computed
+			"family": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"netmask": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func resourceDedicatedCephAclCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	_, ipNet, err := net.ParseCIDR(d.Get("network").(string))
+	if err != nil {
+		return err
+	}
+	network := ipNet.String()
+
+	params := &DedicatedCephAclCreateOpts{AclList: []string{network}}
+
+	log.Printf("[DEBUG] Will create ceph %s acl %s", serviceName, network)
+
+	var taskId string
+	endpoint := fmt.Sprintf("/dedicated/ceph/%s/acl", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, &taskId); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	task, err := cephTaskWait(config.OVHClient, serviceName, taskId)
+	if err != nil {
+		return err
+	}
+	taskSetComputed(d, taskId, task)
+
+	// the API doesn't return the id of the created acl
+	acls := []*DedicatedCephAcl{}
+	if err := config.OVHClient.Get(endpoint, &acls); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	for _, acl := range acls {
+		if cephAclCidr(acl) == network {
+			d.SetId(fmt.Sprintf("%s/%d", serviceName, acl.Id))
+			return resourceDedicatedCephAclRead(d, meta)
+		}
+	}
+
+	return fmt.Errorf("Acl %s not found on ceph %s after its creation", network, serviceName)
+}
+
+func resourceDedicatedCephAclRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	aclId := strings.TrimPrefix(d.Id(), serviceName+"/")
+
+	r := &DedicatedCephAcl{}
+	endpoint := fmt.Sprintf("/dedicated/ceph/%s/acl/%s", serviceName, aclId)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ceph %s acl %s", serviceName, r)
+
+	d.Set("network", cephAclCidr(r))
+	d.Set("family", r.Family)
+	d.Set("netmask", r.Netmask)
+
+	return nil
+}
+
+func resourceDedicatedCephAclDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	aclId := strings.TrimPrefix(d.Id(), serviceName+"/")
+
+	log.Printf("[DEBUG] Will delete ceph %s acl %s", serviceName, aclId)
+
+	var taskId string
+	endpoint := fmt.Sprintf("/dedicated/ceph/%s/acl/%s", serviceName, aclId)
+	if err := config.OVHClient.Delete(endpoint, &taskId); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if _, err := cephTaskWait(config.OVHClient, serviceName, taskId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dedicatedCephAclExists(id string, c *ovh.Client) error {
+	splitId := strings.SplitN(id, "/", 2)
+	if len(splitId) != 2 {
+		return fmt.Errorf("Acl id %s is not SERVICE_NAME/ACL_ID formatted", id)
+	}
+
+	r := &DedicatedCephAcl{}
+	endpoint := fmt.Sprintf("/dedicated/ceph/%s/acl/%s", splitId[0], splitId[1])
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ceph acl: %s", r)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDedicatedCephAclConfig = `
+resource "ovh_dedicated_ceph_acl" "acl" {
+  service_name = "%s"
+  network      = "%s"
+}
+`
+
+func TestAccDedicatedCephAcl_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CEPH_SERVICE")
+	network := os.Getenv("OVH_IP_BLOCK")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckDedicatedCephPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDedicatedCephAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedCephAclConfig, serviceName, network),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedCephAclExists("ovh_dedicated_ceph_acl.acl", t),
+					resource.TestCheckResourceAttrSet("ovh_dedicated_ceph_acl.acl", "netmask"),
+				),
+			},
+			{
+				ResourceName:      "ovh_dedicated_ceph_acl.acl",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDedicatedCephAclExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No acl id is set")
+		}
+
+		return dedicatedCephAclExists(rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckDedicatedCephAclDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dedicated_ceph_acl" {
+			continue
+		}
+
+		err := dedicatedCephAclExists(rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("ceph acl still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDedicatedCephPoolImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/POOL_NAME formatted")
+	}
+	d.Set("service_name", splitId[0])
+	d.Set("name", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDedicatedCephPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedCephPoolCreate,
+		Read:   resourceDedicatedCephPoolRead,
+		Delete: resourceDedicatedCephPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedCephPoolImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"pool_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replica_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"min_active_replicas": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"backup": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func resourceDedicatedCephPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &DedicatedCephPoolCreateOpts{
+		PoolName: d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] Will create ceph %s pool %s", serviceName, params.PoolName)
+
+	var taskId string
+	endpoint := fmt.Sprintf("/dedicated/ceph/%s/pool", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, &taskId); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	task, err := cephTaskWait(config.OVHClient, serviceName, taskId)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, params.PoolName))
+	taskSetComputed(d, taskId, task)
+
+	return resourceDedicatedCephPoolRead(d, meta)
+}
+
+func resourceDedicatedCephPoolRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	name := d.Get("name").(string)
+
+	r := &DedicatedCephPool{}
+	endpoint := fmt.Sprintf("/dedicated/ceph/%s/pool/%s", serviceName, name)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ceph %s pool %s", serviceName, r)
+
+	d.Set("pool_type", r.PoolType)
+	d.Set("replica_count", r.ReplicaCount)
+	d.Set("min_active_replicas", r.MinActiveReplicas)
+	d.Set("backup", r.Backup)
+
+	return nil
+}
+
+func resourceDedicatedCephPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Will delete ceph %s pool %s", serviceName, name)
+
+	var taskId string
+	endpoint := fmt.Sprintf("/dedicated/ceph/%s/pool/%s", serviceName, name)
+	if err := config.OVHClient.Delete(endpoint, &taskId); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if _, err := cephTaskWait(config.OVHClient, serviceName, taskId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dedicatedCephPoolExists(serviceName, name string, c *ovh.Client) error {
+	r := &DedicatedCephPool{}
+	endpoint := fmt.Sprintf("/dedicated/ceph/%s/pool/%s", serviceName, name)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ceph pool: %s", r)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDedicatedCephPoolConfig = `
+resource "ovh_dedicated_ceph_pool" "pool" {
+  service_name = "%s"
+  name         = "%s"
+}
+`
+
+func TestAccDedicatedCephPool_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CEPH_SERVICE")
+	name := strings.Replace(acctest.RandomWithPrefix(test_prefix), "-", "_", -1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckDedicatedCephPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDedicatedCephPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedCephPoolConfig, serviceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedCephPoolExists("ovh_dedicated_ceph_pool.pool", t),
+					resource.TestCheckResourceAttrSet("ovh_dedicated_ceph_pool.pool", "replica_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDedicatedCephPoolExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No pool id is set")
+		}
+
+		return dedicatedCephPoolExists(rs.Primary.Attributes["service_name"], rs.Primary.Attributes["name"], config.OVHClient)
+	}
+}
+
+func testAccCheckDedicatedCephPoolDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dedicated_ceph_pool" {
+			continue
+		}
+
+		err := dedicatedCephPoolExists(rs.Primary.Attributes["service_name"], rs.Primary.Attributes["name"], config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("ceph pool still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDedicatedCephConfig = `
+resource "ovh_dedicated_ceph" "ceph" {
+  service_name   = "%s"
+  label          = "%s"
+  crush_tunables = "OPTIMAL"
+}
+`
+
+func TestAccDedicatedCeph_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CEPH_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckDedicatedCephPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedCephConfig, serviceName, test_prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedCephExists("ovh_dedicated_ceph.ceph", t),
+					resource.TestCheckResourceAttr("ovh_dedicated_ceph.ceph", "label", test_prefix),
+					resource.TestCheckResourceAttr("ovh_dedicated_ceph.ceph", "crush_tunables", "OPTIMAL"),
+				),
+			},
+			{
+				ResourceName:      "ovh_dedicated_ceph.ceph",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDedicatedCephExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ceph service name is set")
+		}
+
+		return dedicatedCephExists(rs.Primary.ID, config.OVHClient)
+	}
+}
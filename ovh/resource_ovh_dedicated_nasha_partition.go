@@ -0,0 +1,192 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDedicatedNashaPartitionImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/PARTITION_NAME formatted")
+	}
+	d.Set("service_name", splitId[0])
+	d.Set("name", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDedicatedNashaPartition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedNashaPartitionCreate,
+		Read:   resourceDedicatedNashaPartitionRead,
+		Update: resourceDedicatedNashaPartitionUpdate,
+		Delete: resourceDedicatedNashaPartitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedNashaPartitionImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"NFS", "CIFS", "NFS_CIFS"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if v.(int) < 10 {
+						errors = append(errors, fmt.Errorf("%q must be at least 10 (GB)", k))
+					}
+					return
+				},
+			},
+
+			// Computed
+			"capacity": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"used_by_snapshots": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDedicatedNashaPartitionCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &DedicatedNashaPartitionCreateOpts{
+		PartitionName:        d.Get("name").(string),
+		PartitionDescription: d.Get("description").(string),
+		Protocol:             d.Get("protocol").(string),
+		Size:                 d.Get("size").(int),
+	}
+
+	log.Printf("[DEBUG] Will create nasha %s partition: %s", serviceName, params)
+
+	task := &DedicatedNashaTask{}
+	endpoint := fmt.Sprintf("/dedicated/nasha/%s/partition", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	if err := nashaTaskWait(config.OVHClient, serviceName, task.TaskId); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, params.PartitionName))
+
+	return resourceDedicatedNashaPartitionRead(d, meta)
+}
+
+func resourceDedicatedNashaPartitionRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	name := d.Get("name").(string)
+
+	r := &DedicatedNashaPartition{}
+	endpoint := fmt.Sprintf("/dedicated/nasha/%s/partition/%s", serviceName, name)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read nasha %s partition %s", serviceName, r)
+
+	d.Set("description", r.PartitionDescription)
+	d.Set("protocol", r.Protocol)
+	d.Set("size", r.Size)
+	d.Set("capacity", r.Capacity)
+	d.Set("used_by_snapshots", r.UsedBySnapshots)
+
+	return nil
+}
+
+func resourceDedicatedNashaPartitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	name := d.Get("name").(string)
+
+	params := &DedicatedNashaPartitionUpdateOpts{
+		PartitionDescription: d.Get("description").(string),
+		Protocol:             d.Get("protocol").(string),
+		Size:                 d.Get("size").(int),
+	}
+
+	log.Printf("[DEBUG] Will update nasha %s partition %s: %v", serviceName, name, params)
+
+	endpoint := fmt.Sprintf("/dedicated/nasha/%s/partition/%s", serviceName, name)
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceDedicatedNashaPartitionRead(d, meta)
+}
+
+func resourceDedicatedNashaPartitionDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Will delete nasha %s partition %s", serviceName, name)
+
+	task := &DedicatedNashaTask{}
+	endpoint := fmt.Sprintf("/dedicated/nasha/%s/partition/%s", serviceName, name)
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := nashaTaskWait(config.OVHClient, serviceName, task.TaskId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dedicatedNashaPartitionExists(serviceName, name string, c *ovh.Client) error {
+	r := &DedicatedNashaPartition{}
+	endpoint := fmt.Sprintf("/dedicated/nasha/%s/partition/%s", serviceName, name)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read nasha partition: %s", r)
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDedicatedNashaPartitionAccessImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/PARTITION_NAME/IP formatted")
+	}
+	d.Set("service_name", splitId[0])
+	d.Set("partition_name", splitId[1])
+	d.Set("ip", splitId[2])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDedicatedNashaPartitionAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedNashaPartitionAccessCreate,
+		Read:   resourceDedicatedNashaPartitionAccessRead,
+		Delete: resourceDedicatedNashaPartitionAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedNashaPartitionAccessImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"partition_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "readwrite",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"readonly", "readwrite"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+		},
+	}
+}
+
+func resourceDedicatedNashaPartitionAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	partitionName := d.Get("partition_name").(string)
+
+	params := &DedicatedNashaPartitionAccessCreateOpts{
+		Ip:   d.Get("ip").(string),
+		Type: d.Get("type").(string),
+	}
+
+	log.Printf("[DEBUG] Will create nasha %s partition %s access: %v", serviceName, partitionName, params)
+
+	task := &DedicatedNashaTask{}
+	endpoint := fmt.Sprintf("/dedicated/nasha/%s/partition/%s/access", serviceName, partitionName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := nashaTaskWait(config.OVHClient, serviceName, task.TaskId); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", serviceName, partitionName, params.Ip))
+
+	return resourceDedicatedNashaPartitionAccessRead(d, meta)
+}
+
+func resourceDedicatedNashaPartitionAccessRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	partitionName := d.Get("partition_name").(string)
+	ip := d.Get("ip").(string)
+
+	r := &DedicatedNashaPartitionAccess{}
+	endpoint := fmt.Sprintf("/dedicated/nasha/%s/partition/%s/access/%s", serviceName, partitionName, strings.Replace(ip, "/", "%2F", 1))
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read nasha %s partition %s access %s", serviceName, partitionName, r)
+
+	d.Set("type", r.Type)
+
+	return nil
+}
+
+func resourceDedicatedNashaPartitionAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	partitionName := d.Get("partition_name").(string)
+	ip := d.Get("ip").(string)
+
+	log.Printf("[DEBUG] Will delete nasha %s partition %s access %s", serviceName, partitionName, ip)
+
+	task := &DedicatedNashaTask{}
+	endpoint := fmt.Sprintf("/dedicated/nasha/%s/partition/%s/access/%s", serviceName, partitionName, strings.Replace(ip, "/", "%2F", 1))
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := nashaTaskWait(config.OVHClient, serviceName, task.TaskId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dedicatedNashaPartitionAccessExists(serviceName, partitionName, ip string, c *ovh.Client) error {
+	r := &DedicatedNashaPartitionAccess{}
+	endpoint := fmt.Sprintf("/dedicated/nasha/%s/partition/%s/access/%s", serviceName, partitionName, strings.Replace(ip, "/", "%2F", 1))
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read nasha partition access: %s", r)
+
+	return nil
+}
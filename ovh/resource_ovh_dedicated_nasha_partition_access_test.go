@@ -0,0 +1,91 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDedicatedNashaPartitionAccessConfig = `
+resource "ovh_dedicated_nasha_partition" "partition" {
+  service_name = "%s"
+  name         = "%s"
+  protocol     = "NFS"
+  size         = 10
+}
+
+resource "ovh_dedicated_nasha_partition_access" "access" {
+  service_name   = "${ovh_dedicated_nasha_partition.partition.service_name}"
+  partition_name = "${ovh_dedicated_nasha_partition.partition.name}"
+  ip             = "%s"
+  type           = "readonly"
+}
+`
+
+func TestAccDedicatedNashaPartitionAccess_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_NASHA_SERVICE")
+	name := strings.Replace(acctest.RandomWithPrefix(test_prefix), "-", "_", -1)
+	ip := os.Getenv("OVH_IP_BLOCK")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckDedicatedNashaPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDedicatedNashaPartitionAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedNashaPartitionAccessConfig, serviceName, name, ip),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedNashaPartitionAccessExists("ovh_dedicated_nasha_partition_access.access", t),
+					resource.TestCheckResourceAttr("ovh_dedicated_nasha_partition_access.access", "type", "readonly"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDedicatedNashaPartitionAccessExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No partition access id is set")
+		}
+
+		return dedicatedNashaPartitionAccessExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["partition_name"],
+			rs.Primary.Attributes["ip"],
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckDedicatedNashaPartitionAccessDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dedicated_nasha_partition_access" {
+			continue
+		}
+
+		err := dedicatedNashaPartitionAccessExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["partition_name"],
+			rs.Primary.Attributes["ip"],
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("nasha partition access still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDedicatedNashaPartitionSnapshotImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/PARTITION_NAME/TYPE formatted")
+	}
+	d.Set("service_name", splitId[0])
+	d.Set("partition_name", splitId[1])
+	d.Set("type", splitId[2])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDedicatedNashaPartitionSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedNashaPartitionSnapshotCreate,
+		Read:   resourceDedicatedNashaPartitionSnapshotRead,
+		Delete: resourceDedicatedNashaPartitionSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedNashaPartitionSnapshotImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"partition_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{
+						"day-1",
+						"day-2",
+						"day-3",
+						"day-7",
+						"hour-1",
+						"hour-6",
+					})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+		},
+	}
+}
+
+func resourceDedicatedNashaPartitionSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	partitionName := d.Get("partition_name").(string)
+
+	params := &DedicatedNashaPartitionSnapshotCreateOpts{
+		SnapshotType: d.Get("type").(string),
+	}
+
+	log.Printf("[DEBUG] Will create nasha %s partition %s snapshot %s", serviceName, partitionName, params.SnapshotType)
+
+	task := &DedicatedNashaTask{}
+	endpoint := fmt.Sprintf("/dedicated/nasha/%s/partition/%s/snapshot", serviceName, partitionName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := nashaTaskWait(config.OVHClient, serviceName, task.TaskId); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", serviceName, partitionName, params.SnapshotType))
+
+	return resourceDedicatedNashaPartitionSnapshotRead(d, meta)
+}
+
+func resourceDedicatedNashaPartitionSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	partitionName := d.Get("partition_name").(string)
+	snapshotType := d.Get("type").(string)
+
+	r := &DedicatedNashaPartitionSnapshot{}
+	endpoint := fmt.Sprintf("/dedicated/nasha/%s/partition/%s/snapshot/%s", serviceName, partitionName, snapshotType)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read nasha %s partition %s snapshot %s", serviceName, partitionName, r.Type)
+
+	d.Set("type", r.Type)
+
+	return nil
+}
+
+func resourceDedicatedNashaPartitionSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	partitionName := d.Get("partition_name").(string)
+	snapshotType := d.Get("type").(string)
+
+	log.Printf("[DEBUG] Will delete nasha %s partition %s snapshot %s", serviceName, partitionName, snapshotType)
+
+	task := &DedicatedNashaTask{}
+	endpoint := fmt.Sprintf("/dedicated/nasha/%s/partition/%s/snapshot/%s", serviceName, partitionName, snapshotType)
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := nashaTaskWait(config.OVHClient, serviceName, task.TaskId); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dedicatedNashaPartitionSnapshotExists(serviceName, partitionName, snapshotType string, c *ovh.Client) error {
+	r := &DedicatedNashaPartitionSnapshot{}
+	endpoint := fmt.Sprintf("/dedicated/nasha/%s/partition/%s/snapshot/%s", serviceName, partitionName, snapshotType)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read nasha partition snapshot: %s", r.Type)
+
+	return nil
+}
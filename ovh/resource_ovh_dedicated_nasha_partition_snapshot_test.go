@@ -0,0 +1,89 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDedicatedNashaPartitionSnapshotConfig = `
+resource "ovh_dedicated_nasha_partition" "partition" {
+  service_name = "%s"
+  name         = "%s"
+  protocol     = "NFS"
+  size         = 10
+}
+
+resource "ovh_dedicated_nasha_partition_snapshot" "snapshot" {
+  service_name   = "${ovh_dedicated_nasha_partition.partition.service_name}"
+  partition_name = "${ovh_dedicated_nasha_partition.partition.name}"
+  type           = "day-1"
+}
+`
+
+func TestAccDedicatedNashaPartitionSnapshot_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_NASHA_SERVICE")
+	name := strings.Replace(acctest.RandomWithPrefix(test_prefix), "-", "_", -1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckDedicatedNashaPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDedicatedNashaPartitionSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedNashaPartitionSnapshotConfig, serviceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedNashaPartitionSnapshotExists("ovh_dedicated_nasha_partition_snapshot.snapshot", t),
+					resource.TestCheckResourceAttr("ovh_dedicated_nasha_partition_snapshot.snapshot", "type", "day-1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDedicatedNashaPartitionSnapshotExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No partition snapshot id is set")
+		}
+
+		return dedicatedNashaPartitionSnapshotExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["partition_name"],
+			rs.Primary.Attributes["type"],
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckDedicatedNashaPartitionSnapshotDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dedicated_nasha_partition_snapshot" {
+			continue
+		}
+
+		err := dedicatedNashaPartitionSnapshotExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["partition_name"],
+			rs.Primary.Attributes["type"],
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("nasha partition snapshot still exists")
+		}
+	}
+	return nil
+}
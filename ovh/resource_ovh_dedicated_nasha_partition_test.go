@@ -0,0 +1,85 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDedicatedNashaPartitionConfig = `
+resource "ovh_dedicated_nasha_partition" "partition" {
+  service_name = "%s"
+  name         = "%s"
+  protocol     = "NFS"
+  size         = %d
+}
+`
+
+func TestAccDedicatedNashaPartition_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_NASHA_SERVICE")
+	name := strings.Replace(acctest.RandomWithPrefix(test_prefix), "-", "_", -1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckDedicatedNashaPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDedicatedNashaPartitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedNashaPartitionConfig, serviceName, name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedNashaPartitionExists("ovh_dedicated_nasha_partition.partition", t),
+					resource.TestCheckResourceAttr("ovh_dedicated_nasha_partition.partition", "size", "10"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDedicatedNashaPartitionConfig, serviceName, name, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedNashaPartitionExists("ovh_dedicated_nasha_partition.partition", t),
+					resource.TestCheckResourceAttr("ovh_dedicated_nasha_partition.partition", "size", "20"),
+				),
+			},
+			{
+				ResourceName:      "ovh_dedicated_nasha_partition.partition",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDedicatedNashaPartitionExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No partition id is set")
+		}
+
+		return dedicatedNashaPartitionExists(rs.Primary.Attributes["service_name"], rs.Primary.Attributes["name"], config.OVHClient)
+	}
+}
+
+func testAccCheckDedicatedNashaPartitionDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dedicated_nasha_partition" {
+			continue
+		}
+
+		err := dedicatedNashaPartitionExists(rs.Primary.Attributes["service_name"], rs.Primary.Attributes["name"], config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("nasha partition still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,648 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDedicatedServerFirewall() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerFirewallCreate,
+		Read:   resourceDedicatedServerFirewallRead,
+		Update: resourceDedicatedServerFirewallUpdate,
+		Delete: resourceDedicatedServerFirewallDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedServerFirewallImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ips": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						err := validateIpV4(v.(string))
+						if err != nil {
+							errors = append(errors, err)
+						}
+						return
+					},
+				},
+				Set: schema.HashString,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := validateStringEnum(v.(string), []string{"deny", "permit"})
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := validateStringEnum(v.(string), []string{"ah", "esp", "gre", "icmp", "ipv4", "tcp", "udp"})
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := validateIpBlock(v.(string))
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"source_port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"destination_port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"fragments": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"tcp_option": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := validateStringEnum(v.(string), []string{"established", "syn"})
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerFirewallCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	blocks, err := dedicatedServerFirewallBlocks(config.OVHClient, serviceName)
+	if err != nil {
+		return fmt.Errorf("calling Get /dedicated/server/%s/ips:\n\t %q", serviceName, err)
+	}
+
+	ips := []string{}
+	if _, ok := d.GetOk("ips"); ok {
+		ips = stringsFromSchema(d, "ips")
+		for _, ip := range ips {
+			if ipBlockContaining(blocks, ip) == "" {
+				return fmt.Errorf("ip %s isn't routed to dedicated server %s", ip, serviceName)
+			}
+		}
+	} else {
+		addresses, err := ipBlocksV4Addresses(blocks)
+		if err != nil {
+			return err
+		}
+		for ip := range addresses {
+			ips = append(ips, ip)
+		}
+		sort.Strings(ips)
+	}
+
+	if len(ips) == 0 {
+		return fmt.Errorf("dedicated server %s has no IPv4 to put on the network firewall", serviceName)
+	}
+
+	d.SetId(serviceName)
+	d.Set("ips", ips)
+
+	return resourceDedicatedServerFirewallUpdate(d, meta)
+}
+
+func resourceDedicatedServerFirewallRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	blocks, err := dedicatedServerFirewallBlocks(config.OVHClient, serviceName)
+	if err != nil {
+		return CheckDeleted(d, err, fmt.Sprintf("/dedicated/server/%s/ips", serviceName))
+	}
+
+	ips := stringsFromSchema(d, "ips")
+	sort.Strings(ips)
+	wanted := dedicatedServerFirewallRules(d)
+
+	firewalls := make([]*OvhIpFirewall, len(ips))
+	rules := make([][]*OvhIpFirewallRule, len(ips))
+	err = fetchConcurrently(len(ips), func(i int) error {
+		block := ipBlockContaining(blocks, ips[i])
+		if block == "" {
+			log.Printf("[WARN] ip %s isn't routed to dedicated server %s anymore", ips[i], serviceName)
+			return nil
+		}
+
+		firewall, ipRules, err := ipFirewallGetWithRules(config.OVHClient, block, ips[i])
+		if err != nil {
+			return err
+		}
+		firewalls[i] = firewall
+		rules[i] = ipRules
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// the resource is in sync when every ip has the firewall in the wanted
+	// state with the wanted rules. Every ip is checked, and the differences
+	// are reported so that the next apply puts the rules back on all of them.
+	enabled := d.Get("enabled").(bool)
+	stateEnabled := enabled
+	var stateRules []interface{}
+	for i, firewall := range firewalls {
+		if firewall == nil {
+			log.Printf("[DEBUG] No firewall on ip %s of dedicated server %s", ips[i], serviceName)
+			stateEnabled = false
+			if stateRules == nil {
+				stateRules = []interface{}{}
+			}
+			continue
+		}
+
+		if firewall.Enabled != enabled {
+			log.Printf("[DEBUG] Firewall on ip %s of dedicated server %s has enabled %t", ips[i], serviceName, firewall.Enabled)
+			stateEnabled = firewall.Enabled
+		}
+
+		if !ipFirewallRulesMatch(rules[i], wanted) {
+			log.Printf("[DEBUG] Rules of firewall on ip %s of dedicated server %s differ", ips[i], serviceName)
+			if stateRules == nil {
+				stateRules = ipFirewallRulesToSchema(rules[i])
+			}
+		}
+	}
+
+	d.Set("enabled", stateEnabled)
+	if stateRules != nil {
+		d.Set("rule", stateRules)
+	}
+
+	return nil
+}
+
+// resourceDedicatedServerFirewallImportState imports the firewall of a
+// dedicated server from its service name, with the ips of its blocks which
+// are on the firewall.
+func resourceDedicatedServerFirewallImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	serviceName := d.Id()
+
+	blocks, err := dedicatedServerFirewallBlocks(config.OVHClient, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("calling Get /dedicated/server/%s/ips:\n\t %q", serviceName, err)
+	}
+
+	ips := []string{}
+	for _, block := range blocks {
+		blockIps := []string{}
+		endpoint := fmt.Sprintf("/ip/%s/firewall", strings.Replace(block, "/", "%2F", 1))
+		if err := config.OVHClient.Get(endpoint, &blockIps); err != nil {
+			return nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+		}
+		ips = append(ips, blockIps...)
+	}
+
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("dedicated server %s has no IPv4 on the network firewall", serviceName)
+	}
+	sort.Strings(ips)
+
+	d.Set("service_name", serviceName)
+	d.Set("ips", ips)
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceDedicatedServerFirewallUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	blocks, err := dedicatedServerFirewallBlocks(config.OVHClient, serviceName)
+	if err != nil {
+		return fmt.Errorf("calling Get /dedicated/server/%s/ips:\n\t %q", serviceName, err)
+	}
+
+	ips := stringsFromSchema(d, "ips")
+	enabled := d.Get("enabled").(bool)
+	wanted := dedicatedServerFirewallRules(d)
+
+	err = fetchConcurrently(len(ips), func(i int) error {
+		block := ipBlockContaining(blocks, ips[i])
+		if block == "" {
+			return fmt.Errorf("ip %s isn't routed to dedicated server %s", ips[i], serviceName)
+		}
+		return ipFirewallApply(config.OVHClient, block, ips[i], enabled, wanted)
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceDedicatedServerFirewallRead(d, meta)
+}
+
+func resourceDedicatedServerFirewallDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	blocks, err := dedicatedServerFirewallBlocks(config.OVHClient, serviceName)
+	if err != nil {
+		return fmt.Errorf("calling Get /dedicated/server/%s/ips:\n\t %q", serviceName, err)
+	}
+
+	ips := stringsFromSchema(d, "ips")
+	err = fetchConcurrently(len(ips), func(i int) error {
+		block := ipBlockContaining(blocks, ips[i])
+		if block == "" {
+			log.Printf("[WARN] ip %s isn't routed to dedicated server %s anymore, its firewall is left as is", ips[i], serviceName)
+			return nil
+		}
+
+		endpoint := fmt.Sprintf("/ip/%s/firewall/%s", strings.Replace(block, "/", "%2F", 1), ips[i])
+
+		log.Printf("[DEBUG] Will delete firewall on ip %s of dedicated server %s", ips[i], serviceName)
+
+		if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+			if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+				return nil
+			}
+			return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+		}
+
+		return ipFirewallWait(config.OVHClient, block, ips[i], []string{"ok", "disableFirewallPending", "enableFirewallPending"}, []string{"deleted"})
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// dedicatedServerFirewallBlocks returns the IPv4 blocks routed to a dedicated
+// server. The IPv6 blocks are ignored, as the network firewall only filters
+// IPv4.
+func dedicatedServerFirewallBlocks(c *ovh.Client, serviceName string) ([]string, error) {
+	blocks := []string{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/ips", serviceName)
+	if err := c.Get(endpoint, &blocks); err != nil {
+		return nil, err
+	}
+
+	result := []string{}
+	for _, block := range blocks {
+		if !strings.Contains(block, ":") {
+			result = append(result, block)
+		}
+	}
+	return result, nil
+}
+
+// ipBlockContaining returns the block an address belongs to, or an empty
+// string if it belongs to none of them.
+func ipBlockContaining(blocks []string, ip string) string {
+	address := net.ParseIP(ip)
+	if address == nil {
+		return ""
+	}
+
+	for _, block := range blocks {
+		cidr := block
+		if !strings.Contains(cidr, "/") {
+			cidr = cidr + "/32"
+		}
+		if _, network, err := net.ParseCIDR(cidr); err == nil && network.Contains(address) {
+			return block
+		}
+	}
+	return ""
+}
+
+// ipBlocksV4Addresses maps every address of the IPv4 blocks to its block.
+func ipBlocksV4Addresses(blocks []string) (map[string]string, error) {
+	ips := map[string]string{}
+	for _, block := range blocks {
+		if strings.Contains(block, ":") {
+			continue
+		}
+
+		cidr := block
+		if !strings.Contains(cidr, "/") {
+			cidr = cidr + "/32"
+		}
+
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ip block %s: %s", block, err)
+		}
+
+		ones, _ := network.Mask.Size()
+		if ones < 24 {
+			return nil, fmt.Errorf("ip block %s is too large to put all its addresses on the firewall: set ips", block)
+		}
+
+		ip := network.IP.To4()
+		for n := 0; n < 1<<uint(32-ones); n++ {
+			address := net.IPv4(ip[0], ip[1], ip[2], ip[3]+byte(n))
+			ips[address.String()] = block
+		}
+	}
+	return ips, nil
+}
+
+// dedicatedServerFirewallRules returns the rules of the configuration, their
+// sequence being their position in the list.
+func dedicatedServerFirewallRules(d *schema.ResourceData) []*OvhIpFirewallRuleCreateOpts {
+	rules := []*OvhIpFirewallRuleCreateOpts{}
+	for i, v := range d.Get("rule").([]interface{}) {
+		rules = append(rules, ipFirewallRuleOpts(i, v.(map[string]interface{})))
+	}
+	return rules
+}
+
+func ipFirewallRuleOpts(sequence int, rule map[string]interface{}) *OvhIpFirewallRuleCreateOpts {
+	params := &OvhIpFirewallRuleCreateOpts{
+		Sequence: sequence,
+		Action:   rule["action"].(string),
+		Protocol: rule["protocol"].(string),
+		Source:   rule["source"].(string),
+	}
+
+	if v := rule["source_port"].(int); v != 0 {
+		params.SourcePort = getNilIntPointer(v)
+	}
+	if v := rule["destination_port"].(int); v != 0 {
+		params.DestinationPort = getNilIntPointer(v)
+	}
+
+	fragments := rule["fragments"].(bool)
+	if params.Protocol == "tcp" {
+		params.TcpOption = &OvhIpFirewallRuleTcpOption{
+			Option: rule["tcp_option"].(string),
+		}
+		if fragments {
+			params.TcpOption.Fragments = getNilBoolPointer(fragments)
+		}
+	} else if fragments {
+		params.Fragments = getNilBoolPointer(fragments)
+	}
+
+	return params
+}
+
+// ipFirewallRuleSource normalizes the source of a rule: the API returns "any"
+// for the rules without source, and single addresses as /32 blocks.
+func ipFirewallRuleSource(source string) string {
+	if source == "any" {
+		return ""
+	}
+	return strings.TrimSuffix(source, "/32")
+}
+
+// ipFirewallRulePort returns the port of a rule, which the API returns with
+// its operator, e.g. "eq 22".
+func ipFirewallRulePort(port string) int {
+	fields := strings.Fields(port)
+	if len(fields) == 0 {
+		return 0
+	}
+	v, err := strconv.Atoi(fields[len(fields)-1])
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// ipFirewallRuleMatches returns whether an existing rule is the one to apply.
+func ipFirewallRuleMatches(r *OvhIpFirewallRule, p *OvhIpFirewallRuleCreateOpts) bool {
+	if r.Sequence != p.Sequence || r.Action != p.Action || r.Protocol != p.Protocol {
+		return false
+	}
+
+	if ipFirewallRuleSource(r.Source) != ipFirewallRuleSource(p.Source) {
+		return false
+	}
+
+	sourcePort, destinationPort := 0, 0
+	if p.SourcePort != nil {
+		sourcePort = *p.SourcePort
+	}
+	if p.DestinationPort != nil {
+		destinationPort = *p.DestinationPort
+	}
+	if ipFirewallRulePort(r.SourcePort) != sourcePort || ipFirewallRulePort(r.DestinationPort) != destinationPort {
+		return false
+	}
+
+	tcpOption, fragments := "", false
+	if p.TcpOption != nil {
+		tcpOption = p.TcpOption.Option
+		fragments = p.TcpOption.Fragments != nil && *p.TcpOption.Fragments
+	} else {
+		fragments = p.Fragments != nil && *p.Fragments
+	}
+	existingTcpOption := r.TcpOption
+	if existingTcpOption == "none" {
+		existingTcpOption = ""
+	}
+
+	return existingTcpOption == tcpOption && r.Fragments == fragments
+}
+
+// ipFirewallRulesMatch returns whether the existing rules of a firewall are
+// exactly the ones to apply.
+func ipFirewallRulesMatch(rules []*OvhIpFirewallRule, wanted []*OvhIpFirewallRuleCreateOpts) bool {
+	if len(rules) != len(wanted) {
+		return false
+	}
+	for _, r := range rules {
+		if r.Sequence >= len(wanted) || !ipFirewallRuleMatches(r, wanted[r.Sequence]) {
+			return false
+		}
+	}
+	return true
+}
+
+func ipFirewallRulesToSchema(rules []*OvhIpFirewallRule) []interface{} {
+	result := make([]interface{}, len(rules))
+	for i, r := range rules {
+		tcpOption := r.TcpOption
+		if tcpOption == "none" {
+			tcpOption = ""
+		}
+		result[i] = map[string]interface{}{
+			"action":           r.Action,
+			"protocol":         r.Protocol,
+			"source":           ipFirewallRuleSource(r.Source),
+			"source_port":      ipFirewallRulePort(r.SourcePort),
+			"destination_port": ipFirewallRulePort(r.DestinationPort),
+			"fragments":        r.Fragments,
+			"tcp_option":       tcpOption,
+		}
+	}
+	return result
+}
+
+// ipFirewallGetWithRules returns the firewall of an ip, nil if the ip isn't on
+// the firewall, and its rules sorted by sequence.
+func ipFirewallGetWithRules(c *ovh.Client, block, ip string) (*OvhIpFirewall, []*OvhIpFirewallRule, error) {
+	firewall := &OvhIpFirewall{}
+	endpoint := fmt.Sprintf("/ip/%s/firewall/%s", strings.Replace(block, "/", "%2F", 1), ip)
+	if err := c.Get(endpoint, firewall); err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	sequences := []int{}
+	if err := c.Get(endpoint+"/rule", &sequences); err != nil {
+		return nil, nil, fmt.Errorf("calling Get %s/rule:\n\t %q", endpoint, err)
+	}
+	sort.Ints(sequences)
+
+	rules := make([]*OvhIpFirewallRule, len(sequences))
+	for i, sequence := range sequences {
+		r := &OvhIpFirewallRule{}
+		if err := c.Get(fmt.Sprintf("%s/rule/%d", endpoint, sequence), r); err != nil {
+			return nil, nil, fmt.Errorf("calling Get %s/rule/%d:\n\t %q", endpoint, sequence, err)
+		}
+		rules[i] = r
+	}
+
+	log.Printf("[DEBUG] Read ip %s %s with %d rules", block, firewall, len(rules))
+	return firewall, rules, nil
+}
+
+// ipFirewallApply puts an ip on the firewall, sets its state and replaces the
+// rules which differ from the ones to apply. The rules already applied are
+// left untouched, so that applying the same rules again makes no change.
+func ipFirewallApply(c *ovh.Client, block, ip string, enabled bool, wanted []*OvhIpFirewallRuleCreateOpts) error {
+	endpoint := fmt.Sprintf("/ip/%s/firewall", strings.Replace(block, "/", "%2F", 1))
+
+	firewall, rules, err := ipFirewallGetWithRules(c, block, ip)
+	if err != nil {
+		return err
+	}
+
+	if firewall == nil {
+		params := &OvhIpFirewallCreateOpts{IpOnFirewall: ip}
+
+		log.Printf("[DEBUG] Will create firewall on ip %s: %v", block, params)
+
+		if err := c.Post(endpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+		}
+		if err := ipFirewallWaitOk(c, block, ip); err != nil {
+			return err
+		}
+		firewall = &OvhIpFirewall{IpOnFirewall: ip}
+	}
+
+	existing := map[int]*OvhIpFirewallRule{}
+	for _, r := range rules {
+		existing[r.Sequence] = r
+	}
+
+	// the rules which differ or are no longer wanted are removed first, as
+	// a sequence can only hold one rule.
+	for _, r := range rules {
+		if r.Sequence < len(wanted) && ipFirewallRuleMatches(r, wanted[r.Sequence]) {
+			continue
+		}
+		if err := ipFirewallRuleDelete(c, block, ip, r.Sequence); err != nil {
+			return err
+		}
+		delete(existing, r.Sequence)
+	}
+
+	for _, params := range wanted {
+		if _, ok := existing[params.Sequence]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Will create firewall rule on ip %s (%s): %s", block, ip, params)
+
+		if err := c.Post(fmt.Sprintf("%s/%s/rule", endpoint, ip), params, nil); err != nil {
+			return fmt.Errorf("calling Post %s/%s/rule with params %s:\n\t %q", endpoint, ip, params, err)
+		}
+
+		if err := ipFirewallRuleWait(c, block, ip, params.Sequence, []string{"creationPending"}, []string{"ok"}); err != nil {
+			return err
+		}
+	}
+
+	if firewall.Enabled != enabled {
+		params := &OvhIpFirewallUpdateOpts{Enabled: enabled}
+
+		log.Printf("[DEBUG] Will update firewall on ip %s (%s): %v", block, ip, params)
+
+		if err := c.Put(fmt.Sprintf("%s/%s", endpoint, ip), params, nil); err != nil {
+			return fmt.Errorf("calling Put %s/%s with params %v:\n\t %q", endpoint, ip, params, err)
+		}
+		if err := ipFirewallWaitOk(c, block, ip); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func ipFirewallRuleDelete(c *ovh.Client, block, ip string, sequence int) error {
+	endpoint := fmt.Sprintf("/ip/%s/firewall/%s/rule/%d", strings.Replace(block, "/", "%2F", 1), ip, sequence)
+
+	log.Printf("[DEBUG] Will delete firewall rule %d on ip %s (%s)", sequence, block, ip)
+
+	if err := c.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	return ipFirewallRuleWait(c, block, ip, sequence, []string{"ok", "removalPending"}, []string{"deleted"})
+}
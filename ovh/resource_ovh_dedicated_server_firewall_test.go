@@ -0,0 +1,142 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccDedicatedServerFirewallConfig = `
+data "ovh_dedicated_server_ips" "server" {
+  service_name = "%s"
+  type         = "dedicated"
+}
+
+resource "ovh_dedicated_server_firewall" "firewall" {
+  service_name = "${data.ovh_dedicated_server_ips.server.service_name}"
+  ips          = ["${lookup(data.ovh_dedicated_server_ips.server.details[0], "ip")}"]
+  enabled      = %t
+
+  rule {
+    action           = "permit"
+    protocol         = "tcp"
+    destination_port = 22
+  }
+
+  rule {
+    action     = "permit"
+    protocol   = "tcp"
+    tcp_option = "established"
+  }
+
+  rule {
+    action   = "deny"
+    protocol = "ipv4"
+  }
+}
+`
+
+func TestAccDedicatedServerFirewall_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckDedicatedServerPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerFirewallConfig, serviceName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_dedicated_server_firewall.firewall", "enabled", "false"),
+					resource.TestCheckResourceAttr("ovh_dedicated_server_firewall.firewall", "rule.#", "3"),
+					resource.TestCheckResourceAttr("ovh_dedicated_server_firewall.firewall", "rule.2.action", "deny"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerFirewallConfig, serviceName, true),
+				Check: resource.TestCheckResourceAttr(
+					"ovh_dedicated_server_firewall.firewall", "enabled", "true"),
+			},
+			{
+				ResourceName:      "ovh_dedicated_server_firewall.firewall",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestIpBlocksV4Addresses(t *testing.T) {
+	ips, err := ipBlocksV4Addresses([]string{"192.0.2.10/32", "198.51.100.8/30", "2001:db8::/64"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"192.0.2.10":    "192.0.2.10/32",
+		"198.51.100.8":  "198.51.100.8/30",
+		"198.51.100.9":  "198.51.100.8/30",
+		"198.51.100.10": "198.51.100.8/30",
+		"198.51.100.11": "198.51.100.8/30",
+	}
+	if len(ips) != len(expected) {
+		t.Fatalf("expected %d addresses, got %v", len(expected), ips)
+	}
+	for ip, block := range expected {
+		if ips[ip] != block {
+			t.Errorf("%s: expected block %s, got %q", ip, block, ips[ip])
+		}
+	}
+
+	if _, err := ipBlocksV4Addresses([]string{"203.0.113.0/22"}); err == nil {
+		t.Errorf("expected an error for a /22 block")
+	}
+}
+
+func TestIpBlockContaining(t *testing.T) {
+	blocks := []string{"192.0.2.10/32", "198.51.100.8/29"}
+	cases := map[string]string{
+		"192.0.2.10":    "192.0.2.10/32",
+		"198.51.100.14": "198.51.100.8/29",
+		"198.51.100.16": "",
+		"not-an-ip":     "",
+	}
+
+	for ip, expected := range cases {
+		if block := ipBlockContaining(blocks, ip); block != expected {
+			t.Errorf("%s: expected %q, got %q", ip, expected, block)
+		}
+	}
+}
+
+func TestIpFirewallRuleMatches(t *testing.T) {
+	ssh := ipFirewallRuleOpts(0, map[string]interface{}{
+		"action":           "permit",
+		"protocol":         "tcp",
+		"source":           "192.0.2.1",
+		"source_port":      0,
+		"destination_port": 22,
+		"fragments":        false,
+		"tcp_option":       "",
+	})
+
+	cases := []struct {
+		rule     OvhIpFirewallRule
+		expected bool
+	}{
+		{OvhIpFirewallRule{Sequence: 0, Action: "permit", Protocol: "tcp", Source: "192.0.2.1/32", DestinationPort: "eq 22", TcpOption: "none"}, true},
+		{OvhIpFirewallRule{Sequence: 0, Action: "permit", Protocol: "tcp", Source: "192.0.2.1/32", DestinationPort: "eq 22"}, true},
+		{OvhIpFirewallRule{Sequence: 1, Action: "permit", Protocol: "tcp", Source: "192.0.2.1/32", DestinationPort: "eq 22"}, false},
+		{OvhIpFirewallRule{Sequence: 0, Action: "deny", Protocol: "tcp", Source: "192.0.2.1/32", DestinationPort: "eq 22"}, false},
+		{OvhIpFirewallRule{Sequence: 0, Action: "permit", Protocol: "tcp", Source: "any", DestinationPort: "eq 22"}, false},
+		{OvhIpFirewallRule{Sequence: 0, Action: "permit", Protocol: "tcp", Source: "192.0.2.1/32", DestinationPort: "eq 2222"}, false},
+		{OvhIpFirewallRule{Sequence: 0, Action: "permit", Protocol: "tcp", Source: "192.0.2.1/32", DestinationPort: "eq 22", TcpOption: "syn"}, false},
+	}
+
+	for i, c := range cases {
+		if ok := ipFirewallRuleMatches(&c.rule, ssh); ok != c.expected {
+			t.Errorf("case %d %s: expected %v, got %v", i, &c.rule, c.expected, ok)
+		}
+	}
+}
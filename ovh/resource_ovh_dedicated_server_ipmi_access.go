@@ -0,0 +1,176 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDedicatedServerIpmiAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerIpmiAccessCreate,
+		Read:   resourceDedicatedServerIpmiAccessRead,
+		Delete: resourceDedicatedServerIpmiAccessDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"kvmipHtml5URL", "kvmipJnlp", "serialOverLanSshKey", "serialOverLanURL"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  15,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					switch v.(int) {
+					case 1, 3, 5, 10, 15:
+					default:
+						errors = append(errors, fmt.Errorf("%q must be one of 1, 3, 5, 10 or 15, got %d", k, v.(int)))
+					}
+					return
+				},
+			},
+			"ip_to_allow": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ssh_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"value": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerIpmiAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &DedicatedServerIpmiAccessCreateOpts{
+		Type:      d.Get("type").(string),
+		Ttl:       d.Get("ttl").(int),
+		IpToAllow: d.Get("ip_to_allow").(string),
+		SshKey:    d.Get("ssh_key").(string),
+	}
+
+	if params.Type == "serialOverLanSshKey" && params.SshKey == "" {
+		return fmt.Errorf("ssh_key is required for %s ipmi access", params.Type)
+	}
+
+	log.Printf("[DEBUG] Will request ipmi access on dedicated server %s: %s", serviceName, params)
+
+	task := &DedicatedServerTask{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/features/ipmi/access", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	if err := dedicatedServerTaskWait(config.OVHClient, serviceName, task.TaskId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, params.Type))
+
+	return resourceDedicatedServerIpmiAccessRead(d, meta)
+}
+
+func resourceDedicatedServerIpmiAccessRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	accessType := d.Get("type").(string)
+
+	r := &DedicatedServerIpmiAccess{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/features/ipmi/access?type=%s",
+		serviceName,
+		url.QueryEscape(accessType),
+	)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	// an expired access is removed from the state, so that a new one
+	// is requested on the next apply.
+	if expiration, err := time.Parse(time.RFC3339, r.Expiration); err == nil && expiration.Before(time.Now()) {
+		log.Printf("[WARN] Ipmi access %s expired on %s, removing it from state", d.Id(), r.Expiration)
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Read ipmi access %s expiring on %s", d.Id(), r.Expiration)
+
+	d.Set("value", strings.TrimSpace(r.Value))
+	d.Set("expiration", r.Expiration)
+
+	return nil
+}
+
+func resourceDedicatedServerIpmiAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	// An ipmi access can't be revoked: it expires after its ttl.
+	log.Printf("[DEBUG] Will remove ipmi access %s from state", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func dedicatedServerIpmiAccessExists(serviceName, accessType string, c *ovh.Client) error {
+	r := &DedicatedServerIpmiAccess{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/features/ipmi/access?type=%s",
+		serviceName,
+		url.QueryEscape(accessType),
+	)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ipmi access expiring on %s", r.Expiration)
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDedicatedServerIpmiAccessConfig = `
+resource "ovh_dedicated_server_ipmi_access" "kvm" {
+  service_name = "%s"
+  type         = "kvmipHtml5URL"
+  ttl          = 1
+  ip_to_allow  = "192.0.2.1"
+}
+`
+
+func TestAccDedicatedServerIpmiAccess_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckDedicatedServerPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerIpmiAccessConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedServerIpmiAccessExists("ovh_dedicated_server_ipmi_access.kvm", t),
+					resource.TestCheckResourceAttrSet("ovh_dedicated_server_ipmi_access.kvm", "value"),
+					resource.TestCheckResourceAttrSet("ovh_dedicated_server_ipmi_access.kvm", "expiration"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDedicatedServerIpmiAccessExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ipmi access is set")
+		}
+
+		return dedicatedServerIpmiAccessExists(rs.Primary.Attributes["service_name"], rs.Primary.Attributes["type"], config.OVHClient)
+	}
+}
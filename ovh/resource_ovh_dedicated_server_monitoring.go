@@ -0,0 +1,421 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+var dedicatedServerAlertLanguages = []string{"cs", "de", "en", "es", "fi", "fr", "it", "lt", "nl", "pl", "pt"}
+
+func resourceDedicatedServerMonitoring() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerMonitoringCreate,
+		Read:   resourceDedicatedServerMonitoringRead,
+		Update: resourceDedicatedServerMonitoringUpdate,
+		Delete: resourceDedicatedServerMonitoringDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("service_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"monitoring": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"no_intervention": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"email_alert": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"language": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "en",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := validateStringEnum(v.(string), dedicatedServerAlertLanguages)
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+					},
+				},
+			},
+			"sms_alert": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"phone_number": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"sms_account": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"from_hour": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validateDedicatedServerAlertHour,
+						},
+						"to_hour": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      23,
+							ValidateFunc: validateDedicatedServerAlertHour,
+						},
+						"language": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "en",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := validateStringEnum(v.(string), dedicatedServerAlertLanguages)
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+					},
+				},
+			},
+
+			// Computed
+			"service_monitoring_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func validateDedicatedServerAlertHour(v interface{}, k string) (ws []string, errors []error) {
+	if hour := v.(int); hour < 0 || hour > 23 {
+		errors = append(errors, fmt.Errorf("%q must be an hour in 0..23, got %d", k, hour))
+	}
+	return
+}
+
+func resourceDedicatedServerMonitoringCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("service_name").(string))
+
+	return resourceDedicatedServerMonitoringUpdate(d, meta)
+}
+
+func resourceDedicatedServerMonitoringRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Id()
+
+	r := &DedicatedServerMonitoring{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s", serviceName)
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read dedicated server %s %s", serviceName, r)
+
+	d.Set("service_name", serviceName)
+	d.Set("monitoring", r.Monitoring)
+	d.Set("no_intervention", r.NoIntervention)
+
+	ids, emails, smss, err := dedicatedServerMonitoringAlerts(config.OVHClient, serviceName)
+	if err != nil {
+		return err
+	}
+	d.Set("service_monitoring_ids", ids)
+
+	// the alerts are in sync when every service monitoring has the wanted
+	// subscriptions. Otherwise, the ones of the first service monitoring
+	// which hasn't are reported, so that the subscriptions are applied again.
+	wantedEmails := dedicatedServerEmailAlertsFromSchema(d)
+	wantedSmss := dedicatedServerSmsAlertsFromSchema(d)
+	for i := range ids {
+		deleted, created := dedicatedServerAlertsDiff(dedicatedServerEmailAlertKeys(emails[i]), dedicatedServerEmailAlertKeys(wantedEmails))
+		if len(deleted) > 0 || len(created) > 0 {
+			d.Set("email_alert", dedicatedServerEmailAlertsToSchema(emails[i]))
+			break
+		}
+	}
+	for i := range ids {
+		deleted, created := dedicatedServerAlertsDiff(dedicatedServerSmsAlertKeys(smss[i]), dedicatedServerSmsAlertKeys(wantedSmss))
+		if len(deleted) > 0 || len(created) > 0 {
+			d.Set("sms_alert", dedicatedServerSmsAlertsToSchema(smss[i]))
+			break
+		}
+	}
+
+	return nil
+}
+
+func resourceDedicatedServerMonitoringUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Id()
+
+	if d.IsNewResource() || d.HasChange("monitoring") || d.HasChange("no_intervention") {
+		params := &DedicatedServerMonitoring{
+			Monitoring:     d.Get("monitoring").(bool),
+			NoIntervention: d.Get("no_intervention").(bool),
+		}
+		endpoint := fmt.Sprintf("/dedicated/server/%s", serviceName)
+
+		log.Printf("[DEBUG] Will update monitoring of dedicated server %s: %s", serviceName, params)
+
+		if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Put %s with params %s:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	if err := dedicatedServerMonitoringAlertsApply(config.OVHClient, serviceName, dedicatedServerEmailAlertsFromSchema(d), dedicatedServerSmsAlertsFromSchema(d)); err != nil {
+		return err
+	}
+
+	return resourceDedicatedServerMonitoringRead(d, meta)
+}
+
+func resourceDedicatedServerMonitoringDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Id()
+
+	// the alert subscriptions are removed, the monitoring flags are left as is
+	if err := dedicatedServerMonitoringAlertsApply(config.OVHClient, serviceName, nil, nil); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dedicatedServerEmailAlertsFromSchema(d *schema.ResourceData) []*DedicatedServerEmailAlert {
+	alerts := []*DedicatedServerEmailAlert{}
+	for _, v := range d.Get("email_alert").(*schema.Set).List() {
+		alert := v.(map[string]interface{})
+		alerts = append(alerts, &DedicatedServerEmailAlert{
+			Email:    alert["email"].(string),
+			Language: alert["language"].(string),
+		})
+	}
+	return alerts
+}
+
+func dedicatedServerSmsAlertsFromSchema(d *schema.ResourceData) []*DedicatedServerSmsAlert {
+	alerts := []*DedicatedServerSmsAlert{}
+	for _, v := range d.Get("sms_alert").(*schema.Set).List() {
+		alert := v.(map[string]interface{})
+		alerts = append(alerts, &DedicatedServerSmsAlert{
+			PhoneNumberTo: alert["phone_number"].(string),
+			SmsAccount:    alert["sms_account"].(string),
+			FromHour:      getNilIntPointer(alert["from_hour"].(int)),
+			ToHour:        getNilIntPointer(alert["to_hour"].(int)),
+			Language:      alert["language"].(string),
+		})
+	}
+	return alerts
+}
+
+func dedicatedServerEmailAlertsToSchema(alerts []*DedicatedServerEmailAlert) []interface{} {
+	result := make([]interface{}, len(alerts))
+	for i, alert := range alerts {
+		result[i] = map[string]interface{}{
+			"email":    alert.Email,
+			"language": alert.Language,
+		}
+	}
+	return result
+}
+
+func dedicatedServerSmsAlertsToSchema(alerts []*DedicatedServerSmsAlert) []interface{} {
+	result := make([]interface{}, len(alerts))
+	for i, alert := range alerts {
+		fromHour, toHour := 0, 23
+		if alert.FromHour != nil {
+			fromHour = *alert.FromHour
+		}
+		if alert.ToHour != nil {
+			toHour = *alert.ToHour
+		}
+		result[i] = map[string]interface{}{
+			"phone_number": alert.PhoneNumberTo,
+			"sms_account":  alert.SmsAccount,
+			"from_hour":    fromHour,
+			"to_hour":      toHour,
+			"language":     alert.Language,
+		}
+	}
+	return result
+}
+
+func dedicatedServerEmailAlertKeys(alerts []*DedicatedServerEmailAlert) []string {
+	keys := make([]string, len(alerts))
+	for i, alert := range alerts {
+		keys[i] = fmt.Sprintf("%s/%s", strings.ToLower(alert.Email), alert.Language)
+	}
+	return keys
+}
+
+func dedicatedServerSmsAlertKeys(alerts []*DedicatedServerSmsAlert) []string {
+	keys := make([]string, len(alerts))
+	for i, alert := range alerts {
+		fromHour, toHour := 0, 23
+		if alert.FromHour != nil {
+			fromHour = *alert.FromHour
+		}
+		if alert.ToHour != nil {
+			toHour = *alert.ToHour
+		}
+		keys[i] = fmt.Sprintf("%s/%s/%d-%d/%s", alert.PhoneNumberTo, alert.SmsAccount, fromHour, toHour, alert.Language)
+	}
+	return keys
+}
+
+// dedicatedServerAlertsDiff returns the indexes of the existing alerts to
+// delete and of the wanted alerts to create, alerts being identified by
+// their keys.
+func dedicatedServerAlertsDiff(existing, wanted []string) ([]int, []int) {
+	wantedKeys := map[string]bool{}
+	for _, key := range wanted {
+		wantedKeys[key] = true
+	}
+
+	deleted := []int{}
+	existingKeys := map[string]bool{}
+	for i, key := range existing {
+		if !wantedKeys[key] || existingKeys[key] {
+			deleted = append(deleted, i)
+			continue
+		}
+		existingKeys[key] = true
+	}
+
+	created := []int{}
+	for i, key := range wanted {
+		if !existingKeys[key] {
+			created = append(created, i)
+			existingKeys[key] = true
+		}
+	}
+
+	return deleted, created
+}
+
+// dedicatedServerMonitoringAlerts returns the service monitorings of a server
+// with their email and sms alerts.
+func dedicatedServerMonitoringAlerts(c *ovh.Client, serviceName string) ([]int64, [][]*DedicatedServerEmailAlert, [][]*DedicatedServerSmsAlert, error) {
+	ids := []int64{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/serviceMonitoring", serviceName)
+	if err := c.Get(endpoint, &ids); err != nil {
+		return nil, nil, nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	emails := make([][]*DedicatedServerEmailAlert, len(ids))
+	smss := make([][]*DedicatedServerSmsAlert, len(ids))
+	err := fetchConcurrently(len(ids), func(i int) error {
+		monitoringEndpoint := fmt.Sprintf("%s/%d", endpoint, ids[i])
+
+		emailIds := []int64{}
+		if err := c.Get(monitoringEndpoint+"/alert/email", &emailIds); err != nil {
+			return fmt.Errorf("calling Get %s/alert/email:\n\t %q", monitoringEndpoint, err)
+		}
+		emails[i] = make([]*DedicatedServerEmailAlert, len(emailIds))
+		for j, id := range emailIds {
+			alert := &DedicatedServerEmailAlert{}
+			if err := c.Get(fmt.Sprintf("%s/alert/email/%d", monitoringEndpoint, id), alert); err != nil {
+				return fmt.Errorf("calling Get %s/alert/email/%d:\n\t %q", monitoringEndpoint, id, err)
+			}
+			emails[i][j] = alert
+		}
+
+		smsIds := []int64{}
+		if err := c.Get(monitoringEndpoint+"/alert/sms", &smsIds); err != nil {
+			return fmt.Errorf("calling Get %s/alert/sms:\n\t %q", monitoringEndpoint, err)
+		}
+		smss[i] = make([]*DedicatedServerSmsAlert, len(smsIds))
+		for j, id := range smsIds {
+			alert := &DedicatedServerSmsAlert{}
+			if err := c.Get(fmt.Sprintf("%s/alert/sms/%d", monitoringEndpoint, id), alert); err != nil {
+				return fmt.Errorf("calling Get %s/alert/sms/%d:\n\t %q", monitoringEndpoint, id, err)
+			}
+			smss[i][j] = alert
+		}
+
+		log.Printf("[DEBUG] Read %d email and %d sms alerts on service monitoring %d of %s", len(emails[i]), len(smss[i]), ids[i], serviceName)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return ids, emails, smss, nil
+}
+
+// dedicatedServerMonitoringAlertsApply subscribes every service monitoring of
+// a server to the wanted alerts, and removes the other subscriptions.
+func dedicatedServerMonitoringAlertsApply(c *ovh.Client, serviceName string, wantedEmails []*DedicatedServerEmailAlert, wantedSmss []*DedicatedServerSmsAlert) error {
+	ids, emails, smss, err := dedicatedServerMonitoringAlerts(c, serviceName)
+	if err != nil {
+		return err
+	}
+
+	return fetchConcurrently(len(ids), func(i int) error {
+		endpoint := fmt.Sprintf("/dedicated/server/%s/serviceMonitoring/%d/alert", serviceName, ids[i])
+
+		deleted, created := dedicatedServerAlertsDiff(dedicatedServerEmailAlertKeys(emails[i]), dedicatedServerEmailAlertKeys(wantedEmails))
+		for _, j := range deleted {
+			log.Printf("[DEBUG] Will delete alert %s of %s", emails[i][j], endpoint)
+			if err := c.Delete(fmt.Sprintf("%s/email/%d", endpoint, emails[i][j].AlertId), nil); err != nil {
+				return fmt.Errorf("calling Delete %s/email/%d:\n\t %q", endpoint, emails[i][j].AlertId, err)
+			}
+		}
+		for _, j := range created {
+			params := wantedEmails[j]
+			log.Printf("[DEBUG] Will create alert %s on %s", params, endpoint)
+			if err := c.Post(endpoint+"/email", params, nil); err != nil {
+				return fmt.Errorf("calling Post %s/email with params %s:\n\t %q", endpoint, params, err)
+			}
+		}
+
+		deleted, created = dedicatedServerAlertsDiff(dedicatedServerSmsAlertKeys(smss[i]), dedicatedServerSmsAlertKeys(wantedSmss))
+		for _, j := range deleted {
+			log.Printf("[DEBUG] Will delete alert %s of %s", smss[i][j], endpoint)
+			if err := c.Delete(fmt.Sprintf("%s/sms/%d", endpoint, smss[i][j].AlertId), nil); err != nil {
+				return fmt.Errorf("calling Delete %s/sms/%d:\n\t %q", endpoint, smss[i][j].AlertId, err)
+			}
+		}
+		for _, j := range created {
+			params := wantedSmss[j]
+			log.Printf("[DEBUG] Will create alert %s on %s", params, endpoint)
+			if err := c.Post(endpoint+"/sms", params, nil); err != nil {
+				return fmt.Errorf("calling Post %s/sms with params %s:\n\t %q", endpoint, params, err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,85 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccDedicatedServerMonitoringConfig = `
+resource "ovh_dedicated_server_monitoring" "server" {
+  service_name    = "%s"
+  monitoring      = %t
+  no_intervention = true
+
+  email_alert {
+    email    = "oncall@example.com"
+    language = "%s"
+  }
+}
+`
+
+func TestAccDedicatedServerMonitoring_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckDedicatedServerPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerMonitoringConfig, serviceName, true, "en"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_dedicated_server_monitoring.server", "monitoring", "true"),
+					resource.TestCheckResourceAttr("ovh_dedicated_server_monitoring.server", "no_intervention", "true"),
+					resource.TestCheckResourceAttr("ovh_dedicated_server_monitoring.server", "email_alert.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerMonitoringConfig, serviceName, false, "fr"),
+				Check: resource.TestCheckResourceAttr(
+					"ovh_dedicated_server_monitoring.server", "monitoring", "false"),
+			},
+			{
+				ResourceName:      "ovh_dedicated_server_monitoring.server",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestDedicatedServerAlertsDiff(t *testing.T) {
+	cases := []struct {
+		existing []string
+		wanted   []string
+		deleted  []int
+		created  []int
+	}{
+		{[]string{}, []string{"a", "b"}, []int{}, []int{0, 1}},
+		{[]string{"a", "b"}, []string{"a", "b"}, []int{}, []int{}},
+		{[]string{"a", "c"}, []string{"a", "b"}, []int{1}, []int{1}},
+		{[]string{"a", "a"}, []string{"a"}, []int{1}, []int{}},
+		{[]string{"a"}, []string{}, []int{0}, []int{}},
+	}
+
+	for _, c := range cases {
+		deleted, created := dedicatedServerAlertsDiff(c.existing, c.wanted)
+		if !reflect.DeepEqual(deleted, c.deleted) || !reflect.DeepEqual(created, c.created) {
+			t.Errorf("%v to %v: expected %v/%v, got %v/%v", c.existing, c.wanted, c.deleted, c.created, deleted, created)
+		}
+	}
+}
+
+func TestDedicatedServerSmsAlertKeys(t *testing.T) {
+	keys := dedicatedServerSmsAlertKeys([]*DedicatedServerSmsAlert{
+		{PhoneNumberTo: "+33123456789", SmsAccount: "sms-ab12345-1", Language: "en"},
+		{PhoneNumberTo: "+33123456789", SmsAccount: "sms-ab12345-1", FromHour: getNilIntPointer(0), ToHour: getNilIntPointer(23), Language: "en"},
+	})
+
+	if keys[0] != keys[1] {
+		t.Errorf("expected an alert without hours to match the whole day, got %v", keys)
+	}
+}
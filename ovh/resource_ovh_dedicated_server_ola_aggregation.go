@@ -0,0 +1,166 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDedicatedServerOlaAggregation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerOlaAggregationCreate,
+		Read:   resourceDedicatedServerOlaAggregationRead,
+		Delete: resourceDedicatedServerOlaAggregationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"virtual_network_interfaces": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 2,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			// Computed
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vrack": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"network_interface_controllers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerOlaAggregationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	interfaces := []string{}
+	for _, v := range d.Get("virtual_network_interfaces").(*schema.Set).List() {
+		interfaces = append(interfaces, v.(string))
+	}
+
+	params := &DedicatedServerOlaAggregationOpts{
+		Name:                     d.Get("name").(string),
+		VirtualNetworkInterfaces: interfaces,
+	}
+
+	log.Printf("[DEBUG] Will group interfaces of dedicated server %s: %v", serviceName, params)
+
+	task := &DedicatedServerTask{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/ola/aggregation", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := dedicatedServerTaskWait(config.OVHClient, serviceName, task.TaskId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	// the grouped interfaces are replaced by a new virtual network
+	// interface, found by its name.
+	uuids := []string{}
+	endpoint = fmt.Sprintf("/dedicated/server/%s/virtualNetworkInterface?name=%s", serviceName, url.QueryEscape(params.Name))
+	if err := config.OVHClient.Get(endpoint, &uuids); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+	if len(uuids) != 1 {
+		return fmt.Errorf("Expected one virtual network interface named %s on dedicated server %s, got %d", params.Name, serviceName, len(uuids))
+	}
+
+	d.SetId(uuids[0])
+
+	return resourceDedicatedServerOlaAggregationRead(d, meta)
+}
+
+func resourceDedicatedServerOlaAggregationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &DedicatedServerVirtualNetworkInterface{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/virtualNetworkInterface/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read dedicated server %s %s", serviceName, r)
+
+	d.Set("name", r.Name)
+	d.Set("mode", r.Mode)
+	d.Set("vrack", r.Vrack)
+	d.Set("enabled", r.Enabled)
+	d.Set("network_interface_controllers", r.NetworkInterfaceControllers)
+
+	return nil
+}
+
+func resourceDedicatedServerOlaAggregationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &DedicatedServerOlaResetOpts{
+		VirtualNetworkInterface: d.Id(),
+	}
+
+	log.Printf("[DEBUG] Will ungroup interfaces of dedicated server %s: %v", serviceName, params)
+
+	task := &DedicatedServerTask{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/ola/reset", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := dedicatedServerTaskWait(config.OVHClient, serviceName, task.TaskId, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dedicatedServerVirtualNetworkInterfaceExists(serviceName, uuid string, c *ovh.Client) error {
+	r := &DedicatedServerVirtualNetworkInterface{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/virtualNetworkInterface/%s", serviceName, uuid)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read dedicated server virtual network interface: %s", r)
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDedicatedServerOlaAggregationConfig = `
+data "ovh_dedicated_server_network_interface_controllers" "nics" {
+  service_name = "%s"
+}
+
+resource "ovh_dedicated_server_ola_aggregation" "ola" {
+  service_name = "${data.ovh_dedicated_server_network_interface_controllers.nics.service_name}"
+  name         = "%s"
+
+  virtual_network_interfaces = [
+    "${data.ovh_dedicated_server_network_interface_controllers.nics.controllers.0.virtual_network_interface}",
+    "${data.ovh_dedicated_server_network_interface_controllers.nics.controllers.1.virtual_network_interface}",
+  ]
+}
+`
+
+func TestAccDedicatedServerOlaAggregation_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccCheckDedicatedServerPreCheck(t)
+			// grouping interfaces cuts the public network of the server
+			if os.Getenv("OVH_TEST_OLA") != "1" {
+				t.Skip("OVH_TEST_OLA must be set to 1 to test ola aggregations")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDedicatedServerOlaAggregationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerOlaAggregationConfig, serviceName, test_prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedServerOlaAggregationExists("ovh_dedicated_server_ola_aggregation.ola", t),
+					resource.TestCheckResourceAttr("ovh_dedicated_server_ola_aggregation.ola", "name", test_prefix),
+					resource.TestCheckResourceAttr("ovh_dedicated_server_ola_aggregation.ola", "network_interface_controllers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDedicatedServerOlaAggregationExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No virtual network interface is set")
+		}
+
+		return dedicatedServerVirtualNetworkInterfaceExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckDedicatedServerOlaAggregationDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dedicated_server_ola_aggregation" {
+			continue
+		}
+
+		err := dedicatedServerVirtualNetworkInterfaceExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("dedicated server ola aggregation still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDedicatedServerSecondaryDnsDomainImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/DOMAIN formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDedicatedServerSecondaryDnsDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerSecondaryDnsDomainCreate,
+		Read:   resourceDedicatedServerSecondaryDnsDomainRead,
+		Update: resourceDedicatedServerSecondaryDnsDomainUpdate,
+		Delete: resourceDedicatedServerSecondaryDnsDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedServerSecondaryDnsDomainImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainDiff,
+			},
+			"ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"dns": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerSecondaryDnsDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	setNormalized(d, normalizeDomain, "domain")
+
+	serviceName := d.Get("service_name").(string)
+
+	params := &DedicatedServerSecondaryDnsDomainCreateOpts{
+		Domain: d.Get("domain").(string),
+		Ip:     d.Get("ip").(string),
+	}
+
+	log.Printf("[DEBUG] Will create secondary dns domain on dedicated server %s: %v", serviceName, params)
+
+	endpoint := fmt.Sprintf("/dedicated/server/%s/secondaryDnsDomains", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(params.Domain)
+
+	return resourceDedicatedServerSecondaryDnsDomainRead(d, meta)
+}
+
+func resourceDedicatedServerSecondaryDnsDomainRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &DedicatedServerSecondaryDnsDomain{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/secondaryDnsDomains/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read dedicated server %s %s", serviceName, r)
+
+	d.Set("domain", r.Domain)
+	d.Set("ip", r.IpMaster)
+	d.Set("dns", r.Dns)
+	d.Set("creation_date", r.CreationDate)
+
+	return nil
+}
+
+func resourceDedicatedServerSecondaryDnsDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &DedicatedServerSecondaryDnsDomainUpdateOpts{
+		IpMaster: d.Get("ip").(string),
+	}
+
+	log.Printf("[DEBUG] Will update secondary dns domain %s on dedicated server %s: %v", d.Id(), serviceName, params)
+
+	endpoint := fmt.Sprintf("/dedicated/server/%s/secondaryDnsDomains/%s", serviceName, d.Id())
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceDedicatedServerSecondaryDnsDomainRead(d, meta)
+}
+
+func resourceDedicatedServerSecondaryDnsDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will delete secondary dns domain %s on dedicated server %s", d.Id(), serviceName)
+
+	endpoint := fmt.Sprintf("/dedicated/server/%s/secondaryDnsDomains/%s", serviceName, d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dedicatedServerSecondaryDnsDomainExists(serviceName, domain string, c *ovh.Client) error {
+	r := &DedicatedServerSecondaryDnsDomain{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/secondaryDnsDomains/%s", serviceName, domain)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read dedicated server secondary dns domain: %s", r)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDedicatedServerSecondaryDnsDomainConfig = `
+resource "ovh_dedicated_server_secondary_dns_domain" "domain" {
+  service_name = "%s"
+  domain       = "%s"
+}
+`
+
+func TestAccDedicatedServerSecondaryDnsDomain_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+	domain := os.Getenv("OVH_SECONDARY_DNS_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccCheckDedicatedServerPreCheck(t)
+			if domain == "" {
+				t.Skip("OVH_SECONDARY_DNS_DOMAIN must be set to test dedicated server secondary dns domains")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDedicatedServerSecondaryDnsDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerSecondaryDnsDomainConfig, serviceName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedServerSecondaryDnsDomainExists("ovh_dedicated_server_secondary_dns_domain.domain", t),
+					resource.TestCheckResourceAttr("ovh_dedicated_server_secondary_dns_domain.domain", "domain", domain),
+					resource.TestCheckResourceAttrSet("ovh_dedicated_server_secondary_dns_domain.domain", "ip"),
+					resource.TestCheckResourceAttrSet("ovh_dedicated_server_secondary_dns_domain.domain", "dns"),
+				),
+			},
+			{
+				ResourceName:      "ovh_dedicated_server_secondary_dns_domain.domain",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", serviceName, domain),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDedicatedServerSecondaryDnsDomainExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No secondary dns domain is set")
+		}
+
+		return dedicatedServerSecondaryDnsDomainExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckDedicatedServerSecondaryDnsDomainDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dedicated_server_secondary_dns_domain" {
+			continue
+		}
+
+		err := dedicatedServerSecondaryDnsDomainExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("dedicated server secondary dns domain still exists")
+		}
+	}
+	return nil
+}
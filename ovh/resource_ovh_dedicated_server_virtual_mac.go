@@ -0,0 +1,201 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDedicatedServerVirtualMacImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/MAC_ADDRESS formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDedicatedServerVirtualMac() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerVirtualMacCreate,
+		Read:   resourceDedicatedServerVirtualMacRead,
+		Delete: resourceDedicatedServerVirtualMacDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedServerVirtualMacImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "ovh",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"ovh", "vmware"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"virtual_machine_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"mac_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerVirtualMacCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &DedicatedServerVirtualMacCreateOpts{
+		IpAddress:          d.Get("ip_address").(string),
+		Type:               d.Get("type").(string),
+		VirtualMachineName: d.Get("virtual_machine_name").(string),
+	}
+
+	log.Printf("[DEBUG] Will create virtual mac on dedicated server %s: %s", serviceName, params)
+
+	task := &DedicatedServerTask{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/virtualMac", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	if err := dedicatedServerTaskWait(config.OVHClient, serviceName, task.TaskId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	// the task doesn't return the generated mac address: it's looked up
+	// with the ip it's associated with.
+	mac, err := dedicatedServerVirtualMacByIp(config.OVHClient, serviceName, params.IpAddress)
+	if err != nil {
+		return err
+	}
+	if mac == "" {
+		return fmt.Errorf("No virtual mac found for ip %s on dedicated server %s", params.IpAddress, serviceName)
+	}
+
+	d.SetId(mac)
+
+	return resourceDedicatedServerVirtualMacRead(d, meta)
+}
+
+func resourceDedicatedServerVirtualMacRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &DedicatedServerVirtualMac{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/virtualMac/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read dedicated server %s %s", serviceName, r)
+
+	d.Set("mac_address", r.MacAddress)
+	d.Set("type", r.Type)
+
+	// on import, the virtual mac is identified by its first address
+	ip := d.Get("ip_address").(string)
+	if ip == "" {
+		ips := []string{}
+		endpoint := fmt.Sprintf("/dedicated/server/%s/virtualMac/%s/virtualAddress", serviceName, d.Id())
+		if err := config.OVHClient.Get(endpoint, &ips); err != nil {
+			return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+		}
+		if len(ips) == 0 {
+			return nil
+		}
+		ip = ips[0]
+	}
+
+	address := &DedicatedServerVirtualMacAddress{}
+	endpoint = fmt.Sprintf("/dedicated/server/%s/virtualMac/%s/virtualAddress/%s", serviceName, d.Id(), ip)
+	if err := config.OVHClient.Get(endpoint, address); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("ip_address", address.IpAddress)
+	d.Set("virtual_machine_name", address.VirtualMachineName)
+
+	return nil
+}
+
+func resourceDedicatedServerVirtualMacDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	ip := d.Get("ip_address").(string)
+
+	// a virtual mac is removed with its last address
+	log.Printf("[DEBUG] Will delete virtual mac %s address %s on dedicated server %s", d.Id(), ip, serviceName)
+
+	task := &DedicatedServerTask{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/virtualMac/%s/virtualAddress/%s", serviceName, d.Id(), ip)
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := dedicatedServerTaskWait(config.OVHClient, serviceName, task.TaskId, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dedicatedServerVirtualMacExists(serviceName, macAddress string, c *ovh.Client) error {
+	r := &DedicatedServerVirtualMac{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/virtualMac/%s", serviceName, macAddress)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read dedicated server virtual mac: %s", r)
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDedicatedServerVirtualMacAddressImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/MAC_ADDRESS/IP_ADDRESS formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("service_name", splitId[0])
+	d.Set("mac_address", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDedicatedServerVirtualMacAddress() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerVirtualMacAddressCreate,
+		Read:   resourceDedicatedServerVirtualMacAddressRead,
+		Delete: resourceDedicatedServerVirtualMacAddressDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedServerVirtualMacAddressImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mac_address": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressMacDiff,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"virtual_machine_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerVirtualMacAddressCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	setNormalized(d, normalizeMac, "mac_address")
+
+	serviceName := d.Get("service_name").(string)
+	macAddress := d.Get("mac_address").(string)
+
+	params := &DedicatedServerVirtualMacAddressCreateOpts{
+		IpAddress:          d.Get("ip_address").(string),
+		VirtualMachineName: d.Get("virtual_machine_name").(string),
+	}
+
+	log.Printf("[DEBUG] Will add address to virtual mac %s on dedicated server %s: %s", macAddress, serviceName, params)
+
+	task := &DedicatedServerTask{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/virtualMac/%s/virtualAddress", serviceName, macAddress)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	if err := dedicatedServerTaskWait(config.OVHClient, serviceName, task.TaskId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(params.IpAddress)
+
+	return resourceDedicatedServerVirtualMacAddressRead(d, meta)
+}
+
+func resourceDedicatedServerVirtualMacAddressRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	macAddress := d.Get("mac_address").(string)
+
+	r := &DedicatedServerVirtualMacAddress{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/virtualMac/%s/virtualAddress/%s", serviceName, macAddress, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read dedicated server %s virtual mac %s %s", serviceName, macAddress, r)
+
+	d.Set("ip_address", r.IpAddress)
+	d.Set("virtual_machine_name", r.VirtualMachineName)
+
+	return nil
+}
+
+func resourceDedicatedServerVirtualMacAddressDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	macAddress := d.Get("mac_address").(string)
+
+	log.Printf("[DEBUG] Will delete virtual mac %s address %s on dedicated server %s", macAddress, d.Id(), serviceName)
+
+	task := &DedicatedServerTask{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/virtualMac/%s/virtualAddress/%s", serviceName, macAddress, d.Id())
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := dedicatedServerTaskWait(config.OVHClient, serviceName, task.TaskId, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dedicatedServerVirtualMacAddressExists(serviceName, macAddress, ip string, c *ovh.Client) error {
+	r := &DedicatedServerVirtualMacAddress{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/virtualMac/%s/virtualAddress/%s", serviceName, macAddress, ip)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read dedicated server virtual mac address: %s", r)
+
+	return nil
+}
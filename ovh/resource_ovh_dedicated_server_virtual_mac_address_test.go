@@ -0,0 +1,94 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDedicatedServerVirtualMacAddressConfig = `
+resource "ovh_dedicated_server_virtual_mac" "vmac" {
+  service_name         = "%s"
+  ip_address           = "%s"
+  virtual_machine_name = "%s"
+}
+
+resource "ovh_dedicated_server_virtual_mac_address" "address" {
+  service_name         = "${ovh_dedicated_server_virtual_mac.vmac.service_name}"
+  mac_address          = "${ovh_dedicated_server_virtual_mac.vmac.mac_address}"
+  ip_address           = "%s"
+  virtual_machine_name = "${ovh_dedicated_server_virtual_mac.vmac.virtual_machine_name}"
+}
+`
+
+func TestAccDedicatedServerVirtualMacAddress_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+	ip := os.Getenv("OVH_FAILOVER_IP")
+	ip2 := os.Getenv("OVH_FAILOVER_IP2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccCheckDedicatedServerVirtualMacPreCheck(t)
+			if ip2 == "" {
+				t.Skip("OVH_FAILOVER_IP2 must be set to test dedicated server virtual mac addresses")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDedicatedServerVirtualMacAddressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerVirtualMacAddressConfig, serviceName, ip, test_prefix, ip2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedServerVirtualMacAddressExists("ovh_dedicated_server_virtual_mac_address.address", t),
+					resource.TestCheckResourceAttr("ovh_dedicated_server_virtual_mac_address.address", "ip_address", ip2),
+					resource.TestCheckResourceAttr("ovh_dedicated_server_virtual_mac_address.address", "virtual_machine_name", test_prefix),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDedicatedServerVirtualMacAddressExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No virtual mac address is set")
+		}
+
+		return dedicatedServerVirtualMacAddressExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["mac_address"],
+			rs.Primary.ID,
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckDedicatedServerVirtualMacAddressDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dedicated_server_virtual_mac_address" {
+			continue
+		}
+
+		err := dedicatedServerVirtualMacAddressExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["mac_address"],
+			rs.Primary.ID,
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("dedicated server virtual mac address still exists")
+		}
+	}
+	return nil
+}
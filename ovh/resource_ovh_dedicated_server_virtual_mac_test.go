@@ -0,0 +1,91 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccDedicatedServerVirtualMacConfig = `
+resource "ovh_dedicated_server_virtual_mac" "vmac" {
+  service_name         = "%s"
+  ip_address           = "%s"
+  virtual_machine_name = "%s"
+}
+`
+
+func TestAccDedicatedServerVirtualMac_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+	ip := os.Getenv("OVH_FAILOVER_IP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckDedicatedServerVirtualMacPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDedicatedServerVirtualMacDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerVirtualMacConfig, serviceName, ip, test_prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedServerVirtualMacExists("ovh_dedicated_server_virtual_mac.vmac", t),
+					resource.TestCheckResourceAttr("ovh_dedicated_server_virtual_mac.vmac", "type", "ovh"),
+					resource.TestCheckResourceAttr("ovh_dedicated_server_virtual_mac.vmac", "ip_address", ip),
+					resource.TestCheckResourceAttrSet("ovh_dedicated_server_virtual_mac.vmac", "mac_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDedicatedServerPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// dedicated servers are optional products
+	// these resources are tested only if env var `OVH_DEDICATED_SERVER`
+	// is set
+	if os.Getenv("OVH_DEDICATED_SERVER") == "" {
+		t.Skip("OVH_DEDICATED_SERVER must be set to test dedicated servers")
+	}
+}
+
+func testAccCheckDedicatedServerVirtualMacPreCheck(t *testing.T) {
+	testAccCheckDedicatedServerPreCheck(t)
+
+	if os.Getenv("OVH_FAILOVER_IP") == "" {
+		t.Skip("OVH_FAILOVER_IP must be set to test dedicated server virtual macs")
+	}
+}
+
+func testAccCheckDedicatedServerVirtualMacExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No virtual mac is set")
+		}
+
+		return dedicatedServerVirtualMacExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckDedicatedServerVirtualMacDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_dedicated_server_virtual_mac" {
+			continue
+		}
+
+		err := dedicatedServerVirtualMacExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("dedicated server virtual mac still exists")
+		}
+	}
+	return nil
+}
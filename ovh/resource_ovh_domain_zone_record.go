@@ -58,14 +58,23 @@ func resourceOvhDomainZoneRecord() *schema.Resource {
 			State: resourceOvhDomainZoneRecordImportState,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: serviceNameCustomizeDiff("zone", serviceNameDomainZone),
+
 		Schema: map[string]*schema.Schema{
 			"zone": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressDomainDiff,
 			},
 			"target": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: resourceOvhDomainZoneRecordTargetDiffSuppress,
 			},
 			"ttl": {
 				Type:     schema.TypeInt,
@@ -80,12 +89,28 @@ func resourceOvhDomainZoneRecord() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"check_propagation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
+// resourceOvhDomainZoneRecordTargetDiffSuppress ignores the notation
+// differences of the IPv6 address targeted by AAAA records.
+func resourceOvhDomainZoneRecordTargetDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("fieldtype").(string) != "AAAA" {
+		return false
+	}
+	return normalizeIp(old) == normalizeIp(new)
+}
+
 func resourceOvhDomainZoneRecordCreate(d *schema.ResourceData, meta interface{}) error {
 	provider := meta.(*Config)
+	setNormalized(d, normalizeDomain, "zone")
+
 	zone := d.Get("zone").(string)
 
 	// Create the new record
@@ -100,55 +125,41 @@ func resourceOvhDomainZoneRecordCreate(d *schema.ResourceData, meta interface{})
 
 	resultRecord := &OvhDomainZoneRecord{}
 
-	err := provider.OVHClient.Post(
-		fmt.Sprintf("/domain/zone/%s/record", zone),
-		newRecord,
-		resultRecord,
-	)
+	err := provider.domainZoneBatcher.Create(zone, func() error {
+		return provider.OVHClient.Post(
+			fmt.Sprintf("/domain/zone/%s/record", zone),
+			newRecord,
+			resultRecord,
+		)
+	})
 
 	if err != nil {
-		return fmt.Errorf("Failed to create OVH Record: %s", err)
+		return fmt.Errorf("Failed to create OVH Record %s on zone %s: %s", ovhDomainZoneRecordName(newRecord), zone, err)
 	}
 
-	// this is an API response BUG known by OVH team
-	// with no planned fix
-	// Workaround is to filter records matching the attributes
-	// and keep the last id if there are doublons
 	if resultRecord.Id == 0 {
-		log.Printf("[WARN] Known OVH API Bug with Inconsistency API result (id = 0): %v", resultRecord)
-		records := make([]int, 0)
-		if err := provider.OVHClient.CallAPI("GET", fmt.Sprintf("/domain/zone/%s/record", zone), newRecord, &records, true); err != nil {
-			return fmt.Errorf("Error calling /domain/zone/%s. Zone may have been left with orphan records!:\n\t %q", zone, err)
-		}
-
-		if len(records) == 0 {
-			return fmt.Errorf("API inconsistency: record creation on zone %s didn't fail but unable to retrieve it.", zone)
-		}
-		// reverse order to keep the last item if found
-		sort.Sort(sort.Reverse(sort.IntSlice(records)))
-		for _, rec := range records {
-			record, err := ovhDomainZoneRecord(provider.OVHClient, zone, strconv.Itoa(rec), true)
-			if err != nil {
-				return fmt.Errorf("Error calling /domain/zone/%s. Zone may have been left with orphan records!:\n\t %q", zone, err)
-			}
-
-			log.Printf("[DEBUG] record found %v", record)
-			if record.Target == newRecord.Target &&
-				record.SubDomain == newRecord.SubDomain &&
-				record.FieldType == newRecord.FieldType {
-				resultRecord = record
-				continue
-			}
+		record, err := ovhDomainZoneRecordFind(provider.OVHClient, zone, newRecord)
+		if err != nil {
+			return err
 		}
-
+		resultRecord = record
 	}
 
 	d.SetId(strconv.Itoa(resultRecord.Id))
 
 	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		if ovhDomainZoneRecordCheckPropagation(d, meta) {
+			return fmt.Errorf("OVH Domain zone refresh after record creation failed: %s", err)
+		}
 		log.Printf("[WARN] OVH Domain zone refresh after record creation failed: %s", err)
 	}
 
+	if ovhDomainZoneRecordCheckPropagation(d, meta) {
+		if err := domainZoneRecordPropagationWait(provider, newRecord, zone, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceOvhDomainZoneRecordRead(d, meta)
 }
 
@@ -171,6 +182,7 @@ func resourceOvhDomainZoneRecordRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceOvhDomainZoneRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 	provider := meta.(*Config)
+	setNormalized(d, normalizeDomain, "zone")
 
 	record := OvhDomainZoneRecord{}
 
@@ -189,20 +201,32 @@ func resourceOvhDomainZoneRecordUpdate(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] OVH Record update configuration: %#v", record)
 
-	err := provider.OVHClient.Put(
-		fmt.Sprintf("/domain/zone/%s/record/%s", d.Get("zone").(string), d.Id()),
-		record,
-		nil,
-	)
+	zone := d.Get("zone").(string)
+	err := provider.domainZoneBatcher.Write(zone, func() error {
+		return provider.OVHClient.Put(
+			fmt.Sprintf("/domain/zone/%s/record/%s", zone, d.Id()),
+			record,
+			nil,
+		)
+	})
 
 	if err != nil {
-		return fmt.Errorf("Failed to update OVH Record: %s", err)
+		return fmt.Errorf("Failed to update OVH Record %s on zone %s: %s", ovhDomainZoneRecordName(&record), zone, err)
 	}
 
 	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		if ovhDomainZoneRecordCheckPropagation(d, meta) {
+			return fmt.Errorf("OVH Domain zone refresh after record update failed: %s", err)
+		}
 		log.Printf("[WARN] OVH Domain zone refresh after record update failed: %s", err)
 	}
 
+	if ovhDomainZoneRecordCheckPropagation(d, meta) {
+		if err := domainZoneRecordPropagationWait(provider, &record, zone, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceOvhDomainZoneRecordRead(d, meta)
 }
 
@@ -211,13 +235,20 @@ func resourceOvhDomainZoneRecordDelete(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[INFO] Deleting OVH Record: %s.%s, %s", d.Get("zone").(string), d.Get("subdomain").(string), d.Id())
 
-	err := provider.OVHClient.Delete(
-		fmt.Sprintf("/domain/zone/%s/record/%s", d.Get("zone").(string), d.Id()),
-		nil,
-	)
+	zone := d.Get("zone").(string)
+	err := provider.domainZoneBatcher.Write(zone, func() error {
+		return provider.OVHClient.Delete(
+			fmt.Sprintf("/domain/zone/%s/record/%s", zone, d.Id()),
+			nil,
+		)
+	})
 
 	if err != nil {
-		return fmt.Errorf("Error deleting OVH Record: %s", err)
+		record := &OvhDomainZoneRecord{
+			SubDomain: d.Get("subdomain").(string),
+			FieldType: d.Get("fieldtype").(string),
+		}
+		return fmt.Errorf("Error deleting OVH Record %s on zone %s: %s", ovhDomainZoneRecordName(record), zone, err)
 	}
 
 	if err := ovhDomainZoneRefresh(d, meta); err != nil {
@@ -227,22 +258,65 @@ func resourceOvhDomainZoneRecordDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+// ovhDomainZoneRefresh refreshes the zone of the resource, sharing the
+// refresh with the other changes made concurrently on the zone.
 func ovhDomainZoneRefresh(d *schema.ResourceData, meta interface{}) error {
 	provider := meta.(*Config)
 
-	log.Printf("[INFO] Refresh OVH Zone: %s", d.Get("zone").(string))
+	return provider.domainZoneBatcher.Refresh(d.Get("zone").(string))
+}
 
-	err := provider.OVHClient.Post(
-		fmt.Sprintf("/domain/zone/%s/refresh", d.Get("zone").(string)),
-		nil,
-		nil,
-	)
+// ovhDomainZoneRecordCheckPropagation returns whether the propagation of the
+// record must be checked, either for all the records of the provider or for
+// this one.
+func ovhDomainZoneRecordCheckPropagation(d *schema.ResourceData, meta interface{}) bool {
+	return meta.(*Config).CheckDomainZonePropagation || d.Get("check_propagation").(bool)
+}
 
-	if err != nil {
-		return fmt.Errorf("Error refresh OVH Zone: %s", err)
+// ovhDomainZoneRecordName names a record in errors by its subdomain and type.
+func ovhDomainZoneRecordName(r *OvhDomainZoneRecord) string {
+	if r.SubDomain == "" {
+		return fmt.Sprintf("@ %s", r.FieldType)
 	}
+	return fmt.Sprintf("%s %s", r.SubDomain, r.FieldType)
+}
 
-	return nil
+// ovhDomainZoneRecordFind looks for a record created on a zone when the API
+// returned it without its id.
+func ovhDomainZoneRecordFind(client *ovh.Client, zone string, newRecord *OvhDomainZoneRecord) (*OvhDomainZoneRecord, error) {
+	// this is an API response BUG known by OVH team
+	// with no planned fix
+	// Workaround is to filter records matching the attributes
+	// and keep the last id if there are doublons
+	log.Printf("[WARN] Known OVH API Bug with Inconsistency API result (id = 0) for %v", newRecord)
+	records := make([]int, 0)
+	if err := client.CallAPI("GET", fmt.Sprintf("/domain/zone/%s/record", zone), newRecord, &records, true); err != nil {
+		return nil, fmt.Errorf("Error calling /domain/zone/%s. Zone may have been left with orphan records!:\n\t %q", zone, err)
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("API inconsistency: record creation on zone %s didn't fail but unable to retrieve it.", zone)
+	}
+
+	resultRecord := &OvhDomainZoneRecord{}
+	// reverse order to keep the last item if found
+	sort.Sort(sort.Reverse(sort.IntSlice(records)))
+	for _, rec := range records {
+		record, err := ovhDomainZoneRecord(client, zone, strconv.Itoa(rec), true)
+		if err != nil {
+			return nil, fmt.Errorf("Error calling /domain/zone/%s. Zone may have been left with orphan records!:\n\t %q", zone, err)
+		}
+
+		log.Printf("[DEBUG] record found %v", record)
+		if record.Target == newRecord.Target &&
+			record.SubDomain == newRecord.SubDomain &&
+			record.FieldType == newRecord.FieldType {
+			resultRecord = record
+			continue
+		}
+	}
+
+	return resultRecord, nil
 }
 
 func ovhDomainZoneRecord(client *ovh.Client, zone string, id string, retry bool) (*OvhDomainZoneRecord, error) {
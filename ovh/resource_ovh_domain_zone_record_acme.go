@@ -0,0 +1,238 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+const domainZoneAcmeChallenge = "_acme-challenge"
+
+func resourceOvhDomainZoneRecordAcme() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOvhDomainZoneRecordAcmeCreate,
+		Read:   resourceOvhDomainZoneRecordAcmeRead,
+		Update: resourceOvhDomainZoneRecordAcmeUpdate,
+		Delete: resourceOvhDomainZoneRecordAcmeDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOvhDomainZoneRecordImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: serviceNameCustomizeDiff("zone", serviceNameDomainZone),
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainDiff,
+			},
+			"subdomain": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return domainZoneAcmeValidatedName(v.(string))
+				},
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if v.(int) < 60 {
+						errors = append(errors, fmt.Errorf("%q must be at least 60 seconds, got %d", k, v.(int)))
+					}
+					return
+				},
+			},
+			"check_propagation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Computed
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// domainZoneAcmeValidatedName returns the name validated by a challenge:
+// the challenge of a wildcard certificate is the one of its parent name.
+func domainZoneAcmeValidatedName(subDomain string) string {
+	subDomain = strings.ToLower(strings.TrimSuffix(subDomain, "."))
+	if subDomain == "*" {
+		return ""
+	}
+	return strings.TrimPrefix(subDomain, "*.")
+}
+
+// domainZoneAcmeRecordSubDomain returns the subdomain of the challenge record
+// of a name of the zone.
+func domainZoneAcmeRecordSubDomain(subDomain string) string {
+	subDomain = domainZoneAcmeValidatedName(subDomain)
+	if subDomain == "" {
+		return domainZoneAcmeChallenge
+	}
+	return fmt.Sprintf("%s.%s", domainZoneAcmeChallenge, subDomain)
+}
+
+func resourceOvhDomainZoneRecordAcmeRecord(d *schema.ResourceData) *OvhDomainZoneRecord {
+	return &OvhDomainZoneRecord{
+		FieldType: "TXT",
+		SubDomain: domainZoneAcmeRecordSubDomain(d.Get("subdomain").(string)),
+		Target:    strconv.Quote(d.Get("value").(string)),
+		Ttl:       d.Get("ttl").(int),
+	}
+}
+
+func resourceOvhDomainZoneRecordAcmeCreate(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*Config)
+	setNormalized(d, normalizeDomain, "zone")
+
+	zone := d.Get("zone").(string)
+
+	newRecord := resourceOvhDomainZoneRecordAcmeRecord(d)
+	resultRecord := &OvhDomainZoneRecord{}
+
+	log.Printf("[DEBUG] Will create acme challenge record %s on zone %s", newRecord.SubDomain, zone)
+
+	err := provider.domainZoneBatcher.Create(zone, func() error {
+		return provider.OVHClient.Post(
+			fmt.Sprintf("/domain/zone/%s/record", zone),
+			newRecord,
+			resultRecord,
+		)
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to create OVH Record %s on zone %s: %s", ovhDomainZoneRecordName(newRecord), zone, err)
+	}
+
+	if resultRecord.Id == 0 {
+		record, err := ovhDomainZoneRecordFind(provider.OVHClient, zone, newRecord)
+		if err != nil {
+			return err
+		}
+		resultRecord = record
+	}
+
+	d.SetId(strconv.Itoa(resultRecord.Id))
+
+	// the challenge is consumed right after its creation: the zone is
+	// refreshed without waiting for the next change.
+	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		return fmt.Errorf("OVH Domain zone refresh after record creation failed: %s", err)
+	}
+
+	if ovhDomainZoneRecordCheckPropagation(d, meta) {
+		if err := domainZoneRecordPropagationWait(provider, newRecord, zone, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	// the record isn't read back: it is only read again on refresh, to keep
+	// the calls made while issuing a certificate to a minimum.
+	d.Set("fqdn", strings.TrimSuffix(domainZoneRecordFqdn(zone, newRecord.SubDomain), "."))
+
+	return nil
+}
+
+func resourceOvhDomainZoneRecordAcmeRead(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	record := &OvhDomainZoneRecord{}
+	endpoint := fmt.Sprintf("/domain/zone/%s/record/%s", zone, d.Id())
+	if err := provider.OVHClient.Get(endpoint, record); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	if record.FieldType != "TXT" || !strings.HasPrefix(record.SubDomain, domainZoneAcmeChallenge) {
+		return fmt.Errorf("Record %s of zone %s isn't an acme challenge: %s", d.Id(), zone, record)
+	}
+
+	d.Set("zone", record.Zone)
+	d.Set("subdomain", strings.TrimPrefix(strings.TrimPrefix(record.SubDomain, domainZoneAcmeChallenge), "."))
+	d.Set("value", domainZoneTxtValue(record.Target))
+	d.Set("ttl", record.Ttl)
+	d.Set("fqdn", strings.TrimSuffix(domainZoneRecordFqdn(record.Zone, record.SubDomain), "."))
+
+	return nil
+}
+
+func resourceOvhDomainZoneRecordAcmeUpdate(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	record := resourceOvhDomainZoneRecordAcmeRecord(d)
+
+	log.Printf("[DEBUG] Will update acme challenge record %s on zone %s", record.SubDomain, zone)
+
+	err := provider.domainZoneBatcher.Write(zone, func() error {
+		return provider.OVHClient.Put(
+			fmt.Sprintf("/domain/zone/%s/record/%s", zone, d.Id()),
+			record,
+			nil,
+		)
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update OVH Record %s on zone %s: %s", ovhDomainZoneRecordName(record), zone, err)
+	}
+
+	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		return fmt.Errorf("OVH Domain zone refresh after record update failed: %s", err)
+	}
+
+	if ovhDomainZoneRecordCheckPropagation(d, meta) {
+		if err := domainZoneRecordPropagationWait(provider, record, zone, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceOvhDomainZoneRecordAcmeDelete(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	log.Printf("[DEBUG] Will delete acme challenge record %s on zone %s", d.Id(), zone)
+
+	// a challenge already cleaned up out of terraform is considered deleted
+	err := provider.domainZoneBatcher.Write(zone, func() error {
+		err := provider.OVHClient.Delete(fmt.Sprintf("/domain/zone/%s/record/%s", zone, d.Id()), nil)
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting OVH Record %s on zone %s: %s", d.Id(), zone, err)
+	}
+
+	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		log.Printf("[WARN] OVH Domain zone refresh after record deletion failed: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,63 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOvhDomainZoneRecordAcme_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE")
+	subdomain := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOvhDomainZoneRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccOvhDomainZoneRecordAcmeConfig, zone, subdomain, "first-digest"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_record_acme.challenge", "subdomain", subdomain),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_record_acme.challenge", "fqdn", fmt.Sprintf("_acme-challenge.%s.%s", subdomain, zone)),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_record_acme.challenge", "ttl", "60"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccOvhDomainZoneRecordAcmeConfig, zone, subdomain, "second-digest"),
+				Check: resource.TestCheckResourceAttr(
+					"ovh_domain_zone_record_acme.challenge", "value", "second-digest"),
+			},
+		},
+	})
+}
+
+func TestDomainZoneAcmeRecordSubDomain(t *testing.T) {
+	cases := map[string]string{
+		"":          "_acme-challenge",
+		"*":         "_acme-challenge",
+		"www":       "_acme-challenge.www",
+		"*.www":     "_acme-challenge.www",
+		"WWW.Shop.": "_acme-challenge.www.shop",
+	}
+
+	for subDomain, expected := range cases {
+		if name := domainZoneAcmeRecordSubDomain(subDomain); name != expected {
+			t.Errorf("%q: expected %s, got %s", subDomain, expected, name)
+		}
+	}
+}
+
+const testAccOvhDomainZoneRecordAcmeConfig = `
+resource "ovh_domain_zone_record_acme" "challenge" {
+  zone      = "%s"
+  subdomain = "*.%s"
+  value     = "%s"
+}
+`
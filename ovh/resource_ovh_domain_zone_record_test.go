@@ -120,6 +120,33 @@ func TestAccOvhDomainZoneRecord_Basic(t *testing.T) {
 	})
 }
 
+func TestAccOvhDomainZoneRecord_CheckPropagation(t *testing.T) {
+	var record OvhDomainZoneRecord
+	zone := os.Getenv("OVH_ZONE")
+	subdomain := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOvhDomainZoneRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckOvhDomainZoneRecordConfig_checkPropagation, zone, subdomain, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOvhDomainZoneRecordExists("ovh_domain_zone_record.foobar", &record),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_record.foobar", "check_propagation", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckOvhDomainZoneRecordConfig_checkPropagation, zone, subdomain, "second"),
+				Check: resource.TestCheckResourceAttr(
+					"ovh_domain_zone_record.foobar", "target", "\"second\""),
+			},
+		},
+	})
+}
+
 func TestAccOvhDomainZoneRecord_Updated(t *testing.T) {
 	record := OvhDomainZoneRecord{}
 	zone := os.Getenv("OVH_ZONE")
@@ -254,6 +281,17 @@ resource "ovh_domain_zone_record" "foobar" {
 	ttl = 3600
 }`
 
+const testAccCheckOvhDomainZoneRecordConfig_checkPropagation = `
+resource "ovh_domain_zone_record" "foobar" {
+	zone = "%s"
+	subdomain = "%s"
+	target = "\"%s\""
+	fieldtype = "TXT"
+	ttl = 60
+	check_propagation = true
+}
+`
+
 const testAccCheckOvhDomainZoneRecordConfig_new_value_1 = `
 resource "ovh_domain_zone_record" "foobar" {
 	zone = "%s"
@@ -26,10 +26,13 @@ func resourceOvhDomainZoneRedirection() *schema.Resource {
 		Update: resourceOvhDomainZoneRedirectionUpdate,
 		Delete: resourceOvhDomainZoneRedirectionDelete,
 
+		CustomizeDiff: serviceNameCustomizeDiff("zone", serviceNameDomainZone),
+
 		Schema: map[string]*schema.Schema{
 			"zone": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressDomainDiff,
 			},
 			"target": {
 				Type:     schema.TypeString,
@@ -62,6 +65,7 @@ func resourceOvhDomainZoneRedirection() *schema.Resource {
 
 func resourceOvhDomainZoneRedirectionCreate(d *schema.ResourceData, meta interface{}) error {
 	provider := meta.(*Config)
+	setNormalized(d, normalizeDomain, "zone")
 
 	// Create the new redirection
 	newRedirection := &OvhDomainZoneRedirection{
@@ -125,6 +129,7 @@ func resourceOvhDomainZoneRedirectionRead(d *schema.ResourceData, meta interface
 
 func resourceOvhDomainZoneRedirectionUpdate(d *schema.ResourceData, meta interface{}) error {
 	provider := meta.(*Config)
+	setNormalized(d, normalizeDomain, "zone")
 
 	redirection := OvhDomainZoneRedirection{}
 
@@ -0,0 +1,179 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// DomainZoneSoa is the SOA record of a zone.
+type DomainZoneSoa struct {
+	Email       string `json:"email"`
+	Expire      int    `json:"expire"`
+	NxDomainTtl int    `json:"nxDomainTtl"`
+	Refresh     int    `json:"refresh"`
+	Serial      int64  `json:"serial,omitempty"`
+	Server      string `json:"server,omitempty"`
+	Ttl         int    `json:"ttl"`
+}
+
+func (s *DomainZoneSoa) String() string {
+	return fmt.Sprintf(
+		"soa[server: %s, email: %s, serial: %d, ttl: %d, refresh: %d, expire: %d, nx domain ttl: %d]",
+		s.Server, s.Email, s.Serial, s.Ttl, s.Refresh, s.Expire, s.NxDomainTtl,
+	)
+}
+
+func resourceOvhDomainZoneSoa() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOvhDomainZoneSoaCreate,
+		Read:   resourceOvhDomainZoneSoaRead,
+		Update: resourceOvhDomainZoneSoaUpdate,
+		Delete: resourceOvhDomainZoneSoaDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("zone", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: serviceNameCustomizeDiff("zone", serviceNameDomainZone),
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainDiff,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceOvhDomainZoneSoaValidateDuration,
+			},
+			"refresh": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceOvhDomainZoneSoaValidateDuration,
+			},
+			"expire": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceOvhDomainZoneSoaValidateDuration,
+			},
+			"nx_domain_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceOvhDomainZoneSoaValidateDuration,
+			},
+
+			// Computed
+			"server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"serial": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOvhDomainZoneSoaValidateDuration(v interface{}, k string) (ws []string, errors []error) {
+	if value := v.(int); value <= 0 {
+		errors = append(errors, fmt.Errorf("%s must be a positive number of seconds, got %d", k, value))
+	}
+	return
+}
+
+func resourceOvhDomainZoneSoaCreate(d *schema.ResourceData, meta interface{}) error {
+	setNormalized(d, normalizeDomain, "zone")
+
+	// The SOA comes with the zone: it is only updated.
+	d.SetId(d.Get("zone").(string))
+
+	return resourceOvhDomainZoneSoaUpdate(d, meta)
+}
+
+func resourceOvhDomainZoneSoaRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	r := &DomainZoneSoa{}
+	endpoint := fmt.Sprintf("/domain/zone/%s/soa", zone)
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read zone %s %s", zone, r)
+
+	d.Set("email", r.Email)
+	d.Set("ttl", r.Ttl)
+	d.Set("refresh", r.Refresh)
+	d.Set("expire", r.Expire)
+	d.Set("nx_domain_ttl", r.NxDomainTtl)
+	d.Set("server", r.Server)
+	d.Set("serial", int(r.Serial))
+
+	return nil
+}
+
+func resourceOvhDomainZoneSoaUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+	endpoint := fmt.Sprintf("/domain/zone/%s/soa", zone)
+
+	// the attributes which are not set keep their current value
+	params := &DomainZoneSoa{}
+	if err := config.OVHClient.Get(endpoint, params); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+	if v, ok := d.GetOk("email"); ok {
+		params.Email = v.(string)
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		params.Ttl = v.(int)
+	}
+	if v, ok := d.GetOk("refresh"); ok {
+		params.Refresh = v.(int)
+	}
+	if v, ok := d.GetOk("expire"); ok {
+		params.Expire = v.(int)
+	}
+	if v, ok := d.GetOk("nx_domain_ttl"); ok {
+		params.NxDomainTtl = v.(int)
+	}
+	params.Serial = 0
+	params.Server = ""
+
+	log.Printf("[DEBUG] Will update zone %s %s", zone, params)
+
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		log.Printf("[WARN] OVH Domain zone refresh after SOA update failed: %s", err)
+	}
+
+	return resourceOvhDomainZoneSoaRead(d, meta)
+}
+
+func resourceOvhDomainZoneSoaDelete(d *schema.ResourceData, meta interface{}) error {
+	// The SOA is left as is, it's only removed from the state.
+	log.Printf("[DEBUG] Will remove zone %s SOA from state", d.Id())
+
+	d.SetId("")
+	return nil
+}
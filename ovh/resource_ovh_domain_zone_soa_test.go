@@ -0,0 +1,50 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccDomainZoneSoaConfig = `
+resource "ovh_domain_zone_soa" "soa" {
+  zone          = "%s"
+  nx_domain_ttl = %d
+  refresh       = 3600
+}
+`
+
+func TestAccDomainZoneSoa_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccCheckDomainZoneExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainZoneSoaConfig, zone, 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_domain_zone_soa.soa", "nx_domain_ttl", "300"),
+					resource.TestCheckResourceAttr("ovh_domain_zone_soa.soa", "refresh", "3600"),
+					resource.TestCheckResourceAttrSet("ovh_domain_zone_soa.soa", "server"),
+					resource.TestCheckResourceAttrSet("ovh_domain_zone_soa.soa", "email"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDomainZoneSoaConfig, zone, 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_domain_zone_soa.soa", "nx_domain_ttl", "600"),
+				),
+			},
+			{
+				ResourceName:            "ovh_domain_zone_soa.soa",
+				ImportState:             true,
+				ImportStateId:           zone,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"serial"},
+			},
+		},
+	})
+}
@@ -0,0 +1,214 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceEmailDomainAccountImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not DOMAIN/ACCOUNT_NAME formatted")
+	}
+	d.Set("domain", splitId[0])
+	d.Set("account_name", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceEmailDomainAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEmailDomainAccountCreate,
+		Read:   resourceEmailDomainAccountRead,
+		Update: resourceEmailDomainAccountUpdate,
+		Delete: resourceEmailDomainAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceEmailDomainAccountImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(v.(string))
+				},
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_blocked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func resourceEmailDomainAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	params := &EmailDomainAccountCreateOpts{
+		AccountName: strings.ToLower(d.Get("account_name").(string)),
+		Password:    d.Get("password").(string),
+		Size:        int64(d.Get("size").(int)),
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] Will create email account %s@%s", params.AccountName, domain)
+
+	task := &EmailDomainTask{}
+	endpoint := fmt.Sprintf("/email/domain/%s/account", domain)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s for account %s:\n\t %q", endpoint, params.AccountName, err)
+	}
+
+	status, err := emailDomainTaskWait(config.OVHClient, domain, "account", task.Id)
+	if err != nil {
+		return err
+	}
+	taskSetComputed(d, task.Id, status)
+
+	d.SetId(fmt.Sprintf("%s/%s", domain, params.AccountName))
+
+	return resourceEmailDomainAccountRead(d, meta)
+}
+
+func resourceEmailDomainAccountRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+	accountName := d.Get("account_name").(string)
+
+	r := &EmailDomainAccount{}
+	endpoint := fmt.Sprintf("/email/domain/%s/account/%s", domain, accountName)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read email account %s", r)
+
+	d.Set("account_name", r.AccountName)
+	d.Set("size", r.Size)
+	d.Set("description", r.Description)
+	d.Set("email", r.Email)
+	d.Set("is_blocked", r.IsBlocked)
+	d.Set("state", r.State)
+
+	return nil
+}
+
+func resourceEmailDomainAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+	accountName := d.Get("account_name").(string)
+
+	if d.HasChange("size") || d.HasChange("description") {
+		params := &EmailDomainAccountUpdateOpts{
+			Size:        int64(d.Get("size").(int)),
+			Description: d.Get("description").(string),
+		}
+
+		log.Printf("[DEBUG] Will update email account %s@%s: %v", accountName, domain, params)
+
+		endpoint := fmt.Sprintf("/email/domain/%s/account/%s", domain, accountName)
+		if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	if d.HasChange("password") {
+		params := &EmailDomainAccountChangePasswordOpts{
+			Password: d.Get("password").(string),
+		}
+
+		log.Printf("[DEBUG] Will change password of email account %s@%s", accountName, domain)
+
+		task := &EmailDomainTask{}
+		endpoint := fmt.Sprintf("/email/domain/%s/account/%s/changePassword", domain, accountName)
+		if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+			return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+		}
+
+		status, err := emailDomainTaskWait(config.OVHClient, domain, "account", task.Id)
+		if err != nil {
+			return err
+		}
+		taskSetComputed(d, task.Id, status)
+	}
+
+	return resourceEmailDomainAccountRead(d, meta)
+}
+
+func resourceEmailDomainAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+	accountName := d.Get("account_name").(string)
+
+	log.Printf("[DEBUG] Will delete email account %s@%s", accountName, domain)
+
+	task := &EmailDomainTask{}
+	endpoint := fmt.Sprintf("/email/domain/%s/account/%s", domain, accountName)
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if _, err := emailDomainTaskWait(config.OVHClient, domain, "account", task.Id); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func emailDomainAccountExists(domain, accountName string, c *ovh.Client) error {
+	r := &EmailDomainAccount{}
+	endpoint := fmt.Sprintf("/email/domain/%s/account/%s", domain, accountName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read email account: %s", r)
+
+	return nil
+}
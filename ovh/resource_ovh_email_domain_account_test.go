@@ -0,0 +1,130 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("ovh_email_domain_account", &resource.Sweeper{
+		Name: "ovh_email_domain_account",
+		F:    testSweepEmailDomainAccount,
+	})
+}
+
+func testSweepEmailDomainAccount(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	domain := os.Getenv("OVH_EMAIL_DOMAIN")
+	if domain == "" {
+		log.Print("[DEBUG] OVH_EMAIL_DOMAIN is not set. No email account to sweep")
+		return nil
+	}
+
+	accounts := make([]string, 0)
+	endpoint := fmt.Sprintf("/email/domain/%s/account", domain)
+	if err := client.Get(endpoint, &accounts); err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	for _, account := range accounts {
+		if !strings.HasPrefix(account, test_prefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting email account %s@%s", account, domain)
+		if err := client.Delete(fmt.Sprintf("%s/%s", endpoint, account), nil); err != nil {
+			return fmt.Errorf("Error calling %s/%s:\n\t %q", endpoint, account, err)
+		}
+	}
+
+	return nil
+}
+
+const testAccEmailDomainAccountConfig = `
+resource "ovh_email_domain_account" "account" {
+  domain       = "%s"
+  account_name = "%s"
+  password     = "Tf-Acc-Test-1"
+  description  = "%s"
+}
+`
+
+func TestAccEmailDomainAccount_basic(t *testing.T) {
+	domain := os.Getenv("OVH_EMAIL_DOMAIN")
+	accountName := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckEmailDomainPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEmailDomainAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccEmailDomainAccountConfig, domain, accountName, "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailDomainAccountExists("ovh_email_domain_account.account", t),
+					resource.TestCheckResourceAttr("ovh_email_domain_account.account", "email", fmt.Sprintf("%s@%s", accountName, domain)),
+					resource.TestCheckResourceAttr("ovh_email_domain_account.account", "description", "created by terraform"),
+					resource.TestCheckResourceAttrSet("ovh_email_domain_account.account", "size"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccEmailDomainAccountConfig, domain, accountName, "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailDomainAccountExists("ovh_email_domain_account.account", t),
+					resource.TestCheckResourceAttr("ovh_email_domain_account.account", "description", "updated by terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEmailDomainAccountExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No email account id is set")
+		}
+
+		return emailDomainAccountExists(
+			rs.Primary.Attributes["domain"],
+			rs.Primary.Attributes["account_name"],
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckEmailDomainAccountDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_email_domain_account" {
+			continue
+		}
+
+		err := emailDomainAccountExists(
+			rs.Primary.Attributes["domain"],
+			rs.Primary.Attributes["account_name"],
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("email account still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,324 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceEmailDomainMailingListImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not DOMAIN/NAME formatted")
+	}
+	d.Set("domain", splitId[0])
+	d.Set("name", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceEmailDomainMailingList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEmailDomainMailingListCreate,
+		Read:   resourceEmailDomainMailingListRead,
+		Update: resourceEmailDomainMailingListUpdate,
+		Delete: resourceEmailDomainMailingListDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceEmailDomainMailingListImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"owner_email": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"language": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "en",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"de", "en", "es", "fr", "it", "nl", "pl", "pt"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"reply_to": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "mailingList",
+			},
+			"moderator_message": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"subscribe_by_moderator": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"users_post_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"moderators": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"subscribers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			// Computed
+			"nb_subscribers": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func emailDomainMailingListOptions(d *schema.ResourceData) *EmailDomainMailingListOptions {
+	return &EmailDomainMailingListOptions{
+		ModeratorMessage:     d.Get("moderator_message").(bool),
+		SubscribeByModerator: d.Get("subscribe_by_moderator").(bool),
+		UsersPostOnly:        d.Get("users_post_only").(bool),
+	}
+}
+
+func resourceEmailDomainMailingListCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	params := &EmailDomainMailingListCreateOpts{
+		Name:       d.Get("name").(string),
+		Language:   d.Get("language").(string),
+		OwnerEmail: d.Get("owner_email").(string),
+		ReplyTo:    d.Get("reply_to").(string),
+		Options:    emailDomainMailingListOptions(d),
+	}
+
+	log.Printf("[DEBUG] Will create mailing list %s on domain %s", params.Name, domain)
+
+	task := &EmailDomainTask{}
+	endpoint := fmt.Sprintf("/email/domain/%s/mailingList", domain)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	status, err := emailDomainTaskWait(config.OVHClient, domain, "mailinglist", task.Id)
+	if err != nil {
+		return err
+	}
+	taskSetComputed(d, task.Id, status)
+
+	d.SetId(fmt.Sprintf("%s/%s", domain, params.Name))
+
+	for _, member := range []string{"moderator", "subscriber"} {
+		if err := emailDomainMailingListSyncMembers(d, config.OVHClient, member); err != nil {
+			return err
+		}
+	}
+
+	return resourceEmailDomainMailingListRead(d, meta)
+}
+
+func resourceEmailDomainMailingListRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+	name := d.Get("name").(string)
+
+	r := &EmailDomainMailingList{}
+	endpoint := fmt.Sprintf("/email/domain/%s/mailingList/%s", domain, name)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read mailing list %s", r)
+
+	d.Set("name", r.Name)
+	d.Set("language", r.Language)
+	d.Set("owner_email", r.OwnerEmail)
+	d.Set("reply_to", r.ReplyTo)
+	d.Set("nb_subscribers", r.NbSubscribers)
+	if r.Options != nil {
+		d.Set("moderator_message", r.Options.ModeratorMessage)
+		d.Set("subscribe_by_moderator", r.Options.SubscribeByModerator)
+		d.Set("users_post_only", r.Options.UsersPostOnly)
+	}
+
+	moderators := []string{}
+	endpoint = fmt.Sprintf("/email/domain/%s/mailingList/%s/moderator", domain, name)
+	if err := config.OVHClient.Get(endpoint, &moderators); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+	d.Set("moderators", moderators)
+
+	subscribers := []string{}
+	endpoint = fmt.Sprintf("/email/domain/%s/mailingList/%s/subscriber", domain, name)
+	if err := config.OVHClient.Get(endpoint, &subscribers); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+	d.Set("subscribers", subscribers)
+
+	return nil
+}
+
+func resourceEmailDomainMailingListUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+	name := d.Get("name").(string)
+
+	if d.HasChange("language") || d.HasChange("owner_email") || d.HasChange("reply_to") {
+		params := &EmailDomainMailingListUpdateOpts{
+			Language:   d.Get("language").(string),
+			OwnerEmail: d.Get("owner_email").(string),
+			ReplyTo:    d.Get("reply_to").(string),
+		}
+
+		log.Printf("[DEBUG] Will update mailing list %s on domain %s: %v", name, domain, params)
+
+		endpoint := fmt.Sprintf("/email/domain/%s/mailingList/%s", domain, name)
+		if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	if d.HasChange("moderator_message") || d.HasChange("subscribe_by_moderator") || d.HasChange("users_post_only") {
+		params := &EmailDomainMailingListChangeOptionsOpts{
+			Options: emailDomainMailingListOptions(d),
+		}
+
+		log.Printf("[DEBUG] Will change options of mailing list %s on domain %s: %v", name, domain, params.Options)
+
+		task := &EmailDomainTask{}
+		endpoint := fmt.Sprintf("/email/domain/%s/mailingList/%s/changeOptions", domain, name)
+		if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+			return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+		}
+
+		status, err := emailDomainTaskWait(config.OVHClient, domain, "mailinglist", task.Id)
+		if err != nil {
+			return err
+		}
+		taskSetComputed(d, task.Id, status)
+	}
+
+	for _, member := range []string{"moderator", "subscriber"} {
+		if err := emailDomainMailingListSyncMembers(d, config.OVHClient, member); err != nil {
+			return err
+		}
+	}
+
+	return resourceEmailDomainMailingListRead(d, meta)
+}
+
+func resourceEmailDomainMailingListDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Will delete mailing list %s on domain %s", name, domain)
+
+	task := &EmailDomainTask{}
+	endpoint := fmt.Sprintf("/email/domain/%s/mailingList/%s", domain, name)
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if _, err := emailDomainTaskWait(config.OVHClient, domain, "mailinglist", task.Id); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// emailDomainMailingListSyncMembers adds and removes the moderators or
+// subscribers of a mailing list to match the configuration.
+func emailDomainMailingListSyncMembers(d *schema.ResourceData, c *ovh.Client, member string) error {
+	domain := d.Get("domain").(string)
+	name := d.Get("name").(string)
+	key := member + "s"
+
+	if !d.HasChange(key) {
+		return nil
+	}
+
+	o, n := d.GetChange(key)
+	oldSet := o.(*schema.Set)
+	newSet := n.(*schema.Set)
+
+	endpoint := fmt.Sprintf("/email/domain/%s/mailingList/%s/%s", domain, name, member)
+
+	for _, email := range oldSet.Difference(newSet).List() {
+		log.Printf("[DEBUG] Will remove %s %s from mailing list %s", member, email, name)
+
+		task := &EmailDomainTask{}
+		if err := c.Delete(fmt.Sprintf("%s/%s", endpoint, email.(string)), task); err != nil {
+			return fmt.Errorf("calling Delete %s/%s:\n\t %q", endpoint, email.(string), err)
+		}
+
+		if _, err := emailDomainTaskWait(c, domain, "mailinglist", task.Id); err != nil {
+			return err
+		}
+	}
+
+	for _, email := range newSet.Difference(oldSet).List() {
+		log.Printf("[DEBUG] Will add %s %s to mailing list %s", member, email, name)
+
+		params := &EmailDomainMailingListMemberOpts{Email: email.(string)}
+		task := &EmailDomainTask{}
+		if err := c.Post(endpoint, params, task); err != nil {
+			return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+		}
+
+		if _, err := emailDomainTaskWait(c, domain, "mailinglist", task.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func emailDomainMailingListExists(domain, name string, c *ovh.Client) error {
+	r := &EmailDomainMailingList{}
+	endpoint := fmt.Sprintf("/email/domain/%s/mailingList/%s", domain, name)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read mailing list: %s", r)
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccEmailDomainMailingListConfig = `
+resource "ovh_email_domain_mailing_list" "list" {
+  domain          = "%s"
+  name            = "%s"
+  owner_email     = "owner@example.com"
+  users_post_only = %v
+  moderators      = ["owner@example.com"]
+  subscribers     = [%s]
+}
+`
+
+func TestAccEmailDomainMailingList_basic(t *testing.T) {
+	domain := os.Getenv("OVH_EMAIL_DOMAIN")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckEmailDomainPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEmailDomainMailingListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccEmailDomainMailingListConfig, domain, name, false, `"alice@example.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailDomainMailingListExists("ovh_email_domain_mailing_list.list", t),
+					resource.TestCheckResourceAttr("ovh_email_domain_mailing_list.list", "users_post_only", "false"),
+					resource.TestCheckResourceAttr("ovh_email_domain_mailing_list.list", "moderators.#", "1"),
+					resource.TestCheckResourceAttr("ovh_email_domain_mailing_list.list", "subscribers.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccEmailDomainMailingListConfig, domain, name, true, `"bob@example.com", "carol@example.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailDomainMailingListExists("ovh_email_domain_mailing_list.list", t),
+					resource.TestCheckResourceAttr("ovh_email_domain_mailing_list.list", "users_post_only", "true"),
+					resource.TestCheckResourceAttr("ovh_email_domain_mailing_list.list", "subscribers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEmailDomainMailingListExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No mailing list id is set")
+		}
+
+		return emailDomainMailingListExists(
+			rs.Primary.Attributes["domain"],
+			rs.Primary.Attributes["name"],
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckEmailDomainMailingListDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_email_domain_mailing_list" {
+			continue
+		}
+
+		err := emailDomainMailingListExists(
+			rs.Primary.Attributes["domain"],
+			rs.Primary.Attributes["name"],
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("mailing list still exists")
+		}
+	}
+	return nil
+}
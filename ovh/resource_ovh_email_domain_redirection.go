@@ -0,0 +1,187 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceEmailDomainRedirectionImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not DOMAIN/ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("domain", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceEmailDomainRedirection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEmailDomainRedirectionCreate,
+		Read:   resourceEmailDomainRedirectionRead,
+		Update: resourceEmailDomainRedirectionUpdate,
+		Delete: resourceEmailDomainRedirectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceEmailDomainRedirectionImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"from": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"to": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"local_copy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func resourceEmailDomainRedirectionCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	params := &EmailDomainRedirectionCreateOpts{
+		From:      d.Get("from").(string),
+		To:        d.Get("to").(string),
+		LocalCopy: d.Get("local_copy").(bool),
+	}
+
+	log.Printf("[DEBUG] Will create email redirection on domain %s: %v", domain, params)
+
+	task := &EmailDomainTask{}
+	endpoint := fmt.Sprintf("/email/domain/%s/redirection", domain)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	status, err := emailDomainTaskWait(config.OVHClient, domain, "redirection", task.Id)
+	if err != nil {
+		return err
+	}
+	taskSetComputed(d, task.Id, status)
+
+	// The id of the redirection isn't returned by the API,
+	// it's looked up from its source and destination.
+	ids := []string{}
+	endpoint = fmt.Sprintf(
+		"/email/domain/%s/redirection?from=%s&to=%s",
+		domain,
+		url.QueryEscape(params.From),
+		url.QueryEscape(params.To),
+	)
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	if len(ids) == 0 {
+		return fmt.Errorf("email redirection from %s to %s not found after creation", params.From, params.To)
+	}
+
+	d.SetId(ids[0])
+
+	return resourceEmailDomainRedirectionRead(d, meta)
+}
+
+func resourceEmailDomainRedirectionRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	r := &EmailDomainRedirection{}
+	endpoint := fmt.Sprintf("/email/domain/%s/redirection/%s", domain, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read email redirection %s", r)
+
+	d.Set("from", r.From)
+	d.Set("to", r.To)
+
+	return nil
+}
+
+func resourceEmailDomainRedirectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	params := &EmailDomainRedirectionChangeOpts{
+		To: d.Get("to").(string),
+	}
+
+	log.Printf("[DEBUG] Will update email redirection %s on domain %s: %v", d.Id(), domain, params)
+
+	task := &EmailDomainTask{}
+	endpoint := fmt.Sprintf("/email/domain/%s/redirection/%s/changeRedirection", domain, d.Id())
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	status, err := emailDomainTaskWait(config.OVHClient, domain, "redirection", task.Id)
+	if err != nil {
+		return err
+	}
+	taskSetComputed(d, task.Id, status)
+
+	return resourceEmailDomainRedirectionRead(d, meta)
+}
+
+func resourceEmailDomainRedirectionDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	log.Printf("[DEBUG] Will delete email redirection %s on domain %s", d.Id(), domain)
+
+	task := &EmailDomainTask{}
+	endpoint := fmt.Sprintf("/email/domain/%s/redirection/%s", domain, d.Id())
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if _, err := emailDomainTaskWait(config.OVHClient, domain, "redirection", task.Id); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func emailDomainRedirectionExists(domain, id string, c *ovh.Client) error {
+	r := &EmailDomainRedirection{}
+	endpoint := fmt.Sprintf("/email/domain/%s/redirection/%s", domain, id)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read email redirection: %s", r)
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccEmailDomainRedirectionConfig = `
+resource "ovh_email_domain_redirection" "redirection" {
+  domain = "%s"
+  from   = "%s@%s"
+  to     = "%s"
+}
+`
+
+func TestAccEmailDomainRedirection_basic(t *testing.T) {
+	domain := os.Getenv("OVH_EMAIL_DOMAIN")
+	from := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckEmailDomainPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEmailDomainRedirectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccEmailDomainRedirectionConfig, domain, from, domain, "noreply@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailDomainRedirectionExists("ovh_email_domain_redirection.redirection", t),
+					resource.TestCheckResourceAttr("ovh_email_domain_redirection.redirection", "to", "noreply@example.com"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccEmailDomainRedirectionConfig, domain, from, domain, "contact@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailDomainRedirectionExists("ovh_email_domain_redirection.redirection", t),
+					resource.TestCheckResourceAttr("ovh_email_domain_redirection.redirection", "to", "contact@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEmailDomainRedirectionExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No email redirection id is set")
+		}
+
+		return emailDomainRedirectionExists(rs.Primary.Attributes["domain"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckEmailDomainRedirectionDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_email_domain_redirection" {
+			continue
+		}
+
+		err := emailDomainRedirectionExists(rs.Primary.Attributes["domain"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("email redirection still exists")
+		}
+	}
+	return nil
+}
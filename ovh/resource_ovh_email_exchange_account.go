@@ -0,0 +1,263 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceEmailExchangeAccountImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not ORGANIZATION_NAME/SERVICE_NAME/EMAIL formatted")
+	}
+	email := strings.SplitN(splitId[2], "@", 2)
+	if len(email) != 2 {
+		return nil, fmt.Errorf("%s is not a valid email address", splitId[2])
+	}
+	d.Set("organization_name", splitId[0])
+	d.Set("service_name", splitId[1])
+	d.Set("login", email[0])
+	d.Set("domain", email[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceEmailExchangeAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEmailExchangeAccountCreate,
+		Read:   resourceEmailExchangeAccountRead,
+		Update: resourceEmailExchangeAccountUpdate,
+		Delete: resourceEmailExchangeAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceEmailExchangeAccountImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"login": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"license": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "standard",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"basic", "standard", "enterprise"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"first_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"quota": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			"primary_email_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_usage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func emailExchangeAccountEndpoint(d *schema.ResourceData) string {
+	return fmt.Sprintf(
+		"/email/exchange/%s/service/%s/account/%s@%s",
+		d.Get("organization_name").(string),
+		d.Get("service_name").(string),
+		d.Get("login").(string),
+		d.Get("domain").(string),
+	)
+}
+
+func resourceEmailExchangeAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	organizationName := d.Get("organization_name").(string)
+	serviceName := d.Get("service_name").(string)
+
+	params := &EmailExchangeAccountCreateOpts{
+		Login:       d.Get("login").(string),
+		Domain:      d.Get("domain").(string),
+		Password:    d.Get("password").(string),
+		License:     d.Get("license").(string),
+		DisplayName: d.Get("display_name").(string),
+		FirstName:   d.Get("first_name").(string),
+		LastName:    d.Get("last_name").(string),
+	}
+
+	log.Printf("[DEBUG] Will create exchange account %s@%s on %s/%s", params.Login, params.Domain, organizationName, serviceName)
+
+	task := &EmailExchangeTask{}
+	endpoint := fmt.Sprintf("/email/exchange/%s/service/%s/account", organizationName, serviceName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s for account %s@%s:\n\t %q", endpoint, params.Login, params.Domain, err)
+	}
+
+	if err := emailExchangeTaskWait(config.OVHClient, organizationName, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s@%s", organizationName, serviceName, params.Login, params.Domain))
+
+	if _, ok := d.GetOk("quota"); ok {
+		return resourceEmailExchangeAccountUpdate(d, meta)
+	}
+
+	return resourceEmailExchangeAccountRead(d, meta)
+}
+
+func resourceEmailExchangeAccountRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &EmailExchangeAccount{}
+	endpoint := emailExchangeAccountEndpoint(d)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read exchange account %s", r)
+
+	d.Set("license", r.AccountLicense)
+	d.Set("display_name", r.DisplayName)
+	d.Set("first_name", r.FirstName)
+	d.Set("last_name", r.LastName)
+	d.Set("quota", r.Quota)
+	d.Set("primary_email_address", r.PrimaryEmailAddress)
+	d.Set("current_usage", r.CurrentUsage)
+	d.Set("state", r.State)
+
+	return nil
+}
+
+func resourceEmailExchangeAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	organizationName := d.Get("organization_name").(string)
+	serviceName := d.Get("service_name").(string)
+	endpoint := emailExchangeAccountEndpoint(d)
+
+	if d.HasChange("display_name") || d.HasChange("first_name") || d.HasChange("last_name") || d.HasChange("quota") {
+		params := &EmailExchangeAccountUpdateOpts{
+			DisplayName: d.Get("display_name").(string),
+			FirstName:   d.Get("first_name").(string),
+			LastName:    d.Get("last_name").(string),
+			Quota:       int64(d.Get("quota").(int)),
+		}
+
+		log.Printf("[DEBUG] Will update exchange account %s: %v", endpoint, params)
+
+		if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	// the password is set at creation
+	if d.HasChange("password") && !d.IsNewResource() {
+		params := &EmailAccountChangePasswordOpts{
+			Password: d.Get("password").(string),
+		}
+
+		log.Printf("[DEBUG] Will change password of exchange account %s", endpoint)
+
+		task := &EmailExchangeTask{}
+		if err := config.OVHClient.Post(endpoint+"/changePassword", params, task); err != nil {
+			return fmt.Errorf("calling Post %s/changePassword:\n\t %q", endpoint, err)
+		}
+
+		if err := emailExchangeTaskWait(config.OVHClient, organizationName, serviceName, task.Id); err != nil {
+			return err
+		}
+	}
+
+	return resourceEmailExchangeAccountRead(d, meta)
+}
+
+func resourceEmailExchangeAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	organizationName := d.Get("organization_name").(string)
+	serviceName := d.Get("service_name").(string)
+	endpoint := emailExchangeAccountEndpoint(d)
+
+	log.Printf("[DEBUG] Will delete exchange account %s", endpoint)
+
+	task := &EmailExchangeTask{}
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := emailExchangeTaskWait(config.OVHClient, organizationName, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func emailExchangeAccountExists(organizationName, serviceName, email string, c *ovh.Client) error {
+	r := &EmailExchangeAccount{}
+	endpoint := fmt.Sprintf("/email/exchange/%s/service/%s/account/%s", organizationName, serviceName, email)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read exchange account: %s", r)
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccEmailExchangeAccountConfig = `
+data "ovh_email_exchange" "exchange" {
+  organization_name = "%s"
+  service_name      = "%s"
+}
+
+resource "ovh_email_exchange_account" "account" {
+  organization_name = "${data.ovh_email_exchange.exchange.organization_name}"
+  service_name      = "${data.ovh_email_exchange.exchange.service_name}"
+  login             = "%s"
+  domain            = "${data.ovh_email_exchange.exchange.domain}"
+  password          = "Tf-Acc-Test-1"
+  license           = "basic"
+  display_name      = "%s"
+}
+`
+
+func TestAccEmailExchangeAccount_basic(t *testing.T) {
+	organizationName := os.Getenv("OVH_EMAIL_EXCHANGE_ORGANIZATION")
+	serviceName := os.Getenv("OVH_EMAIL_EXCHANGE_SERVICE")
+	login := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckEmailExchangePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEmailExchangeAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccEmailExchangeAccountConfig, organizationName, serviceName, login, "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailExchangeAccountExists("ovh_email_exchange_account.account", t),
+					resource.TestCheckResourceAttr("ovh_email_exchange_account.account", "display_name", "created by terraform"),
+					resource.TestCheckResourceAttr("ovh_email_exchange_account.account", "license", "basic"),
+					resource.TestCheckResourceAttrSet("ovh_email_exchange_account.account", "quota"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccEmailExchangeAccountConfig, organizationName, serviceName, login, "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailExchangeAccountExists("ovh_email_exchange_account.account", t),
+					resource.TestCheckResourceAttr("ovh_email_exchange_account.account", "display_name", "updated by terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEmailExchangeAccountExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No exchange account id is set")
+		}
+
+		return emailExchangeAccountExists(
+			rs.Primary.Attributes["organization_name"],
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["primary_email_address"],
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckEmailExchangeAccountDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_email_exchange_account" {
+			continue
+		}
+
+		err := emailExchangeAccountExists(
+			rs.Primary.Attributes["organization_name"],
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["primary_email_address"],
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("exchange account still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceEmailProAccountImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/EMAIL formatted")
+	}
+	email := strings.SplitN(splitId[1], "@", 2)
+	if len(email) != 2 {
+		return nil, fmt.Errorf("%s is not a valid email address", splitId[1])
+	}
+	d.Set("service_name", splitId[0])
+	d.Set("login", email[0])
+	d.Set("domain", email[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceEmailProAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEmailProAccountCreate,
+		Read:   resourceEmailProAccountRead,
+		Update: resourceEmailProAccountUpdate,
+		Delete: resourceEmailProAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceEmailProAccountImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"login": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"first_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed
+			"primary_email_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"quota": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"current_usage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func emailProAccountEndpoint(d *schema.ResourceData) string {
+	return fmt.Sprintf(
+		"/email/pro/%s/account/%s@%s",
+		d.Get("service_name").(string),
+		d.Get("login").(string),
+		d.Get("domain").(string),
+	)
+}
+
+// emailProUnconfiguredAccount returns the email address of an account of the
+// service which isn't configured yet.
+func emailProUnconfiguredAccount(c *ovh.Client, serviceName string) (string, error) {
+	emails := []string{}
+	endpoint := fmt.Sprintf("/email/pro/%s/account", serviceName)
+	if err := c.Get(endpoint, &emails); err != nil {
+		return "", fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	for _, email := range emails {
+		r := &EmailProAccount{}
+		if err := c.Get(fmt.Sprintf("%s/%s", endpoint, email), r); err != nil {
+			return "", fmt.Errorf("calling Get %s/%s:\n\t %q", endpoint, email, err)
+		}
+
+		if !r.Configured {
+			return r.PrimaryEmailAddress, nil
+		}
+	}
+
+	return "", fmt.Errorf("No unconfigured account left on email pro %s. Please order more accounts.", serviceName)
+}
+
+func resourceEmailProAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	// Email pro accounts are ordered with the service and delivered
+	// unconfigured. Creating an account configures one of them.
+	email, err := emailProUnconfiguredAccount(config.OVHClient, serviceName)
+	if err != nil {
+		return err
+	}
+
+	params := &EmailProAccountUpdateOpts{
+		Login:       d.Get("login").(string),
+		Domain:      d.Get("domain").(string),
+		DisplayName: d.Get("display_name").(string),
+		FirstName:   d.Get("first_name").(string),
+		LastName:    d.Get("last_name").(string),
+	}
+
+	log.Printf("[DEBUG] Will configure email pro %s account %s as %s@%s", serviceName, email, params.Login, params.Domain)
+
+	endpoint := fmt.Sprintf("/email/pro/%s/account/%s", serviceName, email)
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	newEmail := fmt.Sprintf("%s@%s", params.Login, params.Domain)
+	if err := emailProAccountWait(config.OVHClient, serviceName, newEmail); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, newEmail))
+
+	if err := emailProAccountChangePassword(d, config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceEmailProAccountRead(d, meta)
+}
+
+func resourceEmailProAccountRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &EmailProAccount{}
+	endpoint := emailProAccountEndpoint(d)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read email pro account %s", r)
+
+	d.Set("display_name", r.DisplayName)
+	d.Set("first_name", r.FirstName)
+	d.Set("last_name", r.LastName)
+	d.Set("primary_email_address", r.PrimaryEmailAddress)
+	d.Set("quota", r.Quota)
+	d.Set("current_usage", r.CurrentUsage)
+	d.Set("state", r.State)
+
+	return nil
+}
+
+func resourceEmailProAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	endpoint := emailProAccountEndpoint(d)
+
+	if d.HasChange("display_name") || d.HasChange("first_name") || d.HasChange("last_name") {
+		params := &EmailProAccountUpdateOpts{
+			Login:       d.Get("login").(string),
+			Domain:      d.Get("domain").(string),
+			DisplayName: d.Get("display_name").(string),
+			FirstName:   d.Get("first_name").(string),
+			LastName:    d.Get("last_name").(string),
+		}
+
+		log.Printf("[DEBUG] Will update email pro account %s: %v", endpoint, params)
+
+		if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	if d.HasChange("password") {
+		if err := emailProAccountChangePassword(d, config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceEmailProAccountRead(d, meta)
+}
+
+func resourceEmailProAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	endpoint := emailProAccountEndpoint(d)
+
+	// The account goes back to the unconfigured accounts of the service.
+	log.Printf("[DEBUG] Will reset email pro account %s", endpoint)
+
+	task := &EmailProTask{}
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := emailProTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func emailProAccountChangePassword(d *schema.ResourceData, c *ovh.Client) error {
+	serviceName := d.Get("service_name").(string)
+	endpoint := emailProAccountEndpoint(d) + "/changePassword"
+
+	params := &EmailAccountChangePasswordOpts{
+		Password: d.Get("password").(string),
+	}
+
+	log.Printf("[DEBUG] Will change password of email pro account %s", emailProAccountEndpoint(d))
+
+	task := &EmailProTask{}
+	if err := c.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	return emailProTaskWait(c, serviceName, task.Id)
+}
+
+func emailProAccountExists(serviceName, email string, c *ovh.Client) error {
+	r := &EmailProAccount{}
+	endpoint := fmt.Sprintf("/email/pro/%s/account/%s", serviceName, email)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read email pro account: %s", r)
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccEmailProAccountConfig = `
+data "ovh_email_pro" "pro" {
+  service_name = "%s"
+}
+
+resource "ovh_email_pro_account" "account" {
+  service_name = "${data.ovh_email_pro.pro.service_name}"
+  login        = "%s"
+  domain       = "${data.ovh_email_pro.pro.domain}"
+  password     = "%s"
+  display_name = "%s"
+}
+`
+
+func TestAccEmailProAccount_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_EMAIL_PRO_SERVICE")
+	login := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckEmailProPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEmailProAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccEmailProAccountConfig, serviceName, login, "Tf-Acc-Test-1", "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailProAccountExists("ovh_email_pro_account.account", t),
+					resource.TestCheckResourceAttr("ovh_email_pro_account.account", "display_name", "created by terraform"),
+					resource.TestCheckResourceAttr("ovh_email_pro_account.account", "state", "ok"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccEmailProAccountConfig, serviceName, login, "Tf-Acc-Test-2", "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailProAccountExists("ovh_email_pro_account.account", t),
+					resource.TestCheckResourceAttr("ovh_email_pro_account.account", "display_name", "updated by terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEmailProAccountExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No email pro account id is set")
+		}
+
+		return emailProAccountExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["primary_email_address"],
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckEmailProAccountDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_email_pro_account" {
+			continue
+		}
+
+		err := emailProAccountExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["primary_email_address"],
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("email pro account still configured")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceHostingPrivateDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceHostingPrivateDatabaseCreate,
+		Read:   resourceHostingPrivateDatabaseRead,
+		Update: resourceHostingPrivateDatabaseUpdate,
+		Delete: resourceHostingPrivateDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("service_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: orderCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ovh_subsidiary": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"plan_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "private-sql-512-instance",
+			},
+			"datacenter": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"order_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hostname_ftp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"port_ftp": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"cpu": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ram": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"infrastructure": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHostingPrivateDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	item := &OrderCartItemCreateOpts{
+		PlanCode:    d.Get("plan_code").(string),
+		Duration:    "P1M",
+		PricingMode: "default",
+		Quantity:    1,
+	}
+
+	configuration := map[string]string{
+		"dc":     d.Get("datacenter").(string),
+		"engine": d.Get("engine").(string),
+	}
+
+	log.Printf("[DEBUG] Will order a new private database: %s %v", item, configuration)
+
+	order, err := orderProduct(config.OVHClient, d.Get("ovh_subsidiary").(string), "privateSQL", item, configuration, d.Timeout(schema.TimeoutCreate))
+	if order != nil {
+		d.Set("order_id", order.OrderId)
+	}
+	if err != nil {
+		return fmt.Errorf("Error ordering private database: %s", err)
+	}
+
+	serviceName, err := orderServiceName(config.OVHClient, order.OrderId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving private database from order %d: %s", order.OrderId, err)
+	}
+
+	log.Printf("[DEBUG] Ordered private database %s with order %d", serviceName, order.OrderId)
+
+	d.SetId(serviceName)
+	d.Set("service_name", serviceName)
+
+	if d.Get("display_name").(string) != "" {
+		return resourceHostingPrivateDatabaseUpdate(d, meta)
+	}
+
+	return resourceHostingPrivateDatabaseRead(d, meta)
+}
+
+func resourceHostingPrivateDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &HostingPrivateDatabase{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s", d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read private database %s", r)
+
+	d.Set("service_name", r.ServiceName)
+	d.Set("display_name", r.DisplayName)
+	d.Set("datacenter", r.Datacenter)
+	d.Set("hostname", r.Hostname)
+	d.Set("hostname_ftp", r.HostnameFtp)
+	d.Set("port", r.Port)
+	d.Set("port_ftp", r.PortFtp)
+	d.Set("cpu", r.Cpu)
+	if r.Ram != nil {
+		d.Set("ram", int(r.Ram.Value))
+	}
+	d.Set("infrastructure", r.Infrastructure)
+	d.Set("offer", r.Offer)
+	d.Set("server", r.Server)
+	d.Set("state", r.State)
+	d.Set("type", r.Type)
+	d.Set("version", r.Version)
+
+	return nil
+}
+
+func resourceHostingPrivateDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &HostingPrivateDatabaseUpdateOpts{
+		DisplayName: d.Get("display_name").(string),
+	}
+
+	log.Printf("[DEBUG] Will update private database %s: %v", d.Id(), params)
+
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s", d.Id())
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceHostingPrivateDatabaseRead(d, meta)
+}
+
+func resourceHostingPrivateDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	return serviceTerminate(d, config.OVHClient, fmt.Sprintf("/hosting/privateDatabase/%s", d.Id()))
+}
+
+func hostingPrivateDatabaseExists(serviceName string, c *ovh.Client) error {
+	r := &HostingPrivateDatabase{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s", serviceName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read private database: %s", r)
+
+	return nil
+}
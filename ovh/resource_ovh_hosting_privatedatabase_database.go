@@ -0,0 +1,142 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceHostingPrivateDatabaseDatabaseImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/DATABASE_NAME formatted")
+	}
+	d.Set("service_name", splitId[0])
+	d.Set("database_name", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceHostingPrivateDatabaseDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceHostingPrivateDatabaseDatabaseCreate,
+		Read:   resourceHostingPrivateDatabaseDatabaseRead,
+		Delete: resourceHostingPrivateDatabaseDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceHostingPrivateDatabaseDatabaseImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"quota_used": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHostingPrivateDatabaseDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &HostingPrivateDatabaseDatabaseCreateOpts{
+		DatabaseName: d.Get("database_name").(string),
+	}
+
+	log.Printf("[DEBUG] Will create private database %s database %s", serviceName, params.DatabaseName)
+
+	task := &HostingPrivateDatabaseTask{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/database", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := privateDatabaseTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, params.DatabaseName))
+
+	return resourceHostingPrivateDatabaseDatabaseRead(d, meta)
+}
+
+func resourceHostingPrivateDatabaseDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	r := &HostingPrivateDatabaseDatabase{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/database/%s", serviceName, databaseName)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read private database %s database %s", serviceName, r.DatabaseName)
+
+	d.Set("database_name", r.DatabaseName)
+	d.Set("creation_date", r.CreationDate)
+	if r.QuotaUsed != nil {
+		d.Set("quota_used", r.QuotaUsed.Value)
+	}
+
+	return nil
+}
+
+func resourceHostingPrivateDatabaseDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	log.Printf("[DEBUG] Will delete private database %s database %s", serviceName, databaseName)
+
+	task := &HostingPrivateDatabaseTask{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/database/%s", serviceName, databaseName)
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := privateDatabaseTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func hostingPrivateDatabaseDatabaseExists(serviceName, databaseName string, c *ovh.Client) error {
+	r := &HostingPrivateDatabaseDatabase{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/database/%s", serviceName, databaseName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read private database database: %s", r.DatabaseName)
+
+	return nil
+}
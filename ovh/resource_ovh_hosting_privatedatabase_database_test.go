@@ -0,0 +1,84 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccHostingPrivateDatabaseDatabaseConfig = `
+resource "ovh_hosting_privatedatabase_database" "database" {
+  service_name  = "%s"
+  database_name = "%s"
+}
+`
+
+func TestAccHostingPrivateDatabaseDatabase_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_HOSTING_PRIVATEDATABASE_SERVICE")
+	databaseName := strings.Replace(acctest.RandomWithPrefix(test_prefix), "-", "_", -1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckHostingPrivateDatabasePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHostingPrivateDatabaseDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccHostingPrivateDatabaseDatabaseConfig, serviceName, databaseName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostingPrivateDatabaseDatabaseExists("ovh_hosting_privatedatabase_database.database", t),
+					resource.TestCheckResourceAttr("ovh_hosting_privatedatabase_database.database", "database_name", databaseName),
+				),
+			},
+			{
+				ResourceName:      "ovh_hosting_privatedatabase_database.database",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckHostingPrivateDatabaseDatabaseExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No private database database id is set")
+		}
+
+		return hostingPrivateDatabaseDatabaseExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["database_name"],
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckHostingPrivateDatabaseDatabaseDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_hosting_privatedatabase_database" {
+			continue
+		}
+
+		err := hostingPrivateDatabaseDatabaseExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["database_name"],
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("private database database still exists")
+		}
+	}
+	return nil
+}
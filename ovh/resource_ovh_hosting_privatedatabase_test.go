@@ -0,0 +1,73 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccHostingPrivateDatabaseConfig = `
+resource "ovh_hosting_privatedatabase" "db" {
+  datacenter   = "gra1"
+  engine       = "mysql_8.0"
+  display_name = "%s"
+}
+`
+
+func TestAccHostingPrivateDatabase_basic(t *testing.T) {
+	// ordering a private database is not free of side effects on the account
+	// this resource is tested only if env var `OVH_TEST_PRIVATEDATABASE_ORDER`
+	// is set to "1"
+	v := os.Getenv("OVH_TEST_PRIVATEDATABASE_ORDER")
+	if v != "1" {
+		t.Skip("OVH_TEST_PRIVATEDATABASE_ORDER must be set to 1 to test private database ordering")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccHostingPrivateDatabaseConfig, test_prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostingPrivateDatabaseExists("ovh_hosting_privatedatabase.db", t),
+					resource.TestCheckResourceAttr("ovh_hosting_privatedatabase.db", "display_name", test_prefix),
+					resource.TestCheckResourceAttr("ovh_hosting_privatedatabase.db", "type", "mysql"),
+					resource.TestCheckResourceAttrSet("ovh_hosting_privatedatabase.db", "service_name"),
+					resource.TestCheckResourceAttrSet("ovh_hosting_privatedatabase.db", "hostname"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckHostingPrivateDatabasePreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// private database is an optional product
+	// these resources are tested only if env var `OVH_HOSTING_PRIVATEDATABASE_SERVICE`
+	// is set
+	if os.Getenv("OVH_HOSTING_PRIVATEDATABASE_SERVICE") == "" {
+		t.Skip("OVH_HOSTING_PRIVATEDATABASE_SERVICE must be set to test private database")
+	}
+}
+
+func testAccCheckHostingPrivateDatabaseExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No private database service name is set")
+		}
+
+		return hostingPrivateDatabaseExists(rs.Primary.ID, config.OVHClient)
+	}
+}
@@ -0,0 +1,168 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceHostingPrivateDatabaseUserImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/USER_NAME formatted")
+	}
+	d.Set("service_name", splitId[0])
+	d.Set("user_name", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceHostingPrivateDatabaseUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceHostingPrivateDatabaseUserCreate,
+		Read:   resourceHostingPrivateDatabaseUserRead,
+		Update: resourceHostingPrivateDatabaseUserUpdate,
+		Delete: resourceHostingPrivateDatabaseUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceHostingPrivateDatabaseUserImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			// Computed
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHostingPrivateDatabaseUserCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &HostingPrivateDatabaseUserCreateOpts{
+		UserName: d.Get("user_name").(string),
+		Password: d.Get("password").(string),
+	}
+
+	log.Printf("[DEBUG] Will create private database %s user %s", serviceName, params.UserName)
+
+	task := &HostingPrivateDatabaseTask{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/user", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s for user %s:\n\t %q", endpoint, params.UserName, err)
+	}
+
+	if err := privateDatabaseTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, params.UserName))
+
+	return resourceHostingPrivateDatabaseUserRead(d, meta)
+}
+
+func resourceHostingPrivateDatabaseUserRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	userName := d.Get("user_name").(string)
+
+	r := &HostingPrivateDatabaseUser{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/user/%s", serviceName, userName)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read private database %s user %s", serviceName, r.UserName)
+
+	d.Set("user_name", r.UserName)
+	d.Set("creation_date", r.CreationDate)
+
+	return nil
+}
+
+func resourceHostingPrivateDatabaseUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	userName := d.Get("user_name").(string)
+
+	if d.HasChange("password") {
+		params := &HostingPrivateDatabaseUserChangePasswordOpts{
+			Password: d.Get("password").(string),
+		}
+
+		log.Printf("[DEBUG] Will change password of private database %s user %s", serviceName, userName)
+
+		task := &HostingPrivateDatabaseTask{}
+		endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/user/%s/changePassword", serviceName, userName)
+		if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+			return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+		}
+
+		if err := privateDatabaseTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+			return err
+		}
+	}
+
+	return resourceHostingPrivateDatabaseUserRead(d, meta)
+}
+
+func resourceHostingPrivateDatabaseUserDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	userName := d.Get("user_name").(string)
+
+	log.Printf("[DEBUG] Will delete private database %s user %s", serviceName, userName)
+
+	task := &HostingPrivateDatabaseTask{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/user/%s", serviceName, userName)
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := privateDatabaseTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func hostingPrivateDatabaseUserExists(serviceName, userName string, c *ovh.Client) error {
+	r := &HostingPrivateDatabaseUser{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/user/%s", serviceName, userName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read private database user: %s", r.UserName)
+
+	return nil
+}
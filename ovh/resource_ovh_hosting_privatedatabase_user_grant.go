@@ -0,0 +1,183 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceHostingPrivateDatabaseUserGrantImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/USER_NAME/DATABASE_NAME formatted")
+	}
+	d.Set("service_name", splitId[0])
+	d.Set("user_name", splitId[1])
+	d.Set("database_name", splitId[2])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceHostingPrivateDatabaseUserGrant() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceHostingPrivateDatabaseUserGrantCreate,
+		Read:   resourceHostingPrivateDatabaseUserGrantRead,
+		Update: resourceHostingPrivateDatabaseUserGrantUpdate,
+		Delete: resourceHostingPrivateDatabaseUserGrantDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceHostingPrivateDatabaseUserGrantImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grant": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"admin", "none", "ro", "rw"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHostingPrivateDatabaseUserGrantCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	userName := d.Get("user_name").(string)
+
+	params := &HostingPrivateDatabaseUserGrantCreateOpts{
+		DatabaseName: d.Get("database_name").(string),
+		Grant:        d.Get("grant").(string),
+	}
+
+	log.Printf("[DEBUG] Will grant %s on private database %s database %s to user %s", params.Grant, serviceName, params.DatabaseName, userName)
+
+	task := &HostingPrivateDatabaseTask{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/user/%s/grant", serviceName, userName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := privateDatabaseTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", serviceName, userName, params.DatabaseName))
+
+	return resourceHostingPrivateDatabaseUserGrantRead(d, meta)
+}
+
+func resourceHostingPrivateDatabaseUserGrantRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	userName := d.Get("user_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	r := &HostingPrivateDatabaseUserGrant{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/user/%s/grant/%s", serviceName, userName, databaseName)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read private database %s grant %s of user %s on %s", serviceName, r.Grant, userName, r.DatabaseName)
+
+	d.Set("database_name", r.DatabaseName)
+	d.Set("grant", r.Grant)
+	d.Set("creation_date", r.CreationDate)
+
+	return nil
+}
+
+func resourceHostingPrivateDatabaseUserGrantUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	userName := d.Get("user_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	params := &HostingPrivateDatabaseUserGrantUpdateOpts{
+		Grant: d.Get("grant").(string),
+	}
+
+	log.Printf("[DEBUG] Will update private database %s grant of user %s on %s: %v", serviceName, userName, databaseName, params)
+
+	task := &HostingPrivateDatabaseTask{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/user/%s/grant/%s/update", serviceName, userName, databaseName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := privateDatabaseTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	return resourceHostingPrivateDatabaseUserGrantRead(d, meta)
+}
+
+func resourceHostingPrivateDatabaseUserGrantDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	userName := d.Get("user_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	log.Printf("[DEBUG] Will revoke private database %s grant of user %s on %s", serviceName, userName, databaseName)
+
+	task := &HostingPrivateDatabaseTask{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/user/%s/grant/%s", serviceName, userName, databaseName)
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := privateDatabaseTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func hostingPrivateDatabaseUserGrantExists(serviceName, userName, databaseName string, c *ovh.Client) error {
+	r := &HostingPrivateDatabaseUserGrant{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/user/%s/grant/%s", serviceName, userName, databaseName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read private database grant: %s", r.Grant)
+
+	return nil
+}
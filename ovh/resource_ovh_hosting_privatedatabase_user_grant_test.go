@@ -0,0 +1,101 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccHostingPrivateDatabaseUserGrantConfig = `
+resource "ovh_hosting_privatedatabase_database" "database" {
+  service_name  = "%s"
+  database_name = "%s"
+}
+
+resource "ovh_hosting_privatedatabase_user" "user" {
+  service_name = "${ovh_hosting_privatedatabase_database.database.service_name}"
+  user_name    = "%s"
+  password     = "Tf-Acc-Test-1"
+}
+
+resource "ovh_hosting_privatedatabase_user_grant" "grant" {
+  service_name  = "${ovh_hosting_privatedatabase_user.user.service_name}"
+  user_name     = "${ovh_hosting_privatedatabase_user.user.user_name}"
+  database_name = "${ovh_hosting_privatedatabase_database.database.database_name}"
+  grant         = "%s"
+}
+`
+
+func TestAccHostingPrivateDatabaseUserGrant_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_HOSTING_PRIVATEDATABASE_SERVICE")
+	name := strings.Replace(acctest.RandomWithPrefix(test_prefix), "-", "_", -1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckHostingPrivateDatabasePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHostingPrivateDatabaseUserGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccHostingPrivateDatabaseUserGrantConfig, serviceName, name, name, "ro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostingPrivateDatabaseUserGrantExists("ovh_hosting_privatedatabase_user_grant.grant", t),
+					resource.TestCheckResourceAttr("ovh_hosting_privatedatabase_user_grant.grant", "grant", "ro"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccHostingPrivateDatabaseUserGrantConfig, serviceName, name, name, "rw"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostingPrivateDatabaseUserGrantExists("ovh_hosting_privatedatabase_user_grant.grant", t),
+					resource.TestCheckResourceAttr("ovh_hosting_privatedatabase_user_grant.grant", "grant", "rw"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckHostingPrivateDatabaseUserGrantExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No private database grant id is set")
+		}
+
+		return hostingPrivateDatabaseUserGrantExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["user_name"],
+			rs.Primary.Attributes["database_name"],
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckHostingPrivateDatabaseUserGrantDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_hosting_privatedatabase_user_grant" {
+			continue
+		}
+
+		err := hostingPrivateDatabaseUserGrantExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["user_name"],
+			rs.Primary.Attributes["database_name"],
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("private database grant still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccHostingPrivateDatabaseUserConfig = `
+resource "ovh_hosting_privatedatabase_user" "user" {
+  service_name = "%s"
+  user_name    = "%s"
+  password     = "%s"
+}
+`
+
+func TestAccHostingPrivateDatabaseUser_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_HOSTING_PRIVATEDATABASE_SERVICE")
+	userName := strings.Replace(acctest.RandomWithPrefix(test_prefix), "-", "_", -1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckHostingPrivateDatabasePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHostingPrivateDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccHostingPrivateDatabaseUserConfig, serviceName, userName, "Tf-Acc-Test-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostingPrivateDatabaseUserExists("ovh_hosting_privatedatabase_user.user", t),
+					resource.TestCheckResourceAttr("ovh_hosting_privatedatabase_user.user", "user_name", userName),
+					resource.TestCheckResourceAttrSet("ovh_hosting_privatedatabase_user.user", "creation_date"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccHostingPrivateDatabaseUserConfig, serviceName, userName, "Tf-Acc-Test-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostingPrivateDatabaseUserExists("ovh_hosting_privatedatabase_user.user", t),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckHostingPrivateDatabaseUserExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No private database user id is set")
+		}
+
+		return hostingPrivateDatabaseUserExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["user_name"],
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckHostingPrivateDatabaseUserDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_hosting_privatedatabase_user" {
+			continue
+		}
+
+		err := hostingPrivateDatabaseUserExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["user_name"],
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("private database user still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceHostingPrivateDatabaseWhitelistImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/IP formatted")
+	}
+	d.Set("service_name", splitId[0])
+	d.Set("ip", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceHostingPrivateDatabaseWhitelist() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceHostingPrivateDatabaseWhitelistCreate,
+		Read:   resourceHostingPrivateDatabaseWhitelistRead,
+		Update: resourceHostingPrivateDatabaseWhitelistUpdate,
+		Delete: resourceHostingPrivateDatabaseWhitelistDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceHostingPrivateDatabaseWhitelistImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"service": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"sftp": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHostingPrivateDatabaseWhitelistCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &HostingPrivateDatabaseWhitelistCreateOpts{
+		Ip:      d.Get("ip").(string),
+		Name:    d.Get("name").(string),
+		Service: d.Get("service").(bool),
+		Sftp:    d.Get("sftp").(bool),
+	}
+
+	log.Printf("[DEBUG] Will whitelist %s on private database %s", params.Ip, serviceName)
+
+	task := &HostingPrivateDatabaseTask{}
+	endpoint := fmt.Sprintf("/hosting/privateDatabase/%s/whitelist", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := privateDatabaseTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, params.Ip))
+
+	return resourceHostingPrivateDatabaseWhitelistRead(d, meta)
+}
+
+func resourceHostingPrivateDatabaseWhitelistRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	ip := d.Get("ip").(string)
+
+	r := &HostingPrivateDatabaseWhitelist{}
+	endpoint := hostingPrivateDatabaseWhitelistEndpoint(serviceName, ip)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read private database %s whitelist %s", serviceName, r)
+
+	d.Set("name", r.Name)
+	d.Set("service", r.Service)
+	d.Set("sftp", r.Sftp)
+	d.Set("status", r.Status)
+	d.Set("creation_date", r.CreationDate)
+
+	return nil
+}
+
+func resourceHostingPrivateDatabaseWhitelistUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	ip := d.Get("ip").(string)
+
+	params := &HostingPrivateDatabaseWhitelistUpdateOpts{
+		Name:    d.Get("name").(string),
+		Service: d.Get("service").(bool),
+		Sftp:    d.Get("sftp").(bool),
+	}
+
+	log.Printf("[DEBUG] Will update private database %s whitelist %s: %v", serviceName, ip, params)
+
+	endpoint := hostingPrivateDatabaseWhitelistEndpoint(serviceName, ip)
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceHostingPrivateDatabaseWhitelistRead(d, meta)
+}
+
+func resourceHostingPrivateDatabaseWhitelistDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	ip := d.Get("ip").(string)
+
+	log.Printf("[DEBUG] Will remove %s from private database %s whitelist", ip, serviceName)
+
+	task := &HostingPrivateDatabaseTask{}
+	endpoint := hostingPrivateDatabaseWhitelistEndpoint(serviceName, ip)
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := privateDatabaseTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func hostingPrivateDatabaseWhitelistEndpoint(serviceName, ip string) string {
+	return fmt.Sprintf("/hosting/privateDatabase/%s/whitelist/%s", serviceName, strings.Replace(ip, "/", "%2F", 1))
+}
+
+func hostingPrivateDatabaseWhitelistExists(serviceName, ip string, c *ovh.Client) error {
+	r := &HostingPrivateDatabaseWhitelist{}
+	endpoint := hostingPrivateDatabaseWhitelistEndpoint(serviceName, ip)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read private database whitelist: %s", r)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccHostingPrivateDatabaseWhitelistConfig = `
+resource "ovh_hosting_privatedatabase_whitelist" "ip" {
+  service_name = "%s"
+  ip           = "%s"
+  name         = "%s"
+  service      = true
+  sftp         = %v
+}
+`
+
+func TestAccHostingPrivateDatabaseWhitelist_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_HOSTING_PRIVATEDATABASE_SERVICE")
+	ipBlock := os.Getenv("OVH_IP_BLOCK")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckHostingPrivateDatabasePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHostingPrivateDatabaseWhitelistDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccHostingPrivateDatabaseWhitelistConfig, serviceName, ipBlock, test_prefix, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostingPrivateDatabaseWhitelistExists("ovh_hosting_privatedatabase_whitelist.ip", t),
+					resource.TestCheckResourceAttr("ovh_hosting_privatedatabase_whitelist.ip", "name", test_prefix),
+					resource.TestCheckResourceAttr("ovh_hosting_privatedatabase_whitelist.ip", "sftp", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccHostingPrivateDatabaseWhitelistConfig, serviceName, ipBlock, test_prefix, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostingPrivateDatabaseWhitelistExists("ovh_hosting_privatedatabase_whitelist.ip", t),
+					resource.TestCheckResourceAttr("ovh_hosting_privatedatabase_whitelist.ip", "sftp", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckHostingPrivateDatabaseWhitelistExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No private database whitelist id is set")
+		}
+
+		return hostingPrivateDatabaseWhitelistExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["ip"],
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckHostingPrivateDatabaseWhitelistDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_hosting_privatedatabase_whitelist" {
+			continue
+		}
+
+		err := hostingPrivateDatabaseWhitelistExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["ip"],
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("private database whitelist still exists")
+		}
+	}
+	return nil
+}
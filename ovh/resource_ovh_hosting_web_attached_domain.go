@@ -0,0 +1,206 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceHostingWebAttachedDomainImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/DOMAIN formatted")
+	}
+	d.Set("service_name", splitId[0])
+	d.Set("domain", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceHostingWebAttachedDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceHostingWebAttachedDomainCreate,
+		Read:   resourceHostingWebAttachedDomainRead,
+		Update: resourceHostingWebAttachedDomainUpdate,
+		Delete: resourceHostingWebAttachedDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceHostingWebAttachedDomainImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ssl": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"cdn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "none",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"active", "none"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"firewall": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "none",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"active", "none"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"own_log": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHostingWebAttachedDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &HostingWebAttachedDomainCreateOpts{
+		Domain:   d.Get("domain").(string),
+		Path:     d.Get("path").(string),
+		Ssl:      d.Get("ssl").(bool),
+		Cdn:      d.Get("cdn").(string),
+		Firewall: d.Get("firewall").(string),
+		OwnLog:   d.Get("own_log").(string),
+	}
+
+	log.Printf("[DEBUG] Will attach domain %s to web hosting %s", params.Domain, serviceName)
+
+	task := &HostingWebTask{}
+	endpoint := fmt.Sprintf("/hosting/web/%s/attachedDomain", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := hostingWebTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, params.Domain))
+
+	return resourceHostingWebAttachedDomainRead(d, meta)
+}
+
+func resourceHostingWebAttachedDomainRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	domain := d.Get("domain").(string)
+
+	r := &HostingWebAttachedDomain{}
+	endpoint := fmt.Sprintf("/hosting/web/%s/attachedDomain/%s", serviceName, domain)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read web hosting %s attached domain %s", serviceName, r)
+
+	d.Set("domain", r.Domain)
+	d.Set("path", r.Path)
+	d.Set("ssl", r.Ssl)
+	d.Set("cdn", r.Cdn)
+	d.Set("firewall", r.Firewall)
+	d.Set("own_log", r.OwnLog)
+	d.Set("status", r.Status)
+
+	return nil
+}
+
+func resourceHostingWebAttachedDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	domain := d.Get("domain").(string)
+
+	params := &HostingWebAttachedDomainUpdateOpts{
+		Path:     d.Get("path").(string),
+		Ssl:      d.Get("ssl").(bool),
+		Cdn:      d.Get("cdn").(string),
+		Firewall: d.Get("firewall").(string),
+		OwnLog:   d.Get("own_log").(string),
+	}
+
+	log.Printf("[DEBUG] Will update web hosting %s attached domain %s: %v", serviceName, domain, params)
+
+	endpoint := fmt.Sprintf("/hosting/web/%s/attachedDomain/%s", serviceName, domain)
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceHostingWebAttachedDomainRead(d, meta)
+}
+
+func resourceHostingWebAttachedDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	domain := d.Get("domain").(string)
+
+	log.Printf("[DEBUG] Will detach domain %s from web hosting %s", domain, serviceName)
+
+	task := &HostingWebTask{}
+	endpoint := fmt.Sprintf("/hosting/web/%s/attachedDomain/%s", serviceName, domain)
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := hostingWebTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func hostingWebAttachedDomainExists(serviceName, domain string, c *ovh.Client) error {
+	r := &HostingWebAttachedDomain{}
+	endpoint := fmt.Sprintf("/hosting/web/%s/attachedDomain/%s", serviceName, domain)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read web hosting attached domain: %s", r)
+
+	return nil
+}
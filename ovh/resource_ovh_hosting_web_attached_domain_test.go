@@ -0,0 +1,105 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccHostingWebAttachedDomainConfig = `
+resource "ovh_hosting_web_attached_domain" "domain" {
+  service_name = "%s"
+  domain       = "%s"
+  path         = "%s"
+  ssl          = false
+  cdn          = "none"
+}
+`
+
+func TestAccHostingWebAttachedDomain_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_HOSTING_WEB_SERVICE")
+	domain := fmt.Sprintf("%s.%s", acctest.RandomWithPrefix(test_prefix), os.Getenv("OVH_ZONE"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckHostingWebPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHostingWebAttachedDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccHostingWebAttachedDomainConfig, serviceName, domain, "www"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostingWebAttachedDomainExists("ovh_hosting_web_attached_domain.domain", t),
+					resource.TestCheckResourceAttr("ovh_hosting_web_attached_domain.domain", "domain", domain),
+					resource.TestCheckResourceAttr("ovh_hosting_web_attached_domain.domain", "path", "www"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccHostingWebAttachedDomainConfig, serviceName, domain, "site"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostingWebAttachedDomainExists("ovh_hosting_web_attached_domain.domain", t),
+					resource.TestCheckResourceAttr("ovh_hosting_web_attached_domain.domain", "path", "site"),
+				),
+			},
+			{
+				ResourceName:      "ovh_hosting_web_attached_domain.domain",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckHostingWebPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// web hosting is an optional product
+	// these resources are tested only if env var `OVH_HOSTING_WEB_SERVICE`
+	// is set
+	if os.Getenv("OVH_HOSTING_WEB_SERVICE") == "" {
+		t.Skip("OVH_HOSTING_WEB_SERVICE must be set to test web hosting")
+	}
+}
+
+func testAccCheckHostingWebAttachedDomainExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No attached domain id is set")
+		}
+
+		return hostingWebAttachedDomainExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["domain"],
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckHostingWebAttachedDomainDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_hosting_web_attached_domain" {
+			continue
+		}
+
+		err := hostingWebAttachedDomainExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["domain"],
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("attached domain still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,367 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceHostingWebModuleImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/MODULE_ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceHostingWebModule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceHostingWebModuleCreate,
+		Read:   resourceHostingWebModuleRead,
+		Update: resourceHostingWebModuleUpdate,
+		Delete: resourceHostingWebModuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceHostingWebModuleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"module": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(v.(string))
+				},
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return hostingWebModulePath(v.(string))
+				},
+			},
+			"language": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"cz", "de", "en", "es", "fi", "fr", "it", "lt", "nl", "pl", "pt"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"admin_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"admin_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"dependency": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"server": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			// Computed
+			"module_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_folder": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHostingWebModuleCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	path := hostingWebModulePath(d.Get("path").(string))
+
+	entry, err := hostingWebModuleListFind(config.OVHClient, d.Get("module").(string))
+	if err != nil {
+		return err
+	}
+
+	params := &HostingWebModuleCreateOpts{
+		ModuleId:      entry.Id,
+		Domain:        d.Get("domain").(string),
+		Path:          path,
+		Language:      d.Get("language").(string),
+		AdminName:     d.Get("admin_name").(string),
+		AdminPassword: d.Get("admin_password").(string),
+	}
+	for _, v := range d.Get("dependency").([]interface{}) {
+		dep := v.(map[string]interface{})
+		dependency := HostingWebModuleDependency{
+			Name:     dep["name"].(string),
+			Password: dep["password"].(string),
+			Prefix:   dep["prefix"].(string),
+			Server:   dep["server"].(string),
+			Type:     dep["type"].(string),
+		}
+		if port := dep["port"].(int); port != 0 {
+			dependency.Port = &port
+		}
+		params.Dependencies = append(params.Dependencies, dependency)
+	}
+
+	log.Printf("[DEBUG] Will install %s on web hosting %s: %s", entry, serviceName, params)
+
+	task := &HostingWebTask{}
+	endpoint := fmt.Sprintf("/hosting/web/%s/module", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	if err := hostingWebTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	// the task doesn't return the installed module: it's the one of the path.
+	module, err := hostingWebModuleByPath(config.OVHClient, serviceName, path)
+	if err != nil {
+		return err
+	}
+	if module == nil {
+		return fmt.Errorf("module %s installed on web hosting %s not found in path %q", entry.Name, serviceName, path)
+	}
+
+	d.SetId(strconv.FormatInt(module.Id, 10))
+
+	return resourceHostingWebModuleRead(d, meta)
+}
+
+func resourceHostingWebModuleRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &HostingWebModule{}
+	endpoint := fmt.Sprintf("/hosting/web/%s/module/%s", serviceName, d.Id())
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read web hosting %s %s", serviceName, r)
+
+	entry := &HostingWebModuleListEntry{}
+	endpoint = fmt.Sprintf("/hosting/web/moduleList/%d", r.ModuleId)
+	if err := config.OVHClient.Get(endpoint, entry); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	d.Set("module", strings.ToLower(entry.Name))
+	d.Set("module_id", int(r.ModuleId))
+	d.Set("version", entry.Version)
+	d.Set("path", hostingWebModulePath(r.Path))
+	d.Set("language", r.Language)
+	d.Set("admin_name", r.AdminName)
+	d.Set("admin_folder", r.AdminFolder)
+	d.Set("target_url", r.TargetUrl)
+	d.Set("status", r.Status)
+
+	return nil
+}
+
+func resourceHostingWebModuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	if d.HasChange("admin_password") {
+		params := &HostingWebModulePasswordOpts{
+			Password: d.Get("admin_password").(string),
+		}
+
+		log.Printf("[DEBUG] Will change admin password of module %s of web hosting %s", d.Id(), serviceName)
+
+		task := &HostingWebTask{}
+		endpoint := fmt.Sprintf("/hosting/web/%s/module/%s/changePassword", serviceName, d.Id())
+		if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+			return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+		}
+
+		if err := hostingWebTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+			return err
+		}
+	}
+
+	return resourceHostingWebModuleRead(d, meta)
+}
+
+func resourceHostingWebModuleDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will remove module %s from web hosting %s", d.Id(), serviceName)
+
+	task := &HostingWebTask{}
+	endpoint := fmt.Sprintf("/hosting/web/%s/module/%s", serviceName, d.Id())
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := hostingWebTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// hostingWebModulePath normalizes the installation folder of a module, which
+// the API returns relative to the home of the hosting, e.g. "./www/blog/".
+func hostingWebModulePath(path string) string {
+	return strings.Trim(strings.TrimPrefix(path, "./"), "/")
+}
+
+// hostingWebModuleListFind returns the module of the catalog to install for a
+// name, i.e. its latest active version.
+func hostingWebModuleListFind(c *ovh.Client, name string) (*HostingWebModuleListEntry, error) {
+	ids := []int64{}
+	endpoint := "/hosting/web/moduleList?active=true&latest=true"
+	if err := c.Get(endpoint, &ids); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	entries := make([]*HostingWebModuleListEntry, len(ids))
+	err := fetchConcurrently(len(ids), func(i int) error {
+		r := &HostingWebModuleListEntry{}
+		endpoint := fmt.Sprintf("/hosting/web/moduleList/%d", ids[i])
+		if err := c.Get(endpoint, r); err != nil {
+			return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+		}
+		entries[i] = r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hostingWebModuleListSelect(entries, name)
+}
+
+// hostingWebModuleListSelect returns the latest active module named name,
+// preferring the stable branch when several branches are available.
+func hostingWebModuleListSelect(entries []*HostingWebModuleListEntry, name string) (*HostingWebModuleListEntry, error) {
+	var selected *HostingWebModuleListEntry
+	names := []string{}
+	for _, e := range entries {
+		if !e.Active || !e.Latest {
+			continue
+		}
+		names = append(names, strings.ToLower(e.Name))
+		if !strings.EqualFold(e.Name, name) {
+			continue
+		}
+		if selected == nil || e.Branch == "stable" {
+			selected = e
+		}
+	}
+
+	if selected == nil {
+		return nil, fmt.Errorf("No module named %s. Available modules: %v", name, names)
+	}
+	return selected, nil
+}
+
+// hostingWebModuleByPath returns the module installed in a folder of a web
+// hosting, if any.
+func hostingWebModuleByPath(c *ovh.Client, serviceName, path string) (*HostingWebModule, error) {
+	ids := []int64{}
+	endpoint := fmt.Sprintf("/hosting/web/%s/module", serviceName)
+	if err := c.Get(endpoint, &ids); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	for _, id := range ids {
+		r := &HostingWebModule{}
+		endpoint := fmt.Sprintf("/hosting/web/%s/module/%d", serviceName, id)
+		if err := c.Get(endpoint, r); err != nil {
+			return nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+		}
+		if hostingWebModulePath(r.Path) == path {
+			return r, nil
+		}
+	}
+
+	return nil, nil
+}
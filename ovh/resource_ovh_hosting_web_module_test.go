@@ -0,0 +1,107 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccHostingWebModuleConfig = `
+resource "ovh_hosting_web_module" "wordpress" {
+  service_name   = "%s"
+  module         = "wordpress"
+  path           = "%s"
+  language       = "en"
+  admin_name     = "admin"
+  admin_password = "%s"
+}
+`
+
+func TestAccHostingWebModule_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_HOSTING_WEB_SERVICE")
+	path := fmt.Sprintf("www/%s", acctest.RandomWithPrefix(test_prefix))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckHostingWebPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHostingWebModuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccHostingWebModuleConfig, serviceName, path, acctest.RandString(16)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_hosting_web_module.wordpress", "module", "wordpress"),
+					resource.TestCheckResourceAttr("ovh_hosting_web_module.wordpress", "path", path),
+					resource.TestCheckResourceAttrSet("ovh_hosting_web_module.wordpress", "target_url"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccHostingWebModuleConfig, serviceName, path, acctest.RandString(16)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ovh_hosting_web_module.wordpress", "status"),
+				),
+			},
+			{
+				ResourceName:            "ovh_hosting_web_module.wordpress",
+				ImportState:             true,
+				ImportStateIdPrefix:     serviceName + "/",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"admin_password"},
+			},
+		},
+	})
+}
+
+func testAccCheckHostingWebModuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_hosting_web_module" {
+			continue
+		}
+
+		endpoint := fmt.Sprintf("/hosting/web/%s/module/%s", rs.Primary.Attributes["service_name"], rs.Primary.ID)
+		if err := config.OVHClient.Get(endpoint, &HostingWebModule{}); err == nil {
+			return fmt.Errorf("module %s still installed", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestHostingWebModulePath(t *testing.T) {
+	cases := map[string]string{
+		"":            "",
+		"./www/blog/": "www/blog",
+		"www/blog":    "www/blog",
+		"/www":        "www",
+	}
+
+	for path, expected := range cases {
+		if got := hostingWebModulePath(path); got != expected {
+			t.Errorf("%q: expected %q, got %q", path, expected, got)
+		}
+	}
+}
+
+func TestHostingWebModuleListSelect(t *testing.T) {
+	entries := []*HostingWebModuleListEntry{
+		{Id: 1, Name: "WordPress", Branch: "stable", Latest: false, Active: true},
+		{Id: 2, Name: "WordPress", Branch: "testing", Latest: true, Active: true},
+		{Id: 3, Name: "WordPress", Branch: "stable", Latest: true, Active: true},
+		{Id: 4, Name: "Joomla", Branch: "stable", Latest: true, Active: false},
+	}
+
+	e, err := hostingWebModuleListSelect(entries, "wordpress")
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if e.Id != 3 {
+		t.Errorf("expected the latest stable wordpress, got %s", e)
+	}
+
+	if _, err := hostingWebModuleListSelect(entries, "joomla"); err == nil {
+		t.Errorf("expected an error for an inactive module")
+	}
+}
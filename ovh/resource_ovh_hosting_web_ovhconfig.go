@@ -0,0 +1,212 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceHostingWebOvhConfigImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	d.Set("service_name", splitId[0])
+	if len(splitId) == 2 {
+		d.Set("path", splitId[1])
+	} else {
+		d.Set("path", "")
+	}
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceHostingWebOvhConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceHostingWebOvhConfigCreate,
+		Read:   resourceHostingWebOvhConfigRead,
+		Update: resourceHostingWebOvhConfigUpdate,
+		Delete: resourceHostingWebOvhConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceHostingWebOvhConfigImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "",
+			},
+			"engine_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"php", "phpcgi"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"environment": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"development", "production"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"http_firewall": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"none", "security"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"container": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			"ovhconfig_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHostingWebOvhConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	serviceName := d.Get("service_name").(string)
+	path := d.Get("path").(string)
+
+	// the ovhConfig of a path always exists: it's only reconfigured.
+	if path == "" {
+		d.SetId(serviceName)
+	} else {
+		d.SetId(fmt.Sprintf("%s/%s", serviceName, path))
+	}
+
+	return resourceHostingWebOvhConfigUpdate(d, meta)
+}
+
+func resourceHostingWebOvhConfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	path := d.Get("path").(string)
+
+	r, err := hostingWebOvhConfigByPath(config.OVHClient, serviceName, path)
+	if err != nil {
+		return CheckDeleted(d, err, fmt.Sprintf("/hosting/web/%s/ovhConfig", serviceName))
+	}
+	if r == nil {
+		log.Printf("[WARN] No ovhConfig for path %q of web hosting %s, removing from state", path, serviceName)
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Read web hosting %s %s", serviceName, r)
+
+	d.Set("engine_name", r.EngineName)
+	d.Set("engine_version", r.EngineVersion)
+	d.Set("environment", r.Environment)
+	d.Set("http_firewall", r.HttpFirewall)
+	d.Set("container", r.Container)
+	d.Set("ovhconfig_id", int(r.Id))
+	d.Set("status", r.Status)
+
+	return nil
+}
+
+func resourceHostingWebOvhConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	path := d.Get("path").(string)
+
+	current, err := hostingWebOvhConfigByPath(config.OVHClient, serviceName, path)
+	if err != nil {
+		return fmt.Errorf("calling Get /hosting/web/%s/ovhConfig:\n\t %q", serviceName, err)
+	}
+	if current == nil {
+		return fmt.Errorf("web hosting %s has no ovhConfig for path %q", serviceName, path)
+	}
+
+	params := &HostingWebOvhConfigChangeOpts{
+		EngineName:    d.Get("engine_name").(string),
+		EngineVersion: d.Get("engine_version").(string),
+		Environment:   d.Get("environment").(string),
+		HttpFirewall:  d.Get("http_firewall").(string),
+		Container:     d.Get("container").(string),
+	}
+
+	if hostingWebOvhConfigUpToDate(current, params) {
+		log.Printf("[DEBUG] %s of web hosting %s is up to date", current, serviceName)
+		return resourceHostingWebOvhConfigRead(d, meta)
+	}
+
+	log.Printf("[DEBUG] Will change %s of web hosting %s: %v", current, serviceName, params)
+
+	task := &HostingWebTask{}
+	endpoint := fmt.Sprintf("/hosting/web/%s/ovhConfig/%d/changeConfiguration", serviceName, current.Id)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := hostingWebTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	return resourceHostingWebOvhConfigRead(d, meta)
+}
+
+func resourceHostingWebOvhConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	// the ovhConfig can't be removed from the hosting: it's kept as is.
+	log.Printf("[DEBUG] ovhConfig %s is kept on the web hosting", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+// hostingWebOvhConfigUpToDate returns whether the settings to apply, unset
+// ones being left as is, are the current ones.
+func hostingWebOvhConfigUpToDate(current *HostingWebOvhConfig, params *HostingWebOvhConfigChangeOpts) bool {
+	same := func(wanted, got string) bool {
+		return wanted == "" || wanted == got
+	}
+
+	return same(params.EngineName, current.EngineName) &&
+		same(params.EngineVersion, current.EngineVersion) &&
+		same(params.Environment, current.Environment) &&
+		same(params.HttpFirewall, current.HttpFirewall) &&
+		same(params.Container, current.Container)
+}
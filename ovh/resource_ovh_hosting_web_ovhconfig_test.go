@@ -0,0 +1,72 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccHostingWebOvhConfigConfig = `
+resource "ovh_hosting_web_ovhconfig" "config" {
+  service_name  = "%s"
+  environment   = "%s"
+  http_firewall = "none"
+}
+`
+
+func TestAccHostingWebOvhConfig_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_HOSTING_WEB_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckHostingWebPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccHostingWebOvhConfigConfig, serviceName, "development"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_hosting_web_ovhconfig.config", "environment", "development"),
+					resource.TestCheckResourceAttrSet("ovh_hosting_web_ovhconfig.config", "engine_version"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccHostingWebOvhConfigConfig, serviceName, "production"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_hosting_web_ovhconfig.config", "environment", "production"),
+				),
+			},
+			{
+				ResourceName:      "ovh_hosting_web_ovhconfig.config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestHostingWebOvhConfigUpToDate(t *testing.T) {
+	current := &HostingWebOvhConfig{
+		EngineName:    "php",
+		EngineVersion: "8.2",
+		Environment:   "production",
+		HttpFirewall:  "none",
+		Container:     "stable64",
+	}
+
+	cases := []struct {
+		params   *HostingWebOvhConfigChangeOpts
+		expected bool
+	}{
+		{&HostingWebOvhConfigChangeOpts{}, true},
+		{&HostingWebOvhConfigChangeOpts{EngineVersion: "8.2", Environment: "production"}, true},
+		{&HostingWebOvhConfigChangeOpts{EngineVersion: "8.3"}, false},
+		{&HostingWebOvhConfigChangeOpts{HttpFirewall: "security"}, false},
+	}
+
+	for _, c := range cases {
+		if got := hostingWebOvhConfigUpToDate(current, c.params); got != c.expected {
+			t.Errorf("%v: expected %t, got %t", c.params, c.expected, got)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type IamPermissionsGroup struct {
+	Id          string         `json:"id"`
+	Urn         string         `json:"urn"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Permissions IamPermissions `json:"permissions"`
+	Owner       string         `json:"owner"`
+	CreatedAt   string         `json:"createdAt"`
+	UpdatedAt   string         `json:"updatedAt"`
+}
+
+func (g *IamPermissionsGroup) String() string {
+	return fmt.Sprintf("IamPermissionsGroup[urn: %s, name: %s]", g.Urn, g.Name)
+}
+
+type IamPermissionsGroupOpts struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Permissions IamPermissions `json:"permissions"`
+}
+
+func resourceIamPermissionsGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIamPermissionsGroupCreate,
+		Read:   resourceIamPermissionsGroupRead,
+		Update: resourceIamPermissionsGroupUpdate,
+		Delete: resourceIamPermissionsGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"allow": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"except": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"deny": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			// Computed
+			"urn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func iamPermissionsGroupOptsFromSchema(d *schema.ResourceData) *IamPermissionsGroupOpts {
+	return &IamPermissionsGroupOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Permissions: IamPermissions{
+			Allow:  iamActionsFromSchema(d, "allow"),
+			Except: iamActionsFromSchema(d, "except"),
+			Deny:   iamActionsFromSchema(d, "deny"),
+		},
+	}
+}
+
+func resourceIamPermissionsGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := iamPermissionsGroupOptsFromSchema(d)
+	r := &IamPermissionsGroup{}
+
+	log.Printf("[DEBUG] Will create iam permissions group %s", params.Name)
+
+	endpoint := "/iam/permissionsGroup"
+	err := config.OVHClientV2.Post(endpoint, params, r)
+	if err != nil {
+		return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	// permissions groups are addressed by their urn
+	d.SetId(r.Urn)
+
+	return resourceIamPermissionsGroupRead(d, meta)
+}
+
+func resourceIamPermissionsGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &IamPermissionsGroup{}
+	endpoint := fmt.Sprintf("/iam/permissionsGroup/%s", url.PathEscape(d.Id()))
+
+	err := config.OVHClientV2.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read iam permissions group %s", r)
+
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+	d.Set("allow", iamActionsToSchema(r.Permissions.Allow))
+	d.Set("except", iamActionsToSchema(r.Permissions.Except))
+	d.Set("deny", iamActionsToSchema(r.Permissions.Deny))
+	d.Set("urn", r.Urn)
+	d.Set("owner", r.Owner)
+	d.Set("created_at", r.CreatedAt)
+	d.Set("updated_at", r.UpdatedAt)
+
+	return nil
+}
+
+func resourceIamPermissionsGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := iamPermissionsGroupOptsFromSchema(d)
+	endpoint := fmt.Sprintf("/iam/permissionsGroup/%s", url.PathEscape(d.Id()))
+
+	log.Printf("[DEBUG] Will update iam permissions group %s", d.Id())
+
+	err := config.OVHClientV2.Put(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Put %s:\n\t %q", endpoint, err)
+	}
+
+	return resourceIamPermissionsGroupRead(d, meta)
+}
+
+func resourceIamPermissionsGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	endpoint := fmt.Sprintf("/iam/permissionsGroup/%s", url.PathEscape(d.Id()))
+
+	log.Printf("[DEBUG] Will delete iam permissions group %s", d.Id())
+
+	err := config.OVHClientV2.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func iamPermissionsGroupExists(urn string, c *OVHClientV2) error {
+	r := &IamPermissionsGroup{}
+	endpoint := fmt.Sprintf("/iam/permissionsGroup/%s", url.PathEscape(urn))
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read iam permissions group: %s", r)
+
+	return nil
+}
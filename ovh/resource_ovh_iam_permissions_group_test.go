@@ -0,0 +1,75 @@
+package ovh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccIamPermissionsGroupConfig = `
+resource "ovh_iam_permissions_group" "group" {
+  name  = "%s"
+  allow = ["%s"]
+}
+`
+
+func TestAccIamPermissionsGroup_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIamPermissionsGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIamPermissionsGroupConfig, name, "vps:apiovh:get"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIamPermissionsGroupExists("ovh_iam_permissions_group.group", t),
+					resource.TestCheckResourceAttrSet("ovh_iam_permissions_group.group", "urn"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIamPermissionsGroupConfig, name, "vps:apiovh:reboot"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIamPermissionsGroupExists("ovh_iam_permissions_group.group", t),
+					resource.TestCheckResourceAttr("ovh_iam_permissions_group.group", "allow.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIamPermissionsGroupExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No iam permissions group urn is set")
+		}
+
+		return iamPermissionsGroupExists(rs.Primary.ID, config.OVHClientV2)
+	}
+}
+
+func testAccCheckIamPermissionsGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_iam_permissions_group" {
+			continue
+		}
+
+		err := iamPermissionsGroupExists(rs.Primary.ID, config.OVHClientV2)
+		if err == nil {
+			return fmt.Errorf("IAM permissions group still exists")
+		}
+	}
+	return nil
+}
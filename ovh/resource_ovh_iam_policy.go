@@ -0,0 +1,273 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type IamAction struct {
+	Action string `json:"action"`
+}
+
+type IamPermissions struct {
+	Allow  []IamAction `json:"allow"`
+	Except []IamAction `json:"except"`
+	Deny   []IamAction `json:"deny"`
+}
+
+type IamUrn struct {
+	Urn string `json:"urn"`
+}
+
+type IamPolicy struct {
+	Id                string         `json:"id"`
+	Name              string         `json:"name"`
+	Description       string         `json:"description"`
+	Identities        []string       `json:"identities"`
+	Resources         []IamUrn       `json:"resources"`
+	Permissions       IamPermissions `json:"permissions"`
+	PermissionsGroups []IamUrn       `json:"permissionsGroups"`
+	Owner             string         `json:"owner"`
+	ReadOnly          bool           `json:"readOnly"`
+	CreatedAt         string         `json:"createdAt"`
+	UpdatedAt         string         `json:"updatedAt"`
+}
+
+func (p *IamPolicy) String() string {
+	return fmt.Sprintf("IamPolicy[id: %s, name: %s, identities: %v]", p.Id, p.Name, p.Identities)
+}
+
+type IamPolicyOpts struct {
+	Name              string         `json:"name"`
+	Description       string         `json:"description,omitempty"`
+	Identities        []string       `json:"identities"`
+	Resources         []IamUrn       `json:"resources"`
+	Permissions       IamPermissions `json:"permissions"`
+	PermissionsGroups []IamUrn       `json:"permissionsGroups"`
+}
+
+func resourceIamPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIamPolicyCreate,
+		Read:   resourceIamPolicyRead,
+		Update: resourceIamPolicyUpdate,
+		Delete: resourceIamPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"identities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"resources": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"allow": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"except": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"deny": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"permissions_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			// Computed
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// iamActionsFromSchema builds the list of actions of a permissions attribute.
+func iamActionsFromSchema(d *schema.ResourceData, id string) []IamAction {
+	actions := []IamAction{}
+	for _, action := range stringsFromSchema(d, id) {
+		actions = append(actions, IamAction{Action: action})
+	}
+	return actions
+}
+
+// iamUrnsFromSchema builds the list of urns of a set attribute.
+func iamUrnsFromSchema(d *schema.ResourceData, id string) []IamUrn {
+	urns := []IamUrn{}
+	for _, urn := range stringsFromSchema(d, id) {
+		urns = append(urns, IamUrn{Urn: urn})
+	}
+	return urns
+}
+
+func iamActionsToSchema(actions []IamAction) []string {
+	xs := make([]string, len(actions))
+	for i, a := range actions {
+		xs[i] = a.Action
+	}
+	return xs
+}
+
+func iamUrnsToSchema(urns []IamUrn) []string {
+	xs := make([]string, len(urns))
+	for i, u := range urns {
+		xs[i] = u.Urn
+	}
+	return xs
+}
+
+func iamPolicyOptsFromSchema(d *schema.ResourceData) *IamPolicyOpts {
+	identities := stringsFromSchema(d, "identities")
+	if identities == nil {
+		identities = []string{}
+	}
+
+	return &IamPolicyOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Identities:  identities,
+		Resources:   iamUrnsFromSchema(d, "resources"),
+		Permissions: IamPermissions{
+			Allow:  iamActionsFromSchema(d, "allow"),
+			Except: iamActionsFromSchema(d, "except"),
+			Deny:   iamActionsFromSchema(d, "deny"),
+		},
+		PermissionsGroups: iamUrnsFromSchema(d, "permissions_groups"),
+	}
+}
+
+func resourceIamPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := iamPolicyOptsFromSchema(d)
+	r := &IamPolicy{}
+
+	log.Printf("[DEBUG] Will create iam policy %s", params.Name)
+
+	endpoint := "/iam/policy"
+	err := config.OVHClientV2.Post(endpoint, params, r)
+	if err != nil {
+		return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(r.Id)
+
+	return resourceIamPolicyRead(d, meta)
+}
+
+func resourceIamPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &IamPolicy{}
+	endpoint := fmt.Sprintf("/iam/policy/%s", d.Id())
+
+	err := config.OVHClientV2.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read iam policy %s", r)
+
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+	d.Set("identities", r.Identities)
+	d.Set("resources", iamUrnsToSchema(r.Resources))
+	d.Set("allow", iamActionsToSchema(r.Permissions.Allow))
+	d.Set("except", iamActionsToSchema(r.Permissions.Except))
+	d.Set("deny", iamActionsToSchema(r.Permissions.Deny))
+	d.Set("permissions_groups", iamUrnsToSchema(r.PermissionsGroups))
+	d.Set("owner", r.Owner)
+	d.Set("read_only", r.ReadOnly)
+	d.Set("created_at", r.CreatedAt)
+	d.Set("updated_at", r.UpdatedAt)
+
+	return nil
+}
+
+func resourceIamPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := iamPolicyOptsFromSchema(d)
+	endpoint := fmt.Sprintf("/iam/policy/%s", d.Id())
+
+	log.Printf("[DEBUG] Will update iam policy %s", d.Id())
+
+	err := config.OVHClientV2.Put(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Put %s:\n\t %q", endpoint, err)
+	}
+
+	return resourceIamPolicyRead(d, meta)
+}
+
+func resourceIamPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	endpoint := fmt.Sprintf("/iam/policy/%s", d.Id())
+
+	log.Printf("[DEBUG] Will delete iam policy %s", d.Id())
+
+	err := config.OVHClientV2.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func iamPolicyExists(id string, c *OVHClientV2) error {
+	r := &IamPolicy{}
+	endpoint := fmt.Sprintf("/iam/policy/%s", id)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read iam policy: %s", r)
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package ovh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccIamPolicyConfig = `
+resource "ovh_me_identity_group" "group" {
+  name = "%s"
+}
+
+resource "ovh_iam_resource_group" "resources" {
+  name = "%s"
+}
+
+resource "ovh_iam_policy" "policy" {
+  name        = "%s"
+  description = "%s"
+  identities  = ["${ovh_me_identity_group.group.urn}"]
+  resources   = ["${ovh_iam_resource_group.resources.urn}"]
+  allow       = ["vps:apiovh:reboot", "vps:apiovh:get"]
+}
+`
+
+func TestAccIamPolicy_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIamPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIamPolicyConfig, name, name, name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIamPolicyExists("ovh_iam_policy.policy", t),
+					resource.TestCheckResourceAttr("ovh_iam_policy.policy", "identities.#", "1"),
+					resource.TestCheckResourceAttr("ovh_iam_policy.policy", "allow.#", "2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIamPolicyConfig, name, name, name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIamPolicyExists("ovh_iam_policy.policy", t),
+					resource.TestCheckResourceAttr("ovh_iam_policy.policy", "description", "second"),
+				),
+			},
+			{
+				ResourceName:      "ovh_iam_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIamPolicyExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No iam policy id is set")
+		}
+
+		return iamPolicyExists(rs.Primary.ID, config.OVHClientV2)
+	}
+}
+
+func testAccCheckIamPolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_iam_policy" {
+			continue
+		}
+
+		err := iamPolicyExists(rs.Primary.ID, config.OVHClientV2)
+		if err == nil {
+			return fmt.Errorf("IAM policy still exists")
+		}
+	}
+	return nil
+}
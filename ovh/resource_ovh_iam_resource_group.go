@@ -0,0 +1,169 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type IamResourceGroup struct {
+	Id        string   `json:"id"`
+	Urn       string   `json:"urn"`
+	Name      string   `json:"name"`
+	Resources []IamUrn `json:"resources"`
+	Owner     string   `json:"owner"`
+	ReadOnly  bool     `json:"readOnly"`
+	CreatedAt string   `json:"createdAt"`
+	UpdatedAt string   `json:"updatedAt"`
+}
+
+func (g *IamResourceGroup) String() string {
+	return fmt.Sprintf("IamResourceGroup[id: %s, name: %s]", g.Id, g.Name)
+}
+
+type IamResourceGroupOpts struct {
+	Name      string   `json:"name"`
+	Resources []IamUrn `json:"resources"`
+}
+
+func resourceIamResourceGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIamResourceGroupCreate,
+		Read:   resourceIamResourceGroupRead,
+		Update: resourceIamResourceGroupUpdate,
+		Delete: resourceIamResourceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			// Computed
+			"urn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceIamResourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &IamResourceGroupOpts{
+		Name:      d.Get("name").(string),
+		Resources: iamUrnsFromSchema(d, "resources"),
+	}
+	r := &IamResourceGroup{}
+
+	log.Printf("[DEBUG] Will create iam resource group %s", params.Name)
+
+	endpoint := "/iam/resourceGroup"
+	err := config.OVHClientV2.Post(endpoint, params, r)
+	if err != nil {
+		return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(r.Id)
+
+	return resourceIamResourceGroupRead(d, meta)
+}
+
+func resourceIamResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &IamResourceGroup{}
+	endpoint := fmt.Sprintf("/iam/resourceGroup/%s?details=true", d.Id())
+
+	err := config.OVHClientV2.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read iam resource group %s", r)
+
+	d.Set("name", r.Name)
+	d.Set("resources", iamUrnsToSchema(r.Resources))
+	d.Set("urn", r.Urn)
+	d.Set("owner", r.Owner)
+	d.Set("read_only", r.ReadOnly)
+	d.Set("created_at", r.CreatedAt)
+	d.Set("updated_at", r.UpdatedAt)
+
+	return nil
+}
+
+func resourceIamResourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &IamResourceGroupOpts{
+		Name:      d.Get("name").(string),
+		Resources: iamUrnsFromSchema(d, "resources"),
+	}
+	endpoint := fmt.Sprintf("/iam/resourceGroup/%s", d.Id())
+
+	log.Printf("[DEBUG] Will update iam resource group %s", d.Id())
+
+	err := config.OVHClientV2.Put(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Put %s:\n\t %q", endpoint, err)
+	}
+
+	return resourceIamResourceGroupRead(d, meta)
+}
+
+func resourceIamResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	endpoint := fmt.Sprintf("/iam/resourceGroup/%s", d.Id())
+
+	log.Printf("[DEBUG] Will delete iam resource group %s", d.Id())
+
+	err := config.OVHClientV2.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func iamResourceGroupExists(id string, c *OVHClientV2) error {
+	r := &IamResourceGroup{}
+	endpoint := fmt.Sprintf("/iam/resourceGroup/%s", id)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read iam resource group: %s", r)
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package ovh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccIamResourceGroupConfig = `
+resource "ovh_iam_resource_group" "group" {
+  name = "%s"
+}
+`
+
+func TestAccIamResourceGroup_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIamResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIamResourceGroupConfig, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIamResourceGroupExists("ovh_iam_resource_group.group", t),
+					resource.TestCheckResourceAttr("ovh_iam_resource_group.group", "name", name),
+					resource.TestCheckResourceAttrSet("ovh_iam_resource_group.group", "urn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIamResourceGroupExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No iam resource group id is set")
+		}
+
+		return iamResourceGroupExists(rs.Primary.ID, config.OVHClientV2)
+	}
+}
+
+func testAccCheckIamResourceGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_iam_resource_group" {
+			continue
+		}
+
+		err := iamResourceGroupExists(rs.Primary.ID, config.OVHClientV2)
+		if err == nil {
+			return fmt.Errorf("IAM resource group still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceOvhIpFailover() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOvhIpFailoverCreate,
+		Read:   resourceOvhIpFailoverRead,
+		Delete: resourceOvhIpFailoverDelete,
+
+		CustomizeDiff: orderCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ovh_subsidiary": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"plan_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "ip-failover-ripe",
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return strings.ToUpper(v.(string))
+				},
+			},
+			"quantity": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  1,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if v.(int) < 1 {
+						errors = append(errors, fmt.Errorf("%q must be at least 1", k))
+					}
+					return
+				},
+			},
+			"routed_to_service_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"order_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceOvhIpFailoverCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	item := &OrderCartItemCreateOpts{
+		PlanCode:    d.Get("plan_code").(string),
+		Duration:    "P1M",
+		PricingMode: "default",
+		Quantity:    d.Get("quantity").(int),
+	}
+
+	configuration := map[string]string{
+		"country": strings.ToUpper(d.Get("country").(string)),
+	}
+	if v, ok := d.GetOk("routed_to_service_name"); ok {
+		configuration["destination"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Will order failover ips: %s %v", item, configuration)
+
+	order, err := orderProduct(config.OVHClient, d.Get("ovh_subsidiary").(string), "ip", item, configuration, d.Timeout(schema.TimeoutCreate))
+	if order != nil {
+		d.Set("order_id", order.OrderId)
+	}
+	if err != nil {
+		return fmt.Errorf("Error ordering failover ips: %s", err)
+	}
+
+	details, err := orderDetails(config.OVHClient, order.OrderId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving ips from order %d: %s", order.OrderId, err)
+	}
+
+	blocks := []string{}
+	for _, detail := range details {
+		if detail.Domain != "" && detail.Domain != "*" {
+			blocks = append(blocks, detail.Domain)
+		}
+	}
+
+	if len(blocks) == 0 {
+		return fmt.Errorf("no ip found in details of order %d", order.OrderId)
+	}
+
+	log.Printf("[DEBUG] Ordered failover ips %v with order %d", blocks, order.OrderId)
+
+	d.SetId(fmt.Sprintf("%d", order.OrderId))
+	d.Set("blocks", blocks)
+
+	return resourceOvhIpFailoverRead(d, meta)
+}
+
+func resourceOvhIpFailoverRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	blocks := []string{}
+	for _, block := range d.Get("blocks").([]interface{}) {
+		r := &OvhIp{}
+		endpoint := fmt.Sprintf("/ip/%s", strings.Replace(block.(string), "/", "%2F", 1))
+
+		err := config.OVHClient.Get(endpoint, r)
+		if err != nil {
+			if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+				log.Printf("[WARN] Failover ip %s of order %s not found", block, d.Id())
+				continue
+			}
+			return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+		}
+
+		log.Printf("[DEBUG] Read failover ip %s", r)
+		blocks = append(blocks, r.Ip)
+	}
+
+	if len(blocks) == 0 {
+		log.Printf("[WARN] No failover ip of order %s left, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("blocks", blocks)
+	d.Set("ip", blocks[0])
+
+	return nil
+}
+
+func resourceOvhIpFailoverDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	paths := []string{}
+	for _, block := range d.Get("blocks").([]interface{}) {
+		paths = append(paths, fmt.Sprintf("/ip/%s", strings.Replace(block.(string), "/", "%2F", 1)))
+	}
+
+	return serviceTerminate(d, config.OVHClient, paths...)
+}
@@ -0,0 +1,42 @@
+package ovh
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccIpFailoverConfig = `
+resource "ovh_ip_failover" "ip" {
+  country = "fr"
+}
+`
+
+func TestAccIpFailover_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckIpFailoverPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpFailoverConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ovh_ip_failover.ip", "ip"),
+					resource.TestCheckResourceAttr("ovh_ip_failover.ip", "blocks.#", "1"),
+					resource.TestCheckResourceAttr("ovh_ip_failover.ip", "country", "FR"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIpFailoverPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// ordering an ip is charged to the account
+	// this resource is tested only if env var `OVH_TEST_IP_FAILOVER_ORDER`
+	// is set to 1
+	if os.Getenv("OVH_TEST_IP_FAILOVER_ORDER") != "1" {
+		t.Skip("OVH_TEST_IP_FAILOVER_ORDER must be set to 1 to test failover ip orders")
+	}
+}
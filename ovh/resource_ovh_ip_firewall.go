@@ -0,0 +1,211 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type OvhIpFirewall struct {
+	IpOnFirewall string `json:"ipOnFirewall"`
+	Enabled      bool   `json:"enabled"`
+	State        string `json:"state"`
+}
+
+func (f *OvhIpFirewall) String() string {
+	return fmt.Sprintf("firewall[ip: %s, enabled: %v, state: %s]", f.IpOnFirewall, f.Enabled, f.State)
+}
+
+func (f *OvhIpFirewall) TaskStatus() string {
+	return f.State
+}
+
+type OvhIpFirewallCreateOpts struct {
+	IpOnFirewall string `json:"ipOnFirewall"`
+}
+
+type OvhIpFirewallUpdateOpts struct {
+	Enabled bool `json:"enabled"`
+}
+
+func resourceOvhIpFirewall() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOvhIpFirewallCreate,
+		Read:   resourceOvhIpFirewallRead,
+		Update: resourceOvhIpFirewallUpdate,
+		Delete: resourceOvhIpFirewallDelete,
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_on_firewall": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Computed
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOvhIpFirewallCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Get("ip").(string)
+	ipOnFirewall := d.Get("ip_on_firewall").(string)
+
+	params := &OvhIpFirewallCreateOpts{IpOnFirewall: ipOnFirewall}
+	endpoint := fmt.Sprintf("/ip/%s/firewall", strings.Replace(ip, "/", "%2F", 1))
+
+	log.Printf("[DEBUG] Will create firewall on ip %s: %v", ip, params)
+
+	err := config.OVHClient.Post(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", ip, ipOnFirewall))
+
+	if err := ipFirewallWaitOk(config.OVHClient, ip, ipOnFirewall); err != nil {
+		return err
+	}
+
+	if d.Get("enabled").(bool) {
+		return resourceOvhIpFirewallUpdate(d, meta)
+	}
+
+	return resourceOvhIpFirewallRead(d, meta)
+}
+
+func resourceOvhIpFirewallRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Get("ip").(string)
+	ipOnFirewall := d.Get("ip_on_firewall").(string)
+
+	r := &OvhIpFirewall{}
+	endpoint := fmt.Sprintf("/ip/%s/firewall/%s", strings.Replace(ip, "/", "%2F", 1), ipOnFirewall)
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ip %s %s", ip, r)
+
+	d.Set("enabled", r.Enabled)
+	d.Set("state", r.State)
+
+	return nil
+}
+
+func resourceOvhIpFirewallUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Get("ip").(string)
+	ipOnFirewall := d.Get("ip_on_firewall").(string)
+
+	params := &OvhIpFirewallUpdateOpts{Enabled: d.Get("enabled").(bool)}
+	endpoint := fmt.Sprintf("/ip/%s/firewall/%s", strings.Replace(ip, "/", "%2F", 1), ipOnFirewall)
+
+	log.Printf("[DEBUG] Will update firewall on ip %s: %v", ip, params)
+
+	err := config.OVHClient.Put(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := ipFirewallWaitOk(config.OVHClient, ip, ipOnFirewall); err != nil {
+		return err
+	}
+
+	return resourceOvhIpFirewallRead(d, meta)
+}
+
+func resourceOvhIpFirewallDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Get("ip").(string)
+	ipOnFirewall := d.Get("ip_on_firewall").(string)
+
+	endpoint := fmt.Sprintf("/ip/%s/firewall/%s", strings.Replace(ip, "/", "%2F", 1), ipOnFirewall)
+
+	log.Printf("[DEBUG] Will delete firewall on ip %s: %s", ip, ipOnFirewall)
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := ipFirewallWait(config.OVHClient, ip, ipOnFirewall, []string{"ok", "disableFirewallPending", "enableFirewallPending"}, []string{"deleted"}); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func ipFirewallWaitOk(c *ovh.Client, ip, ipOnFirewall string) error {
+	return ipFirewallWait(c, ip, ipOnFirewall, []string{"disableFirewallPending", "enableFirewallPending"}, []string{"ok"})
+}
+
+// ipFirewallWait waits for the firewall of an ip to reach one of the target
+// states. A firewall which can't be found anymore is reported as "deleted".
+func ipFirewallWait(c *ovh.Client, ip, ipOnFirewall string, pending, target []string) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/ip/%s/firewall/%s", strings.Replace(ip, "/", "%2F", 1), ipOnFirewall),
+		Description: fmt.Sprintf("firewall on ip %s (%s)", ip, ipOnFirewall),
+		Task:        &OvhIpFirewall{},
+		Pending:     pending,
+		Target:      target,
+		Purged:      "deleted",
+		Timeout:     10 * time.Minute,
+		Delay:       5 * time.Second,
+	}).Wait(c)
+	return err
+}
+
+func ipFirewallExists(ip, ipOnFirewall string, c *ovh.Client) error {
+	r := &OvhIpFirewall{}
+	endpoint := fmt.Sprintf("/ip/%s/firewall/%s", strings.Replace(ip, "/", "%2F", 1), ipOnFirewall)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ip firewall: %s", r)
+
+	return nil
+}
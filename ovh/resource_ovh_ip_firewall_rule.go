@@ -0,0 +1,315 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type OvhIpFirewallRuleTcpOption struct {
+	Fragments *bool  `json:"fragments,omitempty"`
+	Option    string `json:"option,omitempty"`
+}
+
+type OvhIpFirewallRuleCreateOpts struct {
+	Sequence        int                         `json:"sequence"`
+	Action          string                      `json:"action"`
+	Protocol        string                      `json:"protocol"`
+	Source          string                      `json:"source,omitempty"`
+	SourcePort      *int                        `json:"sourcePort,omitempty"`
+	DestinationPort *int                        `json:"destinationPort,omitempty"`
+	Fragments       *bool                       `json:"fragments,omitempty"`
+	TcpOption       *OvhIpFirewallRuleTcpOption `json:"tcpOption,omitempty"`
+}
+
+func (p *OvhIpFirewallRuleCreateOpts) String() string {
+	return fmt.Sprintf("RuleCreateOpts[sequence: %d, action: %s, protocol: %s, source: %s]", p.Sequence, p.Action, p.Protocol, p.Source)
+}
+
+type OvhIpFirewallRule struct {
+	Sequence        int    `json:"sequence"`
+	Action          string `json:"action"`
+	Protocol        string `json:"protocol"`
+	Source          string `json:"source"`
+	SourcePort      string `json:"sourcePort"`
+	Destination     string `json:"destination"`
+	DestinationPort string `json:"destinationPort"`
+	Fragments       bool   `json:"fragments"`
+	TcpOption       string `json:"tcpOption"`
+	Rule            string `json:"rule"`
+	State           string `json:"state"`
+	CreationDate    string `json:"creationDate"`
+}
+
+func (r *OvhIpFirewallRule) String() string {
+	return fmt.Sprintf("rule[sequence: %d, rule: %s, state: %s]", r.Sequence, r.Rule, r.State)
+}
+
+func (r *OvhIpFirewallRule) TaskStatus() string {
+	return r.State
+}
+
+func resourceOvhIpFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOvhIpFirewallRuleCreate,
+		Read:   resourceOvhIpFirewallRuleRead,
+		Delete: resourceOvhIpFirewallRuleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_on_firewall": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"sequence": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(int)
+					if value < 0 || value > 19 {
+						errors = append(errors, fmt.Errorf("Rule sequence not in 0..19 range"))
+					}
+					return
+				},
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"deny", "permit"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"ah", "esp", "gre", "icmp", "ipv4", "tcp", "udp"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"source_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"destination_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"fragments": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tcp_option": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"established", "syn"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"destination": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOvhIpFirewallRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Get("ip").(string)
+	ipOnFirewall := d.Get("ip_on_firewall").(string)
+	sequence := d.Get("sequence").(int)
+	protocol := d.Get("protocol").(string)
+
+	params := &OvhIpFirewallRuleCreateOpts{
+		Sequence: sequence,
+		Action:   d.Get("action").(string),
+		Protocol: protocol,
+		Source:   d.Get("source").(string),
+	}
+
+	if v, ok := d.GetOk("source_port"); ok {
+		params.SourcePort = getNilIntPointer(v.(int))
+	}
+	if v, ok := d.GetOk("destination_port"); ok {
+		params.DestinationPort = getNilIntPointer(v.(int))
+	}
+
+	if protocol == "tcp" {
+		tcpOption := &OvhIpFirewallRuleTcpOption{
+			Option: d.Get("tcp_option").(string),
+		}
+		if v, ok := d.GetOk("fragments"); ok {
+			tcpOption.Fragments = getNilBoolPointer(v.(bool))
+		}
+		params.TcpOption = tcpOption
+	} else if v, ok := d.GetOk("fragments"); ok {
+		params.Fragments = getNilBoolPointer(v.(bool))
+	}
+
+	endpoint := fmt.Sprintf("/ip/%s/firewall/%s/rule", strings.Replace(ip, "/", "%2F", 1), ipOnFirewall)
+
+	log.Printf("[DEBUG] Will create firewall rule on ip %s: %s", ip, params)
+
+	err := config.OVHClient.Post(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s_%d", ip, ipOnFirewall, sequence))
+
+	if err := ipFirewallRuleWait(config.OVHClient, ip, ipOnFirewall, sequence, []string{"creationPending"}, []string{"ok"}); err != nil {
+		return err
+	}
+
+	return resourceOvhIpFirewallRuleRead(d, meta)
+}
+
+func resourceOvhIpFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Get("ip").(string)
+	ipOnFirewall := d.Get("ip_on_firewall").(string)
+	sequence := d.Get("sequence").(int)
+
+	r := &OvhIpFirewallRule{}
+	endpoint := fmt.Sprintf("/ip/%s/firewall/%s/rule/%d", strings.Replace(ip, "/", "%2F", 1), ipOnFirewall, sequence)
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ip %s firewall %s", ip, r)
+
+	d.Set("action", r.Action)
+	d.Set("protocol", r.Protocol)
+	d.Set("destination", r.Destination)
+	d.Set("rule", r.Rule)
+	d.Set("state", r.State)
+	d.Set("creation_date", r.CreationDate)
+
+	return nil
+}
+
+func resourceOvhIpFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Get("ip").(string)
+	ipOnFirewall := d.Get("ip_on_firewall").(string)
+	sequence := d.Get("sequence").(int)
+
+	endpoint := fmt.Sprintf("/ip/%s/firewall/%s/rule/%d", strings.Replace(ip, "/", "%2F", 1), ipOnFirewall, sequence)
+
+	log.Printf("[DEBUG] Will delete firewall rule %d on ip %s (%s)", sequence, ip, ipOnFirewall)
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := ipFirewallRuleWait(config.OVHClient, ip, ipOnFirewall, sequence, []string{"ok", "removalPending"}, []string{"deleted"}); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// ipFirewallRuleWait waits for a firewall rule to reach one of the target
+// states. A rule which can't be found anymore is reported as "deleted".
+func ipFirewallRuleWait(c *ovh.Client, ip, ipOnFirewall string, sequence int, pending, target []string) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/ip/%s/firewall/%s/rule/%d", strings.Replace(ip, "/", "%2F", 1), ipOnFirewall, sequence),
+		Description: fmt.Sprintf("firewall rule %d on ip %s (%s)", sequence, ip, ipOnFirewall),
+		Task:        &OvhIpFirewallRule{},
+		Pending:     pending,
+		Target:      target,
+		Purged:      "deleted",
+		Timeout:     10 * time.Minute,
+		Delay:       5 * time.Second,
+	}).Wait(c)
+	return err
+}
+
+func ipFirewallRuleExists(ip, ipOnFirewall string, sequence int, c *ovh.Client) error {
+	r := &OvhIpFirewallRule{}
+	endpoint := fmt.Sprintf("/ip/%s/firewall/%s/rule/%d", strings.Replace(ip, "/", "%2F", 1), ipOnFirewall, sequence)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ip firewall rule: %s", r)
+
+	return nil
+}
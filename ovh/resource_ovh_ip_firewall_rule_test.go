@@ -0,0 +1,92 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var testAccIpFirewallRuleConfig = fmt.Sprintf(`
+resource "ovh_ip_firewall" "firewall" {
+  ip             = "%s"
+  ip_on_firewall = "%s"
+  enabled        = true
+}
+
+resource "ovh_ip_firewall_rule" "ssh" {
+  ip               = "${ovh_ip_firewall.firewall.ip}"
+  ip_on_firewall   = "${ovh_ip_firewall.firewall.ip_on_firewall}"
+  sequence         = 0
+  action           = "permit"
+  protocol         = "tcp"
+  destination_port = 22
+}
+`, os.Getenv("OVH_IP_BLOCK"), os.Getenv("OVH_IP_FIREWALL"))
+
+func TestAccIpFirewallRule_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckIpFirewallPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpFirewallRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpFirewallRuleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIpFirewallRuleExists("ovh_ip_firewall_rule.ssh", t),
+					resource.TestCheckResourceAttr("ovh_ip_firewall_rule.ssh", "state", "ok"),
+					resource.TestCheckResourceAttrSet("ovh_ip_firewall_rule.ssh", "rule"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIpFirewallRuleExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["ip"] == "" {
+			return fmt.Errorf("No IP block is set")
+		}
+
+		if rs.Primary.Attributes["ip_on_firewall"] == "" {
+			return fmt.Errorf("No IP on firewall is set")
+		}
+
+		sequence, err := strconv.Atoi(rs.Primary.Attributes["sequence"])
+		if err != nil {
+			return fmt.Errorf("Invalid rule sequence: %s", err)
+		}
+
+		return ipFirewallRuleExists(rs.Primary.Attributes["ip"], rs.Primary.Attributes["ip_on_firewall"], sequence, config.OVHClient)
+	}
+}
+
+func testAccCheckIpFirewallRuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_ip_firewall_rule" {
+			continue
+		}
+
+		sequence, err := strconv.Atoi(rs.Primary.Attributes["sequence"])
+		if err != nil {
+			return fmt.Errorf("Invalid rule sequence: %s", err)
+		}
+
+		err = ipFirewallRuleExists(rs.Primary.Attributes["ip"], rs.Primary.Attributes["ip_on_firewall"], sequence, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("IP Firewall rule still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var testAccIpFirewallConfig = fmt.Sprintf(`
+resource "ovh_ip_firewall" "firewall" {
+  ip             = "%s"
+  ip_on_firewall = "%s"
+  enabled        = true
+}
+`, os.Getenv("OVH_IP_BLOCK"), os.Getenv("OVH_IP_FIREWALL"))
+
+func TestAccIpFirewall_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckIpFirewallPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpFirewallConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIpFirewallExists("ovh_ip_firewall.firewall", t),
+					resource.TestCheckResourceAttr("ovh_ip_firewall.firewall", "enabled", "true"),
+					resource.TestCheckResourceAttr("ovh_ip_firewall.firewall", "state", "ok"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIpFirewallPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// enabling the firewall on an ip filters its traffic
+	// this resource is tested only if env var `OVH_IP_FIREWALL`
+	// is set
+	if os.Getenv("OVH_IP_FIREWALL") == "" {
+		t.Skip("OVH_IP_FIREWALL must be set to test ip firewalls")
+	}
+}
+
+func testAccCheckIpFirewallExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["ip"] == "" {
+			return fmt.Errorf("No IP block is set")
+		}
+
+		if rs.Primary.Attributes["ip_on_firewall"] == "" {
+			return fmt.Errorf("No IP on firewall is set")
+		}
+
+		return ipFirewallExists(rs.Primary.Attributes["ip"], rs.Primary.Attributes["ip_on_firewall"], config.OVHClient)
+	}
+}
+
+func testAccCheckIpFirewallDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_ip_firewall" {
+			continue
+		}
+
+		err := ipFirewallExists(rs.Primary.Attributes["ip"], rs.Primary.Attributes["ip_on_firewall"], config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("IP Firewall still exists")
+		}
+	}
+	return nil
+}
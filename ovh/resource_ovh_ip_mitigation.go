@@ -0,0 +1,219 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type OvhIpMitigation struct {
+	IpOnMitigation string `json:"ipOnMitigation"`
+	Permanent      bool   `json:"permanent"`
+	Auto           bool   `json:"auto"`
+	State          string `json:"state"`
+}
+
+func (m *OvhIpMitigation) String() string {
+	return fmt.Sprintf("mitigation[ip: %s, permanent: %v, auto: %v, state: %s]", m.IpOnMitigation, m.Permanent, m.Auto, m.State)
+}
+
+func (m *OvhIpMitigation) TaskStatus() string {
+	return m.State
+}
+
+type OvhIpMitigationCreateOpts struct {
+	IpOnMitigation string `json:"ipOnMitigation"`
+}
+
+type OvhIpMitigationUpdateOpts struct {
+	Permanent bool `json:"permanent"`
+}
+
+func resourceOvhIpMitigation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOvhIpMitigationCreate,
+		Read:   resourceOvhIpMitigationRead,
+		Update: resourceOvhIpMitigationUpdate,
+		Delete: resourceOvhIpMitigationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_on_mitigation": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"permanent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			// Computed
+			"auto": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOvhIpMitigationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Get("ip").(string)
+	ipOnMitigation := d.Get("ip_on_mitigation").(string)
+
+	params := &OvhIpMitigationCreateOpts{IpOnMitigation: ipOnMitigation}
+	endpoint := fmt.Sprintf("/ip/%s/mitigation", strings.Replace(ip, "/", "%2F", 1))
+
+	log.Printf("[DEBUG] Will create permanent mitigation on ip %s: %v", ip, params)
+
+	err := config.OVHClient.Post(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", ip, ipOnMitigation))
+
+	if err := ipMitigationWaitOk(config.OVHClient, ip, ipOnMitigation); err != nil {
+		return err
+	}
+
+	// the mitigation is created permanent
+	if !d.Get("permanent").(bool) {
+		return resourceOvhIpMitigationUpdate(d, meta)
+	}
+
+	return resourceOvhIpMitigationRead(d, meta)
+}
+
+func resourceOvhIpMitigationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Get("ip").(string)
+	ipOnMitigation := d.Get("ip_on_mitigation").(string)
+
+	r := &OvhIpMitigation{}
+	endpoint := fmt.Sprintf("/ip/%s/mitigation/%s", strings.Replace(ip, "/", "%2F", 1), ipOnMitigation)
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ip %s %s", ip, r)
+
+	d.Set("permanent", r.Permanent)
+	d.Set("auto", r.Auto)
+	d.Set("state", r.State)
+
+	return nil
+}
+
+func resourceOvhIpMitigationUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Get("ip").(string)
+	ipOnMitigation := d.Get("ip_on_mitigation").(string)
+
+	params := &OvhIpMitigationUpdateOpts{Permanent: d.Get("permanent").(bool)}
+	endpoint := fmt.Sprintf("/ip/%s/mitigation/%s", strings.Replace(ip, "/", "%2F", 1), ipOnMitigation)
+
+	log.Printf("[DEBUG] Will update mitigation on ip %s: %v", ip, params)
+
+	err := config.OVHClient.Put(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := ipMitigationWaitOk(config.OVHClient, ip, ipOnMitigation); err != nil {
+		return err
+	}
+
+	return resourceOvhIpMitigationRead(d, meta)
+}
+
+func resourceOvhIpMitigationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Get("ip").(string)
+	ipOnMitigation := d.Get("ip_on_mitigation").(string)
+
+	endpoint := fmt.Sprintf("/ip/%s/mitigation/%s", strings.Replace(ip, "/", "%2F", 1), ipOnMitigation)
+
+	log.Printf("[DEBUG] Will delete permanent mitigation on ip %s: %s", ip, ipOnMitigation)
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := ipMitigationWait(config.OVHClient, ip, ipOnMitigation, []string{"ok", "removalPending"}, []string{"deleted"}); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func ipMitigationWaitOk(c *ovh.Client, ip, ipOnMitigation string) error {
+	return ipMitigationWait(c, ip, ipOnMitigation, []string{"creationPending"}, []string{"ok"})
+}
+
+// ipMitigationWait waits for the permanent mitigation of an ip to reach one
+// of the target states. A mitigation which can't be found anymore is
+// reported as "deleted".
+func ipMitigationWait(c *ovh.Client, ip, ipOnMitigation string, pending, target []string) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/ip/%s/mitigation/%s", strings.Replace(ip, "/", "%2F", 1), ipOnMitigation),
+		Description: fmt.Sprintf("mitigation on ip %s (%s)", ip, ipOnMitigation),
+		Task:        &OvhIpMitigation{},
+		Pending:     pending,
+		Target:      target,
+		Purged:      "deleted",
+		Timeout:     10 * time.Minute,
+		Delay:       5 * time.Second,
+	}).Wait(c)
+	return err
+}
+
+func ipMitigationExists(ip, ipOnMitigation string, c *ovh.Client) error {
+	r := &OvhIpMitigation{}
+	endpoint := fmt.Sprintf("/ip/%s/mitigation/%s", strings.Replace(ip, "/", "%2F", 1), ipOnMitigation)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ip mitigation: %s", r)
+
+	return nil
+}
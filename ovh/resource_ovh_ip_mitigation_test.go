@@ -0,0 +1,82 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var testAccIpMitigationConfig = fmt.Sprintf(`
+resource "ovh_ip_mitigation" "mitigation" {
+  ip               = "%s"
+  ip_on_mitigation = "%s"
+}
+`, os.Getenv("OVH_IP_BLOCK"), os.Getenv("OVH_IP_MITIGATION"))
+
+func TestAccIpMitigation_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckIpMitigationPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpMitigationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpMitigationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIpMitigationExists("ovh_ip_mitigation.mitigation", t),
+					resource.TestCheckResourceAttr("ovh_ip_mitigation.mitigation", "permanent", "true"),
+					resource.TestCheckResourceAttr("ovh_ip_mitigation.mitigation", "state", "ok"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIpMitigationPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// permanent mitigation reroutes the ip traffic through the
+	// scrubbing centers. this resource is tested only if env var
+	// `OVH_IP_MITIGATION` is set
+	if os.Getenv("OVH_IP_MITIGATION") == "" {
+		t.Skip("OVH_IP_MITIGATION must be set to test ip mitigation")
+	}
+}
+
+func testAccCheckIpMitigationExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["ip"] == "" {
+			return fmt.Errorf("No IP block is set")
+		}
+
+		if rs.Primary.Attributes["ip_on_mitigation"] == "" {
+			return fmt.Errorf("No IP on mitigation is set")
+		}
+
+		return ipMitigationExists(rs.Primary.Attributes["ip"], rs.Primary.Attributes["ip_on_mitigation"], config.OVHClient)
+	}
+}
+
+func testAccCheckIpMitigationDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_ip_mitigation" {
+			continue
+		}
+
+		err := ipMitigationExists(rs.Primary.Attributes["ip"], rs.Primary.Attributes["ip_on_mitigation"], config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("IP Mitigation still exists")
+		}
+	}
+	return nil
+}
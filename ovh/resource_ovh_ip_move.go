@@ -0,0 +1,166 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type OvhIpMoveOpts struct {
+	To      string  `json:"to"`
+	Nexthop *string `json:"nexthop,omitempty"`
+}
+
+func (p *OvhIpMoveOpts) String() string {
+	return fmt.Sprintf("MoveOpts[to: %s]", p.To)
+}
+
+func resourceOvhIpMove() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOvhIpMoveCreate,
+		Read:   resourceOvhIpMoveRead,
+		Update: resourceOvhIpMoveUpdate,
+		Delete: resourceOvhIpMoveDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("ip", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"routed_to_service_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"nexthop": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOvhIpMoveCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Get("ip").(string)
+
+	r := &OvhIp{}
+	endpoint := fmt.Sprintf("/ip/%s", strings.Replace(ip, "/", "%2F", 1))
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(ip)
+
+	current := ""
+	if r.RoutedTo != nil {
+		current = r.RoutedTo.ServiceName
+	}
+
+	if current != d.Get("routed_to_service_name").(string) {
+		if err := ipMove(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceOvhIpMoveRead(d, meta)
+}
+
+func resourceOvhIpMoveRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &OvhIp{}
+	endpoint := fmt.Sprintf("/ip/%s", strings.Replace(d.Id(), "/", "%2F", 1))
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ip %s", r)
+
+	routedTo := ""
+	if r.RoutedTo != nil {
+		routedTo = r.RoutedTo.ServiceName
+	}
+
+	d.Set("ip", r.Ip)
+	d.Set("routed_to_service_name", routedTo)
+	d.Set("type", r.Type)
+
+	return nil
+}
+
+func resourceOvhIpMoveUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("routed_to_service_name") || d.HasChange("nexthop") {
+		if err := ipMove(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceOvhIpMoveRead(d, meta)
+}
+
+func resourceOvhIpMoveDelete(d *schema.ResourceData, meta interface{}) error {
+	// The ip is left routed where it is, it's only removed from the state.
+	log.Printf("[DEBUG] Will remove ip move %s from state", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+// ipMove routes the ip to the configured service, or parks it
+// if no service is set.
+func ipMove(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Id()
+	to := d.Get("routed_to_service_name").(string)
+	task := &OvhIpTask{}
+
+	if to == "" {
+		endpoint := fmt.Sprintf("/ip/%s/park", strings.Replace(ip, "/", "%2F", 1))
+
+		log.Printf("[DEBUG] Will park ip %s", ip)
+
+		if err := config.OVHClient.Post(endpoint, nil, task); err != nil {
+			return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+		}
+	} else {
+		params := &OvhIpMoveOpts{To: to}
+		if v, ok := d.GetOk("nexthop"); ok {
+			params.Nexthop = getNilStringPointer(v.(string))
+		}
+		endpoint := fmt.Sprintf("/ip/%s/move", strings.Replace(ip, "/", "%2F", 1))
+
+		log.Printf("[DEBUG] Will move ip %s: %s", ip, params)
+
+		if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+			return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	return ipTaskWait(config.OVHClient, ip, task.TaskId)
+}
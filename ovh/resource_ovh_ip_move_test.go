@@ -0,0 +1,43 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+var testAccIpMoveConfig = fmt.Sprintf(`
+resource "ovh_ip_move" "failover" {
+  ip                     = "%s"
+  routed_to_service_name = "%s"
+}
+`, os.Getenv("OVH_IP_MOVE_BLOCK"), os.Getenv("OVH_IP_MOVE_SERVICE"))
+
+func TestAccIpMove_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckIpMovePreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpMoveConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_ip_move.failover", "routed_to_service_name", os.Getenv("OVH_IP_MOVE_SERVICE")),
+					resource.TestCheckResourceAttr("ovh_ip_move.failover", "type", "failover"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIpMovePreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// moving an ip reroutes its traffic
+	// this resource is tested only if env vars `OVH_IP_MOVE_BLOCK`
+	// and `OVH_IP_MOVE_SERVICE` are set
+	if os.Getenv("OVH_IP_MOVE_BLOCK") == "" || os.Getenv("OVH_IP_MOVE_SERVICE") == "" {
+		t.Skip("OVH_IP_MOVE_BLOCK and OVH_IP_MOVE_SERVICE must be set to test ip moves")
+	}
+}
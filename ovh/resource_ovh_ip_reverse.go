@@ -16,12 +16,33 @@ type OvhIpReverse struct {
 	Reverse   string `json:"reverse"`
 }
 
+func resourceOvhIpReverseImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "_", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not IP_BLOCK_IP formatted")
+	}
+	ip := splitId[0]
+	ipReverse := splitId[1]
+	d.SetId(fmt.Sprintf("%s_%s", ip, ipReverse))
+	d.Set("ip", ip)
+	d.Set("ipreverse", ipReverse)
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
 func resourceOvhIpReverse() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceOvhIpReverseCreate,
 		Read:   resourceOvhIpReverseRead,
 		Update: resourceOvhIpReverseUpdate,
 		Delete: resourceOvhIpReverseDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOvhIpReverseImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ip": {
@@ -34,6 +55,7 @@ func resourceOvhIpReverse() *schema.Resource {
 					}
 					return
 				},
+				DiffSuppressFunc: suppressIpDiff,
 			},
 			"ipreverse": {
 				Type:     schema.TypeString,
@@ -45,10 +67,12 @@ func resourceOvhIpReverse() *schema.Resource {
 					}
 					return
 				},
+				DiffSuppressFunc: suppressIpDiff,
 			},
 			"reverse": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressDomainDiff,
 			},
 		},
 	}
@@ -56,6 +80,8 @@ func resourceOvhIpReverse() *schema.Resource {
 
 func resourceOvhIpReverseCreate(d *schema.ResourceData, meta interface{}) error {
 	provider := meta.(*Config)
+	setNormalized(d, normalizeIp, "ip", "ipreverse")
+	setNormalized(d, normalizeDomain, "reverse")
 
 	// Create the new reverse
 	newIp := d.Get("ip").(string)
@@ -120,6 +146,8 @@ func resourceOvhIpReverseRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceOvhIpReverseUpdate(d *schema.ResourceData, meta interface{}) error {
 	provider := meta.(*Config)
+	setNormalized(d, normalizeIp, "ip", "ipreverse")
+	setNormalized(d, normalizeDomain, "reverse")
 
 	reverse := OvhIpReverse{}
 
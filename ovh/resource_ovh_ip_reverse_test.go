@@ -33,6 +33,11 @@ func TestAccIpReverse_basic(t *testing.T) {
 					testAccCheckIpReverseExists("ovh_ip_reverse.reverse", t),
 				),
 			},
+			{
+				ResourceName:      "ovh_ip_reverse.reverse",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
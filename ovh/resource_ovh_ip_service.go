@@ -0,0 +1,211 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type OvhIpRoutedTo struct {
+	ServiceName string `json:"serviceName"`
+}
+
+type OvhIp struct {
+	Ip              string         `json:"ip"`
+	Description     string         `json:"description"`
+	Type            string         `json:"type"`
+	Country         string         `json:"country"`
+	OrganisationId  string         `json:"organisationId"`
+	CanBeTerminated bool           `json:"canBeTerminated"`
+	RoutedTo        *OvhIpRoutedTo `json:"routedTo"`
+}
+
+func (i *OvhIp) String() string {
+	return fmt.Sprintf("ip[ip: %s, description: %s, type: %s, organisation: %s]", i.Ip, i.Description, i.Type, i.OrganisationId)
+}
+
+type OvhIpUpdateOpts struct {
+	Description string `json:"description"`
+}
+
+type OvhIpChangeOrgOpts struct {
+	Organisation string `json:"organisation"`
+}
+
+type OvhIpTask struct {
+	TaskId   int    `json:"taskId"`
+	Function string `json:"function"`
+	Status   string `json:"status"`
+	Comment  string `json:"comment"`
+}
+
+func (t *OvhIpTask) String() string {
+	return fmt.Sprintf("task[id: %d, function: %s, status: %s]", t.TaskId, t.Function, t.Status)
+}
+
+func resourceOvhIpService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOvhIpServiceCreate,
+		Read:   resourceOvhIpServiceRead,
+		Update: resourceOvhIpServiceUpdate,
+		Delete: resourceOvhIpServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("ip", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"organisation_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"can_be_terminated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"routed_to": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOvhIpServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	// IP blocks can't be created through this resource. They are
+	// delivered with their service or ordered, then managed here.
+	d.SetId(d.Get("ip").(string))
+
+	return resourceOvhIpServiceUpdate(d, meta)
+}
+
+func resourceOvhIpServiceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &OvhIp{}
+	endpoint := fmt.Sprintf("/ip/%s", strings.Replace(d.Id(), "/", "%2F", 1))
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ip %s", r)
+
+	d.Set("ip", r.Ip)
+	d.Set("description", r.Description)
+	d.Set("organisation_id", r.OrganisationId)
+	d.Set("type", r.Type)
+	d.Set("country", r.Country)
+	d.Set("can_be_terminated", r.CanBeTerminated)
+
+	routedTo := ""
+	if r.RoutedTo != nil {
+		routedTo = r.RoutedTo.ServiceName
+	}
+	d.Set("routed_to", routedTo)
+
+	return nil
+}
+
+func resourceOvhIpServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ip := d.Id()
+
+	if d.HasChange("description") {
+		params := &OvhIpUpdateOpts{Description: d.Get("description").(string)}
+		endpoint := fmt.Sprintf("/ip/%s", strings.Replace(ip, "/", "%2F", 1))
+
+		log.Printf("[DEBUG] Will update ip %s: %v", ip, params)
+
+		err := config.OVHClient.Put(endpoint, params, nil)
+		if err != nil {
+			return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	if d.HasChange("organisation_id") && d.Get("organisation_id").(string) != "" {
+		params := &OvhIpChangeOrgOpts{Organisation: d.Get("organisation_id").(string)}
+		endpoint := fmt.Sprintf("/ip/%s/changeOrg", strings.Replace(ip, "/", "%2F", 1))
+		task := &OvhIpTask{}
+
+		log.Printf("[DEBUG] Will change organisation of ip %s: %v", ip, params)
+
+		err := config.OVHClient.Post(endpoint, params, task)
+		if err != nil {
+			return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+		}
+
+		if err := ipTaskWait(config.OVHClient, ip, task.TaskId); err != nil {
+			return err
+		}
+	}
+
+	return resourceOvhIpServiceRead(d, meta)
+}
+
+func resourceOvhIpServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	// The ip block is owned by its service and is left untouched,
+	// it's only removed from the state.
+	log.Printf("[DEBUG] Will remove ip %s from state", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+// ipTaskWait waits for an ip task to be done.
+func ipTaskWait(c *ovh.Client, ip string, taskId int) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/ip/%s/task/%d", strings.Replace(ip, "/", "%2F", 1), taskId),
+		Description: fmt.Sprintf("task %d on ip %s", taskId, ip),
+	}).Wait(c)
+	return err
+}
+
+func ipServiceExists(ip string, c *ovh.Client) error {
+	r := &OvhIp{}
+	endpoint := fmt.Sprintf("/ip/%s", strings.Replace(ip, "/", "%2F", 1))
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ip: %s", r)
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var testAccIpServiceConfig = fmt.Sprintf(`
+resource "ovh_ip_service" "block" {
+  ip          = "%s"
+  description = "terraform acceptance tests"
+}
+`, os.Getenv("OVH_IP_BLOCK"))
+
+func TestAccIpService_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpServiceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIpServiceExists("ovh_ip_service.block", t),
+					resource.TestCheckResourceAttr("ovh_ip_service.block", "description", "terraform acceptance tests"),
+					resource.TestCheckResourceAttrSet("ovh_ip_service.block", "type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIpServiceExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["ip"] == "" {
+			return fmt.Errorf("No IP block is set")
+		}
+
+		return ipServiceExists(rs.Primary.Attributes["ip"], config.OVHClient)
+	}
+}
@@ -0,0 +1,226 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// ipLoadbalancingHttpsRedirectTarget redirects to the same url over https.
+const ipLoadbalancingHttpsRedirectTarget = "https://${host}${path}${arguments}"
+
+func resourceIPLoadbalancingFrontendRedirect() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIPLoadbalancingFrontendRedirectCreate,
+		Read:   resourceIPLoadbalancingFrontendRedirectRead,
+		Update: resourceIPLoadbalancingFrontendRedirectUpdate,
+		Delete: resourceIPLoadbalancingFrontendRedirectDelete,
+
+		CustomizeDiff: customizeDiffs(
+			serviceNameCustomizeDiff("service_name", serviceNameIpLoadbalancing),
+			resourceIPLoadbalancingFrontendRedirectCustomizeDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"frontend_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  302,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(fmt.Sprintf("%d", v.(int)), []string{"301", "302", "303", "307", "308"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ipLoadbalancingHttpsRedirectTarget,
+			},
+			"weight": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			// Computed
+			"rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ipLoadbalancingFrontendRedirectRule only matches plain http traffic, so
+// that the redirect doesn't loop on frontends also serving https.
+func ipLoadbalancingFrontendRedirectRule(displayName string) *IPLoadbalancingRouteHTTPRule {
+	return &IPLoadbalancingRouteHTTPRule{
+		DisplayName: displayName,
+		Field:       "protocol",
+		Match:       "is",
+		Pattern:     "http",
+	}
+}
+
+func ipLoadbalancingFrontendRedirectRoute(d *schema.ResourceData) *IPLoadbalancingRouteHTTP {
+	return &IPLoadbalancingRouteHTTP{
+		Action: &IPLoadbalancingRouteHTTPAction{
+			Type:   "redirect",
+			Status: d.Get("status").(int),
+			Target: d.Get("target").(string),
+		},
+		DisplayName: d.Get("display_name").(string),
+		FrontendID:  d.Get("frontend_id").(int),
+		Weight:      d.Get("weight").(int),
+	}
+}
+
+func resourceIPLoadbalancingFrontendRedirectCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+
+	route := ipLoadbalancingFrontendRedirectRoute(d)
+	resp := &IPLoadbalancingRouteHTTP{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route", service)
+
+	if err := config.OVHClient.Post(endpoint, route, resp); err != nil {
+		return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+	}
+
+	ruleId, err := ipLoadbalancingFrontendRedirectRuleCreate(config.OVHClient, service, resp.RouteID, route.DisplayName)
+	if err != nil {
+		// without its rule the route would redirect all the traffic, https
+		// included: it is removed rather than left behind
+		if delErr := ipLoadbalancingRouteHTTPDelete(config.OVHClient, service, resp.RouteID); delErr != nil {
+			log.Printf("[WARN] Could not remove route %d of %s: %s", resp.RouteID, service, delErr)
+		}
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", resp.RouteID))
+	d.Set("rule_id", ruleId)
+
+	return resourceIPLoadbalancingFrontendRedirectRead(d, meta)
+}
+
+func resourceIPLoadbalancingFrontendRedirectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+
+	r := &IPLoadbalancingRouteHTTP{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%s", service, d.Id())
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	// a rule removed outside of terraform is forgotten, to be created again
+	// by the next apply
+	rule := &IPLoadbalancingRouteHTTPRule{}
+	ruleEndpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%s/rule/%s", service, d.Id(), d.Get("rule_id").(string))
+	if err := config.OVHClient.Get(ruleEndpoint, rule); err != nil {
+		if apiErr, ok := err.(*ovh.APIError); !ok || apiErr.Code != 404 {
+			return fmt.Errorf("calling Get %s:\n\t %q", ruleEndpoint, err)
+		}
+		log.Printf("[WARN] Rule %s of redirect route %s/%s is gone", d.Get("rule_id").(string), service, d.Id())
+		d.Set("rule_id", "")
+	}
+
+	d.Set("display_name", r.DisplayName)
+	d.Set("frontend_id", r.FrontendID)
+	d.Set("weight", r.Weight)
+	if r.Action != nil {
+		d.Set("status", r.Action.Status)
+		d.Set("target", r.Action.Target)
+	}
+
+	return nil
+}
+
+func resourceIPLoadbalancingFrontendRedirectUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%s", service, d.Id())
+	if err := config.OVHClient.Put(endpoint, ipLoadbalancingFrontendRedirectRoute(d), nil); err != nil {
+		return fmt.Errorf("calling %s:\n\t %s", endpoint, err.Error())
+	}
+
+	if d.Get("rule_id").(string) == "" {
+		routeId, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return fmt.Errorf("invalid route id %q: %s", d.Id(), err)
+		}
+		ruleId, err := ipLoadbalancingFrontendRedirectRuleCreate(config.OVHClient, service, routeId, d.Get("display_name").(string))
+		if err != nil {
+			return err
+		}
+		d.Set("rule_id", ruleId)
+	} else if d.HasChange("display_name") {
+		ruleEndpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%s/rule/%s", service, d.Id(), d.Get("rule_id").(string))
+		rule := ipLoadbalancingFrontendRedirectRule(d.Get("display_name").(string))
+		if err := config.OVHClient.Put(ruleEndpoint, rule, nil); err != nil {
+			return fmt.Errorf("calling %s:\n\t %s", ruleEndpoint, err.Error())
+		}
+	}
+
+	return resourceIPLoadbalancingFrontendRedirectRead(d, meta)
+}
+
+// resourceIPLoadbalancingFrontendRedirectCustomizeDiff plans the creation of
+// a rule which was removed outside of terraform.
+func resourceIPLoadbalancingFrontendRedirectCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.Get("rule_id").(string) != "" {
+		return nil
+	}
+	return d.SetNewComputed("rule_id")
+}
+
+func resourceIPLoadbalancingFrontendRedirectDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	// the rules of a route are deleted along with it
+	routeId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid route id %q: %s", d.Id(), err)
+	}
+	return ipLoadbalancingRouteHTTPDelete(config.OVHClient, d.Get("service_name").(string), routeId)
+}
+
+// ipLoadbalancingFrontendRedirectRuleCreate adds the rule restricting the
+// redirect to plain http traffic to the route, and returns its id.
+func ipLoadbalancingFrontendRedirectRuleCreate(c *ovh.Client, service string, routeId int, displayName string) (string, error) {
+	resp := &IPLoadbalancingRouteHTTPRule{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%d/rule", service, routeId)
+	if err := c.Post(endpoint, ipLoadbalancingFrontendRedirectRule(displayName), resp); err != nil {
+		return "", fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+	}
+	return fmt.Sprintf("%d", resp.RuleID), nil
+}
+
+func ipLoadbalancingRouteHTTPDelete(c *ovh.Client, service string, routeId int) error {
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%d", service, routeId)
+	if err := c.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling %s: %s \n", endpoint, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package ovh
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccIpLoadbalancingFrontendRedirectConfig = `
+resource "ovh_iploadbalancing_frontend_redirect" "https" {
+  service_name = "%s"
+  display_name = "%s"
+  status       = %d
+}
+`
+
+func TestAccIpLoadbalancingFrontendRedirect_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_IPLB_SERVICE")
+	name := test_prefix + "-frontend-redirect"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckIpLoadbalancingRouteHTTPPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpLoadbalancingFrontendRedirectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingFrontendRedirectConfig, serviceName, name, 302),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_frontend_redirect.https", "display_name", name),
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_frontend_redirect.https", "status", "302"),
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_frontend_redirect.https", "target", ipLoadbalancingHttpsRedirectTarget),
+					resource.TestCheckResourceAttrSet("ovh_iploadbalancing_frontend_redirect.https", "rule_id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingFrontendRedirectConfig, serviceName, name+"-permanent", 301),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_frontend_redirect.https", "display_name", name+"-permanent"),
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_frontend_redirect.https", "status", "301"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIpLoadbalancingFrontendRedirectDestroy(state *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "ovh_iploadbalancing_frontend_redirect" {
+			continue
+		}
+
+		endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%s", rs.Primary.Attributes["service_name"], rs.Primary.ID)
+		if err := config.OVHClient.Get(endpoint, nil); err == nil {
+			return fmt.Errorf("IpLoadbalancing redirect route %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestIpLoadbalancingFrontendRedirectCreate(t *testing.T) {
+	var calls []string
+	ruleFails, ruleGone := false, false
+	client := newTestOVHClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/ipLoadbalancing/lb-1/http/route":
+			w.Write([]byte(`{"routeId":7}`))
+		case r.Method == "POST" && r.URL.Path == "/ipLoadbalancing/lb-1/http/route/7/rule":
+			if ruleFails {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"message":"invalid rule"}`))
+				return
+			}
+			w.Write([]byte(`{"ruleId":3,"routeId":7}`))
+		case r.Method == "GET" && r.URL.Path == "/ipLoadbalancing/lb-1/http/route/7/rule/3" && ruleGone:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+		case r.URL.Path == "/ipLoadbalancing/lb-1/http/route/7":
+			w.Write([]byte(`{"routeId":7,"action":{"type":"redirect","status":302,"target":"https://${host}${path}${arguments}"}}`))
+		default:
+			w.Write([]byte(`{}`))
+		}
+	})
+	config := &Config{OVHClient: client}
+
+	r := resourceIPLoadbalancingFrontendRedirect()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"service_name": "lb-1",
+	})
+	if err := r.Create(d, config); err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	if d.Id() != "7" || d.Get("rule_id").(string) != "3" {
+		t.Errorf("unexpected route %q and rule %q", d.Id(), d.Get("rule_id"))
+	}
+	if d.Get("status").(int) != 302 || d.Get("target").(string) != ipLoadbalancingHttpsRedirectTarget {
+		t.Errorf("unexpected redirect %d to %q", d.Get("status"), d.Get("target"))
+	}
+
+	// a rule removed outside of terraform is forgotten, then created again
+	ruleGone = true
+	if err := r.Read(d, config); err != nil {
+		t.Fatalf("read failed: %s", err)
+	}
+	if d.Id() != "7" || d.Get("rule_id").(string) != "" {
+		t.Errorf("expected route 7 without rule, got %q and %q", d.Id(), d.Get("rule_id"))
+	}
+	ruleGone = false
+	if err := r.Update(d, config); err != nil {
+		t.Fatalf("update failed: %s", err)
+	}
+	if d.Get("rule_id").(string) != "3" {
+		t.Errorf("expected the rule to be created again, got %q", d.Get("rule_id"))
+	}
+
+	// a route whose rule can't be created is removed
+	calls, ruleFails = nil, true
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"service_name": "lb-1",
+	})
+	if err := r.Create(d, config); err == nil {
+		t.Fatal("expected create to fail")
+	}
+	if d.Id() != "" {
+		t.Errorf("expected no id, got %q", d.Id())
+	}
+	if last := calls[len(calls)-1]; last != "DELETE /ipLoadbalancing/lb-1/http/route/7" {
+		t.Errorf("expected the route to be removed, last call was %s", last)
+	}
+}
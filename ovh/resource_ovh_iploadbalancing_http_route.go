@@ -2,8 +2,11 @@ package ovh
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
 )
 
 func resourceIPLoadbalancingRouteHTTP() *schema.Resource {
@@ -13,6 +16,11 @@ func resourceIPLoadbalancingRouteHTTP() *schema.Resource {
 		Update: resourceIPLoadbalancingRouteHTTPUpdate,
 		Delete: resourceIPLoadbalancingRouteHTTPDelete,
 
+		CustomizeDiff: customizeDiffs(
+			serviceNameCustomizeDiff("service_name", serviceNameIpLoadbalancing),
+			resourceIPLoadbalancingRouteHTTPCustomizeDiff,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"service_name": {
 				Type:     schema.TypeString,
@@ -64,7 +72,7 @@ type IPLoadbalancingRouteHTTPAction struct {
 	Type   string `json:"type,omitempty"`   // Action to trigger if all the rules of this route matches
 }
 
-//IPLoadbalancingRouteHTTP HTTP Route
+// IPLoadbalancingRouteHTTP HTTP Route
 type IPLoadbalancingRouteHTTP struct {
 	Status      string                          `json:"status,omitempty"`      //Route status. Routes in "ok" state are ready to operate
 	Weight      int                             `json:"weight,omitempty"`      //Route priority ([0..255]). 0 if null. Highest priority routes are evaluated first. Only the first matching route will trigger an action
@@ -151,6 +159,59 @@ func resourceIPLoadbalancingRouteHTTPUpdate(d *schema.ResourceData, meta interfa
 	return resourceIPLoadbalancingRouteHTTPRead(d, meta)
 }
 
+// resourceIPLoadbalancingRouteHTTPCustomizeDiff checks at plan time that the
+// farm targeted by the route can receive the traffic of its frontend, when
+// both already exist.
+func resourceIPLoadbalancingRouteHTTPCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("action") && !d.HasChange("frontend_id") {
+		return nil
+	}
+
+	if !d.NewValueKnown("service_name") || !d.NewValueKnown("action") || !d.NewValueKnown("frontend_id") {
+		return nil
+	}
+
+	actions := d.Get("action").(*schema.Set).List()
+	if len(actions) == 0 {
+		return nil
+	}
+	actionSet := actions[0].(map[string]interface{})
+
+	return ipLoadbalancingRouteHTTPFarmCheck(
+		meta.(*Config).OVHClient,
+		d.Get("service_name").(string),
+		d.Get("frontend_id").(int),
+		&IPLoadbalancingRouteHTTPAction{
+			Target: actionSet["target"].(string),
+			Type:   actionSet["type"].(string),
+		},
+	)
+}
+
+// ipLoadbalancingRouteHTTPFarmCheck checks that the target of a "farm"
+// action is a farm which can be used from the zone of the route frontend.
+func ipLoadbalancingRouteHTTPFarmCheck(c *ovh.Client, service string, frontendId int, action *IPLoadbalancingRouteHTTPAction) error {
+	if action.Type != "farm" || frontendId == 0 {
+		return nil
+	}
+
+	farmId, err := strconv.Atoi(action.Target)
+	if err != nil {
+		return fmt.Errorf("the target of a farm action must be a farm id, got %q", action.Target)
+	}
+
+	frontend := &IpLoadbalancingZoned{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/frontend/%d", service, frontendId)
+	if err := c.Get(endpoint, frontend); err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			return fmt.Errorf("frontend_id %d doesn't match any http frontend of %s", frontendId, service)
+		}
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	return ipLoadbalancingFarmZoneCheck(c, service, "http", farmId, frontend.Zone, "action.target")
+}
+
 func resourceIPLoadbalancingRouteHTTPDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -13,6 +13,8 @@ func resourceIPLoadbalancingRouteHTTPRule() *schema.Resource {
 		Update: resourceIPLoadbalancingRouteHTTPRuleUpdate,
 		Delete: resourceIPLoadbalancingRouteHTTPRuleDelete,
 
+		CustomizeDiff: serviceNameCustomizeDiff("service_name", serviceNameIpLoadbalancing),
+
 		Schema: map[string]*schema.Schema{
 			"service_name": {
 				Type:     schema.TypeString,
@@ -59,7 +61,7 @@ func resourceIPLoadbalancingRouteHTTPRule() *schema.Resource {
 	}
 }
 
-//IPLoadbalancingRouteHTTPRule HTTP Route Rule
+// IPLoadbalancingRouteHTTPRule HTTP Route Rule
 type IPLoadbalancingRouteHTTPRule struct {
 	RuleID      int    `json:"ruleId,omitempty"`      //Id of your rule
 	RouteID     int    `json:"routeId,omitempty"`     //Id of your route
@@ -2,17 +2,73 @@ package ovh
 
 import (
 	"fmt"
+	"log"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("ovh_iploadbalancing_http_route", &resource.Sweeper{
+		Name: "ovh_iploadbalancing_http_route",
+		F:    testSweepIploadbalancingHttpRoute,
+	})
+}
+
+func testSweepIploadbalancingHttpRoute(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+	if iplb == "" {
+		return fmt.Errorf("OVH_IPLB_SERVICE must be set")
+	}
+
+	routes := make([]int64, 0)
+	if err := client.Get(fmt.Sprintf("/ipLoadbalancing/%s/http/route", iplb), &routes); err != nil {
+		return fmt.Errorf("Error calling /ipLoadbalancing/%s/http/route:\n\t %q", iplb, err)
+	}
+
+	if len(routes) == 0 {
+		log.Print("[DEBUG] No route to sweep")
+		return nil
+	}
+
+	for _, r := range routes {
+		route := &IPLoadbalancingRouteHTTP{}
+
+		if err := client.Get(fmt.Sprintf("/ipLoadbalancing/%s/http/route/%d", iplb, r), &route); err != nil {
+			return fmt.Errorf("Error calling /ipLoadbalancing/%s/http/route/%d:\n\t %q", iplb, r, err)
+		}
+
+		if !strings.HasPrefix(route.DisplayName, test_prefix) {
+			continue
+		}
+
+		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+			if err := client.Delete(fmt.Sprintf("/ipLoadbalancing/%s/http/route/%d", iplb, r), nil); err != nil {
+				return resource.RetryableError(err)
+			}
+			// Successful delete
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccIPLoadbalancingRouteHTTPBasicCreate(t *testing.T) {
 	serviceName := os.Getenv("OVH_IPLB_SERVICE")
-	name := "test-route-redirect-https"
+	name := test_prefix + "-route-redirect-https"
 	weight := "0"
 	actionStatus := "302"
 	actionTarget := "https://$${host}$${path}$${arguments}"
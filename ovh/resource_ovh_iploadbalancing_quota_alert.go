@@ -0,0 +1,186 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type IpLoadbalancingQuota struct {
+	Zone      string `json:"zone"`
+	Alert     *int64 `json:"alert"`
+	Included  *int64 `json:"included"`
+	Total     int64  `json:"total"`
+	LastReset string `json:"lastReset"`
+}
+
+func (q *IpLoadbalancingQuota) String() string {
+	alert := int64(0)
+	if q.Alert != nil {
+		alert = *q.Alert
+	}
+	return fmt.Sprintf("Quota[zone: %s, alert: %d, total: %d]", q.Zone, alert, q.Total)
+}
+
+type IpLoadbalancingQuotaOpts struct {
+	Alert int64 `json:"alert"`
+}
+
+func resourceIpLoadbalancingQuotaAlertImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/ZONE formatted")
+	}
+	d.Set("service_name", splitId[0])
+	d.Set("zone", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpLoadbalancingQuotaAlert() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpLoadbalancingQuotaAlertCreate,
+		Read:   resourceIpLoadbalancingQuotaAlertRead,
+		Update: resourceIpLoadbalancingQuotaAlertUpdate,
+		Delete: resourceIpLoadbalancingQuotaAlertDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpLoadbalancingQuotaAlertImportState,
+		},
+
+		CustomizeDiff: serviceNameCustomizeDiff("service_name", serviceNameIpLoadbalancing),
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressZoneDiff,
+			},
+			"alert": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if v.(int) < 0 {
+						errors = append(errors, fmt.Errorf("%q must be a positive number of bytes, got %d", k, v.(int)))
+					}
+					return
+				},
+			},
+
+			// Computed
+			"included": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_reset": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceIpLoadbalancingQuotaAlertCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	setNormalized(d, normalizeZone, "zone")
+
+	service := d.Get("service_name").(string)
+	zone := d.Get("zone").(string)
+
+	// the quotas can't be created: the zone must be one of the service
+	if _, err := ipLoadbalancingQuotaGet(config.OVHClient, service, zone); err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			zones := []string{}
+			endpoint := fmt.Sprintf("/ipLoadbalancing/%s/quota", service)
+			if err := config.OVHClient.Get(endpoint, &zones); err != nil {
+				return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+			}
+			return fmt.Errorf("%s has no quota for zone %s. Available zones: %v", service, zone, zones)
+		}
+		return fmt.Errorf("calling Get /ipLoadbalancing/%s/quota/%s:\n\t %q", service, zone, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", service, zone))
+
+	return resourceIpLoadbalancingQuotaAlertUpdate(d, meta)
+}
+
+func resourceIpLoadbalancingQuotaAlertRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+	zone := d.Get("zone").(string)
+
+	r, err := ipLoadbalancingQuotaGet(config.OVHClient, service, zone)
+	if err != nil {
+		return CheckDeleted(d, err, fmt.Sprintf("/ipLoadbalancing/%s/quota/%s", service, zone))
+	}
+
+	d.Set("zone", r.Zone)
+	if r.Alert != nil {
+		d.Set("alert", int(*r.Alert))
+	} else {
+		d.Set("alert", 0)
+	}
+	if r.Included != nil {
+		d.Set("included", int(*r.Included))
+	}
+	d.Set("total", int(r.Total))
+	d.Set("last_reset", r.LastReset)
+
+	return nil
+}
+
+func resourceIpLoadbalancingQuotaAlertUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+	zone := d.Get("zone").(string)
+
+	params := &IpLoadbalancingQuotaOpts{
+		Alert: int64(d.Get("alert").(int)),
+	}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/quota/%s", service, zone)
+
+	log.Printf("[DEBUG] Will set quota alert of %s in zone %s to %d bytes", service, zone, params.Alert)
+
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceIpLoadbalancingQuotaAlertRead(d, meta)
+}
+
+func resourceIpLoadbalancingQuotaAlertDelete(d *schema.ResourceData, meta interface{}) error {
+	// the zone quotas belong to the service: the alert is left as is.
+	log.Printf("[DEBUG] Quota alert %s is kept on the service", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func ipLoadbalancingQuotaGet(c *ovh.Client, service, zone string) (*IpLoadbalancingQuota, error) {
+	r := &IpLoadbalancingQuota{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/quota/%s", service, zone)
+	if err := c.Get(endpoint, r); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Read quota of %s: %s", service, r)
+	return r, nil
+}
@@ -0,0 +1,51 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIpLoadbalancingQuotaAlert_basic(t *testing.T) {
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccCheckIpLoadbalancingExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingQuotaAlertConfig, iplb, 500000000000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_quota_alert.quota", "alert", "500000000000"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_iploadbalancing_quota_alert.quota", "total"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingQuotaAlertConfig, iplb, 0),
+				Check: resource.TestCheckResourceAttr(
+					"ovh_iploadbalancing_quota_alert.quota", "alert", "0"),
+			},
+			{
+				ResourceName:      "ovh_iploadbalancing_quota_alert.quota",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIpLoadbalancingQuotaAlertConfig = `
+data "ovh_iploadbalancing" "iplb" {
+  service_name = "%s"
+}
+
+resource "ovh_iploadbalancing_quota_alert" "quota" {
+  service_name = "${data.ovh_iploadbalancing.iplb.service_name}"
+  zone         = "${element(data.ovh_iploadbalancing.iplb.zone, 0)}"
+  alert        = %d
+}
+`
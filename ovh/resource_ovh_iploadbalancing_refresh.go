@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -18,6 +17,10 @@ type IPLoadbalancingRefreshTask struct {
 	Zones        []string `json:"zones"`
 }
 
+func (t *IPLoadbalancingRefreshTask) TaskStatus() string {
+	return t.Status
+}
+
 type IPLoadbalancingRefreshPending struct {
 	Number int    `json:"number"`
 	Zone   string `json:"zone"`
@@ -31,6 +34,8 @@ func resourceIPLoadbalancingRefresh() *schema.Resource {
 		Read:   resourceIPLoadbalancingRefreshRead,
 		Delete: resourceIPLoadbalancingRefreshDelete,
 
+		CustomizeDiff: serviceNameCustomizeDiff("service_name", serviceNameIpLoadbalancing),
+
 		Schema: map[string]*schema.Schema{
 			"service_name": {
 				Type:     schema.TypeString,
@@ -45,6 +50,8 @@ func resourceIPLoadbalancingRefresh() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
 		},
 	}
 }
@@ -56,30 +63,28 @@ func resourceIPLoadbalancingRefreshCreate(d *schema.ResourceData, meta interface
 	// verify if there are no active tasks for the loadbalancer
 	// at the moment and wait till finished if there are any
 
-	stateConf := &resource.StateChangeConf{
-		Target: []string{"empty"},
-		Refresh: func() (interface{}, string, error) {
-			for _, state := range []string{"todo", "doing"} {
-				taskResp := &[]int{}
-				endpoint := fmt.Sprintf("/ipLoadbalancing/%s/task?action=refreshIplb&status=%s", service, state)
-				err := config.OVHClient.Get(endpoint, taskResp)
-				if err != nil {
-					return d, "error", fmt.Errorf("calling GET %s :\n\t %s", endpoint, err.Error())
-				}
-				if len(*taskResp) > 0 {
-					return d, "exists", nil
-				}
+	for _, state := range []string{"todo", "doing"} {
+		taskIds := []int{}
+		endpoint := fmt.Sprintf("/ipLoadbalancing/%s/task?action=refreshIplb&status=%s", service, state)
+		if err := config.OVHClient.Get(endpoint, &taskIds); err != nil {
+			return fmt.Errorf("calling GET %s :\n\t %s", endpoint, err.Error())
+		}
+
+		for _, id := range taskIds {
+			// the outcome of a refresh started elsewhere doesn't matter,
+			// only its end does.
+			_, err := (&taskPoller{
+				Endpoint:    fmt.Sprintf("/ipLoadbalancing/%s/task/%d", service, id),
+				Description: fmt.Sprintf("refresh task %d on iploadbalancing %s", id, service),
+				Task:        &IPLoadbalancingRefreshTask{},
+				Pending:     []string{"todo", "doing"},
+				Target:      []string{"done", "cancelled", "error", "blocked"},
+				Timeout:     10 * time.Minute,
+			}).Wait(config.OVHClient)
+			if err != nil {
+				return fmt.Errorf("Error waiting for IPLoadbalancer tasks to finish: %s", err)
 			}
-			return d, "empty", nil
-		},
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for IPLoadbalancer tasks to finish: %s", err)
+		}
 	}
 
 	// verify if there are any outstanding changes to refresh
@@ -87,7 +92,7 @@ func resourceIPLoadbalancingRefreshCreate(d *schema.ResourceData, meta interface
 	checkResp := &IPLoadbalancingRefreshPendings{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/pendingChanges", service)
 
-	err = config.OVHClient.Get(endpoint, checkResp)
+	err := config.OVHClient.Get(endpoint, checkResp)
 	if err != nil {
 		return fmt.Errorf("calling GET %s :\n\t %s", endpoint, err.Error())
 	}
@@ -95,6 +100,7 @@ func resourceIPLoadbalancingRefreshCreate(d *schema.ResourceData, meta interface
 	// no changes detected, return successfull creation/refresh
 	if len(*checkResp) == 0 {
 		d.SetId(service)
+		taskSetComputed(d, nil, nil)
 		return nil
 	}
 
@@ -108,28 +114,20 @@ func resourceIPLoadbalancingRefreshCreate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
 	}
 
-	stateConf = &resource.StateChangeConf{
-		Target: []string{"done"},
-		Refresh: func() (interface{}, string, error) {
-			endpoint := fmt.Sprintf("/ipLoadbalancing/%s/task/%d", service, resp.ID)
-			stateResp := &IPLoadbalancingRefreshTask{}
-			err := config.OVHClient.Get(endpoint, stateResp)
-			if err != nil {
-				return nil, "", err
-			}
-			return d, stateResp.Status, nil
-		},
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
+	task, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/ipLoadbalancing/%s/task/%d", service, resp.ID),
+		Description: fmt.Sprintf("refresh task %d on iploadbalancing %s", resp.ID, service),
+		Task:        &IPLoadbalancingRefreshTask{},
+		Pending:     []string{"todo", "doing"},
+		Failed:      []string{"cancelled", "error"},
+		Timeout:     10 * time.Minute,
+	}).Wait(config.OVHClient)
 	if err != nil {
 		return fmt.Errorf("Error waiting for IPLoadbalancer refresh: %s", err)
 	}
 
 	d.SetId(service)
+	taskSetComputed(d, resp.ID, task)
 
 	return nil
 }
@@ -36,6 +36,8 @@ func resourceIpLoadbalancingTcpFarm() *schema.Resource {
 		Update: resourceIpLoadbalancingTcpFarmUpdate,
 		Delete: resourceIpLoadbalancingTcpFarmDelete,
 
+		CustomizeDiff: serviceNameCustomizeDiff("service_name", serviceNameIpLoadbalancing),
+
 		Schema: map[string]*schema.Schema{
 			"service_name": {
 				Type:     schema.TypeString,
@@ -218,6 +220,7 @@ func resourceIpLoadbalancingTcpFarmRead(d *schema.ResourceData, meta interface{}
 	}
 
 	d.Set("display_name", r.DisplayName)
+	d.Set("vrack_network_id", r.VrackNetworkId)
 
 	return nil
 }
@@ -24,6 +24,7 @@ type IpLoadbalancingTcpFarmServer struct {
 	Ssl                  *bool   `json:"ssl"`
 	Backup               *bool   `json:"backup"`
 	Status               *string `json:"status"`
+	OnMarkedDown         *string `json:"onMarkedDown"`
 }
 
 func resourceIpLoadbalancingTcpFarmServer() *schema.Resource {
@@ -32,6 +33,12 @@ func resourceIpLoadbalancingTcpFarmServer() *schema.Resource {
 		Read:   resourceIpLoadbalancingTcpFarmServerRead,
 		Update: resourceIpLoadbalancingTcpFarmServerUpdate,
 		Delete: resourceIpLoadbalancingTcpFarmServerDelete,
+
+		CustomizeDiff: customizeDiffs(
+			serviceNameCustomizeDiff("service_name", serviceNameIpLoadbalancing),
+			resourceIpLoadbalancingTcpFarmServerCustomizeDiff,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"service_name": {
 				Type:     schema.TypeString,
@@ -58,6 +65,7 @@ func resourceIpLoadbalancingTcpFarmServer() *schema.Resource {
 					}
 					return
 				},
+				DiffSuppressFunc: suppressIpDiff,
 			},
 			"ssl": {
 				Type:     schema.TypeBool,
@@ -91,6 +99,14 @@ func resourceIpLoadbalancingTcpFarmServer() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 				Default:  1,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					// a weight of 0 keeps the server in the farm without
+					// sending it new connections, which drains it
+					if weight := v.(int); weight < 0 || weight > 256 {
+						errors = append(errors, fmt.Errorf("%s must be between 0 and 256, got %d", k, weight))
+					}
+					return
+				},
 			},
 			"probe": {
 				Type:     schema.TypeBool,
@@ -113,12 +129,24 @@ func resourceIpLoadbalancingTcpFarmServer() *schema.Resource {
 					return
 				},
 			},
+			"on_marked_down": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"shutdown-sessions"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
 		},
 	}
 }
 
 func resourceIpLoadbalancingTcpFarmServerCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	setNormalized(d, normalizeIp, "address")
 
 	newBackendServer := &IpLoadbalancingTcpFarmServer{
 		DisplayName:          getNilStringPointer(d.Get("display_name").(string)),
@@ -131,6 +159,7 @@ func resourceIpLoadbalancingTcpFarmServerCreate(d *schema.ResourceData, meta int
 		Ssl:                  getNilBoolPointer(d.Get("ssl").(bool)),
 		Backup:               getNilBoolPointer(d.Get("backup").(bool)),
 		Status:               getNilStringPointer(d.Get("status").(string)),
+		OnMarkedDown:         getNilStringPointer(d.Get("on_marked_down").(string)),
 	}
 
 	service := d.Get("service_name").(string)
@@ -183,6 +212,11 @@ func resourceIpLoadbalancingTcpFarmServerRead(d *schema.ResourceData, meta inter
 	}
 	d.Set("weight", *r.Weight)
 	d.Set("status", *r.Status)
+	if r.OnMarkedDown != nil {
+		d.Set("on_marked_down", *r.OnMarkedDown)
+	} else {
+		d.Set("on_marked_down", "")
+	}
 
 	return nil
 }
@@ -201,6 +235,7 @@ func resourceIpLoadbalancingTcpFarmServerUpdate(d *schema.ResourceData, meta int
 		Ssl:                  getNilBoolPointer(d.Get("ssl").(bool)),
 		Backup:               getNilBoolPointer(d.Get("backup").(bool)),
 		Status:               getNilStringPointer(d.Get("status").(string)),
+		OnMarkedDown:         getNilStringPointer(d.Get("on_marked_down").(string)),
 	}
 
 	service := d.Get("service_name").(string)
@@ -232,3 +267,63 @@ func resourceIpLoadbalancingTcpFarmServerDelete(d *schema.ResourceData, meta int
 
 	return nil
 }
+
+// ipLoadbalancingPrivateNetworks are the RFC 1918 networks, which the load
+// balancer can only reach through a vrack.
+var ipLoadbalancingPrivateNetworks = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}
+
+func ipLoadbalancingIsPrivateIp(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, cidr := range ipLoadbalancingPrivateNetworks {
+		_, network, _ := net.ParseCIDR(cidr)
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceIpLoadbalancingTcpFarmServerCustomizeDiff makes the plan fail when a
+// server with a private address is added to a farm without vrack network, as
+// the load balancer would never reach it.
+func resourceIpLoadbalancingTcpFarmServerCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("service_name") || !d.NewValueKnown("farm_id") || !d.NewValueKnown("address") {
+		return nil
+	}
+
+	return ipLoadbalancingTcpFarmServerVrackCheck(
+		meta.(*Config),
+		d.Get("service_name").(string),
+		d.Get("farm_id").(int),
+		d.Get("address").(string),
+	)
+}
+
+func ipLoadbalancingTcpFarmServerVrackCheck(config *Config, service string, farmId int, address string) error {
+	if !ipLoadbalancingIsPrivateIp(address) {
+		return nil
+	}
+
+	farm := &IpLoadbalancingTcpFarm{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/farm/%d", service, farmId)
+	if err := config.OVHClient.Get(endpoint, farm); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	if farm.VrackNetworkId == 0 {
+		return fmt.Errorf(
+			"address %s is a private address, which farm %d of %s can only reach through a vrack: set the vrack_network_id of the farm",
+			address, farmId, service,
+		)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -13,12 +14,14 @@ import (
 
 var TestAccIpLoadbalancingTcpFarmServerPlan = [][]map[string]interface{}{
 	{
-		{"Status": "active", "Address": "10.0.0.11", "Port": 80, "Weight": 3, "DisplayName": "testBackendA"},
+		{"Status": "active", "Address": "198.51.100.11", "Port": 80, "Weight": 3, "DisplayName": "testBackendA"},
 		{"Port": 8080, "Probe": true, "Backup": true},
 		{"Port": 8080, "Probe": false, "Backup": false, "Weight": 2, "DisplayName": "testBackendB"},
+		{"Weight": 0, "OnMarkedDown": "shutdown-sessions"},
+		{"Weight": 2, "OnMarkedDown": nil},
 	},
 	{
-		{"Status": "inactive", "Address": "10.0.0.12", "Port": 80},
+		{"Status": "inactive", "Address": "198.51.100.12", "Port": 80},
 		{"Port": 8080, "ProxyProtocolVersion": "v2", "Ssl": true},
 		{"Port": 8080, "ProxyProtocolVersion": "v1", "Ssl": true, "Backup": false},
 		{"Port": 8080, "ProxyProtocolVersion": nil, "Ssl": true, "Backup": true, "Status": "active"},
@@ -41,6 +44,7 @@ type TestAccIpLoadbalancingTcpFarmServer struct {
 	Ssl                  *bool   `json:"ssl"`
 	Backup               *bool   `json:"backup"`
 	Status               *string `json:"status"`
+	OnMarkedDown         *string `json:"onMarkedDown"`
 }
 
 type TestAccIpLoadbalancingTcpFarmServerWrapper struct {
@@ -77,6 +81,7 @@ func (w *TestAccIpLoadbalancingTcpFarmServerWrapper) Config() string {
 	conditionalAttributeBool(&config, "probe", w.Expected.Probe)
 	conditionalAttributeBool(&config, "ssl", w.Expected.Ssl)
 	conditionalAttributeBool(&config, "backup", w.Expected.Backup)
+	conditionalAttributeString(&config, "on_marked_down", w.Expected.OnMarkedDown)
 	config.WriteString(`}`)
 	return config.String()
 }
@@ -112,6 +117,9 @@ func (server *TestAccIpLoadbalancingTcpFarmServer) MustEqual(compared *TestAccIp
 	if !reflect.DeepEqual(server.Status, compared.Status) {
 		return fmt.Errorf("Status differs")
 	}
+	if !reflect.DeepEqual(server.OnMarkedDown, compared.OnMarkedDown) {
+		return fmt.Errorf("OnMarkedDown differs")
+	}
 	return nil
 }
 
@@ -151,6 +159,9 @@ func (w *TestAccIpLoadbalancingTcpFarmServerWrapper) TestStep(c map[string]inter
 	if val, ok := c["Status"]; ok {
 		w.Expected.Status = getNilStringPointer(val)
 	}
+	if val, ok := c["OnMarkedDown"]; ok {
+		w.Expected.OnMarkedDown = getNilStringPointer(val)
+	}
 
 	expected := *w.Expected
 
@@ -243,3 +254,56 @@ func TestAccIpLoadbalancingTcpFarmServerBasicCreate(t *testing.T) {
 		})
 	}
 }
+
+const testAccIpLoadbalancingTcpFarmServerPrivateFarmConfig = `
+resource "ovh_iploadbalancing_tcp_farm" "testacc" {
+  service_name = "%s"
+  display_name = "%s"
+  port         = 8080
+  zone         = "all"
+}
+`
+
+const testAccIpLoadbalancingTcpFarmServerPrivateConfig = testAccIpLoadbalancingTcpFarmServerPrivateFarmConfig + `
+resource "ovh_iploadbalancing_tcp_farm_server" "testacc" {
+  service_name = "${ovh_iploadbalancing_tcp_farm.testacc.service_name}"
+  farm_id      = "${ovh_iploadbalancing_tcp_farm.testacc.id}"
+  address      = "10.0.0.11"
+  status       = "active"
+}
+`
+
+func TestAccIpLoadbalancingTcpFarmServer_privateWithoutVrack(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccCheckIpLoadbalancingExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			// the farm has to exist for the check to run at plan time
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingTcpFarmServerPrivateFarmConfig, os.Getenv("OVH_IPLB_SERVICE"), test_prefix+"-private"),
+			},
+			{
+				Config:      fmt.Sprintf(testAccIpLoadbalancingTcpFarmServerPrivateConfig, os.Getenv("OVH_IPLB_SERVICE"), test_prefix+"-private"),
+				ExpectError: regexp.MustCompile("set the vrack_network_id of the farm"),
+			},
+		},
+	})
+}
+
+func TestIpLoadbalancingIsPrivateIp(t *testing.T) {
+	cases := map[string]bool{
+		"10.0.0.11":      true,
+		"172.16.4.1":     true,
+		"172.31.255.255": true,
+		"192.168.1.1":    true,
+		"172.32.0.1":     false,
+		"51.210.10.10":   false,
+		"not-an-ip":      false,
+	}
+
+	for address, expected := range cases {
+		if private := ipLoadbalancingIsPrivateIp(address); private != expected {
+			t.Errorf("%s: expected %v, got %v", address, expected, private)
+		}
+	}
+}
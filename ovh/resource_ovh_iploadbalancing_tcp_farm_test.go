@@ -2,9 +2,12 @@ package ovh
 
 import (
 	"fmt"
+	"log"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -41,6 +44,61 @@ func (r *TestAccIpLoadbalancingTcpFarmResponse) Equals(c *TestAccIpLoadbalancing
 	return false
 }
 
+func init() {
+	resource.AddTestSweepers("ovh_iploadbalancing_tcp_farm", &resource.Sweeper{
+		Name:         "ovh_iploadbalancing_tcp_farm",
+		Dependencies: []string{"ovh_iploadbalancing_tcp_frontend"},
+		F:            testSweepIploadbalancingTcpFarm,
+	})
+}
+
+func testSweepIploadbalancingTcpFarm(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+	if iplb == "" {
+		return fmt.Errorf("OVH_IPLB_SERVICE must be set")
+	}
+
+	farms := make([]int64, 0)
+	if err := client.Get(fmt.Sprintf("/ipLoadbalancing/%s/tcp/farm", iplb), &farms); err != nil {
+		return fmt.Errorf("Error calling /ipLoadbalancing/%s/tcp/farm:\n\t %q", iplb, err)
+	}
+
+	if len(farms) == 0 {
+		log.Print("[DEBUG] No farm to sweep")
+		return nil
+	}
+
+	for _, f := range farms {
+		farm := &IpLoadbalancingTcpFarm{}
+
+		if err := client.Get(fmt.Sprintf("/ipLoadbalancing/%s/tcp/farm/%d", iplb, f), &farm); err != nil {
+			return fmt.Errorf("Error calling /ipLoadbalancing/%s/tcp/farm/%d:\n\t %q", iplb, f, err)
+		}
+
+		if !strings.HasPrefix(farm.DisplayName, test_prefix) {
+			continue
+		}
+
+		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+			if err := client.Delete(fmt.Sprintf("/ipLoadbalancing/%s/tcp/farm/%d", iplb, f), nil); err != nil {
+				return resource.RetryableError(err)
+			}
+			// Successful delete
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func testAccIpLoadbalancingTcpFarmTestStep(name, zone string, port, probePort, probeInterval int, probeType string) resource.TestStep {
 	expected := &TestAccIpLoadbalancingTcpFarmResponse{
 		Zone:        zone,
@@ -81,8 +139,8 @@ func TestAccIpLoadbalancingTcpFarmBasicCreate(t *testing.T) {
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckIpLoadbalancingTcpFarmDestroy,
 		Steps: []resource.TestStep{
-			testAccIpLoadbalancingTcpFarmTestStep("test-farm-v1", "all", 8080, 8888, 35, "tcp"),
-			testAccIpLoadbalancingTcpFarmTestStep("test-farm-v2", "all", 8080, 9999, 60, "tcp"),
+			testAccIpLoadbalancingTcpFarmTestStep(test_prefix+"-farm-v1", "all", 8080, 8888, 35, "tcp"),
+			testAccIpLoadbalancingTcpFarmTestStep(test_prefix+"-farm-v2", "all", 8080, 9999, 60, "tcp"),
 		},
 	})
 }
@@ -2,6 +2,8 @@ package ovh
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -10,8 +12,8 @@ type IpLoadbalancingTcpFrontend struct {
 	FrontendId    int      `json:"frontendId,omitempty"`
 	Port          string   `json:"port"`
 	Zone          string   `json:"zone"`
-	AllowedSource []string `json:"allowedSource,omitempty"`
-	DedicatedIpFo []string `json:"dedicatedIpfo,omitempty"`
+	AllowedSource []string `json:"allowedSource"`
+	DedicatedIpFo []string `json:"dedicatedIpfo"`
 	DefaultFarmId *int     `json:"defaultFarmId,omitempty"`
 	DefaultSslId  *int     `json:"defaultSslId,omitempty"`
 	Disabled      *bool    `json:"disabled"`
@@ -26,6 +28,11 @@ func resourceIpLoadbalancingTcpFrontend() *schema.Resource {
 		Update: resourceIpLoadbalancingTcpFrontendUpdate,
 		Delete: resourceIpLoadbalancingTcpFrontendDelete,
 
+		CustomizeDiff: customizeDiffs(
+			serviceNameCustomizeDiff("service_name", serviceNameIpLoadbalancing),
+			resourceIpLoadbalancingTcpFrontendCustomizeDiff,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"service_name": {
 				Type:     schema.TypeString,
@@ -36,25 +43,49 @@ func resourceIpLoadbalancingTcpFrontend() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: false,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpLoadbalancingPortRange(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
 			},
 			"zone": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: false,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         false,
+				DiffSuppressFunc: suppressZoneDiff,
 			},
 			"allowed_source": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						err := validateIpBlock(v.(string))
+						if err != nil {
+							errors = append(errors, err)
+						}
+						return
+					},
+				},
+				Set: normalizedStringHash(normalizeIp),
 			},
 			"dedicated_ipfo": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						err := validateIpBlock(v.(string))
+						if err != nil {
+							errors = append(errors, err)
+						}
+						return
+					},
+				},
+				Set: normalizedStringHash(normalizeIp),
 			},
 			"default_farm_id": {
 				Type:     schema.TypeInt,
@@ -91,20 +122,18 @@ func resourceIpLoadbalancingTcpFrontend() *schema.Resource {
 
 func resourceIpLoadbalancingTcpFrontendCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	setNormalized(d, normalizeZone, "zone")
 
-	allowedSources := stringsFromSchema(d, "allowed_source")
-	dedicatedIpFo := stringsFromSchema(d, "dedicated_ipfo")
+	// empty lists are sent as is, so that sources and failover IPs can be
+	// removed from an existing frontend
+	allowedSources := append([]string{}, stringsFromSchema(d, "allowed_source")...)
+	dedicatedIpFo := append([]string{}, stringsFromSchema(d, "dedicated_ipfo")...)
 
-	for _, s := range allowedSources {
-		if err := validateIpBlock(s); err != nil {
-			return fmt.Errorf("Error validating `allowed_source` value: %s", err)
-		}
+	for i, s := range allowedSources {
+		allowedSources[i] = normalizeIp(s)
 	}
-
-	for _, s := range dedicatedIpFo {
-		if err := validateIpBlock(s); err != nil {
-			return fmt.Errorf("Error validating `dedicated_ipfo` value: %s", err)
-		}
+	for i, s := range dedicatedIpFo {
+		dedicatedIpFo[i] = normalizeIp(s)
 	}
 
 	frontend := &IpLoadbalancingTcpFrontend{
@@ -144,29 +173,28 @@ func resourceIpLoadbalancingTcpFrontendRead(d *schema.ResourceData, meta interfa
 
 	err := config.OVHClient.Get(endpoint, &r)
 	if err != nil {
-		return fmt.Errorf("calling %s:\n\t %s", endpoint, err.Error())
+		return CheckDeleted(d, err, endpoint)
 	}
 	return readIpLoadbalancingTcpFrontend(r, d)
 }
 
 func resourceIpLoadbalancingTcpFrontendUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	setNormalized(d, normalizeZone, "zone")
+
 	service := d.Get("service_name").(string)
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/frontend/%s", service, d.Id())
 
-	allowedSources := stringsFromSchema(d, "allowed_source")
-	dedicatedIpFo := stringsFromSchema(d, "dedicated_ipfo")
+	// empty lists are sent as is, so that sources and failover IPs can be
+	// removed from an existing frontend
+	allowedSources := append([]string{}, stringsFromSchema(d, "allowed_source")...)
+	dedicatedIpFo := append([]string{}, stringsFromSchema(d, "dedicated_ipfo")...)
 
-	for _, s := range allowedSources {
-		if err := validateIpBlock(s); err != nil {
-			return fmt.Errorf("Error validating `allowed_source` value: %s", err)
-		}
+	for i, s := range allowedSources {
+		allowedSources[i] = normalizeIp(s)
 	}
-
-	for _, s := range dedicatedIpFo {
-		if err := validateIpBlock(s); err != nil {
-			return fmt.Errorf("Error validating `dedicated_ipfo` value: %s", err)
-		}
+	for i, s := range dedicatedIpFo {
+		dedicatedIpFo[i] = normalizeIp(s)
 	}
 
 	frontend := &IpLoadbalancingTcpFrontend{
@@ -190,7 +218,34 @@ func resourceIpLoadbalancingTcpFrontendUpdate(d *schema.ResourceData, meta inter
 	if err != nil {
 		return fmt.Errorf("calling %s:\n\t %s", endpoint, err.Error())
 	}
-	return nil
+	return resourceIpLoadbalancingTcpFrontendRead(d, meta)
+}
+
+// resourceIpLoadbalancingTcpFrontendCustomizeDiff checks at plan time that
+// the default farm can receive the traffic of the frontend zone, when the
+// farm already exists.
+func resourceIpLoadbalancingTcpFrontendCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("default_farm_id") && !d.HasChange("zone") {
+		return nil
+	}
+
+	if !d.NewValueKnown("service_name") || !d.NewValueKnown("default_farm_id") || !d.NewValueKnown("zone") {
+		return nil
+	}
+
+	farmId := d.Get("default_farm_id").(int)
+	if farmId == 0 {
+		return nil
+	}
+
+	return ipLoadbalancingFarmZoneCheck(
+		meta.(*Config).OVHClient,
+		d.Get("service_name").(string),
+		"tcp",
+		farmId,
+		d.Get("zone").(string),
+		"default_farm_id",
+	)
 }
 
 func readIpLoadbalancingTcpFrontend(r *IpLoadbalancingTcpFrontend, d *schema.ResourceData) error {
@@ -229,6 +284,33 @@ func readIpLoadbalancingTcpFrontend(r *IpLoadbalancingTcpFrontend, d *schema.Res
 	return nil
 }
 
+// validateIpLoadbalancingPortRange checks the syntax of the ports a frontend
+// listens on: a comma separated list of ports or ranges of ports, e.g.
+// "80,443" or "8000-8100".
+func validateIpLoadbalancingPortRange(value string) error {
+	for _, part := range strings.Split(value, ",") {
+		bounds := strings.Split(strings.TrimSpace(part), "-")
+		if len(bounds) > 2 {
+			return fmt.Errorf("Value %s is not a valid port range: %s has more than 2 bounds", value, part)
+		}
+
+		ports := make([]int, len(bounds))
+		for i, b := range bounds {
+			port, err := strconv.Atoi(b)
+			if err != nil || port < 1 || port > 49151 {
+				return fmt.Errorf("Value %s is not a valid port range: %s is not a port between 1 and 49151", value, b)
+			}
+			ports[i] = port
+		}
+
+		if len(ports) == 2 && ports[0] > ports[1] {
+			return fmt.Errorf("Value %s is not a valid port range: %s ends before it starts", value, part)
+		}
+	}
+
+	return nil
+}
+
 func resourceIpLoadbalancingTcpFrontendDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -102,10 +103,40 @@ func TestAccOvhIpLoadbalancingTcpFrontend_basic(t *testing.T) {
 						"ovh_iploadbalancing_tcp_frontend.testfrontend", "disabled", "false"),
 				),
 			},
+			{
+				Config: fmt.Sprintf(testAccCheckOvhIpLoadbalancingTcpFrontendConfig_allowedSource, iplb, test_prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_frontend.testfrontend", "port", "22280-22290"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_frontend.testfrontend", "allowed_source.#", "2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckOvhIpLoadbalancingTcpFrontendConfig_update, iplb, test_prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_frontend.testfrontend", "allowed_source.#", "0"),
+				),
+			},
 		},
 	})
 }
 
+func TestValidateIpLoadbalancingPortRange(t *testing.T) {
+	for _, v := range []string{"80", "80,443", "8000-8100", "80, 443,8000-8100", "1-49151"} {
+		if err := validateIpLoadbalancingPortRange(v); err != nil {
+			t.Errorf("expected %s to be valid, got %s", v, err)
+		}
+	}
+
+	for _, v := range []string{"", "http", "0", "49152", "80,", "8100-8000", "80-90-100", "80-"} {
+		if err := validateIpLoadbalancingPortRange(v); err == nil {
+			t.Errorf("expected %s to be invalid", v)
+		}
+	}
+}
+
 func TestAccOvhIpLoadbalancingTcpFrontend_withfarm(t *testing.T) {
 	iplb := os.Getenv("OVH_IPLB_SERVICE")
 
@@ -126,6 +157,24 @@ func TestAccOvhIpLoadbalancingTcpFrontend_withfarm(t *testing.T) {
 	})
 }
 
+func TestAccOvhIpLoadbalancingTcpFrontend_farmZoneMismatch(t *testing.T) {
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccCheckIpLoadbalancingExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckOvhIpLoadbalancingTcpFrontendConfig_zonedFarm, iplb, test_prefix),
+			},
+			{
+				Config:      fmt.Sprintf(testAccCheckOvhIpLoadbalancingTcpFrontendConfig_farmZoneMismatch, iplb, test_prefix, test_prefix),
+				ExpectError: regexp.MustCompile("can't receive the traffic of zone"),
+			},
+		},
+	})
+}
+
 const testAccCheckOvhIpLoadbalancingTcpFrontendConfig_basic = `
 resource "ovh_iploadbalancing_tcp_frontend" "testfrontend" {
    service_name = "%s"
@@ -145,6 +194,16 @@ resource "ovh_iploadbalancing_tcp_frontend" "testfrontend" {
 }
 `
 
+const testAccCheckOvhIpLoadbalancingTcpFrontendConfig_allowedSource = `
+resource "ovh_iploadbalancing_tcp_frontend" "testfrontend" {
+   service_name   = "%s"
+   display_name   = "%s"
+   zone           = "all"
+   port           = "22280-22290"
+   allowed_source = ["192.0.2.0/24", "198.51.100.1/32"]
+}
+`
+
 const testAccCheckOvhIpLoadbalancingTcpFrontendConfig_withfarm = `
 data "ovh_iploadbalancing" "iplb" {
   service_name = "%s"
@@ -165,3 +224,37 @@ resource "ovh_iploadbalancing_tcp_frontend" "testfrontend" {
    default_farm_id = "${ovh_iploadbalancing_tcp_farm.farm.id}"
 }
 `
+
+const testAccCheckOvhIpLoadbalancingTcpFrontendConfig_zonedFarm = `
+data "ovh_iploadbalancing" "iplb" {
+  service_name = "%s"
+}
+
+resource "ovh_iploadbalancing_tcp_farm" "farm" {
+   service_name = "${data.ovh_iploadbalancing.iplb.service_name}"
+   display_name = "%s"
+   zone = "${element(data.ovh_iploadbalancing.iplb.zone, 0)}"
+   port = 22280
+}
+`
+
+const testAccCheckOvhIpLoadbalancingTcpFrontendConfig_farmZoneMismatch = `
+data "ovh_iploadbalancing" "iplb" {
+  service_name = "%s"
+}
+
+resource "ovh_iploadbalancing_tcp_farm" "farm" {
+   service_name = "${data.ovh_iploadbalancing.iplb.service_name}"
+   display_name = "%s"
+   zone = "${element(data.ovh_iploadbalancing.iplb.zone, 0)}"
+   port = 22280
+}
+
+resource "ovh_iploadbalancing_tcp_frontend" "testfrontend" {
+   service_name = "${data.ovh_iploadbalancing.iplb.service_name}"
+   display_name = "%s"
+   zone = "all"
+   port = "22280,22443"
+   default_farm_id = "${ovh_iploadbalancing_tcp_farm.farm.id}"
+}
+`
@@ -0,0 +1,70 @@
+package ovh
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceLicenseCpanel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLicenseCpanelCreate,
+		Read:   resourceLicenseCpanelRead,
+		Update: resourceLicenseCpanelUpdate,
+		Delete: resourceLicenseCpanelDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("service_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: orderCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: licenseSchema(),
+	}
+}
+
+// licenseCpanelOptions maps the attributes of the resource to the parameters
+// of its upgrade order.
+var licenseCpanelOptions = map[string]string{
+	"version": "version",
+}
+
+func resourceLicenseCpanelCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if err := licenseOrder(d, config.OVHClient, "licensecPanel"); err != nil {
+		return err
+	}
+
+	return resourceLicenseCpanelUpdate(d, meta)
+}
+
+func resourceLicenseCpanelRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	_, err := licenseRead(d, config.OVHClient, "cpanel")
+	return err
+}
+
+func resourceLicenseCpanelUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if err := licenseUpdate(d, config.OVHClient, "cpanel", licenseCpanelOptions); err != nil {
+		return err
+	}
+
+	return resourceLicenseCpanelRead(d, meta)
+}
+
+func resourceLicenseCpanelDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	return licenseTerminate(d, config.OVHClient, "cpanel")
+}
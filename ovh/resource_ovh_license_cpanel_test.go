@@ -0,0 +1,68 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccLicenseCpanelConfig = `
+resource "ovh_license_cpanel" "license" {
+  plan_code = "cpanel-license-admin-cloud"
+  ip        = "%s"
+}
+`
+
+func TestAccLicenseCpanel_basic(t *testing.T) {
+	ip := os.Getenv("OVH_LICENSE_IP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckLicenseOrderPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccLicenseCpanelConfig, ip),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLicenseCpanelExists("ovh_license_cpanel.license", t),
+					resource.TestCheckResourceAttr("ovh_license_cpanel.license", "ip", ip),
+					resource.TestCheckResourceAttrSet("ovh_license_cpanel.license", "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLicenseOrderPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// ordering a license is not free of side effects on the account
+	// these resources are tested only if env var `OVH_TEST_LICENSE_ORDER`
+	// is set to "1" and `OVH_LICENSE_IP` is set to an ip of the account
+	if os.Getenv("OVH_TEST_LICENSE_ORDER") != "1" {
+		t.Skip("OVH_TEST_LICENSE_ORDER must be set to 1 to test license ordering")
+	}
+
+	if os.Getenv("OVH_LICENSE_IP") == "" {
+		t.Skip("OVH_LICENSE_IP must be set to test license ordering")
+	}
+}
+
+func testAccCheckLicenseCpanelExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No license service name is set")
+		}
+
+		return licenseExists("cpanel", rs.Primary.ID, config.OVHClient)
+	}
+}
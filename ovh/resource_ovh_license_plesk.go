@@ -0,0 +1,103 @@
+package ovh
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceLicensePlesk() *schema.Resource {
+	s := licenseSchema()
+	for _, attr := range []string{"domain_number", "antivirus", "language_pack_number"} {
+		s[attr] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		}
+	}
+	for _, attr := range []string{"powerpack", "reseller_management", "wordpress_toolkit"} {
+		s[attr] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceLicensePleskCreate,
+		Read:   resourceLicensePleskRead,
+		Update: resourceLicensePleskUpdate,
+		Delete: resourceLicensePleskDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("service_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: orderCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+// licensePleskOptions maps the attributes of the resource to the parameters
+// of its upgrade order.
+var licensePleskOptions = map[string]string{
+	"version":              "version",
+	"domain_number":        "domainNumber",
+	"antivirus":            "antivirus",
+	"language_pack_number": "languagePackNumber",
+	"powerpack":            "powerpack",
+	"reseller_management":  "resellerManagement",
+	"wordpress_toolkit":    "wordpressToolkit",
+}
+
+func resourceLicensePleskCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if err := licenseOrder(d, config.OVHClient, "licensePlesk"); err != nil {
+		return err
+	}
+
+	return resourceLicensePleskUpdate(d, meta)
+}
+
+func resourceLicensePleskRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r, err := licenseRead(d, config.OVHClient, "plesk")
+	if err != nil || r == nil {
+		return err
+	}
+
+	d.Set("domain_number", r.DomainNumber)
+	d.Set("antivirus", r.Antivirus)
+	d.Set("language_pack_number", r.LanguagePackNumber)
+	d.Set("powerpack", r.Powerpack)
+	d.Set("reseller_management", r.ResellerManagement)
+	d.Set("wordpress_toolkit", r.WordpressToolkit)
+
+	return nil
+}
+
+func resourceLicensePleskUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if err := licenseUpdate(d, config.OVHClient, "plesk", licensePleskOptions); err != nil {
+		return err
+	}
+
+	return resourceLicensePleskRead(d, meta)
+}
+
+func resourceLicensePleskDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	return licenseTerminate(d, config.OVHClient, "plesk")
+}
@@ -0,0 +1,53 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccLicensePleskConfig = `
+resource "ovh_license_plesk" "license" {
+  plan_code = "plesk-12-webadmin-for-vps"
+  ip        = "%s"
+}
+`
+
+func TestAccLicensePlesk_basic(t *testing.T) {
+	ip := os.Getenv("OVH_LICENSE_IP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckLicenseOrderPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccLicensePleskConfig, ip),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLicensePleskExists("ovh_license_plesk.license", t),
+					resource.TestCheckResourceAttr("ovh_license_plesk.license", "ip", ip),
+					resource.TestCheckResourceAttrSet("ovh_license_plesk.license", "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLicensePleskExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No license service name is set")
+		}
+
+		return licenseExists("plesk", rs.Primary.ID, config.OVHClient)
+	}
+}
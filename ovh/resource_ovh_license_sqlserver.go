@@ -0,0 +1,70 @@
+package ovh
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceLicenseSqlServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLicenseSqlServerCreate,
+		Read:   resourceLicenseSqlServerRead,
+		Update: resourceLicenseSqlServerUpdate,
+		Delete: resourceLicenseSqlServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("service_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: orderCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: licenseSchema(),
+	}
+}
+
+// licenseSqlServerOptions maps the attributes of the resource to the parameters
+// of its upgrade order.
+var licenseSqlServerOptions = map[string]string{
+	"version": "version",
+}
+
+func resourceLicenseSqlServerCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if err := licenseOrder(d, config.OVHClient, "licenseSqlServer"); err != nil {
+		return err
+	}
+
+	return resourceLicenseSqlServerUpdate(d, meta)
+}
+
+func resourceLicenseSqlServerRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	_, err := licenseRead(d, config.OVHClient, "sqlserver")
+	return err
+}
+
+func resourceLicenseSqlServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if err := licenseUpdate(d, config.OVHClient, "sqlserver", licenseSqlServerOptions); err != nil {
+		return err
+	}
+
+	return resourceLicenseSqlServerRead(d, meta)
+}
+
+func resourceLicenseSqlServerDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	return licenseTerminate(d, config.OVHClient, "sqlserver")
+}
@@ -0,0 +1,53 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccLicenseSqlServerConfig = `
+resource "ovh_license_sqlserver" "license" {
+  plan_code = "sql-server-2019-license-web-edition-2-cpu"
+  ip        = "%s"
+}
+`
+
+func TestAccLicenseSqlServer_basic(t *testing.T) {
+	ip := os.Getenv("OVH_LICENSE_IP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckLicenseOrderPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccLicenseSqlServerConfig, ip),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLicenseSqlServerExists("ovh_license_sqlserver.license", t),
+					resource.TestCheckResourceAttr("ovh_license_sqlserver.license", "ip", ip),
+					resource.TestCheckResourceAttrSet("ovh_license_sqlserver.license", "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLicenseSqlServerExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No license service name is set")
+		}
+
+		return licenseExists("sqlserver", rs.Primary.ID, config.OVHClient)
+	}
+}
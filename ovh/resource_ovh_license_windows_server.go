@@ -0,0 +1,84 @@
+package ovh
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceLicenseWindows() *schema.Resource {
+	s := licenseSchema()
+	s["sql_version"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceLicenseWindowsCreate,
+		Read:   resourceLicenseWindowsRead,
+		Update: resourceLicenseWindowsUpdate,
+		Delete: resourceLicenseWindowsDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("service_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: orderCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+// licenseWindowsOptions maps the attributes of the resource to the parameters
+// of its upgrade order.
+var licenseWindowsOptions = map[string]string{
+	"version":     "version",
+	"sql_version": "sqlVersion",
+}
+
+func resourceLicenseWindowsCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if err := licenseOrder(d, config.OVHClient, "licenseWindows"); err != nil {
+		return err
+	}
+
+	return resourceLicenseWindowsUpdate(d, meta)
+}
+
+func resourceLicenseWindowsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r, err := licenseRead(d, config.OVHClient, "windows")
+	if err != nil || r == nil {
+		return err
+	}
+
+	d.Set("sql_version", r.SqlVersion)
+
+	return nil
+}
+
+func resourceLicenseWindowsUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if err := licenseUpdate(d, config.OVHClient, "windows", licenseWindowsOptions); err != nil {
+		return err
+	}
+
+	return resourceLicenseWindowsRead(d, meta)
+}
+
+func resourceLicenseWindowsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	return licenseTerminate(d, config.OVHClient, "windows")
+}
@@ -0,0 +1,53 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccLicenseWindowsConfig = `
+resource "ovh_license_windows" "license" {
+  plan_code = "windows-server-2019-license-standard-edition-2-cpu"
+  ip        = "%s"
+}
+`
+
+func TestAccLicenseWindows_basic(t *testing.T) {
+	ip := os.Getenv("OVH_LICENSE_IP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckLicenseOrderPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccLicenseWindowsConfig, ip),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLicenseWindowsExists("ovh_license_windows.license", t),
+					resource.TestCheckResourceAttr("ovh_license_windows.license", "ip", ip),
+					resource.TestCheckResourceAttrSet("ovh_license_windows.license", "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLicenseWindowsExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No license service name is set")
+		}
+
+		return licenseExists("windows", rs.Primary.ID, config.OVHClient)
+	}
+}
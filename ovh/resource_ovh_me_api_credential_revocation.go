@@ -0,0 +1,59 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceMeApiCredentialRevocation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMeApiCredentialRevocationCreate,
+		Read:   resourceMeApiCredentialRevocationRead,
+		Delete: resourceMeApiCredentialRevocationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"credential_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceMeApiCredentialRevocationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	credentialId := d.Get("credential_id").(int)
+	endpoint := fmt.Sprintf("/me/api/credential/%d", credentialId)
+
+	log.Printf("[DEBUG] Will revoke api credential %d", credentialId)
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		// an already revoked credential is what we want
+		if apiErr, ok := err.(*ovh.APIError); !ok || apiErr.Code != 404 {
+			return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+		}
+	}
+
+	d.SetId(strconv.Itoa(credentialId))
+
+	return nil
+}
+
+func resourceMeApiCredentialRevocationRead(d *schema.ResourceData, meta interface{}) error {
+	// A revoked credential can't come back, there is nothing to refresh.
+	return nil
+}
+
+func resourceMeApiCredentialRevocationDelete(d *schema.ResourceData, meta interface{}) error {
+	// A revocation can't be undone, it's only removed from the state.
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,41 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+var testAccMeApiCredentialRevocationConfig = fmt.Sprintf(`
+resource "ovh_me_api_credential_revocation" "revoke" {
+  credential_id = %s
+}
+`, os.Getenv("OVH_TEST_REVOKE_CREDENTIAL_ID"))
+
+func TestAccMeApiCredentialRevocation_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckMeApiCredentialRevocationPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeApiCredentialRevocationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_me_api_credential_revocation.revoke", "id", os.Getenv("OVH_TEST_REVOKE_CREDENTIAL_ID")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMeApiCredentialRevocationPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// revoking a credential can't be undone
+	// this resource is tested only if env var `OVH_TEST_REVOKE_CREDENTIAL_ID`
+	// is set to a disposable credential id
+	if os.Getenv("OVH_TEST_REVOKE_CREDENTIAL_ID") == "" {
+		t.Skip("OVH_TEST_REVOKE_CREDENTIAL_ID must be set to test credential revocation")
+	}
+}
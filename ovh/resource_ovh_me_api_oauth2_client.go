@@ -0,0 +1,203 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type MeApiOauth2Client struct {
+	ClientId     string   `json:"clientId"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Flow         string   `json:"flow"`
+	CallbackUrls []string `json:"callbackUrls"`
+	Identity     string   `json:"identity"`
+}
+
+func (c *MeApiOauth2Client) String() string {
+	return fmt.Sprintf("Oauth2Client[id: %s, name: %s, flow: %s]", c.ClientId, c.Name, c.Flow)
+}
+
+type MeApiOauth2ClientCreateOpts struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Flow         string   `json:"flow"`
+	CallbackUrls []string `json:"callbackUrls"`
+}
+
+type MeApiOauth2ClientUpdateOpts struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	CallbackUrls []string `json:"callbackUrls"`
+}
+
+type MeApiOauth2ClientCredentials struct {
+	ClientId     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"`
+}
+
+func resourceMeApiOauth2Client() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMeApiOauth2ClientCreate,
+		Read:   resourceMeApiOauth2ClientRead,
+		Update: resourceMeApiOauth2ClientUpdate,
+		Delete: resourceMeApiOauth2ClientDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"flow": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"AUTHORIZATION_CODE", "CLIENT_CREDENTIALS"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"callback_urls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed
+			"client_id": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"identity": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func meApiOauth2ClientCallbackUrls(d *schema.ResourceData) []string {
+	urls := []string{}
+	for _, u := range d.Get("callback_urls").([]interface{}) {
+		urls = append(urls, u.(string))
+	}
+	return urls
+}
+
+func resourceMeApiOauth2ClientCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &MeApiOauth2ClientCreateOpts{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		Flow:         d.Get("flow").(string),
+		CallbackUrls: meApiOauth2ClientCallbackUrls(d),
+	}
+	r := &MeApiOauth2ClientCredentials{}
+
+	log.Printf("[DEBUG] Will create oauth2 client %s", params.Name)
+
+	endpoint := "/me/api/oauth2/client"
+	err := config.OVHClient.Post(endpoint, params, r)
+	if err != nil {
+		return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(r.ClientId)
+	// the secret is only returned at creation
+	d.Set("client_secret", r.ClientSecret)
+
+	return resourceMeApiOauth2ClientRead(d, meta)
+}
+
+func resourceMeApiOauth2ClientRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &MeApiOauth2Client{}
+	endpoint := fmt.Sprintf("/me/api/oauth2/client/%s", d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read oauth2 client %s", r)
+
+	d.Set("client_id", r.ClientId)
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+	d.Set("flow", r.Flow)
+	d.Set("callback_urls", r.CallbackUrls)
+	d.Set("identity", r.Identity)
+
+	return nil
+}
+
+func resourceMeApiOauth2ClientUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &MeApiOauth2ClientUpdateOpts{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		CallbackUrls: meApiOauth2ClientCallbackUrls(d),
+	}
+	endpoint := fmt.Sprintf("/me/api/oauth2/client/%s", d.Id())
+
+	log.Printf("[DEBUG] Will update oauth2 client %s", d.Id())
+
+	err := config.OVHClient.Put(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Put %s:\n\t %q", endpoint, err)
+	}
+
+	return resourceMeApiOauth2ClientRead(d, meta)
+}
+
+func resourceMeApiOauth2ClientDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	endpoint := fmt.Sprintf("/me/api/oauth2/client/%s", d.Id())
+
+	log.Printf("[DEBUG] Will delete oauth2 client %s", d.Id())
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func meApiOauth2ClientExists(clientId string, c *ovh.Client) error {
+	r := &MeApiOauth2Client{}
+	endpoint := fmt.Sprintf("/me/api/oauth2/client/%s", clientId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read oauth2 client: %s", r)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package ovh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccMeApiOauth2ClientConfig = `
+resource "ovh_me_api_oauth2_client" "client" {
+  name        = "%s"
+  description = "%s"
+  flow        = "CLIENT_CREDENTIALS"
+}
+`
+
+func TestAccMeApiOauth2Client_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMeApiOauth2ClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMeApiOauth2ClientConfig, name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeApiOauth2ClientExists("ovh_me_api_oauth2_client.client", t),
+					resource.TestCheckResourceAttrSet("ovh_me_api_oauth2_client.client", "client_id"),
+					resource.TestCheckResourceAttrSet("ovh_me_api_oauth2_client.client", "client_secret"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccMeApiOauth2ClientConfig, name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeApiOauth2ClientExists("ovh_me_api_oauth2_client.client", t),
+					resource.TestCheckResourceAttr("ovh_me_api_oauth2_client.client", "description", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMeApiOauth2ClientExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No oauth2 client id is set")
+		}
+
+		return meApiOauth2ClientExists(rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckMeApiOauth2ClientDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_me_api_oauth2_client" {
+			continue
+		}
+
+		err := meApiOauth2ClientExists(rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("OAuth2 client still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type MeContactAddress struct {
+	Line1    string `json:"line1"`
+	Line2    string `json:"line2,omitempty"`
+	Line3    string `json:"line3,omitempty"`
+	City     string `json:"city"`
+	Zip      string `json:"zip"`
+	Province string `json:"province,omitempty"`
+	Country  string `json:"country"`
+}
+
+type MeContact struct {
+	Id               int64             `json:"id"`
+	FirstName        string            `json:"firstName"`
+	LastName         string            `json:"lastName"`
+	Email            string            `json:"email"`
+	Phone            string            `json:"phone"`
+	Language         string            `json:"language"`
+	LegalForm        string            `json:"legalForm"`
+	OrganisationName string            `json:"organisationName"`
+	Vat              string            `json:"vat"`
+	Address          *MeContactAddress `json:"address"`
+}
+
+func (c *MeContact) String() string {
+	return fmt.Sprintf("Contact[id: %d, name: %s %s, email: %s, legalForm: %s]", c.Id, c.FirstName, c.LastName, c.Email, c.LegalForm)
+}
+
+type MeContactOpts struct {
+	FirstName        string            `json:"firstName"`
+	LastName         string            `json:"lastName"`
+	Email            string            `json:"email"`
+	Phone            string            `json:"phone"`
+	Language         string            `json:"language"`
+	LegalForm        string            `json:"legalForm"`
+	OrganisationName *string           `json:"organisationName,omitempty"`
+	Vat              *string           `json:"vat,omitempty"`
+	Address          *MeContactAddress `json:"address"`
+}
+
+func (o *MeContactOpts) String() string {
+	return fmt.Sprintf("ContactOpts[name: %s %s, email: %s, legalForm: %s]", o.FirstName, o.LastName, o.Email, o.LegalForm)
+}
+
+func resourceMeContact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMeContactCreate,
+		Read:   resourceMeContactRead,
+		Update: resourceMeContactUpdate,
+		Delete: resourceMeContactDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"first_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"phone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"language": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"legal_form": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "individual",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"administration", "association", "corporation", "individual", "other", "personalcorporation"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"organisation_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vat": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"address": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"line1": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"line2": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"line3": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"city": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"zip": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"province": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"country": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMeContactOpts(d *schema.ResourceData) *MeContactOpts {
+	address := d.Get("address.0").(map[string]interface{})
+
+	return &MeContactOpts{
+		FirstName:        d.Get("first_name").(string),
+		LastName:         d.Get("last_name").(string),
+		Email:            d.Get("email").(string),
+		Phone:            d.Get("phone").(string),
+		Language:         d.Get("language").(string),
+		LegalForm:        d.Get("legal_form").(string),
+		OrganisationName: getNilStringPointer(d.Get("organisation_name")),
+		Vat:              getNilStringPointer(d.Get("vat")),
+		Address: &MeContactAddress{
+			Line1:    address["line1"].(string),
+			Line2:    address["line2"].(string),
+			Line3:    address["line3"].(string),
+			City:     address["city"].(string),
+			Zip:      address["zip"].(string),
+			Province: address["province"].(string),
+			Country:  address["country"].(string),
+		},
+	}
+}
+
+func resourceMeContactCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := resourceMeContactOpts(d)
+	r := &MeContact{}
+
+	log.Printf("[DEBUG] Will create contact: %s", params)
+
+	endpoint := "/me/contact"
+	err := config.OVHClient.Post(endpoint, params, r)
+	if err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(strconv.FormatInt(r.Id, 10))
+
+	return resourceMeContactRead(d, meta)
+}
+
+func resourceMeContactRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &MeContact{}
+	endpoint := fmt.Sprintf("/me/contact/%s", d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read contact %s", r)
+
+	d.Set("first_name", r.FirstName)
+	d.Set("last_name", r.LastName)
+	d.Set("email", r.Email)
+	d.Set("phone", r.Phone)
+	d.Set("language", r.Language)
+	d.Set("legal_form", r.LegalForm)
+	d.Set("organisation_name", r.OrganisationName)
+	d.Set("vat", r.Vat)
+
+	if r.Address != nil {
+		d.Set("address", []interface{}{
+			map[string]interface{}{
+				"line1":    r.Address.Line1,
+				"line2":    r.Address.Line2,
+				"line3":    r.Address.Line3,
+				"city":     r.Address.City,
+				"zip":      r.Address.Zip,
+				"province": r.Address.Province,
+				"country":  r.Address.Country,
+			},
+		})
+	}
+
+	return nil
+}
+
+func resourceMeContactUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := resourceMeContactOpts(d)
+	endpoint := fmt.Sprintf("/me/contact/%s", d.Id())
+
+	log.Printf("[DEBUG] Will update contact %s: %s", d.Id(), params)
+
+	err := config.OVHClient.Put(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Put %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceMeContactRead(d, meta)
+}
+
+func resourceMeContactDelete(d *schema.ResourceData, meta interface{}) error {
+	// contacts can't be deleted through the API: they are only removed
+	// from the state.
+	log.Printf("[DEBUG] Contact %s is kept on the account", d.Id())
+
+	d.SetId("")
+	return nil
+}
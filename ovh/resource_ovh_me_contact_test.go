@@ -0,0 +1,60 @@
+package ovh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccMeContactConfig = `
+resource "ovh_me_contact" "contact" {
+  first_name = "%s"
+  last_name  = "Terraform"
+  email      = "%s@example.com"
+  phone      = "+33.123456789"
+  language   = "en_GB"
+  legal_form = "corporation"
+
+  organisation_name = "%s"
+
+  address {
+    line1   = "2 rue Kellermann"
+    city    = "Roubaix"
+    zip     = "59100"
+    country = "FR"
+  }
+}
+`
+
+func TestAccMeContact_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	// contacts can't be deleted: the tests leave them on the account
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMeContactConfig, name, name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_me_contact.contact", "first_name", name),
+					resource.TestCheckResourceAttr("ovh_me_contact.contact", "organisation_name", "first"),
+					resource.TestCheckResourceAttr("ovh_me_contact.contact", "address.0.country", "FR"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccMeContactConfig, name, name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_me_contact.contact", "organisation_name", "second"),
+				),
+			},
+			{
+				ResourceName:      "ovh_me_contact.contact",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,186 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type MeIdentityGroup struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Role         string `json:"role"`
+	DefaultGroup bool   `json:"defaultGroup"`
+	Creation     string `json:"creation"`
+	LastUpdate   string `json:"lastUpdate"`
+	Urn          string `json:"urn"`
+}
+
+func (g *MeIdentityGroup) String() string {
+	return fmt.Sprintf("IdentityGroup[name: %s, role: %s]", g.Name, g.Role)
+}
+
+type MeIdentityGroupCreateOpts struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Role        string `json:"role"`
+}
+
+type MeIdentityGroupUpdateOpts struct {
+	Description string `json:"description"`
+	Role        string `json:"role"`
+}
+
+func resourceMeIdentityGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMeIdentityGroupCreate,
+		Read:   resourceMeIdentityGroupRead,
+		Update: resourceMeIdentityGroupUpdate,
+		Delete: resourceMeIdentityGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "NONE",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"ADMIN", "NONE", "REGULAR", "UNPRIVILEGED"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"default_group": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"creation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_update": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"urn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMeIdentityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &MeIdentityGroupCreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Role:        d.Get("role").(string),
+	}
+
+	log.Printf("[DEBUG] Will create identity group %s", params)
+
+	endpoint := "/me/identity/group"
+	err := config.OVHClient.Post(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(params.Name)
+
+	return resourceMeIdentityGroupRead(d, meta)
+}
+
+func resourceMeIdentityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &MeIdentityGroup{}
+	endpoint := fmt.Sprintf("/me/identity/group/%s", d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read identity group %s", r)
+
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+	d.Set("role", r.Role)
+	d.Set("default_group", r.DefaultGroup)
+	d.Set("creation", r.Creation)
+	d.Set("last_update", r.LastUpdate)
+	d.Set("urn", r.Urn)
+
+	return nil
+}
+
+func resourceMeIdentityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &MeIdentityGroupUpdateOpts{
+		Description: d.Get("description").(string),
+		Role:        d.Get("role").(string),
+	}
+	endpoint := fmt.Sprintf("/me/identity/group/%s", d.Id())
+
+	log.Printf("[DEBUG] Will update identity group %s: %v", d.Id(), params)
+
+	err := config.OVHClient.Put(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceMeIdentityGroupRead(d, meta)
+}
+
+func resourceMeIdentityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	endpoint := fmt.Sprintf("/me/identity/group/%s", d.Id())
+
+	log.Printf("[DEBUG] Will delete identity group %s", d.Id())
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func meIdentityGroupExists(name string, c *ovh.Client) error {
+	r := &MeIdentityGroup{}
+	endpoint := fmt.Sprintf("/me/identity/group/%s", name)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read identity group: %s", r)
+
+	return nil
+}
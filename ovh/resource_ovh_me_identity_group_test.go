@@ -0,0 +1,112 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("ovh_me_identity_group", &resource.Sweeper{
+		Name:         "ovh_me_identity_group",
+		Dependencies: []string{"ovh_me_identity_user"},
+		F:            testSweepMeIdentityGroup,
+	})
+}
+
+func testSweepMeIdentityGroup(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	names := make([]string, 0)
+	if err := client.Get("/me/identity/group", &names); err != nil {
+		return fmt.Errorf("Error calling /me/identity/group:\n\t %q", err)
+	}
+
+	for _, name := range names {
+		if !strings.HasPrefix(name, test_prefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting identity group %s", name)
+		if err := client.Delete(fmt.Sprintf("/me/identity/group/%s", name), nil); err != nil {
+			return fmt.Errorf("Error calling /me/identity/group/%s:\n\t %q", name, err)
+		}
+	}
+
+	return nil
+}
+
+const testAccMeIdentityGroupConfig = `
+resource "ovh_me_identity_group" "group" {
+  name        = "%s"
+  description = "%s"
+  role        = "%s"
+}
+`
+
+func TestAccMeIdentityGroup_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMeIdentityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMeIdentityGroupConfig, name, "read only", "UNPRIVILEGED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeIdentityGroupExists("ovh_me_identity_group.group", t),
+					resource.TestCheckResourceAttr("ovh_me_identity_group.group", "role", "UNPRIVILEGED"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccMeIdentityGroupConfig, name, "operators", "REGULAR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeIdentityGroupExists("ovh_me_identity_group.group", t),
+					resource.TestCheckResourceAttr("ovh_me_identity_group.group", "description", "operators"),
+					resource.TestCheckResourceAttr("ovh_me_identity_group.group", "role", "REGULAR"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMeIdentityGroupExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No identity group name is set")
+		}
+
+		return meIdentityGroupExists(rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckMeIdentityGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_me_identity_group" {
+			continue
+		}
+
+		err := meIdentityGroupExists(rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("Identity group still exists")
+		}
+	}
+	return nil
+}
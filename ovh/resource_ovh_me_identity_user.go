@@ -0,0 +1,277 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"unicode"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type MeIdentityUser struct {
+	Login              string `json:"login"`
+	Email              string `json:"email"`
+	Group              string `json:"group"`
+	Description        string `json:"description"`
+	Status             string `json:"status"`
+	Creation           string `json:"creation"`
+	LastUpdate         string `json:"lastUpdate"`
+	PasswordLastUpdate string `json:"passwordLastUpdate"`
+	Urn                string `json:"urn"`
+}
+
+func (u *MeIdentityUser) String() string {
+	return fmt.Sprintf("IdentityUser[login: %s, email: %s, group: %s, status: %s]", u.Login, u.Email, u.Group, u.Status)
+}
+
+type MeIdentityUserCreateOpts struct {
+	Login       string `json:"login"`
+	Email       string `json:"email"`
+	Password    string `json:"password"`
+	Group       string `json:"group,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type MeIdentityUserUpdateOpts struct {
+	Email       string `json:"email"`
+	Group       string `json:"group,omitempty"`
+	Description string `json:"description"`
+}
+
+func resourceMeIdentityUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMeIdentityUserCreate,
+		Read:   resourceMeIdentityUserRead,
+		Update: resourceMeIdentityUserUpdate,
+		Delete: resourceMeIdentityUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("login", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"login": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if err := validateMeIdentityUserPassword(v.(string)); err != nil {
+						errors = append(errors, fmt.Errorf("%s: %s", k, err))
+					}
+					return
+				},
+			},
+			"group": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_update": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"password_last_update": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"urn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMeIdentityUserCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &MeIdentityUserCreateOpts{
+		Login:       d.Get("login").(string),
+		Email:       d.Get("email").(string),
+		Password:    d.Get("password").(string),
+		Group:       d.Get("group").(string),
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] Will create identity user %s", params.Login)
+
+	endpoint := "/me/identity/user"
+	err := config.OVHClient.Post(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(params.Login)
+
+	if !d.Get("enabled").(bool) {
+		if err := meIdentityUserSetEnabled(config.OVHClient, d.Id(), false); err != nil {
+			return err
+		}
+	}
+
+	return resourceMeIdentityUserRead(d, meta)
+}
+
+func resourceMeIdentityUserRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &MeIdentityUser{}
+	endpoint := fmt.Sprintf("/me/identity/user/%s", d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read identity user %s", r)
+
+	d.Set("login", r.Login)
+	d.Set("email", r.Email)
+	d.Set("group", r.Group)
+	d.Set("description", r.Description)
+	d.Set("enabled", r.Status != "DISABLED")
+	d.Set("status", r.Status)
+	d.Set("creation", r.Creation)
+	d.Set("last_update", r.LastUpdate)
+	d.Set("password_last_update", r.PasswordLastUpdate)
+	d.Set("urn", r.Urn)
+
+	return nil
+}
+
+func resourceMeIdentityUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("email") || d.HasChange("group") || d.HasChange("description") {
+		params := &MeIdentityUserUpdateOpts{
+			Email:       d.Get("email").(string),
+			Group:       d.Get("group").(string),
+			Description: d.Get("description").(string),
+		}
+		endpoint := fmt.Sprintf("/me/identity/user/%s", d.Id())
+
+		log.Printf("[DEBUG] Will update identity user %s: %v", d.Id(), params)
+
+		err := config.OVHClient.Put(endpoint, params, nil)
+		if err != nil {
+			return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if err := meIdentityUserSetEnabled(config.OVHClient, d.Id(), d.Get("enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
+	return resourceMeIdentityUserRead(d, meta)
+}
+
+func resourceMeIdentityUserDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	endpoint := fmt.Sprintf("/me/identity/user/%s", d.Id())
+
+	log.Printf("[DEBUG] Will delete identity user %s", d.Id())
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func meIdentityUserExists(login string, c *ovh.Client) error {
+	r := &MeIdentityUser{}
+	endpoint := fmt.Sprintf("/me/identity/user/%s", login)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read identity user: %s", r)
+
+	return nil
+}
+
+// meIdentityUserSetEnabled enables or disables the login of an identity user.
+// A disabled user keeps its group and settings and can be enabled back.
+func meIdentityUserSetEnabled(c *ovh.Client, login string, enabled bool) error {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+	endpoint := fmt.Sprintf("/me/identity/user/%s/%s", login, action)
+
+	log.Printf("[DEBUG] Will %s identity user %s", action, login)
+
+	if err := c.Post(endpoint, nil, nil); err != nil {
+		return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	return nil
+}
+
+// validateMeIdentityUserPassword checks a password against the policy the API
+// enforces on identity users, so that a weak password fails at plan time
+// rather than halfway through an apply.
+func validateMeIdentityUserPassword(password string) error {
+	if len(password) < 8 || len(password) > 30 {
+		return fmt.Errorf("password must be between 8 and 30 characters long")
+	}
+
+	var lower, upper, digit, special bool
+	for _, c := range password {
+		switch {
+		case unicode.IsLower(c):
+			lower = true
+		case unicode.IsUpper(c):
+			upper = true
+		case unicode.IsDigit(c):
+			digit = true
+		default:
+			special = true
+		}
+	}
+
+	if !lower || !upper || !digit || !special {
+		return fmt.Errorf("password must contain a lower case letter, an upper case letter, a digit and a special character")
+	}
+
+	return nil
+}
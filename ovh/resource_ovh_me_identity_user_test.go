@@ -0,0 +1,150 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("ovh_me_identity_user", &resource.Sweeper{
+		Name: "ovh_me_identity_user",
+		F:    testSweepMeIdentityUser,
+	})
+}
+
+func testSweepMeIdentityUser(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	logins := make([]string, 0)
+	if err := client.Get("/me/identity/user", &logins); err != nil {
+		return fmt.Errorf("Error calling /me/identity/user:\n\t %q", err)
+	}
+
+	for _, login := range logins {
+		if !strings.HasPrefix(login, test_prefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting identity user %s", login)
+		if err := client.Delete(fmt.Sprintf("/me/identity/user/%s", login), nil); err != nil {
+			return fmt.Errorf("Error calling /me/identity/user/%s:\n\t %q", login, err)
+		}
+	}
+
+	return nil
+}
+
+const testAccMeIdentityUserConfig = `
+resource "ovh_me_identity_group" "group" {
+  name = "%s"
+  role = "REGULAR"
+}
+
+resource "ovh_me_identity_user" "user" {
+  login       = "%s"
+  email       = "%s@example.com"
+  password    = "%s"
+  group       = "${ovh_me_identity_group.group.name}"
+  description = "%s"
+  enabled     = %t
+}
+`
+
+func TestAccMeIdentityUser_basic(t *testing.T) {
+	group := acctest.RandomWithPrefix(test_prefix)
+	login := acctest.RandomWithPrefix(test_prefix)
+	password := fmt.Sprintf("Tf-%s!9", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMeIdentityUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMeIdentityUserConfig, group, login, login, password, "first", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeIdentityUserExists("ovh_me_identity_user.user", t),
+					resource.TestCheckResourceAttr("ovh_me_identity_user.user", "group", group),
+					resource.TestCheckResourceAttrSet("ovh_me_identity_user.user", "status"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccMeIdentityUserConfig, group, login, login, password, "second", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeIdentityUserExists("ovh_me_identity_user.user", t),
+					resource.TestCheckResourceAttr("ovh_me_identity_user.user", "description", "second"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccMeIdentityUserConfig, group, login, login, password, "second", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_me_identity_user.user", "enabled", "false"),
+					resource.TestCheckResourceAttr("ovh_me_identity_user.user", "status", "DISABLED"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccMeIdentityUserConfig, group, login, login, password, "second", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_me_identity_user.user", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateMeIdentityUserPassword(t *testing.T) {
+	valid := []string{"Tf-abcdef!9", "Aa1!Aa1!"}
+	for _, p := range valid {
+		if err := validateMeIdentityUserPassword(p); err != nil {
+			t.Errorf("%q should be valid: %s", p, err)
+		}
+	}
+
+	invalid := []string{"Aa1!", "abcdefgh1!", "ABCDEFGH1!", "Abcdefgh!!", "Abcdefgh12", "Aa1!" + strings.Repeat("a", 27)}
+	for _, p := range invalid {
+		if err := validateMeIdentityUserPassword(p); err == nil {
+			t.Errorf("%q should be invalid", p)
+		}
+	}
+}
+
+func testAccCheckMeIdentityUserExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No identity user login is set")
+		}
+
+		return meIdentityUserExists(rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckMeIdentityUserDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_me_identity_user" {
+			continue
+		}
+
+		err := meIdentityUserExists(rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("Identity user still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type MeIpxeScript struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Script      string `json:"script"`
+}
+
+func (s *MeIpxeScript) String() string {
+	return fmt.Sprintf("IpxeScript[name: %s, description: %s]", s.Name, s.Description)
+}
+
+func resourceMeIpxeScript() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMeIpxeScriptCreate,
+		Read:   resourceMeIpxeScriptRead,
+		Delete: resourceMeIpxeScriptDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"script": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceMeIpxeScriptCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &MeIpxeScript{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Script:      d.Get("script").(string),
+	}
+
+	log.Printf("[DEBUG] Will create ipxe script %s", params)
+
+	endpoint := "/me/ipxeScript"
+	err := config.OVHClient.Post(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(params.Name)
+
+	return resourceMeIpxeScriptRead(d, meta)
+}
+
+func resourceMeIpxeScriptRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &MeIpxeScript{}
+	endpoint := fmt.Sprintf("/me/ipxeScript/%s", d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ipxe script %s", r)
+
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+	d.Set("script", r.Script)
+
+	return nil
+}
+
+func resourceMeIpxeScriptDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	endpoint := fmt.Sprintf("/me/ipxeScript/%s", d.Id())
+
+	log.Printf("[DEBUG] Will delete ipxe script %s", d.Id())
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func meIpxeScriptExists(name string, c *ovh.Client) error {
+	r := &MeIpxeScript{}
+	endpoint := fmt.Sprintf("/me/ipxeScript/%s", name)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ipxe script: %s", r)
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("ovh_me_ipxe_script", &resource.Sweeper{
+		Name: "ovh_me_ipxe_script",
+		F:    testSweepMeIpxeScript,
+	})
+}
+
+func testSweepMeIpxeScript(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	names := make([]string, 0)
+	if err := client.Get("/me/ipxeScript", &names); err != nil {
+		return fmt.Errorf("Error calling /me/ipxeScript:\n\t %q", err)
+	}
+
+	for _, name := range names {
+		if !strings.HasPrefix(name, test_prefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting ipxe script %s", name)
+		if err := client.Delete(fmt.Sprintf("/me/ipxeScript/%s", name), nil); err != nil {
+			return fmt.Errorf("Error calling /me/ipxeScript/%s:\n\t %q", name, err)
+		}
+	}
+
+	return nil
+}
+
+const testAccMeIpxeScriptConfig = `
+resource "ovh_me_ipxe_script" "script" {
+  name        = "%s"
+  description = "terraform acceptance tests"
+  script      = "#!ipxe\necho Booting from terraform acceptance tests\nshell\n"
+}
+`
+
+func TestAccMeIpxeScript_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMeIpxeScriptDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMeIpxeScriptConfig, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeIpxeScriptExists("ovh_me_ipxe_script.script", t),
+					resource.TestCheckResourceAttr("ovh_me_ipxe_script.script", "name", name),
+					resource.TestCheckResourceAttr("ovh_me_ipxe_script.script", "description", "terraform acceptance tests"),
+				),
+			},
+			{
+				ResourceName:      "ovh_me_ipxe_script.script",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMeIpxeScriptExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ipxe script name is set")
+		}
+
+		return meIpxeScriptExists(rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckMeIpxeScriptDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_me_ipxe_script" {
+			continue
+		}
+
+		err := meIpxeScriptExists(rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("ipxe script still exists")
+		}
+	}
+	return nil
+}
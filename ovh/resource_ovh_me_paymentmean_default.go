@@ -0,0 +1,123 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceMePaymentmeanDefaultImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not PAYMENT_MEAN_TYPE/PAYMENT_MEAN_ID formatted")
+	}
+	paymentMeanId, err := strconv.Atoi(splitId[1])
+	if err != nil {
+		return nil, fmt.Errorf("Payment mean id %s is not an integer: %s", splitId[1], err)
+	}
+	d.Set("payment_mean_type", splitId[0])
+	d.Set("payment_mean_id", paymentMeanId)
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceMePaymentmeanDefault() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMePaymentmeanDefaultCreate,
+		Read:   resourceMePaymentmeanDefaultRead,
+		Delete: resourceMePaymentmeanDefaultDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMePaymentmeanDefaultImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"payment_mean_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), mePaymentMeanTypes)
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"payment_mean_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMePaymentmeanDefaultCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	paymentMeanType := d.Get("payment_mean_type").(string)
+	paymentMeanId := d.Get("payment_mean_id").(int)
+
+	log.Printf("[DEBUG] Will choose %s %d as default payment mean", paymentMeanType, paymentMeanId)
+
+	endpoint := fmt.Sprintf("/me/paymentMean/%s/%d/chooseAsDefaultPaymentMean", paymentMeanType, paymentMeanId)
+	if err := config.OVHClient.Post(endpoint, nil, nil); err != nil {
+		return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", paymentMeanType, paymentMeanId))
+
+	return resourceMePaymentmeanDefaultRead(d, meta)
+}
+
+func resourceMePaymentmeanDefaultRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	paymentMeanType := d.Get("payment_mean_type").(string)
+	paymentMeanId := d.Get("payment_mean_id").(int)
+
+	r, err := mePaymentMeanGet(config.OVHClient, paymentMeanType, int64(paymentMeanId))
+	if err != nil {
+		return CheckDeleted(d, err, fmt.Sprintf("/me/paymentMean/%s/%d", paymentMeanType, paymentMeanId))
+	}
+
+	log.Printf("[DEBUG] Read payment mean %s: %s", paymentMeanType, r)
+
+	// another payment mean has been chosen as default outside of terraform
+	if !r.Default {
+		log.Printf("[WARN] Payment mean %s is no longer the default one", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("description", r.Description)
+	d.Set("state", r.State)
+
+	return nil
+}
+
+func resourceMePaymentmeanDefaultDelete(d *schema.ResourceData, meta interface{}) error {
+	// an account always keeps a default payment mean:
+	// the resource is only removed from the state.
+	log.Printf("[DEBUG] Payment mean %s stays the default one of the account", d.Id())
+
+	d.SetId("")
+	return nil
+}
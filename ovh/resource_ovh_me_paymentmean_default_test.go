@@ -0,0 +1,64 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccMePaymentmeanDefaultConfig = `
+data "ovh_me_paymentmean_creditcard" "cc" {
+  use_default = true
+}
+
+resource "ovh_me_paymentmean_default" "default" {
+  payment_mean_type = "creditCard"
+  payment_mean_id   = "${data.ovh_me_paymentmean_creditcard.cc.id}"
+}
+`
+
+func TestAccMePaymentmeanDefault_basic(t *testing.T) {
+	// choosing a default payment mean changes how the account pays its bills
+	// this resource is tested only if env var `OVH_TEST_CREDITCARD`
+	// is set to "1", on an account already paying with its credit card
+	v := os.Getenv("OVH_TEST_CREDITCARD")
+	if v == "1" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccMePaymentmeanDefaultConfig,
+					Check: resource.ComposeTestCheckFunc(
+						testAccCheckMePaymentmeanDefault("ovh_me_paymentmean_default.default"),
+						resource.TestCheckResourceAttr("ovh_me_paymentmean_default.default", "state", "valid"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccCheckMePaymentmeanDefault(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if err := meDefaultPaymentMeanCheck(config.OVHClient); err != nil {
+			return err
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No payment mean id is set")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,152 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type MeSshKey struct {
+	KeyName string `json:"keyName"`
+	Key     string `json:"key"`
+	Default bool   `json:"default"`
+}
+
+func (k *MeSshKey) String() string {
+	return fmt.Sprintf("SshKey[name: %s, default: %v]", k.KeyName, k.Default)
+}
+
+type MeSshKeyCreateOpts struct {
+	KeyName string `json:"keyName"`
+	Key     string `json:"key"`
+}
+
+type MeSshKeyUpdateOpts struct {
+	Default bool `json:"default"`
+}
+
+func resourceMeSshKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMeSshKeyCreate,
+		Read:   resourceMeSshKeyRead,
+		Update: resourceMeSshKeyUpdate,
+		Delete: resourceMeSshKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("key_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceMeSshKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &MeSshKeyCreateOpts{
+		KeyName: d.Get("key_name").(string),
+		Key:     d.Get("key").(string),
+	}
+
+	log.Printf("[DEBUG] Will create ssh key %s", params.KeyName)
+
+	endpoint := "/me/sshKey"
+	err := config.OVHClient.Post(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(params.KeyName)
+
+	if d.Get("default").(bool) {
+		return resourceMeSshKeyUpdate(d, meta)
+	}
+
+	return resourceMeSshKeyRead(d, meta)
+}
+
+func resourceMeSshKeyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &MeSshKey{}
+	endpoint := fmt.Sprintf("/me/sshKey/%s", d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ssh key %s", r)
+
+	d.Set("key_name", r.KeyName)
+	d.Set("key", r.Key)
+	d.Set("default", r.Default)
+
+	return nil
+}
+
+func resourceMeSshKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &MeSshKeyUpdateOpts{Default: d.Get("default").(bool)}
+	endpoint := fmt.Sprintf("/me/sshKey/%s", d.Id())
+
+	log.Printf("[DEBUG] Will update ssh key %s: %v", d.Id(), params)
+
+	err := config.OVHClient.Put(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceMeSshKeyRead(d, meta)
+}
+
+func resourceMeSshKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	endpoint := fmt.Sprintf("/me/sshKey/%s", d.Id())
+
+	log.Printf("[DEBUG] Will delete ssh key %s", d.Id())
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func meSshKeyExists(keyName string, c *ovh.Client) error {
+	r := &MeSshKey{}
+	endpoint := fmt.Sprintf("/me/sshKey/%s", keyName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ssh key: %s", r)
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("ovh_me_ssh_key", &resource.Sweeper{
+		Name: "ovh_me_ssh_key",
+		F:    testSweepMeSshKey,
+	})
+}
+
+func testSweepMeSshKey(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	keyNames := make([]string, 0)
+	if err := client.Get("/me/sshKey", &keyNames); err != nil {
+		return fmt.Errorf("Error calling /me/sshKey:\n\t %q", err)
+	}
+
+	for _, keyName := range keyNames {
+		if !strings.HasPrefix(keyName, test_prefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting ssh key %s", keyName)
+		if err := client.Delete(fmt.Sprintf("/me/sshKey/%s", keyName), nil); err != nil {
+			return fmt.Errorf("Error calling /me/sshKey/%s:\n\t %q", keyName, err)
+		}
+	}
+
+	return nil
+}
+
+const testAccMeSshKeyConfig = `
+resource "ovh_me_ssh_key" "key" {
+  key_name = "%s"
+  key      = "%s"
+  default  = %v
+}
+`
+
+func TestAccMeSshKey_basic(t *testing.T) {
+	keyName := acctest.RandomWithPrefix(test_prefix)
+	publicKey, _, err := acctest.RandSSHKeyPair(test_prefix)
+	if err != nil {
+		t.Fatalf("error generating ssh key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMeSshKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMeSshKeyConfig, keyName, publicKey, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeSshKeyExists("ovh_me_ssh_key.key", t),
+					resource.TestCheckResourceAttr("ovh_me_ssh_key.key", "key_name", keyName),
+					resource.TestCheckResourceAttr("ovh_me_ssh_key.key", "default", "false"),
+				),
+			},
+			{
+				ResourceName:      "ovh_me_ssh_key.key",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMeSshKeyExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ssh key name is set")
+		}
+
+		return meSshKeyExists(rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckMeSshKeyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_me_ssh_key" {
+			continue
+		}
+
+		err := meSshKeyExists(rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("SSH key still exists")
+		}
+	}
+	return nil
+}
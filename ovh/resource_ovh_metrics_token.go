@@ -0,0 +1,200 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceMetricsTokenImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/TOKEN_ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceMetricsToken() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMetricsTokenCreate,
+		Read:   resourceMetricsTokenRead,
+		Update: resourceMetricsTokenUpdate,
+		Delete: resourceMetricsTokenDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMetricsTokenImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"permission": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"read", "write"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed
+			"access": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiry_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMetricsTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &MetricsTokenCreateOpts{
+		Description: d.Get("description").(string),
+		Permission:  d.Get("permission").(string),
+		Labels:      []*MetricsTokenLabel{},
+	}
+
+	for key, value := range d.Get("labels").(map[string]interface{}) {
+		params.Labels = append(params.Labels, &MetricsTokenLabel{
+			Key:   key,
+			Value: value.(string),
+		})
+	}
+
+	log.Printf("[DEBUG] Will create metrics %s token: %v", serviceName, params)
+
+	r := &MetricsToken{}
+	endpoint := fmt.Sprintf("/metrics/%s/token", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(r.Id)
+
+	return resourceMetricsTokenRead(d, meta)
+}
+
+func resourceMetricsTokenRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &MetricsToken{}
+	endpoint := fmt.Sprintf("/metrics/%s/token/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read metrics %s token %s", serviceName, r)
+
+	// revoked tokens are kept by the API but can't be used anymore
+	if r.IsRevoked {
+		log.Printf("[WARN] Metrics token %s has been revoked, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	labels := make(map[string]string)
+	for _, label := range r.Labels {
+		labels[label.Key] = label.Value
+	}
+
+	d.Set("permission", r.Permission)
+	d.Set("description", r.Description)
+	d.Set("labels", labels)
+	d.Set("access", r.Access)
+	d.Set("created_at", r.CreatedAt)
+	if r.ExpiryAt != nil {
+		d.Set("expiry_at", *r.ExpiryAt)
+	}
+
+	return nil
+}
+
+func resourceMetricsTokenUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &MetricsTokenUpdateOpts{
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] Will update metrics %s token %s: %v", serviceName, d.Id(), params)
+
+	endpoint := fmt.Sprintf("/metrics/%s/token/%s", serviceName, d.Id())
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceMetricsTokenRead(d, meta)
+}
+
+func resourceMetricsTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will revoke metrics %s token %s", serviceName, d.Id())
+
+	endpoint := fmt.Sprintf("/metrics/%s/token/%s", serviceName, d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func metricsTokenExists(serviceName, tokenId string, c *ovh.Client) error {
+	r := &MetricsToken{}
+	endpoint := fmt.Sprintf("/metrics/%s/token/%s", serviceName, tokenId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read metrics token: %s", r)
+
+	if r.IsRevoked {
+		return fmt.Errorf("metrics token %s is revoked", tokenId)
+	}
+
+	return nil
+}
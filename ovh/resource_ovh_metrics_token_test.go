@@ -0,0 +1,93 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccMetricsTokenConfig = `
+resource "ovh_metrics_token" "token" {
+  service_name = "%s"
+  permission   = "write"
+  description  = "%s"
+
+  labels = {
+    source = "terraform"
+  }
+}
+`
+
+func TestAccMetricsToken_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_METRICS_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckMetricsPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMetricsTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccMetricsTokenConfig, serviceName, "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricsTokenExists("ovh_metrics_token.token", t),
+					resource.TestCheckResourceAttr("ovh_metrics_token.token", "permission", "write"),
+					resource.TestCheckResourceAttr("ovh_metrics_token.token", "labels.source", "terraform"),
+					resource.TestCheckResourceAttrSet("ovh_metrics_token.token", "access"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccMetricsTokenConfig, serviceName, "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricsTokenExists("ovh_metrics_token.token", t),
+					resource.TestCheckResourceAttr("ovh_metrics_token.token", "description", "updated by terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMetricsPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// metrics data platform is an optional product
+	// these resources are tested only if env var `OVH_METRICS_SERVICE`
+	// is set
+	if os.Getenv("OVH_METRICS_SERVICE") == "" {
+		t.Skip("OVH_METRICS_SERVICE must be set to test metrics data platform")
+	}
+}
+
+func testAccCheckMetricsTokenExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No metrics token id is set")
+		}
+
+		return metricsTokenExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckMetricsTokenDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_metrics_token" {
+			continue
+		}
+
+		err := metricsTokenExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("metrics token still valid")
+		}
+	}
+	return nil
+}
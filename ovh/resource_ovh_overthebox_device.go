@@ -0,0 +1,129 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceOverTheBoxDevice() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOverTheBoxDeviceCreate,
+		Read:   resourceOverTheBoxDeviceRead,
+		Delete: resourceOverTheBoxDeviceDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("service_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"activated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"last_seen": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"system_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOverTheBoxDeviceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &OverTheBoxLinkDeviceOpts{
+		DeviceId: d.Get("device_id").(string),
+	}
+
+	log.Printf("[DEBUG] Will link device to overthebox %s: %v", serviceName, params)
+
+	endpoint := fmt.Sprintf("/overTheBox/%s/linkDevice", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(serviceName)
+
+	return resourceOverTheBoxDeviceRead(d, meta)
+}
+
+func resourceOverTheBoxDeviceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &OverTheBoxDevice{}
+	endpoint := fmt.Sprintf("/overTheBox/%s/device", d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read overthebox %s device %s", d.Id(), r)
+
+	d.Set("service_name", d.Id())
+	d.Set("device_id", r.DeviceId)
+	d.Set("activated", r.Activated)
+	d.Set("last_seen", r.LastSeen)
+	d.Set("public_ip", r.PublicIp)
+	d.Set("version", r.Version)
+	d.Set("system_version", r.SystemVersion)
+
+	return nil
+}
+
+func resourceOverTheBoxDeviceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Will unlink device from overthebox %s", d.Id())
+
+	endpoint := fmt.Sprintf("/overTheBox/%s/device", d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func overTheBoxDeviceExists(serviceName string, c *ovh.Client) error {
+	r := &OverTheBoxDevice{}
+	endpoint := fmt.Sprintf("/overTheBox/%s/device", serviceName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read overthebox device: %s", r)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccOverTheBoxDeviceConfig = `
+resource "ovh_overthebox_device" "device" {
+  service_name = "%s"
+  device_id    = "%s"
+}
+`
+
+func TestAccOverTheBoxDevice_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_OVERTHEBOX_SERVICE")
+	deviceId := os.Getenv("OVH_OVERTHEBOX_DEVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccCheckOverTheBoxPreCheck(t)
+			if deviceId == "" {
+				t.Skip("OVH_OVERTHEBOX_DEVICE must be set to test overthebox device binding")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOverTheBoxDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccOverTheBoxDeviceConfig, serviceName, deviceId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOverTheBoxDeviceExists("ovh_overthebox_device.device", t),
+					resource.TestCheckResourceAttr("ovh_overthebox_device.device", "device_id", deviceId),
+				),
+			},
+			{
+				ResourceName:      "ovh_overthebox_device.device",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOverTheBoxDeviceExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No overthebox service name is set")
+		}
+
+		return overTheBoxDeviceExists(rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckOverTheBoxDeviceDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_overthebox_device" {
+			continue
+		}
+
+		err := overTheBoxDeviceExists(rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("overthebox %s still has a device", rs.Primary.ID)
+		}
+	}
+	return nil
+}
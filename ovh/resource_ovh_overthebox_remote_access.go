@@ -0,0 +1,212 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceOverTheBoxRemoteAccessImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/REMOTE_ACCESS_ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceOverTheBoxRemoteAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOverTheBoxRemoteAccessCreate,
+		Read:   resourceOverTheBoxRemoteAccessRead,
+		Delete: resourceOverTheBoxRemoteAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOverTheBoxRemoteAccessImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"exposed_port": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"public_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"allowed_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIp(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"remote_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"remote_user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"accepted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"connection_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOverTheBoxRemoteAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &OverTheBoxRemoteAccessCreateOpts{
+		ExposedPort:    d.Get("exposed_port").(int),
+		PublicKey:      d.Get("public_key").(string),
+		AllowedIp:      d.Get("allowed_ip").(string),
+		ExpirationDate: d.Get("expiration_date").(string),
+	}
+
+	log.Printf("[DEBUG] Will create overthebox %s remote access: %s", serviceName, params)
+
+	r := &OverTheBoxRemoteAccess{}
+	endpoint := fmt.Sprintf("/overTheBox/%s/remoteAccesses", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(r.RemoteAccessId)
+
+	// the password is only returned on creation
+	d.Set("password", r.Password)
+
+	if err := overTheBoxRemoteAccessWait(config.OVHClient, serviceName, r.RemoteAccessId, []string{"toCreate", "creating"}, []string{"active"}); err != nil {
+		return err
+	}
+
+	return resourceOverTheBoxRemoteAccessRead(d, meta)
+}
+
+func resourceOverTheBoxRemoteAccessRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &OverTheBoxRemoteAccess{}
+	endpoint := fmt.Sprintf("/overTheBox/%s/remoteAccesses/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read overthebox %s remote access %s", serviceName, r)
+
+	if r.Status == "deleted" {
+		log.Printf("[WARN] Remote access %s on overthebox %s is deleted, removing it from state", d.Id(), serviceName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("exposed_port", r.ExposedPort)
+	d.Set("public_key", r.PublicKey)
+	d.Set("allowed_ip", r.AllowedIp)
+	d.Set("expiration_date", r.ExpirationDate)
+	d.Set("remote_port", r.RemotePort)
+	d.Set("remote_user_name", r.RemoteUserName)
+	d.Set("accepted", r.Accepted)
+	d.Set("status", r.Status)
+	d.Set("created_at", r.CreatedAt)
+	if r.ConnectionInfos != nil {
+		d.Set("connection_ip", r.ConnectionInfos.Ip)
+		d.Set("connection_port", r.ConnectionInfos.Port)
+	}
+
+	return nil
+}
+
+func resourceOverTheBoxRemoteAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will delete overthebox %s remote access %s", serviceName, d.Id())
+
+	endpoint := fmt.Sprintf("/overTheBox/%s/remoteAccesses/%s", serviceName, d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := overTheBoxRemoteAccessWait(config.OVHClient, serviceName, d.Id(), []string{"active", "toDelete", "deleting"}, []string{"deleted"}); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func overTheBoxRemoteAccessExists(serviceName, remoteAccessId string, c *ovh.Client) error {
+	r := &OverTheBoxRemoteAccess{}
+	endpoint := fmt.Sprintf("/overTheBox/%s/remoteAccesses/%s", serviceName, remoteAccessId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read overthebox remote access: %s", r)
+
+	if r.Status == "deleted" {
+		return fmt.Errorf("remote access %s on overthebox %s is deleted", remoteAccessId, serviceName)
+	}
+
+	return nil
+}
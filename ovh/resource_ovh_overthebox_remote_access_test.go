@@ -0,0 +1,73 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccOverTheBoxRemoteAccessConfig = `
+resource "ovh_overthebox_remote_access" "access" {
+  service_name = "%s"
+  exposed_port = 22
+  allowed_ip   = "%s"
+}
+`
+
+func TestAccOverTheBoxRemoteAccess_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_OVERTHEBOX_SERVICE")
+	allowedIp := "192.0.2.1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckOverTheBoxPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOverTheBoxRemoteAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccOverTheBoxRemoteAccessConfig, serviceName, allowedIp),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOverTheBoxRemoteAccessExists("ovh_overthebox_remote_access.access", t),
+					resource.TestCheckResourceAttr("ovh_overthebox_remote_access.access", "exposed_port", "22"),
+					resource.TestCheckResourceAttr("ovh_overthebox_remote_access.access", "allowed_ip", allowedIp),
+					resource.TestCheckResourceAttr("ovh_overthebox_remote_access.access", "status", "active"),
+					resource.TestCheckResourceAttrSet("ovh_overthebox_remote_access.access", "remote_port"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOverTheBoxRemoteAccessExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No remote access id is set")
+		}
+
+		return overTheBoxRemoteAccessExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckOverTheBoxRemoteAccessDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_overthebox_remote_access" {
+			continue
+		}
+
+		err := overTheBoxRemoteAccessExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("overthebox remote access still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceOvhCloudConnectPopConfigImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/CONFIG_POP_ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceOvhCloudConnectPopConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOvhCloudConnectPopConfigCreate,
+		Read:   resourceOvhCloudConnectPopConfigRead,
+		Delete: resourceOvhCloudConnectPopConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOvhCloudConnectPopConfigImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"interface_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"l2", "l3"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"customer_bgp_area": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"ovh_bgp_area": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"subnet": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOvhCloudConnectPopConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &OvhCloudConnectPopConfigCreateOpts{
+		Type:            d.Get("type").(string),
+		InterfaceId:     int64(d.Get("interface_id").(int)),
+		CustomerBgpArea: int64(d.Get("customer_bgp_area").(int)),
+		OvhBgpArea:      int64(d.Get("ovh_bgp_area").(int)),
+		Subnet:          d.Get("subnet").(string),
+	}
+
+	log.Printf("[DEBUG] Will create ovhcloud connect %s pop config: %v", serviceName, params)
+
+	task := &OvhCloudConnectTask{}
+	endpoint := fmt.Sprintf("/ovhCloudConnect/%s/config/pop", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := ovhCloudConnectTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", task.ResourceId))
+
+	return resourceOvhCloudConnectPopConfigRead(d, meta)
+}
+
+func resourceOvhCloudConnectPopConfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &OvhCloudConnectPopConfig{}
+	endpoint := fmt.Sprintf("/ovhCloudConnect/%s/config/pop/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ovhcloud connect %s pop config %s", serviceName, r)
+
+	d.Set("type", r.Type)
+	d.Set("interface_id", r.InterfaceId)
+	d.Set("customer_bgp_area", r.CustomerBgpArea)
+	d.Set("ovh_bgp_area", r.OvhBgpArea)
+	d.Set("subnet", r.Subnet)
+	d.Set("status", r.Status)
+
+	return nil
+}
+
+func resourceOvhCloudConnectPopConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will delete ovhcloud connect %s pop config %s", serviceName, d.Id())
+
+	task := &OvhCloudConnectTask{}
+	endpoint := fmt.Sprintf("/ovhCloudConnect/%s/config/pop/%s", serviceName, d.Id())
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := ovhCloudConnectTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func ovhCloudConnectPopConfigExists(serviceName, popId string, c *ovh.Client) error {
+	r := &OvhCloudConnectPopConfig{}
+	endpoint := fmt.Sprintf("/ovhCloudConnect/%s/config/pop/%s", serviceName, popId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ovhcloud connect pop config: %s", r)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccOvhCloudConnectPopConfigConfig = `
+data "ovh_ovhcloud_connect" "occ" {
+  service_name = "%s"
+}
+
+resource "ovh_ovhcloud_connect_pop_config" "pop" {
+  service_name      = "${data.ovh_ovhcloud_connect.occ.service_name}"
+  interface_id      = "${data.ovh_ovhcloud_connect.occ.interface_list[0]}"
+  type              = "l3"
+  customer_bgp_area = 65400
+  subnet            = "10.254.0.0/30"
+}
+`
+
+func TestAccOvhCloudConnectPopConfig_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_OVHCLOUD_CONNECT_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckOvhCloudConnectPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOvhCloudConnectPopConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccOvhCloudConnectPopConfigConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOvhCloudConnectPopConfigExists("ovh_ovhcloud_connect_pop_config.pop", t),
+					resource.TestCheckResourceAttr("ovh_ovhcloud_connect_pop_config.pop", "type", "l3"),
+					resource.TestCheckResourceAttr("ovh_ovhcloud_connect_pop_config.pop", "customer_bgp_area", "65400"),
+					resource.TestCheckResourceAttrSet("ovh_ovhcloud_connect_pop_config.pop", "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOvhCloudConnectPopConfigExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No pop config id is set")
+		}
+
+		return ovhCloudConnectPopConfigExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckOvhCloudConnectPopConfigDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_ovhcloud_connect_pop_config" {
+			continue
+		}
+
+		err := ovhCloudConnectPopConfigExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("ovhcloud connect pop config still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceOvhCloudConnectPopDatacenterConfigImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/CONFIG_POP_ID/CONFIG_DATACENTER_ID formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("service_name", splitId[0])
+	d.Set("config_pop_id", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceOvhCloudConnectPopDatacenterConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOvhCloudConnectPopDatacenterConfigCreate,
+		Read:   resourceOvhCloudConnectPopDatacenterConfigRead,
+		Delete: resourceOvhCloudConnectPopDatacenterConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOvhCloudConnectPopDatacenterConfigImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"config_pop_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"datacenter_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ovh_bgp_area": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOvhCloudConnectPopDatacenterConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	popId := d.Get("config_pop_id").(string)
+
+	params := &OvhCloudConnectDatacenterConfigCreateOpts{
+		DatacenterId: int64(d.Get("datacenter_id").(int)),
+		OvhBgpArea:   int64(d.Get("ovh_bgp_area").(int)),
+		Subnet:       d.Get("subnet").(string),
+	}
+
+	log.Printf("[DEBUG] Will create ovhcloud connect %s pop %s datacenter config: %v", serviceName, popId, params)
+
+	task := &OvhCloudConnectTask{}
+	endpoint := fmt.Sprintf("/ovhCloudConnect/%s/config/pop/%s/datacenter", serviceName, popId)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := ovhCloudConnectTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", task.ResourceId))
+
+	return resourceOvhCloudConnectPopDatacenterConfigRead(d, meta)
+}
+
+func resourceOvhCloudConnectPopDatacenterConfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	popId := d.Get("config_pop_id").(string)
+
+	r := &OvhCloudConnectDatacenterConfig{}
+	endpoint := fmt.Sprintf("/ovhCloudConnect/%s/config/pop/%s/datacenter/%s", serviceName, popId, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ovhcloud connect %s datacenter config %s", serviceName, r)
+
+	d.Set("datacenter_id", r.DatacenterId)
+	d.Set("ovh_bgp_area", r.OvhBgpArea)
+	d.Set("subnet", r.Subnet)
+	d.Set("status", r.Status)
+
+	return nil
+}
+
+func resourceOvhCloudConnectPopDatacenterConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	popId := d.Get("config_pop_id").(string)
+
+	log.Printf("[DEBUG] Will delete ovhcloud connect %s pop %s datacenter config %s", serviceName, popId, d.Id())
+
+	task := &OvhCloudConnectTask{}
+	endpoint := fmt.Sprintf("/ovhCloudConnect/%s/config/pop/%s/datacenter/%s", serviceName, popId, d.Id())
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := ovhCloudConnectTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func ovhCloudConnectPopDatacenterConfigExists(serviceName, popId, datacenterConfigId string, c *ovh.Client) error {
+	r := &OvhCloudConnectDatacenterConfig{}
+	endpoint := fmt.Sprintf("/ovhCloudConnect/%s/config/pop/%s/datacenter/%s", serviceName, popId, datacenterConfigId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ovhcloud connect datacenter config: %s", r)
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccOvhCloudConnectPopDatacenterConfigConfig = `
+data "ovh_ovhcloud_connect" "occ" {
+  service_name = "%s"
+}
+
+resource "ovh_ovhcloud_connect_pop_config" "pop" {
+  service_name      = "${data.ovh_ovhcloud_connect.occ.service_name}"
+  interface_id      = "${data.ovh_ovhcloud_connect.occ.interface_list[0]}"
+  type              = "l3"
+  customer_bgp_area = 65400
+  subnet            = "10.254.0.0/30"
+}
+
+resource "ovh_ovhcloud_connect_pop_datacenter_config" "dc" {
+  service_name  = "${ovh_ovhcloud_connect_pop_config.pop.service_name}"
+  config_pop_id = "${ovh_ovhcloud_connect_pop_config.pop.id}"
+  datacenter_id = "${data.ovh_ovhcloud_connect.occ.datacenters.0.id}"
+  subnet        = "10.253.0.0/28"
+}
+`
+
+func TestAccOvhCloudConnectPopDatacenterConfig_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_OVHCLOUD_CONNECT_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckOvhCloudConnectPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOvhCloudConnectPopDatacenterConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccOvhCloudConnectPopDatacenterConfigConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOvhCloudConnectPopDatacenterConfigExists("ovh_ovhcloud_connect_pop_datacenter_config.dc", t),
+					resource.TestCheckResourceAttr("ovh_ovhcloud_connect_pop_datacenter_config.dc", "subnet", "10.253.0.0/28"),
+					resource.TestCheckResourceAttrSet("ovh_ovhcloud_connect_pop_datacenter_config.dc", "ovh_bgp_area"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOvhCloudConnectPopDatacenterConfigExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No datacenter config id is set")
+		}
+
+		return ovhCloudConnectPopDatacenterConfigExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["config_pop_id"],
+			rs.Primary.ID,
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckOvhCloudConnectPopDatacenterConfigDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_ovhcloud_connect_pop_datacenter_config" {
+			continue
+		}
+
+		err := ovhCloudConnectPopDatacenterConfigExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["config_pop_id"],
+			rs.Primary.ID,
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("ovhcloud connect datacenter config still exists")
+		}
+	}
+	return nil
+}
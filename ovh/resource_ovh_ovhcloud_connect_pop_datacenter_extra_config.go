@@ -0,0 +1,222 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceOvhCloudConnectPopDatacenterExtraConfigImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 4)
+	if len(splitId) != 4 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/CONFIG_POP_ID/CONFIG_DATACENTER_ID/ID formatted")
+	}
+	d.SetId(splitId[3])
+	d.Set("service_name", splitId[0])
+	d.Set("config_pop_id", splitId[1])
+	d.Set("config_datacenter_id", splitId[2])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceOvhCloudConnectPopDatacenterExtraConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOvhCloudConnectPopDatacenterExtraConfigCreate,
+		Read:   resourceOvhCloudConnectPopDatacenterExtraConfigRead,
+		Delete: resourceOvhCloudConnectPopDatacenterExtraConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOvhCloudConnectPopDatacenterExtraConfigImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"config_pop_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"config_datacenter_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"bgp", "network"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"bgp_neighbor_area": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bgp_neighbor_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIp(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"next_hop": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIp(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"subnet": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOvhCloudConnectPopDatacenterExtraConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	popId := d.Get("config_pop_id").(string)
+	datacenterConfigId := d.Get("config_datacenter_id").(string)
+
+	params := &OvhCloudConnectDatacenterExtraConfigCreateOpts{
+		Type:            d.Get("type").(string),
+		BgpNeighborArea: int64(d.Get("bgp_neighbor_area").(int)),
+		BgpNeighborIp:   d.Get("bgp_neighbor_ip").(string),
+		NextHop:         d.Get("next_hop").(string),
+		Subnet:          d.Get("subnet").(string),
+	}
+
+	switch params.Type {
+	case "bgp":
+		if params.BgpNeighborArea == 0 || params.BgpNeighborIp == "" {
+			return fmt.Errorf("bgp_neighbor_area and bgp_neighbor_ip are required for a bgp extra config")
+		}
+	case "network":
+		if params.NextHop == "" || params.Subnet == "" {
+			return fmt.Errorf("next_hop and subnet are required for a network extra config")
+		}
+	}
+
+	log.Printf("[DEBUG] Will create ovhcloud connect %s datacenter config %s extra config: %v", serviceName, datacenterConfigId, params)
+
+	task := &OvhCloudConnectTask{}
+	endpoint := fmt.Sprintf("/ovhCloudConnect/%s/config/pop/%s/datacenter/%s/extra", serviceName, popId, datacenterConfigId)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := ovhCloudConnectTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", task.ResourceId))
+
+	return resourceOvhCloudConnectPopDatacenterExtraConfigRead(d, meta)
+}
+
+func resourceOvhCloudConnectPopDatacenterExtraConfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	popId := d.Get("config_pop_id").(string)
+	datacenterConfigId := d.Get("config_datacenter_id").(string)
+
+	r := &OvhCloudConnectDatacenterExtraConfig{}
+	endpoint := fmt.Sprintf("/ovhCloudConnect/%s/config/pop/%s/datacenter/%s/extra/%s", serviceName, popId, datacenterConfigId, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ovhcloud connect %s extra config %s", serviceName, r)
+
+	d.Set("type", r.Type)
+	d.Set("bgp_neighbor_area", r.BgpNeighborArea)
+	d.Set("bgp_neighbor_ip", r.BgpNeighborIp)
+	d.Set("next_hop", r.NextHop)
+	d.Set("subnet", r.Subnet)
+	d.Set("status", r.Status)
+
+	return nil
+}
+
+func resourceOvhCloudConnectPopDatacenterExtraConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	popId := d.Get("config_pop_id").(string)
+	datacenterConfigId := d.Get("config_datacenter_id").(string)
+
+	log.Printf("[DEBUG] Will delete ovhcloud connect %s extra config %s", serviceName, d.Id())
+
+	task := &OvhCloudConnectTask{}
+	endpoint := fmt.Sprintf("/ovhCloudConnect/%s/config/pop/%s/datacenter/%s/extra/%s", serviceName, popId, datacenterConfigId, d.Id())
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := ovhCloudConnectTaskWait(config.OVHClient, serviceName, task.Id); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func ovhCloudConnectPopDatacenterExtraConfigExists(serviceName, popId, datacenterConfigId, extraConfigId string, c *ovh.Client) error {
+	r := &OvhCloudConnectDatacenterExtraConfig{}
+	endpoint := fmt.Sprintf("/ovhCloudConnect/%s/config/pop/%s/datacenter/%s/extra/%s", serviceName, popId, datacenterConfigId, extraConfigId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ovhcloud connect extra config: %s", r)
+
+	return nil
+}
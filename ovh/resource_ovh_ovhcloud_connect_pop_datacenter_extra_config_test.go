@@ -0,0 +1,104 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccOvhCloudConnectPopDatacenterExtraConfigConfig = `
+data "ovh_ovhcloud_connect" "occ" {
+  service_name = "%s"
+}
+
+resource "ovh_ovhcloud_connect_pop_config" "pop" {
+  service_name      = "${data.ovh_ovhcloud_connect.occ.service_name}"
+  interface_id      = "${data.ovh_ovhcloud_connect.occ.interface_list[0]}"
+  type              = "l3"
+  customer_bgp_area = 65400
+  subnet            = "10.254.0.0/30"
+}
+
+resource "ovh_ovhcloud_connect_pop_datacenter_config" "dc" {
+  service_name  = "${ovh_ovhcloud_connect_pop_config.pop.service_name}"
+  config_pop_id = "${ovh_ovhcloud_connect_pop_config.pop.id}"
+  datacenter_id = "${data.ovh_ovhcloud_connect.occ.datacenters.0.id}"
+  subnet        = "10.253.0.0/28"
+}
+
+resource "ovh_ovhcloud_connect_pop_datacenter_extra_config" "network" {
+  service_name         = "${ovh_ovhcloud_connect_pop_datacenter_config.dc.service_name}"
+  config_pop_id        = "${ovh_ovhcloud_connect_pop_datacenter_config.dc.config_pop_id}"
+  config_datacenter_id = "${ovh_ovhcloud_connect_pop_datacenter_config.dc.id}"
+  type                 = "network"
+  next_hop             = "10.253.0.5"
+  subnet               = "192.168.100.0/24"
+}
+`
+
+func TestAccOvhCloudConnectPopDatacenterExtraConfig_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_OVHCLOUD_CONNECT_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckOvhCloudConnectPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOvhCloudConnectPopDatacenterExtraConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccOvhCloudConnectPopDatacenterExtraConfigConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOvhCloudConnectPopDatacenterExtraConfigExists("ovh_ovhcloud_connect_pop_datacenter_extra_config.network", t),
+					resource.TestCheckResourceAttr("ovh_ovhcloud_connect_pop_datacenter_extra_config.network", "type", "network"),
+					resource.TestCheckResourceAttr("ovh_ovhcloud_connect_pop_datacenter_extra_config.network", "next_hop", "10.253.0.5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOvhCloudConnectPopDatacenterExtraConfigExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No extra config id is set")
+		}
+
+		return ovhCloudConnectPopDatacenterExtraConfigExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["config_pop_id"],
+			rs.Primary.Attributes["config_datacenter_id"],
+			rs.Primary.ID,
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckOvhCloudConnectPopDatacenterExtraConfigDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_ovhcloud_connect_pop_datacenter_extra_config" {
+			continue
+		}
+
+		err := ovhCloudConnectPopDatacenterExtraConfigExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["config_pop_id"],
+			rs.Primary.Attributes["config_datacenter_id"],
+			rs.Primary.ID,
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("ovhcloud connect extra config still exists")
+		}
+	}
+	return nil
+}
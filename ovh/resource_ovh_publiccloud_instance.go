@@ -0,0 +1,350 @@
+package ovh
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourcePublicCloudInstanceImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not OVH_PROJECT_ID/instance_id formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("project_id", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourcePublicCloudInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudInstanceCreate,
+		Read:   resourcePublicCloudInstanceRead,
+		Update: resourcePublicCloudInstanceUpdate,
+		Delete: resourcePublicCloudInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePublicCloudInstanceImportState,
+		},
+
+		CustomizeDiff: serviceNameCustomizeDiff("project_id", serviceNameCloudProject),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", nil),
+			},
+			"region": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressRegionDiff,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ssh_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"monthly_billing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"networks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_data": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"user_data_base64"},
+				StateFunc:     publicCloudInstanceUserDataStateFunc,
+			},
+			"user_data_base64": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"user_data"},
+				StateFunc:     publicCloudInstanceUserDataStateFunc,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if _, err := base64.StdEncoding.DecodeString(v.(string)); err != nil {
+						errors = append(errors, fmt.Errorf("%s is not base64 encoded: %s", k, err))
+					}
+					return
+				},
+			},
+
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv4": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ipv6": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// publicCloudInstanceUserDataStateFunc stores a hash of the user data in
+// the state: it may be large and hold secrets, and it is only compared to
+// the configuration to know when the instance must be recreated.
+func publicCloudInstanceUserDataStateFunc(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		if s == "" {
+			return ""
+		}
+		hash := sha1.Sum([]byte(s))
+		return hex.EncodeToString(hash[:])
+	default:
+		return ""
+	}
+}
+
+func resourcePublicCloudInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	setNormalized(d, normalizeRegion, "region")
+
+	projectId := d.Get("project_id").(string)
+
+	params := &PublicCloudInstanceCreateOpts{
+		Name:           d.Get("name").(string),
+		Region:         d.Get("region").(string),
+		FlavorId:       d.Get("flavor_id").(string),
+		ImageId:        d.Get("image_id").(string),
+		SshKeyId:       d.Get("ssh_key_id").(string),
+		MonthlyBilling: d.Get("monthly_billing").(bool),
+		UserData:       d.Get("user_data").(string),
+		GroupId:        d.Get("group_id").(string),
+	}
+
+	// the API expects the user data as is: base64 encoded data, e.g. a
+	// gzipped cloud-init configuration, is decoded first
+	if v, ok := d.GetOk("user_data_base64"); ok {
+		userData, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return fmt.Errorf("user_data_base64 is not base64 encoded: %s", err)
+		}
+		params.UserData = string(userData)
+	}
+
+	for _, networkId := range d.Get("networks").([]interface{}) {
+		params.Networks = append(params.Networks, &PublicCloudInstanceNetworkOpts{NetworkId: networkId.(string)})
+	}
+
+	log.Printf("[DEBUG] Will create public cloud instance: %s", params)
+
+	r := &PublicCloudInstanceResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance", projectId)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(r.Id)
+
+	err := publicCloudInstanceWait(config.OVHClient, projectId, r.Id,
+		[]string{"BUILD", "BUILDING", "REBUILD"},
+		[]string{"ACTIVE"},
+		d.Timeout(schema.TimeoutCreate),
+	)
+	if err != nil {
+		return fmt.Errorf("waiting for public cloud instance %s to be active: %s", r.Id, err)
+	}
+
+	return resourcePublicCloudInstanceRead(d, meta)
+}
+
+func resourcePublicCloudInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	r := &PublicCloudInstanceResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s", projectId, d.Id())
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read public cloud instance %s", r)
+
+	ipv4 := []string{}
+	ipv6 := []string{}
+	ipAddresses := make([]interface{}, len(r.IpAddresses))
+	for i, ip := range r.IpAddresses {
+		// the public addresses come first, for provisioners and DNS records
+		// to use the first address of each list
+		if ip.Version == 6 {
+			if ip.Type == "public" {
+				ipv6 = append([]string{ip.Ip}, ipv6...)
+			} else {
+				ipv6 = append(ipv6, ip.Ip)
+			}
+		} else {
+			if ip.Type == "public" {
+				ipv4 = append([]string{ip.Ip}, ipv4...)
+			} else {
+				ipv4 = append(ipv4, ip.Ip)
+			}
+		}
+
+		ipAddresses[i] = map[string]interface{}{
+			"ip":         ip.Ip,
+			"version":    ip.Version,
+			"type":       ip.Type,
+			"network_id": ip.NetworkId,
+		}
+	}
+
+	d.Set("name", r.Name)
+	d.Set("region", r.Region)
+	d.Set("flavor_id", r.FlavorId)
+	d.Set("image_id", r.ImageId)
+	if r.SshKeyId != nil {
+		d.Set("ssh_key_id", *r.SshKeyId)
+	}
+	d.Set("status", r.Status)
+	d.Set("created", r.Created)
+	d.Set("ipv4", ipv4)
+	d.Set("ipv6", ipv6)
+	d.Set("ip_addresses", ipAddresses)
+
+	return nil
+}
+
+func resourcePublicCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	if d.HasChange("name") {
+		params := &PublicCloudInstanceUpdateOpts{
+			InstanceName: d.Get("name").(string),
+		}
+
+		endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s", projectId, d.Id())
+		if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	return resourcePublicCloudInstanceRead(d, meta)
+}
+
+func resourcePublicCloudInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	log.Printf("[DEBUG] Will delete public cloud instance %s", d.Id())
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s", projectId, d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	err := publicCloudInstanceWait(config.OVHClient, projectId, d.Id(),
+		[]string{"ACTIVE", "DELETING", "SHUTOFF", "STOPPED"},
+		[]string{"DELETED"},
+		d.Timeout(schema.TimeoutDelete),
+	)
+	if err != nil {
+		return fmt.Errorf("waiting for public cloud instance %s to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func (p *PublicCloudInstanceResponse) TaskStatus() string {
+	return p.Status
+}
+
+// publicCloudInstanceWait waits for an instance to reach one of the target
+// statuses. An instance which can't be found anymore is reported as
+// "DELETED".
+func publicCloudInstanceWait(c *ovh.Client, projectId, id string, pending, target []string, timeout time.Duration) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/cloud/project/%s/instance/%s", projectId, id),
+		Description: fmt.Sprintf("public cloud instance %s", id),
+		Task:        &PublicCloudInstanceResponse{},
+		Pending:     pending,
+		Target:      target,
+		Purged:      "DELETED",
+		Timeout:     timeout,
+		Delay:       5 * time.Second,
+	}).Wait(c)
+	return err
+}
@@ -0,0 +1,194 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourcePublicCloudInstanceInterfaceImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not OVH_PROJECT_ID/instance_id/interface_id formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("project_id", splitId[0])
+	d.Set("instance_id", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourcePublicCloudInstanceInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudInstanceInterfaceCreate,
+		Read:   resourcePublicCloudInstanceInterfaceRead,
+		Delete: resourcePublicCloudInstanceInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePublicCloudInstanceInterfaceImportState,
+		},
+
+		CustomizeDiff: serviceNameCustomizeDiff("project_id", serviceNameCloudProject),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", nil),
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     resourcePubliccloudPrivateNetworkSubnetValidateIP,
+				DiffSuppressFunc: suppressIpDiff,
+			},
+
+			// Computed
+			"mac_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePublicCloudInstanceInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	setNormalized(d, normalizeIp, "ip")
+
+	projectId := d.Get("project_id").(string)
+	instanceId := d.Get("instance_id").(string)
+
+	params := &PublicCloudInstanceInterfaceCreateOpts{
+		NetworkId: d.Get("network_id").(string),
+		Ip:        d.Get("ip").(string),
+	}
+
+	log.Printf("[DEBUG] Will attach interface to public cloud instance %s: %s", instanceId, params)
+
+	r := &PublicCloudInstanceInterfaceResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s/interface", projectId, instanceId)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(r.Id)
+
+	err := publicCloudInstanceInterfaceWait(config.OVHClient, projectId, instanceId, r.Id,
+		[]string{"BUILD"},
+		[]string{"ACTIVE", "DOWN"},
+		d.Timeout(schema.TimeoutCreate),
+	)
+	if err != nil {
+		return fmt.Errorf("waiting for interface %s of public cloud instance %s to be attached: %s", r.Id, instanceId, err)
+	}
+
+	return resourcePublicCloudInstanceInterfaceRead(d, meta)
+}
+
+func resourcePublicCloudInstanceInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+	instanceId := d.Get("instance_id").(string)
+
+	r := &PublicCloudInstanceInterfaceResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s/interface/%s", projectId, instanceId, d.Id())
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read public cloud instance %s %s", instanceId, r)
+
+	d.Set("network_id", r.NetworkId)
+	d.Set("mac_address", r.MacAddress)
+	d.Set("state", r.State)
+	d.Set("type", r.Type)
+	if len(r.FixedIps) > 0 {
+		d.Set("ip", r.FixedIps[0].Ip)
+		d.Set("subnet_id", r.FixedIps[0].SubnetId)
+	}
+
+	return nil
+}
+
+func resourcePublicCloudInstanceInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+	instanceId := d.Get("instance_id").(string)
+
+	log.Printf("[DEBUG] Will detach interface %s from public cloud instance %s", d.Id(), instanceId)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s/interface/%s", projectId, instanceId, d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	err := publicCloudInstanceInterfaceWait(config.OVHClient, projectId, instanceId, d.Id(),
+		[]string{"ACTIVE", "BUILD", "DOWN"},
+		[]string{"DELETED"},
+		d.Timeout(schema.TimeoutDelete),
+	)
+	if err != nil {
+		return fmt.Errorf("waiting for interface %s of public cloud instance %s to be detached: %s", d.Id(), instanceId, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func (p *PublicCloudInstanceInterfaceResponse) TaskStatus() string {
+	return p.State
+}
+
+// publicCloudInstanceInterfaceWait waits for an interface of an instance to
+// reach one of the target states. An interface which can't be found anymore
+// is reported as "DELETED".
+func publicCloudInstanceInterfaceWait(c *ovh.Client, projectId, instanceId, id string, pending, target []string, timeout time.Duration) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/cloud/project/%s/instance/%s/interface/%s", projectId, instanceId, id),
+		Description: fmt.Sprintf("interface %s of public cloud instance %s", id, instanceId),
+		Task:        &PublicCloudInstanceInterfaceResponse{},
+		Pending:     pending,
+		Target:      target,
+		Purged:      "DELETED",
+		Timeout:     timeout,
+		Delay:       3 * time.Second,
+	}).Wait(c)
+	return err
+}
@@ -0,0 +1,98 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccPublicCloudInstanceInterfaceConfig = `
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id   = "%s"
+  project_id = "%s"
+}
+
+resource "ovh_cloud_region_network" "network" {
+  project_id = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+  region     = "%s"
+  name       = "%s"
+
+  subnet {
+    cidr = "10.6.0.0/24"
+  }
+}
+
+resource "ovh_cloud_instance" "instance" {
+  project_id = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+  region     = "%s"
+  name       = "%s"
+  flavor_id  = "%s"
+  image_id   = "%s"
+}
+
+resource "ovh_cloud_instance_interface" "interface" {
+  project_id  = "${ovh_cloud_instance.instance.project_id}"
+  instance_id = "${ovh_cloud_instance.instance.id}"
+  network_id  = "${ovh_cloud_region_network.network.id}"
+  ip          = "10.6.0.42"
+}
+`
+
+func TestAccPublicCloudInstanceInterface_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	region := os.Getenv("OVH_CLOUD_INSTANCE_REGION")
+	config := fmt.Sprintf(
+		testAccPublicCloudInstanceInterfaceConfig,
+		os.Getenv("OVH_VRACK"),
+		os.Getenv("OVH_PUBLIC_CLOUD"),
+		region,
+		name,
+		region,
+		name,
+		os.Getenv("OVH_CLOUD_INSTANCE_FLAVOR_ID"),
+		os.Getenv("OVH_CLOUD_INSTANCE_IMAGE_ID"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudInstancePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudInstanceInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_instance_interface.interface", "ip", "10.6.0.42"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_instance_interface.interface", "mac_address"),
+					resource.TestCheckResourceAttrPair(
+						"ovh_cloud_instance_interface.interface", "network_id",
+						"ovh_cloud_region_network.network", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPublicCloudInstanceInterfaceDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_cloud_instance_interface" {
+			continue
+		}
+
+		endpoint := fmt.Sprintf(
+			"/cloud/project/%s/instance/%s/interface/%s",
+			rs.Primary.Attributes["project_id"],
+			rs.Primary.Attributes["instance_id"],
+			rs.Primary.ID,
+		)
+		if err := config.OVHClient.Get(endpoint, nil); err == nil {
+			return fmt.Errorf("Public cloud instance interface %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccPublicCloudInstanceConfig = `
+resource "ovh_cloud_instance" "instance" {
+  project_id = "%s"
+  region     = "%s"
+  name       = "%s"
+  flavor_id  = "%s"
+  image_id   = "%s"
+  user_data  = <<EOF
+#cloud-config
+hostname: testacc
+EOF
+}
+`
+
+func testAccCheckPublicCloudInstancePreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccCheckPublicCloudExists(t)
+
+	// instances are billed: they are tested only when the region, the flavor
+	// and the image to use are given
+	for _, v := range []string{"OVH_CLOUD_INSTANCE_REGION", "OVH_CLOUD_INSTANCE_FLAVOR_ID", "OVH_CLOUD_INSTANCE_IMAGE_ID"} {
+		if os.Getenv(v) == "" {
+			t.Skipf("%s must be set to test public cloud instances", v)
+		}
+	}
+}
+
+func testAccPublicCloudInstanceConfigWithName(name string) string {
+	return fmt.Sprintf(
+		testAccPublicCloudInstanceConfig,
+		os.Getenv("OVH_PUBLIC_CLOUD"),
+		os.Getenv("OVH_CLOUD_INSTANCE_REGION"),
+		name,
+		os.Getenv("OVH_CLOUD_INSTANCE_FLAVOR_ID"),
+		os.Getenv("OVH_CLOUD_INSTANCE_IMAGE_ID"),
+	)
+}
+
+func TestAccPublicCloudInstance_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudInstancePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublicCloudInstanceConfigWithName(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_instance.instance", "name", name),
+					resource.TestCheckResourceAttr("ovh_cloud_instance.instance", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(
+						"ovh_cloud_instance.instance", "user_data",
+						publicCloudInstanceUserDataStateFunc("#cloud-config\nhostname: testacc\n"),
+					),
+					resource.TestCheckResourceAttrSet("ovh_cloud_instance.instance", "ipv4.0"),
+				),
+			},
+			{
+				Config: testAccPublicCloudInstanceConfigWithName(name + "-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_instance.instance", "name", name+"-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestPublicCloudInstanceUserDataStateFunc(t *testing.T) {
+	if h := publicCloudInstanceUserDataStateFunc(""); h != "" {
+		t.Errorf("empty user data should not be hashed, got %q", h)
+	}
+
+	h := publicCloudInstanceUserDataStateFunc("#cloud-config\n")
+	if len(h) != 40 {
+		t.Errorf("expected a sha1 hex digest, got %q", h)
+	}
+	if h == publicCloudInstanceUserDataStateFunc("#cloud-config\nhostname: a\n") {
+		t.Errorf("different user data should have different hashes")
+	}
+}
+
+func testAccCheckPublicCloudInstanceDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_cloud_instance" {
+			continue
+		}
+
+		endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID)
+		if err := config.OVHClient.Get(endpoint, nil); err == nil {
+			return fmt.Errorf("Public cloud instance %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
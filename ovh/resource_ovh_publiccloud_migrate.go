@@ -0,0 +1,103 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The public cloud resources are shared by the legacy ovh_publiccloud_* and
+// the ovh_cloud_* names. Their version 0 states may hold the composite ids
+// used to import them, e.g. "project_id/network_id", which were kept as is
+// in state by older versions of the provider. Version 1 states hold the id of
+// the object only, and its parents as attributes.
+
+func resourcePublicCloudPrivateNetworkMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	return publicCloudMigrateState(v, is, "project_id")
+}
+
+func resourcePublicCloudPrivateNetworkSubnetMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	return publicCloudMigrateState(v, is, "project_id", "network_id")
+}
+
+func resourcePublicCloudUserMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	return publicCloudMigrateState(v, is, "project_id")
+}
+
+func publicCloudMigrateState(v int, is *terraform.InstanceState, parents ...string) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found public cloud state v0; migrating to v1")
+		return publicCloudMigrateStateV0toV1(is, parents)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+func publicCloudMigrateStateV0toV1(is *terraform.InstanceState, parents []string) (*terraform.InstanceState, error) {
+	if is.Empty() || !strings.Contains(is.ID, "/") {
+		log.Println("[DEBUG] Empty or already migrated state; nothing to migrate.")
+		return is, nil
+	}
+
+	if is.Attributes == nil {
+		is.Attributes = make(map[string]string)
+	}
+
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+
+	splitId := strings.Split(is.ID, "/")
+	if len(splitId) != len(parents)+1 {
+		return is, fmt.Errorf("Unexpected id %s: expected %s/id", is.ID, strings.Join(parents, "/"))
+	}
+
+	for i, parent := range parents {
+		if is.Attributes[parent] == "" {
+			is.Attributes[parent] = splitId[i]
+		}
+	}
+	is.ID = splitId[len(parents)]
+	is.Attributes["id"] = is.ID
+
+	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
+
+var vrackPublicCloudAttachmentId = regexp.MustCompile("^vrack_(.+)-cloudproject_(.+)-attach$")
+
+// resourceVRackPublicCloudAttachmentMigrateState fills the vrack_id and
+// project_id attributes from the id of the attachment when they are missing
+// from version 0 states.
+func resourceVRackPublicCloudAttachmentMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	if v != 0 {
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+
+	log.Println("[INFO] Found vrack public cloud attachment state v0; migrating to v1")
+
+	if is.Empty() {
+		log.Println("[DEBUG] Empty state; nothing to migrate.")
+		return is, nil
+	}
+
+	if is.Attributes == nil {
+		is.Attributes = make(map[string]string)
+	}
+
+	m := vrackPublicCloudAttachmentId.FindStringSubmatch(is.ID)
+	if m == nil {
+		return is, fmt.Errorf("Unexpected id %s: expected vrack_<vrack_id>-cloudproject_<project_id>-attach", is.ID)
+	}
+
+	if is.Attributes["vrack_id"] == "" {
+		is.Attributes["vrack_id"] = m[1]
+	}
+	if is.Attributes["project_id"] == "" {
+		is.Attributes["project_id"] = m[2]
+	}
+
+	return is, nil
+}
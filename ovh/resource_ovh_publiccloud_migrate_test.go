@@ -0,0 +1,89 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestPublicCloudMigrateState(t *testing.T) {
+	cases := map[string]struct {
+		migrate    func(int, *terraform.InstanceState, interface{}) (*terraform.InstanceState, error)
+		id         string
+		attributes map[string]string
+		expectedId string
+		expected   map[string]string
+	}{
+		"private network composite id": {
+			migrate:    resourcePublicCloudPrivateNetworkMigrateState,
+			id:         "1234/pn-5678_0",
+			attributes: map[string]string{"name": "net"},
+			expectedId: "pn-5678_0",
+			expected:   map[string]string{"project_id": "1234", "name": "net"},
+		},
+		"private network id": {
+			migrate:    resourcePublicCloudPrivateNetworkMigrateState,
+			id:         "pn-5678_0",
+			attributes: map[string]string{"project_id": "1234"},
+			expectedId: "pn-5678_0",
+			expected:   map[string]string{"project_id": "1234"},
+		},
+		"subnet composite id": {
+			migrate:    resourcePublicCloudPrivateNetworkSubnetMigrateState,
+			id:         "1234/pn-5678_0/abcd",
+			attributes: map[string]string{},
+			expectedId: "abcd",
+			expected:   map[string]string{"project_id": "1234", "network_id": "pn-5678_0"},
+		},
+		"subnet without attributes": {
+			migrate:    resourcePublicCloudPrivateNetworkSubnetMigrateState,
+			id:         "1234/pn-5678_0/abcd",
+			expectedId: "abcd",
+			expected:   map[string]string{"project_id": "1234", "network_id": "pn-5678_0"},
+		},
+		"user composite id": {
+			migrate:    resourcePublicCloudUserMigrateState,
+			id:         "1234/42",
+			attributes: map[string]string{"project_id": "1234"},
+			expectedId: "42",
+			expected:   map[string]string{"project_id": "1234"},
+		},
+		"vrack attachment": {
+			migrate:    resourceVRackPublicCloudAttachmentMigrateState,
+			id:         "vrack_pn-1234-cloudproject_5678-attach",
+			attributes: map[string]string{},
+			expectedId: "vrack_pn-1234-cloudproject_5678-attach",
+			expected:   map[string]string{"vrack_id": "pn-1234", "project_id": "5678"},
+		},
+		"vrack attachment without attributes": {
+			migrate:    resourceVRackPublicCloudAttachmentMigrateState,
+			id:         "vrack_pn-1234-cloudproject_5678-attach",
+			expectedId: "vrack_pn-1234-cloudproject_5678-attach",
+			expected:   map[string]string{"vrack_id": "pn-1234", "project_id": "5678"},
+		},
+	}
+
+	for name, c := range cases {
+		is, err := c.migrate(0, &terraform.InstanceState{ID: c.id, Attributes: c.attributes}, nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+
+		if is.ID != c.expectedId {
+			t.Fatalf("%s: expected id %s, got %s", name, c.expectedId, is.ID)
+		}
+
+		for k, v := range c.expected {
+			if is.Attributes[k] != v {
+				t.Fatalf("%s: expected %s to be %s, got %s", name, k, v, is.Attributes[k])
+			}
+		}
+	}
+}
+
+func TestPublicCloudMigrateState_invalidId(t *testing.T) {
+	is := &terraform.InstanceState{ID: "1234/pn-5678_0", Attributes: map[string]string{}}
+	if _, err := resourcePublicCloudPrivateNetworkSubnetMigrateState(0, is, nil); err == nil {
+		t.Fatal("expected an error for a subnet id without network id")
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/ovh/go-ovh/ovh"
@@ -37,6 +36,11 @@ func resourcePublicCloudPrivateNetwork() *schema.Resource {
 			State: resourceOvhCloudNetworkPrivateImportState,
 		},
 
+		SchemaVersion: 1,
+		MigrateState:  resourcePublicCloudPrivateNetworkMigrateState,
+
+		CustomizeDiff: serviceNameCustomizeDiff("project_id", serviceNameCloudProject),
+
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:        schema.TypeString,
@@ -47,7 +51,6 @@ func resourcePublicCloudPrivateNetwork() *schema.Resource {
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: false,
 			},
 			"vlan_id": {
 				Type:     schema.TypeInt,
@@ -78,9 +81,24 @@ func resourcePublicCloudPrivateNetwork() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+
+						"openstack_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
+			"regions_statuses": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"regions_openstack_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -117,25 +135,23 @@ func resourcePublicCloudPrivateNetworkCreate(d *schema.ResourceData, meta interf
 
 	log.Printf("[DEBUG] Waiting for Private Network %s:", r)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"BUILDING"},
-		Target:     []string{"ACTIVE"},
-		Refresh:    waitForPublicCloudPrivateNetworkActive(config.OVHClient, projectId, r.Id),
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
+	_, err = (&taskPoller{
+		Endpoint:    fmt.Sprintf("/cloud/project/%s/network/private/%s", projectId, r.Id),
+		Description: fmt.Sprintf("private network %s of project %s", r.Id, projectId),
+		Task:        &PublicCloudPrivateNetworkResponse{},
+		Pending:     []string{"BUILDING"},
+		Target:      []string{"ACTIVE"},
+		Timeout:     10 * time.Minute,
+	}).Wait(config.OVHClient)
 	if err != nil {
-		return fmt.Errorf("waiting for private network (%s): %s", params, err)
+		return err
 	}
 	log.Printf("[DEBUG] Created Private Network %s", r)
 
 	//set id
 	d.SetId(r.Id)
 
-	return nil
+	return resourcePublicCloudPrivateNetworkRead(d, meta)
 }
 
 func resourcePublicCloudPrivateNetworkRead(d *schema.ResourceData, meta interface{}) error {
@@ -169,20 +185,25 @@ func resourcePublicCloudPrivateNetworkUpdate(d *schema.ResourceData, meta interf
 	config := meta.(*Config)
 
 	projectId := d.Get("project_id").(string)
-	params := &PublicCloudPrivateNetworkUpdateOpts{
-		Name: d.Get("name").(string),
-	}
 
-	log.Printf("[DEBUG] Will update public cloud private network: %s", params)
+	// The API only allows to rename a private network: its vlan id and
+	// regions can't be changed without recreating it.
+	if d.HasChange("name") {
+		params := &PublicCloudPrivateNetworkUpdateOpts{
+			Name: d.Get("name").(string),
+		}
 
-	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s", projectId, d.Id())
+		log.Printf("[DEBUG] Will update public cloud private network: %s", params)
 
-	err := config.OVHClient.Put(endpoint, params, nil)
-	if err != nil {
-		return fmt.Errorf("calling %s with params %s:\n\t %q", endpoint, params, err)
-	}
+		endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s", projectId, d.Id())
+
+		err := config.OVHClient.Put(endpoint, params, nil)
+		if err != nil {
+			return fmt.Errorf("calling %s with params %s:\n\t %q", endpoint, params, err)
+		}
 
-	log.Printf("[DEBUG] Updated Public cloud %s Private Network %s:", projectId, d.Id())
+		log.Printf("[DEBUG] Updated Public cloud %s Private Network %s:", projectId, d.Id())
+	}
 
 	return resourcePublicCloudPrivateNetworkRead(d, meta)
 }
@@ -202,18 +223,17 @@ func resourcePublicCloudPrivateNetworkDelete(d *schema.ResourceData, meta interf
 		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"DELETING"},
-		Target:     []string{"DELETED"},
-		Refresh:    waitForPublicCloudPrivateNetworkDelete(config.OVHClient, projectId, id),
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
+	_, err = (&taskPoller{
+		Endpoint:    endpoint,
+		Description: fmt.Sprintf("deletion of private network %s of project %s", id, projectId),
+		Task:        &PublicCloudPrivateNetworkResponse{},
+		Pending:     []string{"DELETING"},
+		Target:      []string{"DELETED"},
+		Purged:      "DELETED",
+		Timeout:     10 * time.Minute,
+	}).Wait(config.OVHClient)
 	if err != nil {
-		return fmt.Errorf("deleting for private network (%s): %s", id, err)
+		return err
 	}
 
 	d.SetId("")
@@ -230,16 +250,23 @@ func readPublicCloudPrivateNetwork(config *Config, d *schema.ResourceData, r *Pu
 
 	regions_status := make([]map[string]interface{}, 0)
 	regions := make([]string, 0)
+	statuses := make(map[string]string)
+	openstackIds := make(map[string]string)
 
 	for i := range r.Regions {
 		region := make(map[string]interface{})
 		region["region"] = r.Regions[i].Region
 		region["status"] = r.Regions[i].Status
+		region["openstack_id"] = r.Regions[i].OpenstackId
 		regions_status = append(regions_status, region)
-		regions = append(regions, fmt.Sprintf(r.Regions[i].Region))
+		regions = append(regions, r.Regions[i].Region)
+		statuses[r.Regions[i].Region] = r.Regions[i].Status
+		openstackIds[r.Regions[i].Region] = r.Regions[i].OpenstackId
 	}
 	d.Set("regions_status", regions_status)
 	d.Set("regions", regions)
+	d.Set("regions_statuses", statuses)
+	d.Set("regions_openstack_ids", openstackIds)
 
 	d.SetId(r.Id)
 	return nil
@@ -261,38 +288,41 @@ func publicCloudPrivateNetworkExists(projectId, id string, c *ovh.Client) error
 	return nil
 }
 
-// AttachmentStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
-// an Attachment Task.
-func waitForPublicCloudPrivateNetworkActive(c *ovh.Client, projectId, PublicCloudPrivateNetworkId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		r := &PublicCloudPrivateNetworkResponse{}
-		endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s", projectId, PublicCloudPrivateNetworkId)
-		err := c.Get(endpoint, r)
-		if err != nil {
-			return r, "", err
-		}
+// publicCloudPrivateNetworkOpenstackId returns the openstack id of the
+// private network in region.
+func publicCloudPrivateNetworkOpenstackId(c *ovh.Client, projectId, id, region string) (string, error) {
+	r := &PublicCloudPrivateNetworkResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s", projectId, id)
+	if err := c.Get(endpoint, r); err != nil {
+		return "", fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
 
-		log.Printf("[DEBUG] Pending Private Network: %s", r)
-		return r, r.Status, nil
+	for _, reg := range r.Regions {
+		if normalizeRegion(reg.Region) == normalizeRegion(region) && reg.OpenstackId != "" {
+			return reg.OpenstackId, nil
+		}
 	}
+	return "", fmt.Errorf("private network %s of project %s has no openstack id in region %s", id, projectId, region)
 }
 
-// AttachmentStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
-// an Attachment Task.
-func waitForPublicCloudPrivateNetworkDelete(c *ovh.Client, projectId, PublicCloudPrivateNetworkId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		r := &PublicCloudPrivateNetworkResponse{}
-		endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s", projectId, PublicCloudPrivateNetworkId)
-		err := c.Get(endpoint, r)
-		if err != nil {
-			if err.(*ovh.APIError).Code == 404 {
-				log.Printf("[DEBUG] private network id %s on project %s deleted", PublicCloudPrivateNetworkId, projectId)
-				return r, "DELETED", nil
-			} else {
-				return r, "", err
-			}
+func (r *PublicCloudPrivateNetworkResponse) TaskStatus() string {
+	return publicCloudPrivateNetworkStatus(r)
+}
+
+// publicCloudPrivateNetworkStatus returns the status of a private network
+// across its regions: the network is only ACTIVE once it is ACTIVE in all
+// its regions, for their openstack ids to be known.
+func publicCloudPrivateNetworkStatus(r *PublicCloudPrivateNetworkResponse) string {
+	if r.Status != "ACTIVE" {
+		return r.Status
+	}
+	for _, region := range r.Regions {
+		if region.Status == "" || region.Status == "BUILDING" {
+			return "BUILDING"
+		}
+		if region.Status != "ACTIVE" {
+			return region.Status
 		}
-		log.Printf("[DEBUG] Pending Private Network: %s", r)
-		return r, r.Status, nil
 	}
+	return r.Status
 }
@@ -3,6 +3,8 @@ package ovh
 import (
 	"fmt"
 	"log"
+	"net/url"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -11,17 +13,35 @@ import (
 	"github.com/ovh/go-ovh/ovh"
 )
 
+func resourcePublicCloudPrivateNetworkSubnetImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not OVH_PROJECT_ID/network_id/subnet_id formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("project_id", splitId[0])
+	d.Set("network_id", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
 func resourcePublicCloudPrivateNetworkSubnet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePublicCloudPrivateNetworkSubnetCreate,
 		Read:   resourcePublicCloudPrivateNetworkSubnetRead,
+		Update: resourcePublicCloudPrivateNetworkSubnetUpdate,
 		Delete: resourcePublicCloudPrivateNetworkSubnetDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				return []*schema.ResourceData{d}, nil
-			},
+			State: resourcePublicCloudPrivateNetworkSubnetImportState,
 		},
 
+		SchemaVersion: 1,
+		MigrateState:  resourcePublicCloudPrivateNetworkSubnetMigrateState,
+
+		CustomizeDiff: serviceNameCustomizeDiff("project_id", serviceNameCloudProject),
+
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:        schema.TypeString,
@@ -37,36 +57,38 @@ func resourcePublicCloudPrivateNetworkSubnet() *schema.Resource {
 			"dhcp": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
 				Default:  false,
 			},
 			"start": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: resourcePubliccloudPrivateNetworkSubnetValidateIP,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     resourcePubliccloudPrivateNetworkSubnetValidateIP,
+				DiffSuppressFunc: suppressIpDiff,
 			},
 			"end": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: resourcePubliccloudPrivateNetworkSubnetValidateIP,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     resourcePubliccloudPrivateNetworkSubnetValidateIP,
+				DiffSuppressFunc: suppressIpDiff,
 			},
 			"network": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: resourcePubliccloudPrivateNetworkSubnetValidateNetwork,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     resourcePubliccloudPrivateNetworkSubnetValidateNetwork,
+				DiffSuppressFunc: suppressIpDiff,
 			},
 			"region": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressRegionDiff,
 			},
 			"no_gateway": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
 				Default:  false,
 			},
 			"gateway_ip": {
@@ -113,6 +135,8 @@ func resourcePublicCloudPrivateNetworkSubnet() *schema.Resource {
 
 func resourcePublicCloudPrivateNetworkSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	setNormalized(d, normalizeIp, "start", "end", "network")
+	setNormalized(d, normalizeRegion, "region")
 
 	projectId := d.Get("project_id").(string)
 	networkId := d.Get("network_id").(string)
@@ -144,7 +168,7 @@ func resourcePublicCloudPrivateNetworkSubnetCreate(d *schema.ResourceData, meta
 	//set id
 	d.SetId(r.Id)
 
-	return nil
+	return resourcePublicCloudPrivateNetworkSubnetRead(d, meta)
 }
 
 func resourcePublicCloudPrivateNetworkSubnetRead(d *schema.ResourceData, meta interface{}) error {
@@ -173,6 +197,47 @@ func resourcePublicCloudPrivateNetworkSubnetRead(d *schema.ResourceData, meta in
 	return nil
 }
 
+func resourcePublicCloudPrivateNetworkSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	networkId := d.Get("network_id").(string)
+	region := d.Get("region").(string)
+	id := d.Id()
+
+	// The allocation pool and the network of a subnet can't be changed
+	// through the API: only DHCP and the default gateway are updated in place.
+	if d.HasChange("dhcp") || d.HasChange("no_gateway") {
+		params := &PublicCloudPrivateNetworkSubnetUpdateOpts{
+			EnableDhcp:      d.Get("dhcp").(bool),
+			EnableGatewayIp: !d.Get("no_gateway").(bool),
+		}
+
+		log.Printf("[DEBUG] Will update public cloud private network subnet for project: %s, network: %s, id: %s: %s", projectId, networkId, id, params)
+
+		// the region scoped route expects the openstack id of the network in
+		// the region, not the pn-xxx_N id of the private network
+		openstackId, err := publicCloudPrivateNetworkOpenstackId(config.OVHClient, projectId, networkId, region)
+		if err != nil {
+			return err
+		}
+
+		endpoint := fmt.Sprintf(
+			"/cloud/project/%s/region/%s/network/%s/subnet/%s",
+			projectId,
+			url.PathEscape(region),
+			url.PathEscape(openstackId),
+			id,
+		)
+
+		if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Put %s with params %s:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	return resourcePublicCloudPrivateNetworkSubnetRead(d, meta)
+}
+
 func resourcePublicCloudPrivateNetworkSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -182,7 +247,7 @@ func resourcePublicCloudPrivateNetworkSubnetDelete(d *schema.ResourceData, meta
 
 	log.Printf("[DEBUG] Will delete public cloud private network subnet for project: %s, network: %s, id: %s", projectId, networkId, id)
 
-	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s/subnet/%s", projectId, id, id)
+	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s/subnet/%s", projectId, networkId, id)
 
 	err := config.OVHClient.Delete(endpoint, nil)
 	if err != nil {
@@ -2,14 +2,16 @@ package ovh
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
-var testAccPublicCloudPrivateNetworkSubnetConfig = fmt.Sprintf(`
+const testAccPublicCloudPrivateNetworkSubnetConfigTemplate = `
 resource "ovh_vrack_publiccloud_attachment" "attach" {
   vrack_id   = "%s"
   project_id = "%s"
@@ -35,10 +37,26 @@ resource "ovh_publiccloud_private_network_subnet" "subnet" {
   start      = "192.168.168.100"
   end        = "192.168.168.200"
   network    = "192.168.168.0/24"
-  dhcp       = true
-  no_gateway = false
+  dhcp       = %t
+  no_gateway = %t
 }
-`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
+`
+
+var testAccPublicCloudPrivateNetworkSubnetConfig = fmt.Sprintf(
+	testAccPublicCloudPrivateNetworkSubnetConfigTemplate,
+	os.Getenv("OVH_VRACK"),
+	os.Getenv("OVH_PUBLIC_CLOUD"),
+	true,
+	false,
+)
+
+var testAccPublicCloudPrivateNetworkSubnetConfigUpdated = fmt.Sprintf(
+	testAccPublicCloudPrivateNetworkSubnetConfigTemplate,
+	os.Getenv("OVH_VRACK"),
+	os.Getenv("OVH_PUBLIC_CLOUD"),
+	false,
+	true,
+)
 
 func TestAccPublicCloudPrivateNetworkSubnet_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -52,6 +70,27 @@ func TestAccPublicCloudPrivateNetworkSubnet_basic(t *testing.T) {
 					testAccCheckVRackPublicCloudAttachmentExists("ovh_vrack_publiccloud_attachment.attach", t),
 					testAccCheckPublicCloudPrivateNetworkExists("ovh_publiccloud_private_network.network", t),
 					testAccCheckPublicCloudPrivateNetworkSubnetExists("ovh_publiccloud_private_network_subnet.subnet", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "dhcp", "true"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "no_gateway", "false"),
+					testAccCheckPublicCloudPrivateNetworkSubnetSettings("ovh_publiccloud_private_network_subnet.subnet", true, true),
+				),
+			},
+			{
+				Config: testAccPublicCloudPrivateNetworkSubnetConfigUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudPrivateNetworkSubnetExists("ovh_publiccloud_private_network_subnet.subnet", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "dhcp", "false"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "no_gateway", "true"),
+					testAccCheckPublicCloudPrivateNetworkSubnetSettings("ovh_publiccloud_private_network_subnet.subnet", false, false),
+				),
+			},
+			{
+				// toggled back in place, on the same subnet
+				Config: testAccPublicCloudPrivateNetworkSubnetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "dhcp", "true"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "no_gateway", "false"),
+					testAccCheckPublicCloudPrivateNetworkSubnetSettings("ovh_publiccloud_private_network_subnet.subnet", true, true),
 				),
 			},
 		},
@@ -93,6 +132,77 @@ func testAccCheckPublicCloudPrivateNetworkSubnetExists(n string, t *testing.T) r
 	}
 }
 
+// testAccCheckPublicCloudPrivateNetworkSubnetSettings checks the dhcp and
+// gateway settings of the subnet as reported by the API, so that an update
+// which didn't reach it is caught.
+func testAccCheckPublicCloudPrivateNetworkSubnetSettings(n string, dhcp, gateway bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		subnets := []*PublicCloudPrivateNetworksResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s/subnet", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["network_id"])
+		if err := config.OVHClient.Get(endpoint, &subnets); err != nil {
+			return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+		}
+
+		subnet := findPublicCloudPrivateNetworkSubnet(subnets, rs.Primary.ID)
+		if subnet == nil {
+			return fmt.Errorf("subnet %s not found", rs.Primary.ID)
+		}
+		if (subnet.GatewayIp != "") != gateway {
+			return fmt.Errorf("expected gateway %t, got gateway ip %q", gateway, subnet.GatewayIp)
+		}
+		for _, pool := range subnet.IPPools {
+			if pool.Dhcp != dhcp {
+				return fmt.Errorf("expected dhcp %t on pool %s, got %t", dhcp, pool.Region, pool.Dhcp)
+			}
+		}
+		return nil
+	}
+}
+
+func TestPublicCloudPrivateNetworkSubnetUpdate(t *testing.T) {
+	var updated string
+	client := newTestOVHClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/cloud/project/p1/network/private/pn-1_0":
+			w.Write([]byte(`{"id":"pn-1_0","regions":[{"region":"GRA5","openstackId":"os-gra5"},{"region":"SBG5","openstackId":"os-sbg5"}]}`))
+		case r.Method == "PUT":
+			updated = r.URL.Path
+			w.Write([]byte(`{}`))
+		case r.URL.Path == "/cloud/project/p1/network/private/pn-1_0/subnet":
+			w.Write([]byte(`[{"id":"sub-1","cidr":"192.168.168.0/24","ipPools":[{"region":"SBG5","dhcp":true,"start":"192.168.168.100","end":"192.168.168.200","network":"192.168.168.0/24"}]}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+		}
+	})
+
+	r := resourcePublicCloudPrivateNetworkSubnet()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"project_id": "p1",
+		"network_id": "pn-1_0",
+		"region":     "SBG5",
+		"start":      "192.168.168.100",
+		"end":        "192.168.168.200",
+		"network":    "192.168.168.0/24",
+		"dhcp":       true,
+	})
+	d.SetId("sub-1")
+
+	if err := r.Update(d, &Config{OVHClient: client}); err != nil {
+		t.Fatalf("update failed: %s", err)
+	}
+	if expected := "/cloud/project/p1/region/SBG5/network/os-sbg5/subnet/sub-1"; updated != expected {
+		t.Errorf("expected the subnet to be updated with %s, got %q", expected, updated)
+	}
+}
+
 func testAccCheckPublicCloudPrivateNetworkSubnetDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	for _, rs := range s.RootModule().Resources {
@@ -12,7 +12,7 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
-var testAccPublicCloudPrivateNetworkConfig = fmt.Sprintf(`
+const testAccPublicCloudPrivateNetworkConfigTemplate = `
 resource "ovh_vrack_publiccloud_attachment" "attach" {
   vrack_id = "%s"
   project_id = "%s"
@@ -25,10 +25,24 @@ data "ovh_publiccloud_regions" "regions" {
 resource "ovh_publiccloud_private_network" "network" {
   project_id  = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
   vlan_id = 0
-  name = "terraform_testacc_private_net"
+  name = "%s"
   regions     = ["${data.ovh_publiccloud_regions.regions.names}"]
 }
-`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
+`
+
+var testAccPublicCloudPrivateNetworkConfig = fmt.Sprintf(
+	testAccPublicCloudPrivateNetworkConfigTemplate,
+	os.Getenv("OVH_VRACK"),
+	os.Getenv("OVH_PUBLIC_CLOUD"),
+	test_prefix+"-private-net",
+)
+
+var testAccPublicCloudPrivateNetworkConfigRenamed = fmt.Sprintf(
+	testAccPublicCloudPrivateNetworkConfigTemplate,
+	os.Getenv("OVH_VRACK"),
+	os.Getenv("OVH_PUBLIC_CLOUD"),
+	test_prefix+"-private-net-renamed",
+)
 
 func init() {
 	resource.AddTestSweepers("ovh_cloud_network_private", &resource.Sweeper{
@@ -113,12 +127,46 @@ func TestAccPublicCloudPrivateNetwork_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVRackPublicCloudAttachmentExists("ovh_vrack_publiccloud_attachment.attach", t),
 					testAccCheckPublicCloudPrivateNetworkExists("ovh_publiccloud_private_network.network", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network.network", "name", test_prefix+"-private-net"),
+					resource.TestCheckResourceAttrSet("ovh_publiccloud_private_network.network", "regions_openstack_ids.%"),
+					resource.TestCheckResourceAttrSet("ovh_publiccloud_private_network.network", "regions_statuses.%"),
+				),
+			},
+			{
+				Config: testAccPublicCloudPrivateNetworkConfigRenamed,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudPrivateNetworkExists("ovh_publiccloud_private_network.network", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network.network", "name", test_prefix+"-private-net-renamed"),
 				),
 			},
 		},
 	})
 }
 
+func TestPublicCloudPrivateNetworkStatus(t *testing.T) {
+	cases := []struct {
+		network  string
+		regions  []string
+		expected string
+	}{
+		{"BUILDING", []string{"BUILDING"}, "BUILDING"},
+		{"ACTIVE", []string{"ACTIVE", "BUILDING"}, "BUILDING"},
+		{"ACTIVE", []string{"ACTIVE", ""}, "BUILDING"},
+		{"ACTIVE", []string{"ACTIVE", "ACTIVE"}, "ACTIVE"},
+		{"ACTIVE", []string{"ERROR", "ACTIVE"}, "ERROR"},
+	}
+
+	for _, c := range cases {
+		r := &PublicCloudPrivateNetworkResponse{Status: c.network}
+		for _, status := range c.regions {
+			r.Regions = append(r.Regions, &PublicCloudPrivateNetworkRegion{Status: status})
+		}
+		if got := publicCloudPrivateNetworkStatus(r); got != c.expected {
+			t.Errorf("network %s with regions %v: expected %s, got %s", c.network, c.regions, c.expected, got)
+		}
+	}
+}
+
 func testAccCheckPublicCloudPrivateNetworkPreCheck(t *testing.T) {
 	testAccPreCheck(t)
 	testAccCheckPublicCloudExists(t)
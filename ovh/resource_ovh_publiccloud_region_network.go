@@ -0,0 +1,226 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourcePublicCloudRegionNetworkImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not OVH_PROJECT_ID/region/network_id formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("project_id", splitId[0])
+	d.Set("region", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourcePublicCloudRegionNetwork() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudRegionNetworkCreate,
+		Read:   resourcePublicCloudRegionNetworkRead,
+		Delete: resourcePublicCloudRegionNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePublicCloudRegionNetworkImportState,
+		},
+
+		CustomizeDiff: serviceNameCustomizeDiff("project_id", serviceNameCloudProject),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"region": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressRegionDiff,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vlan_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"subnet": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: resourcePubliccloudPrivateNetworkSubnetValidateNetwork,
+						},
+						"ip_version": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+							Default:  4,
+						},
+						"enable_dhcp": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+						"enable_gateway_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+						"gateway_ip": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: resourcePubliccloudPrivateNetworkSubnetValidateIP,
+						},
+						"dns_name_servers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			// Computed
+			"visibility": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func resourcePublicCloudRegionNetworkCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	setNormalized(d, normalizeRegion, "region")
+
+	projectId := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+
+	subnet := d.Get("subnet.0").(map[string]interface{})
+	params := &PublicCloudRegionNetworkCreateOpts{
+		Name: d.Get("name").(string),
+		Subnet: &PublicCloudRegionNetworkSubnetCreateOpts{
+			Cidr:            subnet["cidr"].(string),
+			IpVersion:       subnet["ip_version"].(int),
+			EnableDhcp:      subnet["enable_dhcp"].(bool),
+			EnableGatewayIp: subnet["enable_gateway_ip"].(bool),
+			GatewayIp:       subnet["gateway_ip"].(string),
+		},
+	}
+	for _, ns := range subnet["dns_name_servers"].([]interface{}) {
+		params.Subnet.DnsNameServers = append(params.Subnet.DnsNameServers, ns.(string))
+	}
+	if v, ok := d.GetOk("vlan_id"); ok {
+		vlanId := v.(int)
+		params.VlanId = &vlanId
+	}
+
+	log.Printf("[DEBUG] Will create public cloud region network: %s", params)
+
+	r := &PublicCloudOperation{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/network", projectId, url.PathEscape(region))
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	op, err := cloudProjectOperationWait(config.OVHClient, projectId, r.Id, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	networkId := op.createdResourceId()
+	if networkId == "" {
+		return fmt.Errorf("Operation %s on public cloud project %s completed without the id of the network", op.Id, projectId)
+	}
+
+	d.SetId(networkId)
+	taskSetComputed(d, op.Id, op)
+
+	return resourcePublicCloudRegionNetworkRead(d, meta)
+}
+
+func resourcePublicCloudRegionNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+
+	r := &PublicCloudRegionNetworkResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/network/%s", projectId, url.PathEscape(region), d.Id())
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read public cloud region network %s", r)
+
+	subnets := []*PublicCloudRegionNetworkSubnetResponse{}
+	endpoint = fmt.Sprintf("%s/subnet", endpoint)
+	if err := config.OVHClient.Get(endpoint, &subnets); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	d.Set("name", r.Name)
+	d.Set("visibility", r.Visibility)
+	if r.VlanId != nil {
+		d.Set("vlan_id", *r.VlanId)
+	}
+
+	// the subnet created with the network is its first one, other subnets
+	// may be attached with the ovh_cloud_region_network_subnet resource
+	if len(subnets) > 0 {
+		d.Set("subnet_id", subnets[0].Id)
+	}
+
+	return nil
+}
+
+func resourcePublicCloudRegionNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+
+	log.Printf("[DEBUG] Will delete public cloud region network %s", d.Id())
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/network/%s", projectId, url.PathEscape(region), d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,239 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourcePublicCloudRegionNetworkSubnetImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 4)
+	if len(splitId) != 4 {
+		return nil, fmt.Errorf("Import Id is not OVH_PROJECT_ID/region/network_id/subnet_id formatted")
+	}
+	d.SetId(splitId[3])
+	d.Set("project_id", splitId[0])
+	d.Set("region", splitId[1])
+	d.Set("network_id", splitId[2])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourcePublicCloudRegionNetworkSubnet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudRegionNetworkSubnetCreate,
+		Read:   resourcePublicCloudRegionNetworkSubnetRead,
+		Update: resourcePublicCloudRegionNetworkSubnetUpdate,
+		Delete: resourcePublicCloudRegionNetworkSubnetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePublicCloudRegionNetworkSubnetImportState,
+		},
+
+		CustomizeDiff: serviceNameCustomizeDiff("project_id", serviceNameCloudProject),
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"region": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressRegionDiff,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourcePubliccloudPrivateNetworkSubnetValidateNetwork,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"ip_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  4,
+			},
+			"enable_dhcp": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"enable_gateway_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"gateway_ip": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     resourcePubliccloudPrivateNetworkSubnetValidateIP,
+				DiffSuppressFunc: suppressIpDiff,
+			},
+			"dns_name_servers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"allocation_pools": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     resourcePubliccloudPrivateNetworkSubnetValidateIP,
+							DiffSuppressFunc: suppressIpDiff,
+						},
+						"end": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     resourcePubliccloudPrivateNetworkSubnetValidateIP,
+							DiffSuppressFunc: suppressIpDiff,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePublicCloudRegionNetworkSubnetEndpoint(d *schema.ResourceData) string {
+	return fmt.Sprintf(
+		"/cloud/project/%s/region/%s/network/%s/subnet",
+		d.Get("project_id").(string),
+		url.PathEscape(d.Get("region").(string)),
+		d.Get("network_id").(string),
+	)
+}
+
+func resourcePublicCloudRegionNetworkSubnetCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	setNormalized(d, normalizeRegion, "region")
+	setNormalized(d, normalizeIp, "gateway_ip")
+
+	params := &PublicCloudRegionNetworkSubnetCreateOpts{
+		Name:            d.Get("name").(string),
+		Cidr:            d.Get("cidr").(string),
+		IpVersion:       d.Get("ip_version").(int),
+		EnableDhcp:      d.Get("enable_dhcp").(bool),
+		EnableGatewayIp: d.Get("enable_gateway_ip").(bool),
+		GatewayIp:       d.Get("gateway_ip").(string),
+	}
+	for _, ns := range d.Get("dns_name_servers").([]interface{}) {
+		params.DnsNameServers = append(params.DnsNameServers, ns.(string))
+	}
+	for _, pool := range d.Get("allocation_pools").([]interface{}) {
+		p := pool.(map[string]interface{})
+		params.AllocationPools = append(params.AllocationPools, &PublicCloudRegionNetworkAllocationPool{
+			Start: normalizeIp(p["start"].(string)),
+			End:   normalizeIp(p["end"].(string)),
+		})
+	}
+
+	log.Printf("[DEBUG] Will create public cloud region network subnet: %s", params)
+
+	r := &PublicCloudRegionNetworkSubnetResponse{}
+	endpoint := resourcePublicCloudRegionNetworkSubnetEndpoint(d)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(r.Id)
+
+	return resourcePublicCloudRegionNetworkSubnetRead(d, meta)
+}
+
+func resourcePublicCloudRegionNetworkSubnetRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &PublicCloudRegionNetworkSubnetResponse{}
+	endpoint := fmt.Sprintf("%s/%s", resourcePublicCloudRegionNetworkSubnetEndpoint(d), d.Id())
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read public cloud region network subnet %s", r)
+
+	pools := make([]interface{}, len(r.AllocationPools))
+	for i, pool := range r.AllocationPools {
+		pools[i] = map[string]interface{}{
+			"start": pool.Start,
+			"end":   pool.End,
+		}
+	}
+
+	d.Set("name", r.Name)
+	d.Set("cidr", r.Cidr)
+	d.Set("ip_version", r.IpVersion)
+	d.Set("enable_dhcp", r.DhcpEnabled)
+	d.Set("enable_gateway_ip", r.GatewayIp != nil && *r.GatewayIp != "")
+	if r.GatewayIp != nil {
+		d.Set("gateway_ip", *r.GatewayIp)
+	}
+	d.Set("dns_name_servers", r.DnsNameServers)
+	d.Set("allocation_pools", pools)
+
+	return nil
+}
+
+func resourcePublicCloudRegionNetworkSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("enable_dhcp") || d.HasChange("enable_gateway_ip") {
+		params := &PublicCloudPrivateNetworkSubnetUpdateOpts{
+			EnableDhcp:      d.Get("enable_dhcp").(bool),
+			EnableGatewayIp: d.Get("enable_gateway_ip").(bool),
+		}
+
+		log.Printf("[DEBUG] Will update public cloud region network subnet %s: %s", d.Id(), params)
+
+		endpoint := fmt.Sprintf("%s/%s", resourcePublicCloudRegionNetworkSubnetEndpoint(d), d.Id())
+		if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Put %s with params %s:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	return resourcePublicCloudRegionNetworkSubnetRead(d, meta)
+}
+
+func resourcePublicCloudRegionNetworkSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Will delete public cloud region network subnet %s", d.Id())
+
+	endpoint := fmt.Sprintf("%s/%s", resourcePublicCloudRegionNetworkSubnetEndpoint(d), d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,80 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccPublicCloudRegionNetworkSubnetConfig = `
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id   = "%s"
+  project_id = "%s"
+}
+
+data "ovh_cloud_regions" "regions" {
+  project_id = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+}
+
+resource "ovh_cloud_region_network" "network" {
+  project_id = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+  region     = "${element(sort(data.ovh_cloud_regions.regions.names), 0)}"
+  name       = "%s"
+
+  subnet {
+    cidr = "10.5.0.0/24"
+  }
+}
+
+resource "ovh_cloud_region_network_subnet" "subnet" {
+  project_id  = "${ovh_cloud_region_network.network.project_id}"
+  region      = "${ovh_cloud_region_network.network.region}"
+  network_id  = "${ovh_cloud_region_network.network.id}"
+  cidr        = "10.5.1.0/24"
+  enable_dhcp = %t
+
+  allocation_pools {
+    start = "10.5.1.10"
+    end   = "10.5.1.200"
+  }
+}
+`
+
+func TestAccPublicCloudRegionNetworkSubnet_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	config := func(dhcp bool) string {
+		return fmt.Sprintf(
+			testAccPublicCloudRegionNetworkSubnetConfig,
+			os.Getenv("OVH_VRACK"),
+			os.Getenv("OVH_PUBLIC_CLOUD"),
+			name,
+			dhcp,
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudPrivateNetworkPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudRegionNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_region_network_subnet.subnet", "cidr", "10.5.1.0/24"),
+					resource.TestCheckResourceAttr("ovh_cloud_region_network_subnet.subnet", "enable_dhcp", "true"),
+					resource.TestCheckResourceAttr("ovh_cloud_region_network_subnet.subnet", "allocation_pools.0.start", "10.5.1.10"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_region_network_subnet.subnet", "gateway_ip"),
+				),
+			},
+			{
+				Config: config(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_region_network_subnet.subnet", "enable_dhcp", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,99 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccPublicCloudRegionNetworkConfig = `
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id   = "%s"
+  project_id = "%s"
+}
+
+data "ovh_cloud_regions" "regions" {
+  project_id = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+}
+
+resource "ovh_cloud_region_network" "network" {
+  project_id = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+  region     = "${element(sort(data.ovh_cloud_regions.regions.names), 0)}"
+  name       = "%s"
+
+  subnet {
+    cidr        = "10.4.0.0/24"
+    enable_dhcp = true
+  }
+}
+`
+
+func TestAccPublicCloudRegionNetwork_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	config := fmt.Sprintf(
+		testAccPublicCloudRegionNetworkConfig,
+		os.Getenv("OVH_VRACK"),
+		os.Getenv("OVH_PUBLIC_CLOUD"),
+		name,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudPrivateNetworkPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudRegionNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_region_network.network", "name", name),
+					resource.TestCheckResourceAttrSet("ovh_cloud_region_network.network", "subnet_id"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_region_network.network", "visibility"),
+				),
+			},
+		},
+	})
+}
+
+func TestPublicCloudOperationCreatedResourceId(t *testing.T) {
+	id := "a6d4f0a1-5f3b-4a4e-9b7b-3c0d5e6f7a8b"
+	empty := ""
+
+	cases := []struct {
+		op       *PublicCloudOperation
+		expected string
+	}{
+		{&PublicCloudOperation{ResourceId: &id}, id},
+		{&PublicCloudOperation{ResourceId: &empty, SubOperations: []*PublicCloudOperation{{}, {ResourceId: &id}}}, id},
+		{&PublicCloudOperation{}, ""},
+	}
+
+	for _, c := range cases {
+		if got := c.op.createdResourceId(); got != c.expected {
+			t.Errorf("createdResourceId() = %q, want %q", got, c.expected)
+		}
+	}
+}
+
+func testAccCheckPublicCloudRegionNetworkDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_cloud_region_network" {
+			continue
+		}
+
+		endpoint := fmt.Sprintf(
+			"/cloud/project/%s/region/%s/network/%s",
+			rs.Primary.Attributes["project_id"],
+			rs.Primary.Attributes["region"],
+			rs.Primary.ID,
+		)
+		if err := config.OVHClient.Get(endpoint, nil); err == nil {
+			return fmt.Errorf("Public cloud region network %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
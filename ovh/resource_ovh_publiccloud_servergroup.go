@@ -0,0 +1,134 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourcePublicCloudServerGroupImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not OVH_PROJECT_ID/group_id formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("project_id", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourcePublicCloudServerGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudServerGroupCreate,
+		Read:   resourcePublicCloudServerGroupRead,
+		Delete: resourcePublicCloudServerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePublicCloudServerGroupImportState,
+		},
+
+		CustomizeDiff: serviceNameCustomizeDiff("project_id", serviceNameCloudProject),
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", nil),
+			},
+			"region": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressRegionDiff,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"affinity", "anti-affinity", "soft-affinity", "soft-anti-affinity"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"instance_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourcePublicCloudServerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	setNormalized(d, normalizeRegion, "region")
+
+	projectId := d.Get("project_id").(string)
+
+	params := &PublicCloudServerGroupCreateOpts{
+		Name:   d.Get("name").(string),
+		Policy: d.Get("policy").(string),
+		Region: d.Get("region").(string),
+	}
+
+	log.Printf("[DEBUG] Will create public cloud server group: %s", params)
+
+	r := &PublicCloudServerGroupResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/group", projectId)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(r.Id)
+
+	return resourcePublicCloudServerGroupRead(d, meta)
+}
+
+func resourcePublicCloudServerGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	r := &PublicCloudServerGroupResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/group/%s", projectId, d.Id())
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read public cloud server group %s", r)
+
+	d.Set("name", r.Name)
+	d.Set("policy", r.Policy)
+	d.Set("region", r.Region)
+	d.Set("instance_ids", r.InstanceIds)
+
+	return nil
+}
+
+func resourcePublicCloudServerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectId := d.Get("project_id").(string)
+
+	log.Printf("[DEBUG] Will delete public cloud server group %s", d.Id())
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/group/%s", projectId, d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId("")
+	return nil
+}
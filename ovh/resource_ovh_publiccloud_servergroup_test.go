@@ -0,0 +1,72 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccPublicCloudServerGroupConfig = `
+data "ovh_cloud_regions" "regions" {
+  project_id = "%s"
+}
+
+resource "ovh_cloud_servergroup" "group" {
+  project_id = "${data.ovh_cloud_regions.regions.project_id}"
+  region     = "${element(sort(data.ovh_cloud_regions.regions.names), 0)}"
+  name       = "%s"
+  policy     = "anti-affinity"
+}
+`
+
+func TestAccPublicCloudServerGroup_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	config := fmt.Sprintf(testAccPublicCloudServerGroupConfig, os.Getenv("OVH_PUBLIC_CLOUD"), name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCheckPublicCloudExists(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudServerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_servergroup.group", "name", name),
+					resource.TestCheckResourceAttr("ovh_cloud_servergroup.group", "policy", "anti-affinity"),
+					resource.TestCheckResourceAttr("ovh_cloud_servergroup.group", "instance_ids.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "ovh_cloud_servergroup.group",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["ovh_cloud_servergroup.group"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckPublicCloudServerGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_cloud_servergroup" {
+			continue
+		}
+
+		endpoint := fmt.Sprintf("/cloud/project/%s/instance/group/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID)
+		if err := config.OVHClient.Get(endpoint, nil); err == nil {
+			return fmt.Errorf("Public cloud server group %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
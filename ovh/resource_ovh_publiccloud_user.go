@@ -4,27 +4,48 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/ovh/go-ovh/ovh"
 )
 
+func resourcePublicCloudUserImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not OVH_PROJECT_ID/user_id formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("project_id", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
 func resourcePublicCloudUser() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePublicCloudUserCreate,
 		Read:   resourcePublicCloudUserRead,
+		Update: resourcePublicCloudUserUpdate,
 		Delete: resourcePublicCloudUserDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				return []*schema.ResourceData{d}, nil
-			},
+			State: resourcePublicCloudUserImportState,
 		},
 
+		SchemaVersion: 1,
+		MigrateState:  resourcePublicCloudUserMigrateState,
+
+		CustomizeDiff: customizeDiffs(
+			serviceNameCustomizeDiff("project_id", serviceNameCloudProject),
+			resourcePublicCloudUserCustomizeDiff,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:        schema.TypeString,
@@ -37,6 +58,13 @@ func resourcePublicCloudUser() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"rotate_when": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed
 			"username": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -59,6 +87,11 @@ func resourcePublicCloudUser() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"clouds_yaml": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
@@ -88,16 +121,7 @@ func resourcePublicCloudUserCreate(d *schema.ResourceData, meta interface{}) err
 
 	log.Printf("[DEBUG] Waiting for User %s:", r)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"creating"},
-		Target:     []string{"ok"},
-		Refresh:    waitForPublicCloudUserActive(config.OVHClient, projectId, strconv.Itoa(r.Id)),
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
+	err = publicCloudUserWait(config.OVHClient, projectId, strconv.Itoa(r.Id), []string{"creating"}, []string{"ok"})
 	if err != nil {
 		return fmt.Errorf("waiting for user (%s): %s", params, err)
 	}
@@ -113,6 +137,10 @@ func resourcePublicCloudUserCreate(d *schema.ResourceData, meta interface{}) err
 
 	d.Set("openstack_rc", &openstackrc)
 
+	if err := publicCloudUserSetCloudsYaml(d, config.OVHClient, projectId, openstackrc); err != nil {
+		return err
+	}
+
 	d.Partial(false)
 
 	return nil
@@ -144,11 +172,38 @@ func resourcePublicCloudUserRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.Set("openstack_rc", &openstackrc)
+
+	if err := publicCloudUserSetCloudsYaml(d, config.OVHClient, projectId, openstackrc); err != nil {
+		return err
+	}
 	d.Partial(false)
 	log.Printf("[DEBUG] Read Public Cloud User %s", r)
 	return nil
 }
 
+func resourcePublicCloudUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+
+	if d.HasChange("rotate_when") {
+		r := &PublicCloudUserResponse{}
+
+		log.Printf("[DEBUG] Will regenerate password of public cloud user %s from project: %s", d.Id(), projectId)
+
+		endpoint := fmt.Sprintf("/cloud/project/%s/user/%s/regeneratePassword", projectId, d.Id())
+
+		err := config.OVHClient.Post(endpoint, nil, r)
+		if err != nil {
+			return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+		}
+
+		readPublicCloudUser(d, r, true)
+	}
+
+	return resourcePublicCloudUserRead(d, meta)
+}
+
 func resourcePublicCloudUserDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -166,18 +221,9 @@ func resourcePublicCloudUserDelete(d *schema.ResourceData, meta interface{}) err
 
 	log.Printf("[DEBUG] Deleting Public Cloud User %s from project %s:", id, projectId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting"},
-		Target:     []string{"deleted"},
-		Refresh:    waitForPublicCloudUserDelete(config.OVHClient, projectId, id),
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
+	err = publicCloudUserWait(config.OVHClient, projectId, id, []string{"deleting"}, []string{"deleted"})
 	if err != nil {
-		return fmt.Errorf("Deleting Public Cloud user %s from project %s", id, projectId)
+		return fmt.Errorf("Deleting Public Cloud user %s from project %s: %s", id, projectId, err)
 	}
 	log.Printf("[DEBUG] Deleted Public Cloud User %s from project %s", id, projectId)
 
@@ -186,6 +232,21 @@ func resourcePublicCloudUserDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+// resourcePublicCloudUserCustomizeDiff marks the password and the
+// attributes embedding it as unknown when rotate_when changes, as it will be
+// regenerated during the update.
+func resourcePublicCloudUserCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("rotate_when") {
+		return nil
+	}
+
+	if err := d.SetNewComputed("password"); err != nil {
+		return err
+	}
+
+	return d.SetNewComputed("clouds_yaml")
+}
+
 func publicCloudUserExists(projectId, id string, c *ovh.Client) error {
 	r := &PublicCloudUserResponse{}
 
@@ -244,6 +305,45 @@ func publicCloudUserGetOpenstackRC(projectId, id string, c *ovh.Client, rc map[s
 	return nil
 }
 
+// publicCloudUserSetCloudsYaml sets the clouds_yaml attribute of the user
+// with one cloud entry per region of its project.
+func publicCloudUserSetCloudsYaml(d *schema.ResourceData, c *ovh.Client, projectId string, rc map[string]string) error {
+	regions := []string{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/region", projectId)
+	if err := c.Get(endpoint, &regions); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	d.Set("clouds_yaml", publicCloudUserCloudsYaml(rc, d.Get("password").(string), regions))
+	return nil
+}
+
+// publicCloudUserCloudsYaml renders a clouds.yaml file usable by the
+// openstack clients, with a cloud named "ovh-<region>" for each region.
+// The password is omitted when unknown, e.g. after an import.
+func publicCloudUserCloudsYaml(rc map[string]string, password string, regions []string) string {
+	sorted := make([]string, len(regions))
+	copy(sorted, regions)
+	sort.Strings(sorted)
+
+	var b strings.Builder
+	b.WriteString("clouds:\n")
+	for _, region := range sorted {
+		fmt.Fprintf(&b, "  %s:\n", strconv.Quote("ovh-"+region))
+		b.WriteString("    auth:\n")
+		fmt.Fprintf(&b, "      auth_url: %s\n", strconv.Quote(rc["OS_AUTH_URL"]))
+		fmt.Fprintf(&b, "      username: %s\n", strconv.Quote(rc["OS_USERNAME"]))
+		if password != "" {
+			fmt.Fprintf(&b, "      password: %s\n", strconv.Quote(password))
+		}
+		fmt.Fprintf(&b, "      project_id: %s\n", strconv.Quote(rc["OS_TENANT_ID"]))
+		fmt.Fprintf(&b, "      project_name: %s\n", strconv.Quote(rc["OS_TENANT_NAME"]))
+		fmt.Fprintf(&b, "    region_name: %s\n", strconv.Quote(region))
+	}
+
+	return b.String()
+}
+
 func readPublicCloudUser(d *schema.ResourceData, r *PublicCloudUserResponse, setPassword bool) {
 	d.Set("description", r.Description)
 	d.Set("status", r.Status)
@@ -255,35 +355,21 @@ func readPublicCloudUser(d *schema.ResourceData, r *PublicCloudUserResponse, set
 	d.SetId(strconv.Itoa(r.Id))
 }
 
-func waitForPublicCloudUserActive(c *ovh.Client, projectId, PublicCloudUserId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		r := &PublicCloudUserResponse{}
-		endpoint := fmt.Sprintf("/cloud/project/%s/user/%s", projectId, PublicCloudUserId)
-		err := c.Get(endpoint, r)
-		if err != nil {
-			return r, "", err
-		}
-
-		log.Printf("[DEBUG] Pending User: %s", r)
-		return r, r.Status, nil
-	}
+func (p *PublicCloudUserResponse) TaskStatus() string {
+	return p.Status
 }
 
-func waitForPublicCloudUserDelete(c *ovh.Client, projectId, PublicCloudUserId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		r := &PublicCloudUserResponse{}
-		endpoint := fmt.Sprintf("/cloud/project/%s/user/%s", projectId, PublicCloudUserId)
-		err := c.Get(endpoint, r)
-		if err != nil {
-			if err.(*ovh.APIError).Code == 404 {
-				log.Printf("[DEBUG] user id %s on project %s deleted", PublicCloudUserId, projectId)
-				return r, "deleted", nil
-			} else {
-				return r, "", err
-			}
-		}
-
-		log.Printf("[DEBUG] Pending User: %s", r)
-		return r, r.Status, nil
-	}
+// publicCloudUserWait waits for a user to reach one of the target statuses.
+// A user which can't be found anymore is reported as "deleted".
+func publicCloudUserWait(c *ovh.Client, projectId, id string, pending, target []string) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/cloud/project/%s/user/%s", projectId, id),
+		Description: fmt.Sprintf("user %s of project %s", id, projectId),
+		Task:        &PublicCloudUserResponse{},
+		Pending:     pending,
+		Target:      target,
+		Purged:      "deleted",
+		Timeout:     10 * time.Minute,
+	}).Wait(c)
+	return err
 }
@@ -2,8 +2,11 @@ package ovh
 
 import (
 	"fmt"
+	"log"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -12,9 +15,64 @@ import (
 var testAccPublicCloudUserConfig = fmt.Sprintf(`
 resource "ovh_publiccloud_user" "user" {
 	project_id  = "%s"
-  description = "my user for acceptance tests"
+  description = "%s"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"), test_prefix)
+
+var testAccPublicCloudUserConfigRotated = fmt.Sprintf(`
+resource "ovh_publiccloud_user" "user" {
+	project_id  = "%s"
+  description = "%s"
+
+  rotate_when = {
+    rotation = "1"
+  }
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"), test_prefix)
+
+func init() {
+	resource.AddTestSweepers("ovh_cloud_user", &resource.Sweeper{
+		Name: "ovh_cloud_user",
+		F:    testSweepCloudUser,
+	})
+}
+
+func testSweepCloudUser(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	projectId := os.Getenv("OVH_PUBLIC_CLOUD")
+	if projectId == "" {
+		return fmt.Errorf("OVH_PUBLIC_CLOUD must be set")
+	}
+
+	users := []*PublicCloudUserResponse{}
+	if err := client.Get(fmt.Sprintf("/cloud/project/%s/user", projectId), &users); err != nil {
+		return fmt.Errorf("error listing users for project %q:\n\t %q", projectId, err)
+	}
+
+	for _, u := range users {
+		if !strings.HasPrefix(u.Description, test_prefix) || u.Status == "deleting" {
+			continue
+		}
+
+		log.Printf("[DEBUG] found dangling user for project: %s, id: %d", projectId, u.Id)
+		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+			if err := client.Delete(fmt.Sprintf("/cloud/project/%s/user/%d", projectId, u.Id), nil); err != nil {
+				return resource.RetryableError(err)
+			}
+			// Successful delete
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
-`, os.Getenv("OVH_PUBLIC_CLOUD"))
 
 func TestAccPublicCloudUser_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -27,12 +85,53 @@ func TestAccPublicCloudUser_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPublicCloudUserExists("ovh_publiccloud_user.user", t),
 					testAccCheckPublicCloudUserOpenRC("ovh_publiccloud_user.user", t),
+					resource.TestCheckResourceAttrSet("ovh_publiccloud_user.user", "clouds_yaml"),
+				),
+			},
+			{
+				Config: testAccPublicCloudUserConfigRotated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudUserExists("ovh_publiccloud_user.user", t),
+					resource.TestCheckResourceAttrSet("ovh_publiccloud_user.user", "password"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_user.user", "rotate_when.rotation", "1"),
 				),
 			},
 		},
 	})
 }
 
+func TestPublicCloudUserCloudsYaml(t *testing.T) {
+	rc := map[string]string{
+		"OS_AUTH_URL":    "https://auth.cloud.ovh.net/v2.0/",
+		"OS_TENANT_ID":   "1234",
+		"OS_TENANT_NAME": "5678",
+		"OS_USERNAME":    "user",
+	}
+
+	expected := `clouds:
+  "ovh-BHS3":
+    auth:
+      auth_url: "https://auth.cloud.ovh.net/v2.0/"
+      username: "user"
+      password: "pass\"word"
+      project_id: "1234"
+      project_name: "5678"
+    region_name: "BHS3"
+  "ovh-GRA5":
+    auth:
+      auth_url: "https://auth.cloud.ovh.net/v2.0/"
+      username: "user"
+      password: "pass\"word"
+      project_id: "1234"
+      project_name: "5678"
+    region_name: "GRA5"
+`
+
+	if got := publicCloudUserCloudsYaml(rc, `pass"word`, []string{"GRA5", "BHS3"}); got != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
 func testAccCheckPublicCloudUserPreCheck(t *testing.T) {
 	testAccPreCheck(t)
 	testAccCheckPublicCloudExists(t)
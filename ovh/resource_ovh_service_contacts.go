@@ -0,0 +1,329 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type ServiceChangeContactOpts struct {
+	ContactAdmin   string `json:"contactAdmin,omitempty"`
+	ContactBilling string `json:"contactBilling,omitempty"`
+	ContactTech    string `json:"contactTech,omitempty"`
+}
+
+func (o *ServiceChangeContactOpts) String() string {
+	return fmt.Sprintf("ChangeContact[admin: %s, billing: %s, tech: %s]", o.ContactAdmin, o.ContactBilling, o.ContactTech)
+}
+
+type MeContactChangeTask struct {
+	Id           int64    `json:"id"`
+	State        string   `json:"state"`
+	ContactTypes []string `json:"contactTypes"`
+	FromAccount  string   `json:"fromAccount"`
+	ToAccount    string   `json:"toAccount"`
+	DateRequest  string   `json:"dateRequest"`
+	DateDone     string   `json:"dateDone"`
+}
+
+func (t *MeContactChangeTask) String() string {
+	return fmt.Sprintf("ContactChangeTask[id: %d, state: %s, types: %v, from: %s, to: %s]", t.Id, t.State, t.ContactTypes, t.FromAccount, t.ToAccount)
+}
+
+// serviceContactAttributes maps the contact attributes of the resource to
+// the contact types of the API.
+var serviceContactAttributes = map[string]string{
+	"contact_admin":   "contactAdmin",
+	"contact_billing": "contactBilling",
+	"contact_tech":    "contactTech",
+}
+
+func resourceServiceContacts() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceContactsCreate,
+		Read:   resourceServiceContactsRead,
+		Update: resourceServiceContactsUpdate,
+		Delete: resourceServiceContactsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceServiceRenewImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"route": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return "/" + strings.Trim(v.(string), "/")
+				},
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"contact_admin": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"contact_billing": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"contact_tech": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			"pending": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tasks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"contact_types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"from_account": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to_account": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_request": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_done": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceServiceContactsCreate(d *schema.ResourceData, meta interface{}) error {
+	route := "/" + strings.Trim(d.Get("route").(string), "/")
+	serviceName := d.Get("service_name").(string)
+
+	d.SetId(fmt.Sprintf("%s/%s", route, serviceName))
+
+	return resourceServiceContactsUpdate(d, meta)
+}
+
+func resourceServiceContactsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	route := d.Get("route").(string)
+	serviceName := d.Get("service_name").(string)
+
+	r, err := serviceInfosGet(config.OVHClient, route, serviceName)
+	if err != nil {
+		return CheckDeleted(d, err, serviceInfosEndpoint(route, serviceName))
+	}
+
+	log.Printf("[DEBUG] Read service infos of %s: %s", serviceName, r)
+
+	ids := []int64{}
+	for _, v := range d.Get("tasks").([]interface{}) {
+		ids = append(ids, int64(v.(map[string]interface{})["id"].(int)))
+	}
+
+	tasks := make([]*MeContactChangeTask, len(ids))
+	err = fetchConcurrently(len(ids), func(i int) error {
+		task, err := meContactChangeTaskGet(config.OVHClient, ids[i])
+		if err != nil {
+			return fmt.Errorf("calling Get /me/task/contactChange/%d:\n\t %q", ids[i], err)
+		}
+		tasks[i] = task
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// while a change is waiting for the new contacts to accept it, the
+	// service still has its former contacts: the requested ones are kept
+	// in the state so that the change isn't requested again.
+	pending := meContactChangePendingTypes(tasks)
+	current := map[string]string{
+		"contactAdmin":   r.ContactAdmin,
+		"contactBilling": r.ContactBilling,
+		"contactTech":    r.ContactTech,
+	}
+	for attr, contactType := range serviceContactAttributes {
+		if !pending[contactType] {
+			d.Set(attr, current[contactType])
+		}
+	}
+
+	d.Set("pending", len(pending) > 0)
+	d.Set("tasks", meContactChangeTasksToState(tasks))
+
+	return nil
+}
+
+func resourceServiceContactsUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	route := "/" + strings.Trim(d.Get("route").(string), "/")
+	serviceName := d.Get("service_name").(string)
+
+	r, err := serviceInfosGet(config.OVHClient, route, serviceName)
+	if err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", serviceInfosEndpoint(route, serviceName), err)
+	}
+
+	// the changes already requested to the same contacts are tracked rather
+	// than requested again.
+	tasks := []*MeContactChangeTask{}
+	requested := map[string]string{}
+	for _, v := range d.Get("tasks").([]interface{}) {
+		id := int64(v.(map[string]interface{})["id"].(int))
+		task, err := meContactChangeTaskGet(config.OVHClient, id)
+		if err != nil {
+			return fmt.Errorf("calling Get /me/task/contactChange/%d:\n\t %q", id, err)
+		}
+		if !meContactChangeTaskPending(task) {
+			continue
+		}
+		tasks = append(tasks, task)
+		for _, contactType := range task.ContactTypes {
+			requested[contactType] = task.ToAccount
+		}
+	}
+
+	// only the contacts which differ are sent, as the API refuses to
+	// change a contact to itself.
+	current := map[string]string{
+		"contactAdmin":   r.ContactAdmin,
+		"contactBilling": r.ContactBilling,
+		"contactTech":    r.ContactTech,
+	}
+	wanted := map[string]string{}
+	for attr, contactType := range serviceContactAttributes {
+		v := d.Get(attr).(string)
+		if v != "" && v != current[contactType] && v != requested[contactType] {
+			wanted[contactType] = v
+		}
+	}
+
+	state := make([]interface{}, len(tasks))
+	for i, task := range tasks {
+		state[i] = map[string]interface{}{"id": int(task.Id)}
+	}
+
+	if len(wanted) > 0 {
+		params := &ServiceChangeContactOpts{
+			ContactAdmin:   wanted["contactAdmin"],
+			ContactBilling: wanted["contactBilling"],
+			ContactTech:    wanted["contactTech"],
+		}
+		endpoint := fmt.Sprintf("%s/%s/changeContact", route, serviceName)
+		ids := []int64{}
+
+		log.Printf("[DEBUG] Will change contacts of %s: %s", serviceName, params)
+
+		if err := config.OVHClient.Post(endpoint, params, &ids); err != nil {
+			return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+		}
+
+		log.Printf("[DEBUG] Contact change of %s requested with tasks %v", serviceName, ids)
+
+		for _, id := range ids {
+			state = append(state, map[string]interface{}{"id": int(id)})
+		}
+	} else {
+		log.Printf("[DEBUG] Contacts of %s are up to date", serviceName)
+	}
+
+	d.Set("tasks", state)
+
+	return resourceServiceContactsRead(d, meta)
+}
+
+func resourceServiceContactsDelete(d *schema.ResourceData, meta interface{}) error {
+	// a service always has contacts: they are left as is.
+	log.Printf("[DEBUG] Contacts of %s are kept as is", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func meContactChangeTaskGet(c *ovh.Client, id int64) (*MeContactChangeTask, error) {
+	r := &MeContactChangeTask{}
+	endpoint := fmt.Sprintf("/me/task/contactChange/%d", id)
+	if err := c.Get(endpoint, r); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Read contact change task %s", r)
+	return r, nil
+}
+
+// meContactChangeTaskPending returns whether a contact change is still to be
+// accepted or processed.
+func meContactChangeTaskPending(task *MeContactChangeTask) bool {
+	switch task.State {
+	case "done", "refused", "error":
+		return false
+	}
+	return true
+}
+
+// meContactChangePendingTypes returns the contact types which have a change
+// still to be accepted or processed.
+func meContactChangePendingTypes(tasks []*MeContactChangeTask) map[string]bool {
+	pending := map[string]bool{}
+	for _, task := range tasks {
+		if !meContactChangeTaskPending(task) {
+			continue
+		}
+		for _, contactType := range task.ContactTypes {
+			pending[contactType] = true
+		}
+	}
+	return pending
+}
+
+func meContactChangeTasksToState(tasks []*MeContactChangeTask) []interface{} {
+	result := make([]interface{}, len(tasks))
+	for i, task := range tasks {
+		result[i] = map[string]interface{}{
+			"id":            int(task.Id),
+			"state":         task.State,
+			"contact_types": task.ContactTypes,
+			"from_account":  task.FromAccount,
+			"to_account":    task.ToAccount,
+			"date_request":  task.DateRequest,
+			"date_done":     task.DateDone,
+		}
+	}
+	return result
+}
@@ -0,0 +1,60 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccServiceContactsConfig = `
+data "ovh_service_info" "vrack" {
+  route        = "/vrack"
+  service_name = "%s"
+}
+
+resource "ovh_service_contacts" "vrack" {
+  route         = "/vrack"
+  service_name  = "%s"
+  contact_admin = "${data.ovh_service_info.vrack.contact_admin}"
+}
+`
+
+func TestAccServiceContacts_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_VRACK")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccCheckVRackExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// the contacts are the current ones: no change is requested
+				Config: fmt.Sprintf(testAccServiceContactsConfig, serviceName, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_service_contacts.vrack", "id", fmt.Sprintf("/vrack/%s", serviceName)),
+					resource.TestCheckResourceAttr("ovh_service_contacts.vrack", "pending", "false"),
+					resource.TestCheckResourceAttr("ovh_service_contacts.vrack", "tasks.#", "0"),
+					resource.TestCheckResourceAttrSet("ovh_service_contacts.vrack", "contact_tech"),
+				),
+			},
+		},
+	})
+}
+
+func TestMeContactChangePendingTypes(t *testing.T) {
+	tasks := []*MeContactChangeTask{
+		{Id: 1, State: "done", ContactTypes: []string{"contactAdmin"}},
+		{Id: 2, State: "validatingByCustomers", ContactTypes: []string{"contactTech", "contactBilling"}},
+		{Id: 3, State: "refused", ContactTypes: []string{"contactAdmin"}},
+	}
+
+	pending := meContactChangePendingTypes(tasks)
+	if len(pending) != 2 || !pending["contactTech"] || !pending["contactBilling"] {
+		t.Errorf("expected tech and billing contacts to be pending, got %v", pending)
+	}
+
+	if len(meContactChangePendingTypes(tasks[:1])) != 0 {
+		t.Errorf("done tasks should not be pending")
+	}
+}
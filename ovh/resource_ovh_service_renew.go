@@ -0,0 +1,177 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceServiceRenewImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := strings.Trim(d.Id(), "/")
+	i := strings.LastIndex(givenId, "/")
+	if i < 0 {
+		return nil, fmt.Errorf("Import Id is not ROUTE/SERVICE_NAME formatted")
+	}
+	route := "/" + givenId[:i]
+	serviceName := givenId[i+1:]
+	d.SetId(fmt.Sprintf("%s/%s", route, serviceName))
+	d.Set("route", route)
+	d.Set("service_name", serviceName)
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceServiceRenew() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceRenewCreate,
+		Read:   resourceServiceRenewRead,
+		Update: resourceServiceRenewUpdate,
+		Delete: resourceServiceRenewDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceServiceRenewImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"route": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return "/" + strings.Trim(v.(string), "/")
+				},
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"automatic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"delete_at_expiration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"manual_payment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"period": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"renewal_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceServiceRenewCreate(d *schema.ResourceData, meta interface{}) error {
+	route := "/" + strings.Trim(d.Get("route").(string), "/")
+	serviceName := d.Get("service_name").(string)
+
+	d.SetId(fmt.Sprintf("%s/%s", route, serviceName))
+
+	return resourceServiceRenewUpdate(d, meta)
+}
+
+func resourceServiceRenewRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	route := d.Get("route").(string)
+	serviceName := d.Get("service_name").(string)
+
+	r, err := serviceInfosGet(config.OVHClient, route, serviceName)
+	if err != nil {
+		return CheckDeleted(d, err, serviceInfosEndpoint(route, serviceName))
+	}
+
+	log.Printf("[DEBUG] Read service infos of %s: %s", serviceName, r)
+
+	d.Set("expiration", r.Expiration)
+	d.Set("renewal_type", r.RenewalType)
+
+	if r.Renew != nil {
+		d.Set("automatic", r.Renew.Automatic)
+		d.Set("delete_at_expiration", r.Renew.DeleteAtExpiration)
+		d.Set("manual_payment", r.Renew.ManualPayment)
+		if r.Renew.Period != nil {
+			d.Set("period", *r.Renew.Period)
+		}
+	}
+
+	return nil
+}
+
+func resourceServiceRenewUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	route := d.Get("route").(string)
+	serviceName := d.Get("service_name").(string)
+	endpoint := serviceInfosEndpoint(route, serviceName)
+
+	current, err := serviceInfosGet(config.OVHClient, route, serviceName)
+	if err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	renew := &ServiceInfosRenew{
+		Automatic:          d.Get("automatic").(bool),
+		DeleteAtExpiration: d.Get("delete_at_expiration").(bool),
+		ManualPayment:      d.Get("manual_payment").(bool),
+	}
+
+	if v, ok := d.GetOk("period"); ok {
+		period := v.(int)
+		valid := false
+		for _, p := range current.PossibleRenewPeriod {
+			if p == period {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("Renew period %d is not allowed for %s. Allowed periods: %v", period, serviceName, current.PossibleRenewPeriod)
+		}
+		renew.Period = &period
+	} else if current.Renew != nil {
+		renew.Period = current.Renew.Period
+	}
+
+	params := &ServiceInfosUpdateOpts{Renew: renew}
+
+	log.Printf("[DEBUG] Will update renew settings of %s: %v", serviceName, renew)
+
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, renew, err)
+	}
+
+	return resourceServiceRenewRead(d, meta)
+}
+
+func resourceServiceRenewDelete(d *schema.ResourceData, meta interface{}) error {
+	// the renew settings are left as is: removing them from terraform
+	// must not make the service expire.
+	log.Printf("[DEBUG] Renew settings of %s are kept as is", d.Id())
+
+	d.SetId("")
+	return nil
+}
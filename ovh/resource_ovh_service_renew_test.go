@@ -0,0 +1,65 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccServiceRenewConfig = `
+resource "ovh_service_renew" "vrack" {
+  route                = "/vrack"
+  service_name         = "%s"
+  automatic            = true
+  delete_at_expiration = false
+}
+`
+
+func TestAccServiceRenew_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_VRACK")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccCheckVRackExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccServiceRenewConfig, serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceRenewAutomatic("ovh_service_renew.vrack"),
+					resource.TestCheckResourceAttr("ovh_service_renew.vrack", "id", fmt.Sprintf("/vrack/%s", serviceName)),
+					resource.TestCheckResourceAttr("ovh_service_renew.vrack", "automatic", "true"),
+				),
+			},
+			{
+				ResourceName:      "ovh_service_renew.vrack",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceRenewAutomatic(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		r, err := serviceInfosGet(config.OVHClient, rs.Primary.Attributes["route"], rs.Primary.Attributes["service_name"])
+		if err != nil {
+			return err
+		}
+
+		if r.Renew == nil || !r.Renew.Automatic {
+			return fmt.Errorf("Service %s is not renewed automatically", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
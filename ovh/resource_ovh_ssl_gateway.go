@@ -0,0 +1,179 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceSslGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSslGatewayCreate,
+		Read:   resourceSslGatewayRead,
+		Update: resourceSslGatewayUpdate,
+		Delete: resourceSslGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("service_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"allowed_source": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						err := validateIpBlock(v.(string))
+						if err != nil {
+							errors = append(errors, err)
+						}
+						return
+					},
+				},
+			},
+			"hsts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"https_redirect": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"server_https": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"reverse": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			"ipv4": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSslGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	// SSL gateways can't be created through this resource. They are
+	// ordered, then their settings are managed here.
+	d.SetId(d.Get("service_name").(string))
+
+	return resourceSslGatewayUpdate(d, meta)
+}
+
+func resourceSslGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &SslGateway{}
+	endpoint := fmt.Sprintf("/sslGateway/%s", d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ssl gateway %s", r)
+
+	d.Set("service_name", r.ServiceName)
+	d.Set("display_name", r.DisplayName)
+	d.Set("allowed_source", r.AllowedSource)
+	d.Set("hsts", r.Hsts)
+	d.Set("https_redirect", r.HttpsRedirect)
+	d.Set("server_https", r.ServerHttps)
+	d.Set("reverse", r.Reverse)
+	d.Set("ipv4", r.Ipv4)
+	d.Set("ipv6", r.Ipv6)
+	d.Set("offer", r.Offer)
+	d.Set("state", r.State)
+	d.Set("zones", r.Zones)
+
+	return nil
+}
+
+func resourceSslGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	allowedSource := []string{}
+	for _, v := range d.Get("allowed_source").([]interface{}) {
+		allowedSource = append(allowedSource, v.(string))
+	}
+
+	params := &SslGatewayUpdateOpts{
+		DisplayName:   d.Get("display_name").(string),
+		AllowedSource: allowedSource,
+		Hsts:          d.Get("hsts").(bool),
+		HttpsRedirect: d.Get("https_redirect").(bool),
+		ServerHttps:   d.Get("server_https").(bool),
+		Reverse:       d.Get("reverse").(string),
+	}
+
+	log.Printf("[DEBUG] Will update ssl gateway %s: %v", d.Id(), params)
+
+	endpoint := fmt.Sprintf("/sslGateway/%s", d.Id())
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceSslGatewayRead(d, meta)
+}
+
+func resourceSslGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	// The gateway is left untouched, it's only removed from the state.
+	log.Printf("[DEBUG] Will remove ssl gateway %s from state", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func sslGatewayExists(serviceName string, c *ovh.Client) error {
+	r := &SslGateway{}
+	endpoint := fmt.Sprintf("/sslGateway/%s", serviceName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ssl gateway: %s", r)
+
+	return nil
+}
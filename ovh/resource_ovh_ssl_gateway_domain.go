@@ -0,0 +1,123 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceSslGatewayDomainImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceSslGatewayDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSslGatewayDomainCreate,
+		Read:   resourceSslGatewayDomainRead,
+		Delete: resourceSslGatewayDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSslGatewayDomainImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSslGatewayDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &SslGatewayDomainCreateOpts{Domain: d.Get("domain").(string)}
+	r := &SslGatewayDomain{}
+
+	log.Printf("[DEBUG] Will add domain %s to ssl gateway %s", params.Domain, serviceName)
+
+	endpoint := fmt.Sprintf("/sslGateway/%s/domain", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(strconv.FormatInt(r.Id, 10))
+
+	return resourceSslGatewayDomainRead(d, meta)
+}
+
+func resourceSslGatewayDomainRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &SslGatewayDomain{}
+	endpoint := fmt.Sprintf("/sslGateway/%s/domain/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ssl gateway %s domain %s", serviceName, r)
+
+	d.Set("domain", r.Domain)
+	d.Set("state", r.State)
+
+	return nil
+}
+
+func resourceSslGatewayDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will remove domain %s from ssl gateway %s", d.Id(), serviceName)
+
+	endpoint := fmt.Sprintf("/sslGateway/%s/domain/%s", serviceName, d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func sslGatewayDomainExists(serviceName, id string, c *ovh.Client) error {
+	r := &SslGatewayDomain{}
+	endpoint := fmt.Sprintf("/sslGateway/%s/domain/%s", serviceName, id)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ssl gateway domain: %s", r)
+
+	return nil
+}
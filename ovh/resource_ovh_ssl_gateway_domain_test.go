@@ -0,0 +1,71 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccSslGatewayDomainConfig = `
+resource "ovh_ssl_gateway_domain" "domain" {
+  service_name = "%s"
+  domain       = "%s"
+}
+`
+
+func TestAccSslGatewayDomain_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_SSL_GATEWAY_SERVICE")
+	domain := fmt.Sprintf("%s.%s", acctest.RandomWithPrefix(test_prefix), os.Getenv("OVH_ZONE"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckSslGatewayPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSslGatewayDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccSslGatewayDomainConfig, serviceName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSslGatewayDomainExists("ovh_ssl_gateway_domain.domain", t),
+					resource.TestCheckResourceAttr("ovh_ssl_gateway_domain.domain", "domain", domain),
+					resource.TestCheckResourceAttrSet("ovh_ssl_gateway_domain.domain", "state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSslGatewayDomainExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ssl gateway domain id is set")
+		}
+
+		return sslGatewayDomainExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckSslGatewayDomainDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_ssl_gateway_domain" {
+			continue
+		}
+
+		err := sslGatewayDomainExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("ssl gateway domain still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceSslGatewayServerImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceSslGatewayServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSslGatewayServerCreate,
+		Read:   resourceSslGatewayServerRead,
+		Update: resourceSslGatewayServerUpdate,
+		Delete: resourceSslGatewayServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSslGatewayServerImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"address": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIp(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					port := v.(int)
+					if port < 1 || port > 65535 {
+						errors = append(errors, fmt.Errorf("%q must be between 1 and 65535, got %d", k, port))
+					}
+					return
+				},
+			},
+
+			// Computed
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSslGatewayServerCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &SslGatewayServerOpts{
+		Address: d.Get("address").(string),
+		Port:    d.Get("port").(int),
+	}
+	r := &SslGatewayServer{}
+
+	log.Printf("[DEBUG] Will add server %s:%d to ssl gateway %s", params.Address, params.Port, serviceName)
+
+	endpoint := fmt.Sprintf("/sslGateway/%s/server", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(strconv.FormatInt(r.Id, 10))
+
+	if err := sslGatewayServerWait(config.OVHClient, serviceName, r.Id); err != nil {
+		return err
+	}
+
+	return resourceSslGatewayServerRead(d, meta)
+}
+
+func resourceSslGatewayServerRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &SslGatewayServer{}
+	endpoint := fmt.Sprintf("/sslGateway/%s/server/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read ssl gateway %s server %s", serviceName, r)
+
+	d.Set("address", r.Address)
+	d.Set("port", r.Port)
+	d.Set("state", r.State)
+
+	return nil
+}
+
+func resourceSslGatewayServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Could not parse ssl gateway server id %s: %s", d.Id(), err)
+	}
+
+	params := &SslGatewayServerOpts{
+		Address: d.Get("address").(string),
+		Port:    d.Get("port").(int),
+	}
+
+	log.Printf("[DEBUG] Will update ssl gateway %s server %s: %v", serviceName, d.Id(), params)
+
+	endpoint := fmt.Sprintf("/sslGateway/%s/server/%s", serviceName, d.Id())
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := sslGatewayServerWait(config.OVHClient, serviceName, id); err != nil {
+		return err
+	}
+
+	return resourceSslGatewayServerRead(d, meta)
+}
+
+func resourceSslGatewayServerDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will remove server %s from ssl gateway %s", d.Id(), serviceName)
+
+	endpoint := fmt.Sprintf("/sslGateway/%s/server/%s", serviceName, d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func sslGatewayServerExists(serviceName, id string, c *ovh.Client) error {
+	r := &SslGatewayServer{}
+	endpoint := fmt.Sprintf("/sslGateway/%s/server/%s", serviceName, id)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ssl gateway server: %s", r)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccSslGatewayServerConfig = `
+resource "ovh_ssl_gateway_server" "server" {
+  service_name = "%s"
+  address      = "%s"
+  port         = %d
+}
+`
+
+func TestAccSslGatewayServer_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_SSL_GATEWAY_SERVICE")
+	ip := os.Getenv("OVH_IP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckSslGatewayPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSslGatewayServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccSslGatewayServerConfig, serviceName, ip, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSslGatewayServerExists("ovh_ssl_gateway_server.server", t),
+					resource.TestCheckResourceAttr("ovh_ssl_gateway_server.server", "address", ip),
+					resource.TestCheckResourceAttr("ovh_ssl_gateway_server.server", "port", "80"),
+					resource.TestCheckResourceAttr("ovh_ssl_gateway_server.server", "state", "ok"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccSslGatewayServerConfig, serviceName, ip, 8080),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSslGatewayServerExists("ovh_ssl_gateway_server.server", t),
+					resource.TestCheckResourceAttr("ovh_ssl_gateway_server.server", "port", "8080"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSslGatewayServerExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ssl gateway server id is set")
+		}
+
+		return sslGatewayServerExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckSslGatewayServerDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_ssl_gateway_server" {
+			continue
+		}
+
+		err := sslGatewayServerExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("ssl gateway server still exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccSslGatewayConfig = `
+resource "ovh_ssl_gateway" "gw" {
+  service_name   = "%s"
+  display_name   = "%s"
+  allowed_source = ["%s"]
+  hsts           = true
+  https_redirect = true
+}
+`
+
+func TestAccSslGateway_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_SSL_GATEWAY_SERVICE")
+	ipBlock := os.Getenv("OVH_IP_BLOCK")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckSslGatewayPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccSslGatewayConfig, serviceName, test_prefix, ipBlock),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSslGatewayExists("ovh_ssl_gateway.gw", t),
+					resource.TestCheckResourceAttr("ovh_ssl_gateway.gw", "display_name", test_prefix),
+					resource.TestCheckResourceAttr("ovh_ssl_gateway.gw", "allowed_source.#", "1"),
+					resource.TestCheckResourceAttr("ovh_ssl_gateway.gw", "hsts", "true"),
+					resource.TestCheckResourceAttr("ovh_ssl_gateway.gw", "https_redirect", "true"),
+					resource.TestCheckResourceAttrSet("ovh_ssl_gateway.gw", "ipv4"),
+				),
+			},
+			{
+				ResourceName:      "ovh_ssl_gateway.gw",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSslGatewayPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// ssl gateway is an optional product
+	// this resource is tested only if env var `OVH_SSL_GATEWAY_SERVICE`
+	// is set
+	if os.Getenv("OVH_SSL_GATEWAY_SERVICE") == "" {
+		t.Skip("OVH_SSL_GATEWAY_SERVICE must be set to test ssl gateway")
+	}
+}
+
+func testAccCheckSslGatewayExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ssl gateway service name is set")
+		}
+
+		return sslGatewayExists(rs.Primary.ID, config.OVHClient)
+	}
+}
@@ -0,0 +1,280 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceStorageEfsShareImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/SHARE_ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceStorageEfsShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageEfsShareCreate,
+		Read:   resourceStorageEfsShareRead,
+		Update: resourceStorageEfsShareUpdate,
+		Delete: resourceStorageEfsShareDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageEfsShareImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "NFS",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"NFS"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"snapshot_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_paths": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"preferred": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceStorageEfsShareCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := &StorageEfsShareCreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Protocol:    d.Get("protocol").(string),
+		Size:        int64(d.Get("size").(int)),
+	}
+
+	log.Printf("[DEBUG] Will create efs %s share: %v", serviceName, params)
+
+	r := &StorageEfsShare{}
+	endpoint := fmt.Sprintf("/storage/netapp/%s/share", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(r.Id)
+
+	if err := storageEfsShareWait(config.OVHClient, serviceName, r.Id, []string{"creating"}, []string{"available"}); err != nil {
+		return err
+	}
+
+	if err := storageEfsShareSetSnapshotPolicy(d, config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceStorageEfsShareRead(d, meta)
+}
+
+func resourceStorageEfsShareRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &StorageEfsShare{}
+	endpoint := fmt.Sprintf("/storage/netapp/%s/share/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read efs %s share %s", serviceName, r)
+
+	paths := []*StorageEfsShareAccessPath{}
+	pathsEndpoint := fmt.Sprintf("%s/accessPath", endpoint)
+	if err := config.OVHClient.Get(pathsEndpoint, &paths); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", pathsEndpoint, err)
+	}
+
+	accessPaths := make([]map[string]interface{}, len(paths))
+	for i, path := range paths {
+		accessPaths[i] = map[string]interface{}{
+			"id":        path.Id,
+			"path":      path.Path,
+			"preferred": path.Preferred,
+		}
+	}
+
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+	d.Set("protocol", r.Protocol)
+	d.Set("size", r.Size)
+	d.Set("status", r.Status)
+	d.Set("created_at", r.CreatedAt)
+	d.Set("access_paths", accessPaths)
+
+	return nil
+}
+
+func resourceStorageEfsShareUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	endpoint := fmt.Sprintf("/storage/netapp/%s/share/%s", serviceName, d.Id())
+
+	if d.HasChange("name") || d.HasChange("description") {
+		params := &StorageEfsShareUpdateOpts{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+
+		log.Printf("[DEBUG] Will update efs %s share %s: %v", serviceName, d.Id(), params)
+
+		if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	if d.HasChange("size") {
+		o, n := d.GetChange("size")
+		params := &StorageEfsShareSizeOpts{Size: int64(n.(int))}
+
+		action, pending := "extend", "extending"
+		if n.(int) < o.(int) {
+			action, pending = "shrink", "shrinking"
+		}
+
+		log.Printf("[DEBUG] Will %s efs %s share %s: %v", action, serviceName, d.Id(), params)
+
+		resizeEndpoint := fmt.Sprintf("%s/%s", endpoint, action)
+		if err := config.OVHClient.Post(resizeEndpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Post %s with params %v:\n\t %q", resizeEndpoint, params, err)
+		}
+
+		if err := storageEfsShareWait(config.OVHClient, serviceName, d.Id(), []string{pending}, []string{"available"}); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("snapshot_policy_id") {
+		if err := storageEfsShareSetSnapshotPolicy(d, config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceStorageEfsShareRead(d, meta)
+}
+
+func resourceStorageEfsShareDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will delete efs %s share %s", serviceName, d.Id())
+
+	endpoint := fmt.Sprintf("/storage/netapp/%s/share/%s", serviceName, d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	if err := storageEfsShareWait(config.OVHClient, serviceName, d.Id(), []string{"available", "deleting"}, []string{"deleted"}); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// storageEfsShareSetSnapshotPolicy applies the configured snapshot policy
+// to a share. The API doesn't return the policy applied to a share, so the
+// attribute is only kept from the configuration.
+func storageEfsShareSetSnapshotPolicy(d *schema.ResourceData, c *ovh.Client) error {
+	serviceName := d.Get("service_name").(string)
+
+	v, ok := d.GetOk("snapshot_policy_id")
+	if !ok {
+		return nil
+	}
+
+	params := &StorageEfsShareSnapshotPolicyOpts{SnapshotPolicyId: v.(string)}
+
+	log.Printf("[DEBUG] Will apply snapshot policy on efs %s share %s: %v", serviceName, d.Id(), params)
+
+	endpoint := fmt.Sprintf("/storage/netapp/%s/share/%s/snapshotPolicy", serviceName, d.Id())
+	if err := c.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return nil
+}
+
+func storageEfsShareExists(serviceName, shareId string, c *ovh.Client) error {
+	r := &StorageEfsShare{}
+	endpoint := fmt.Sprintf("/storage/netapp/%s/share/%s", serviceName, shareId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read efs share: %s", r)
+
+	return nil
+}
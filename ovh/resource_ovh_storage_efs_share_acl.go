@@ -0,0 +1,168 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceStorageEfsShareAclImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/SHARE_ID/ACL_ID formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("service_name", splitId[0])
+	d.Set("share_id", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceStorageEfsShareAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageEfsShareAclCreate,
+		Read:   resourceStorageEfsShareAclRead,
+		Delete: resourceStorageEfsShareAclDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageEfsShareAclImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"share_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"access_to": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"access_level": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"ro", "rw"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"access_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageEfsShareAclCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	shareId := d.Get("share_id").(string)
+
+	params := &StorageEfsShareAclCreateOpts{
+		AccessTo:    d.Get("access_to").(string),
+		AccessLevel: d.Get("access_level").(string),
+	}
+
+	log.Printf("[DEBUG] Will create efs %s share %s acl: %v", serviceName, shareId, params)
+
+	r := &StorageEfsShareAcl{}
+	endpoint := fmt.Sprintf("/storage/netapp/%s/share/%s/acl", serviceName, shareId)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(r.Id)
+
+	if err := storageEfsShareAclWait(config.OVHClient, serviceName, shareId, r.Id); err != nil {
+		return err
+	}
+
+	return resourceStorageEfsShareAclRead(d, meta)
+}
+
+func resourceStorageEfsShareAclRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	shareId := d.Get("share_id").(string)
+
+	r := &StorageEfsShareAcl{}
+	endpoint := fmt.Sprintf("/storage/netapp/%s/share/%s/acl/%s", serviceName, shareId, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read efs %s share %s acl %s", serviceName, shareId, r)
+
+	d.Set("access_to", r.AccessTo)
+	d.Set("access_level", r.AccessLevel)
+	d.Set("access_type", r.AccessType)
+	d.Set("status", r.Status)
+	d.Set("created_at", r.CreatedAt)
+
+	return nil
+}
+
+func resourceStorageEfsShareAclDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	shareId := d.Get("share_id").(string)
+
+	log.Printf("[DEBUG] Will delete efs %s share %s acl %s", serviceName, shareId, d.Id())
+
+	endpoint := fmt.Sprintf("/storage/netapp/%s/share/%s/acl/%s", serviceName, shareId, d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func storageEfsShareAclExists(serviceName, shareId, aclId string, c *ovh.Client) error {
+	r := &StorageEfsShareAcl{}
+	endpoint := fmt.Sprintf("/storage/netapp/%s/share/%s/acl/%s", serviceName, shareId, aclId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read efs share acl: %s", r)
+
+	return nil
+}
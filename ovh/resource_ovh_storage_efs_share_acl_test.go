@@ -0,0 +1,89 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccStorageEfsShareAclConfig = `
+resource "ovh_storage_efs_share" "share" {
+  service_name = "%s"
+  name         = "%s"
+  size         = 100
+}
+
+resource "ovh_storage_efs_share_acl" "acl" {
+  service_name = "${ovh_storage_efs_share.share.service_name}"
+  share_id     = "${ovh_storage_efs_share.share.id}"
+  access_to    = "10.0.0.0/24"
+  access_level = "rw"
+}
+`
+
+func TestAccStorageEfsShareAcl_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_STORAGE_EFS_SERVICE")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckStorageEfsPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageEfsShareAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccStorageEfsShareAclConfig, serviceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageEfsShareAclExists("ovh_storage_efs_share_acl.acl", t),
+					resource.TestCheckResourceAttr("ovh_storage_efs_share_acl.acl", "access_level", "rw"),
+					resource.TestCheckResourceAttr("ovh_storage_efs_share_acl.acl", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStorageEfsShareAclExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No efs share acl id is set")
+		}
+
+		return storageEfsShareAclExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["share_id"],
+			rs.Primary.ID,
+			config.OVHClient,
+		)
+	}
+}
+
+func testAccCheckStorageEfsShareAclDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_storage_efs_share_acl" {
+			continue
+		}
+
+		err := storageEfsShareAclExists(
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["share_id"],
+			rs.Primary.ID,
+			config.OVHClient,
+		)
+		if err == nil {
+			return fmt.Errorf("efs share acl still exists")
+		}
+	}
+	return nil
+}
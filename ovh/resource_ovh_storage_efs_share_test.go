@@ -0,0 +1,93 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccStorageEfsShareConfig = `
+resource "ovh_storage_efs_share" "share" {
+  service_name = "%s"
+  name         = "%s"
+  description  = "created by terraform"
+  size         = %d
+}
+`
+
+func TestAccStorageEfsShare_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_STORAGE_EFS_SERVICE")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckStorageEfsPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageEfsShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccStorageEfsShareConfig, serviceName, name, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageEfsShareExists("ovh_storage_efs_share.share", t),
+					resource.TestCheckResourceAttr("ovh_storage_efs_share.share", "name", name),
+					resource.TestCheckResourceAttr("ovh_storage_efs_share.share", "size", "100"),
+					resource.TestCheckResourceAttr("ovh_storage_efs_share.share", "status", "available"),
+					resource.TestCheckResourceAttrSet("ovh_storage_efs_share.share", "access_paths.#"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccStorageEfsShareConfig, serviceName, name, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageEfsShareExists("ovh_storage_efs_share.share", t),
+					resource.TestCheckResourceAttr("ovh_storage_efs_share.share", "size", "200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStorageEfsPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	// enterprise file storage is an optional product
+	// these resources are tested only if env var `OVH_STORAGE_EFS_SERVICE`
+	// is set
+	if os.Getenv("OVH_STORAGE_EFS_SERVICE") == "" {
+		t.Skip("OVH_STORAGE_EFS_SERVICE must be set to test enterprise file storage")
+	}
+}
+
+func testAccCheckStorageEfsShareExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No efs share id is set")
+		}
+
+		return storageEfsShareExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckStorageEfsShareDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_storage_efs_share" {
+			continue
+		}
+
+		err := storageEfsShareExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("efs share still exists")
+		}
+	}
+	return nil
+}
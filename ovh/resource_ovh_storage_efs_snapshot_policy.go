@@ -0,0 +1,227 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceStorageEfsSnapshotPolicyImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/SNAPSHOT_POLICY_ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceStorageEfsSnapshotPolicy() *schema.Resource {
+	scheduleField := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceStorageEfsSnapshotPolicyCreate,
+		Read:   resourceStorageEfsSnapshotPolicyRead,
+		Update: resourceStorageEfsSnapshotPolicyUpdate,
+		Delete: resourceStorageEfsSnapshotPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageEfsSnapshotPolicyImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"copies": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"minutes":  scheduleField(),
+						"hours":    scheduleField(),
+						"days":     scheduleField(),
+						"months":   scheduleField(),
+						"weekdays": scheduleField(),
+					},
+				},
+			},
+
+			// Computed
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func storageEfsSnapshotPolicyScheduleField(v interface{}) []int {
+	values := []int{}
+	for _, value := range v.([]interface{}) {
+		values = append(values, value.(int))
+	}
+	return values
+}
+
+func storageEfsSnapshotPolicyOpts(d *schema.ResourceData) *StorageEfsSnapshotPolicyOpts {
+	params := &StorageEfsSnapshotPolicyOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Rules:       []*StorageEfsSnapshotPolicyRule{},
+	}
+
+	for _, v := range d.Get("rule").([]interface{}) {
+		rule := v.(map[string]interface{})
+		params.Rules = append(params.Rules, &StorageEfsSnapshotPolicyRule{
+			Copies: rule["copies"].(int),
+			Prefix: rule["prefix"].(string),
+			Schedule: &StorageEfsSnapshotPolicySchedule{
+				Minutes:  storageEfsSnapshotPolicyScheduleField(rule["minutes"]),
+				Hours:    storageEfsSnapshotPolicyScheduleField(rule["hours"]),
+				Days:     storageEfsSnapshotPolicyScheduleField(rule["days"]),
+				Months:   storageEfsSnapshotPolicyScheduleField(rule["months"]),
+				Weekdays: storageEfsSnapshotPolicyScheduleField(rule["weekdays"]),
+			},
+		})
+	}
+
+	return params
+}
+
+func resourceStorageEfsSnapshotPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := storageEfsSnapshotPolicyOpts(d)
+
+	log.Printf("[DEBUG] Will create efs %s snapshot policy: %v", serviceName, params)
+
+	r := &StorageEfsSnapshotPolicy{}
+	endpoint := fmt.Sprintf("/storage/netapp/%s/snapshotPolicy", serviceName)
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	d.SetId(r.Id)
+
+	return resourceStorageEfsSnapshotPolicyRead(d, meta)
+}
+
+func resourceStorageEfsSnapshotPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	r := &StorageEfsSnapshotPolicy{}
+	endpoint := fmt.Sprintf("/storage/netapp/%s/snapshotPolicy/%s", serviceName, d.Id())
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read efs %s snapshot policy %s", serviceName, r)
+
+	rules := make([]map[string]interface{}, len(r.Rules))
+	for i, rule := range r.Rules {
+		rules[i] = map[string]interface{}{
+			"copies": rule.Copies,
+			"prefix": rule.Prefix,
+		}
+		if rule.Schedule != nil {
+			rules[i]["minutes"] = rule.Schedule.Minutes
+			rules[i]["hours"] = rule.Schedule.Hours
+			rules[i]["days"] = rule.Schedule.Days
+			rules[i]["months"] = rule.Schedule.Months
+			rules[i]["weekdays"] = rule.Schedule.Weekdays
+		}
+	}
+
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+	d.Set("rule", rules)
+	d.Set("is_default", r.IsDefault)
+	d.Set("status", r.Status)
+
+	return nil
+}
+
+func resourceStorageEfsSnapshotPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := storageEfsSnapshotPolicyOpts(d)
+
+	log.Printf("[DEBUG] Will update efs %s snapshot policy %s: %v", serviceName, d.Id(), params)
+
+	endpoint := fmt.Sprintf("/storage/netapp/%s/snapshotPolicy/%s", serviceName, d.Id())
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceStorageEfsSnapshotPolicyRead(d, meta)
+}
+
+func resourceStorageEfsSnapshotPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	log.Printf("[DEBUG] Will delete efs %s snapshot policy %s", serviceName, d.Id())
+
+	endpoint := fmt.Sprintf("/storage/netapp/%s/snapshotPolicy/%s", serviceName, d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func storageEfsSnapshotPolicyExists(serviceName, policyId string, c *ovh.Client) error {
+	r := &StorageEfsSnapshotPolicy{}
+	endpoint := fmt.Sprintf("/storage/netapp/%s/snapshotPolicy/%s", serviceName, policyId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read efs snapshot policy: %s", r)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccStorageEfsSnapshotPolicyConfig = `
+resource "ovh_storage_efs_snapshot_policy" "policy" {
+  service_name = "%s"
+  name         = "%s"
+  description  = "created by terraform"
+
+  rule {
+    copies  = %d
+    prefix  = "daily"
+    minutes = [0]
+    hours   = [2]
+  }
+}
+`
+
+func TestAccStorageEfsSnapshotPolicy_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_STORAGE_EFS_SERVICE")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckStorageEfsPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageEfsSnapshotPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccStorageEfsSnapshotPolicyConfig, serviceName, name, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageEfsSnapshotPolicyExists("ovh_storage_efs_snapshot_policy.policy", t),
+					resource.TestCheckResourceAttr("ovh_storage_efs_snapshot_policy.policy", "rule.#", "1"),
+					resource.TestCheckResourceAttr("ovh_storage_efs_snapshot_policy.policy", "rule.0.copies", "7"),
+					resource.TestCheckResourceAttr("ovh_storage_efs_snapshot_policy.policy", "rule.0.hours.0", "2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccStorageEfsSnapshotPolicyConfig, serviceName, name, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageEfsSnapshotPolicyExists("ovh_storage_efs_snapshot_policy.policy", t),
+					resource.TestCheckResourceAttr("ovh_storage_efs_snapshot_policy.policy", "rule.0.copies", "14"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStorageEfsSnapshotPolicyExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No efs snapshot policy id is set")
+		}
+
+		return storageEfsSnapshotPolicyExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckStorageEfsSnapshotPolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_storage_efs_snapshot_policy" {
+			continue
+		}
+
+		err := storageEfsSnapshotPolicyExists(rs.Primary.Attributes["service_name"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("efs snapshot policy still exists")
+		}
+	}
+	return nil
+}
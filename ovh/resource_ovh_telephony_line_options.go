@@ -0,0 +1,241 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceTelephonyLineOptionsImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not BILLING_ACCOUNT/SERVICE_NAME formatted")
+	}
+	d.Set("billing_account", splitId[0])
+	d.Set("service_name", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceTelephonyLineOptions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTelephonyLineOptionsCreate,
+		Read:   resourceTelephonyLineOptionsRead,
+		Update: resourceTelephonyLineOptionsUpdate,
+		Delete: resourceTelephonyLineOptionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTelephonyLineOptionsImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"billing_account": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_number": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"do_not_disturb": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"anonymous_rejection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"call_waiting": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"intercom": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), []string{"prefixed", "no", "yes"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"identification_restriction": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"default_voicemail": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"forward_unconditional": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"forward_unconditional_number": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"forward_busy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"forward_busy_number": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"forward_no_reply": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"forward_no_reply_number": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"forward_no_reply_delay": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"forward_backup": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"forward_backup_number": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"codecs": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTelephonyLineOptionsCreate(d *schema.ResourceData, meta interface{}) error {
+	// The options come with the line: they are only updated.
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("billing_account").(string), d.Get("service_name").(string)))
+
+	return resourceTelephonyLineOptionsUpdate(d, meta)
+}
+
+func resourceTelephonyLineOptionsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	billingAccount := d.Get("billing_account").(string)
+	serviceName := d.Get("service_name").(string)
+
+	r := &TelephonyLineOptions{}
+	endpoint := fmt.Sprintf("/telephony/%s/line/%s/options", billingAccount, serviceName)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read telephony line %s/%s options %s", billingAccount, serviceName, r)
+
+	d.Set("display_number", r.DisplayNumber)
+	d.Set("do_not_disturb", r.DoNotDisturb)
+	d.Set("anonymous_rejection", r.AnonymousRejection)
+	d.Set("call_waiting", r.CallWaiting)
+	d.Set("intercom", r.Intercom)
+	d.Set("identification_restriction", r.IdentificationRestriction)
+	d.Set("default_voicemail", r.DefaultVoicemail)
+	d.Set("forward_unconditional", r.ForwardUnconditional)
+	d.Set("forward_unconditional_number", r.ForwardUnconditionalNumber)
+	d.Set("forward_busy", r.ForwardBusy)
+	d.Set("forward_busy_number", r.ForwardBusyNumber)
+	d.Set("forward_no_reply", r.ForwardNoReply)
+	d.Set("forward_no_reply_number", r.ForwardNoReplyNumber)
+	d.Set("forward_no_reply_delay", r.ForwardNoReplyDelay)
+	d.Set("forward_backup", r.ForwardBackup)
+	d.Set("forward_backup_number", r.ForwardBackupNumber)
+	d.Set("codecs", r.Codecs)
+
+	return nil
+}
+
+func resourceTelephonyLineOptionsUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	billingAccount := d.Get("billing_account").(string)
+	serviceName := d.Get("service_name").(string)
+
+	params := &TelephonyLineOptions{
+		DisplayNumber:              d.Get("display_number").(string),
+		DoNotDisturb:               d.Get("do_not_disturb").(bool),
+		AnonymousRejection:         d.Get("anonymous_rejection").(bool),
+		CallWaiting:                d.Get("call_waiting").(bool),
+		Intercom:                   d.Get("intercom").(string),
+		IdentificationRestriction:  d.Get("identification_restriction").(bool),
+		DefaultVoicemail:           d.Get("default_voicemail").(string),
+		ForwardUnconditional:       d.Get("forward_unconditional").(bool),
+		ForwardUnconditionalNumber: d.Get("forward_unconditional_number").(string),
+		ForwardBusy:                d.Get("forward_busy").(bool),
+		ForwardBusyNumber:          d.Get("forward_busy_number").(string),
+		ForwardNoReply:             d.Get("forward_no_reply").(bool),
+		ForwardNoReplyNumber:       d.Get("forward_no_reply_number").(string),
+		ForwardNoReplyDelay:        d.Get("forward_no_reply_delay").(int),
+		ForwardBackup:              d.Get("forward_backup").(bool),
+		ForwardBackupNumber:        d.Get("forward_backup_number").(string),
+		Codecs:                     d.Get("codecs").(string),
+	}
+
+	log.Printf("[DEBUG] Will update telephony line %s/%s options: %s", billingAccount, serviceName, params)
+
+	endpoint := fmt.Sprintf("/telephony/%s/line/%s/options", billingAccount, serviceName)
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceTelephonyLineOptionsRead(d, meta)
+}
+
+func resourceTelephonyLineOptionsDelete(d *schema.ResourceData, meta interface{}) error {
+	// The options are left as is, they're only removed from the state.
+	log.Printf("[DEBUG] Will remove telephony line %s options from state", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func telephonyLineOptionsExists(billingAccount, serviceName string, c *ovh.Client) error {
+	r := &TelephonyLineOptions{}
+	endpoint := fmt.Sprintf("/telephony/%s/line/%s/options", billingAccount, serviceName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read telephony line options: %s", r)
+
+	return nil
+}
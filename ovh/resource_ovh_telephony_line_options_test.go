@@ -0,0 +1,68 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccTelephonyLineOptionsConfig = `
+resource "ovh_telephony_line_options" "options" {
+  billing_account = "%s"
+  service_name    = "%s"
+  do_not_disturb  = %t
+  call_waiting    = true
+}
+`
+
+func TestAccTelephonyLineOptions_basic(t *testing.T) {
+	billingAccount := os.Getenv("OVH_TELEPHONY_BILLING_ACCOUNT")
+	serviceName := os.Getenv("OVH_TELEPHONY_LINE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckTelephonyLinePreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccTelephonyLineOptionsConfig, billingAccount, serviceName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTelephonyLineOptionsExists("ovh_telephony_line_options.options", t),
+					resource.TestCheckResourceAttr("ovh_telephony_line_options.options", "do_not_disturb", "true"),
+					resource.TestCheckResourceAttr("ovh_telephony_line_options.options", "call_waiting", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccTelephonyLineOptionsConfig, billingAccount, serviceName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_telephony_line_options.options", "do_not_disturb", "false"),
+				),
+			},
+			{
+				ResourceName:      "ovh_telephony_line_options.options",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", billingAccount, serviceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTelephonyLineOptionsExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No telephony line is set")
+		}
+
+		return telephonyLineOptionsExists(rs.Primary.Attributes["billing_account"], rs.Primary.Attributes["service_name"], config.OVHClient)
+	}
+}
@@ -0,0 +1,162 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceTelephonyNumberFeatureTypeImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not BILLING_ACCOUNT/SERVICE_NAME formatted")
+	}
+	d.Set("billing_account", splitId[0])
+	d.Set("service_name", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceTelephonyNumberFeatureType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTelephonyNumberFeatureTypeCreate,
+		Read:   resourceTelephonyNumberFeatureTypeRead,
+		Update: resourceTelephonyNumberFeatureTypeUpdate,
+		Delete: resourceTelephonyNumberFeatureTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTelephonyNumberFeatureTypeImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"billing_account": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"feature_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateStringEnum(v.(string), telephonyFeatureTypes)
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTelephonyNumberFeatureTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	// Numbers are ordered: only their feature type is managed here.
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("billing_account").(string), d.Get("service_name").(string)))
+
+	return resourceTelephonyNumberFeatureTypeUpdate(d, meta)
+}
+
+func resourceTelephonyNumberFeatureTypeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	billingAccount := d.Get("billing_account").(string)
+	serviceName := d.Get("service_name").(string)
+
+	r := &TelephonyNumber{}
+	endpoint := fmt.Sprintf("/telephony/%s/number/%s", billingAccount, serviceName)
+
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read telephony number %s", r)
+
+	d.Set("feature_type", r.FeatureType)
+	d.Set("description", r.Description)
+	d.Set("service_type", r.ServiceType)
+
+	return nil
+}
+
+func resourceTelephonyNumberFeatureTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	billingAccount := d.Get("billing_account").(string)
+	serviceName := d.Get("service_name").(string)
+
+	current := &TelephonyNumber{}
+	endpoint := fmt.Sprintf("/telephony/%s/number/%s", billingAccount, serviceName)
+	if err := config.OVHClient.Get(endpoint, current); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	params := &TelephonyNumberChangeFeatureTypeOpts{
+		FeatureType: d.Get("feature_type").(string),
+	}
+
+	// changing the feature type to the current one is rejected by the API
+	if current.FeatureType == params.FeatureType {
+		return resourceTelephonyNumberFeatureTypeRead(d, meta)
+	}
+
+	log.Printf("[DEBUG] Will change telephony number %s/%s feature type: %v", billingAccount, serviceName, params)
+
+	task := &TelephonyTask{}
+	endpoint = fmt.Sprintf("/telephony/%s/number/%s/changeFeatureType", billingAccount, serviceName)
+	if err := config.OVHClient.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if err := telephonyTaskWait(config.OVHClient, billingAccount, serviceName, task.TaskId); err != nil {
+		return err
+	}
+
+	return resourceTelephonyNumberFeatureTypeRead(d, meta)
+}
+
+func resourceTelephonyNumberFeatureTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	// The feature type is left as is, it's only removed from the state.
+	log.Printf("[DEBUG] Will remove telephony number %s feature type from state", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func telephonyNumberExists(billingAccount, serviceName string, c *ovh.Client) error {
+	r := &TelephonyNumber{}
+	endpoint := fmt.Sprintf("/telephony/%s/number/%s", billingAccount, serviceName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read telephony number: %s", r)
+
+	return nil
+}
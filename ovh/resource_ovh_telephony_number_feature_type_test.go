@@ -0,0 +1,60 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccTelephonyNumberFeatureTypeConfig = `
+resource "ovh_telephony_number_feature_type" "number" {
+  billing_account = "%s"
+  service_name    = "%s"
+  feature_type    = "%s"
+}
+`
+
+func TestAccTelephonyNumberFeatureType_basic(t *testing.T) {
+	billingAccount := os.Getenv("OVH_TELEPHONY_BILLING_ACCOUNT")
+	serviceName := os.Getenv("OVH_TELEPHONY_NUMBER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckTelephonyNumberPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccTelephonyNumberFeatureTypeConfig, billingAccount, serviceName, "redirect"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTelephonyNumberExists("ovh_telephony_number_feature_type.number", t),
+					resource.TestCheckResourceAttr("ovh_telephony_number_feature_type.number", "feature_type", "redirect"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccTelephonyNumberFeatureTypeConfig, billingAccount, serviceName, "voicemail"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_telephony_number_feature_type.number", "feature_type", "voicemail"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTelephonyNumberExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No telephony number is set")
+		}
+
+		return telephonyNumberExists(rs.Primary.Attributes["billing_account"], rs.Primary.Attributes["service_name"], config.OVHClient)
+	}
+}
@@ -0,0 +1,179 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceVps() *schema.Resource {
+	s := vpsComputedSchema()
+	s["service_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["display_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+	s["netboot_mode"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+			err := validateStringEnum(v.(string), []string{"local", "rescue"})
+			if err != nil {
+				errors = append(errors, err)
+			}
+			return
+		},
+	}
+	s["image_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	s["ssh_key"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceVpsCreate,
+		Read:   resourceVpsRead,
+		Update: resourceVpsUpdate,
+		Delete: resourceVpsDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("service_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceVpsCreate(d *schema.ResourceData, meta interface{}) error {
+	// VPS can't be created through this resource. They are ordered,
+	// then their settings are managed here.
+	d.SetId(d.Get("service_name").(string))
+
+	return resourceVpsUpdate(d, meta)
+}
+
+func resourceVpsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vps, datacenter, ips, err := vpsDetails(config.OVHClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, fmt.Sprintf("/vps/%s", d.Id()))
+	}
+
+	log.Printf("[DEBUG] Read vps %s", vps)
+
+	d.Set("service_name", vps.Name)
+	d.Set("display_name", vps.DisplayName)
+	d.Set("netboot_mode", vps.NetbootMode)
+	vpsSetComputed(d, vps, datacenter, ips)
+
+	return nil
+}
+
+func resourceVpsUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("display_name") || d.HasChange("netboot_mode") {
+		params := &VpsUpdateOpts{
+			DisplayName: d.Get("display_name").(string),
+			NetbootMode: d.Get("netboot_mode").(string),
+		}
+
+		log.Printf("[DEBUG] Will update vps %s: %v", d.Id(), params)
+
+		endpoint := fmt.Sprintf("/vps/%s", d.Id())
+		if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	if d.HasChange("image_id") || d.HasChange("ssh_key") {
+		if err := vpsRebuild(d, config.OVHClient); err != nil {
+			return err
+		}
+	} else if !d.IsNewResource() && d.HasChange("netboot_mode") {
+		// the netboot mode is applied on the next boot
+		if err := vpsReboot(d, config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceVpsRead(d, meta)
+}
+
+func resourceVpsDelete(d *schema.ResourceData, meta interface{}) error {
+	// The VPS is left untouched, it's only removed from the state.
+	log.Printf("[DEBUG] Will remove vps %s from state", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+// vpsRebuild reinstalls the VPS with the configured image and ssh key.
+func vpsRebuild(d *schema.ResourceData, c *ovh.Client) error {
+	imageId := d.Get("image_id").(string)
+	if imageId == "" {
+		return nil
+	}
+
+	params := &VpsRebuildOpts{
+		ImageId:           imageId,
+		PublicSshKey:      d.Get("ssh_key").(string),
+		DoNotSendPassword: d.Get("ssh_key").(string) != "",
+	}
+
+	log.Printf("[DEBUG] Will rebuild vps %s: %s", d.Id(), params)
+
+	task := &VpsTask{}
+	endpoint := fmt.Sprintf("/vps/%s/rebuild", d.Id())
+	if err := c.Post(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	return vpsTaskWait(c, d.Id(), task.Id)
+}
+
+func vpsReboot(d *schema.ResourceData, c *ovh.Client) error {
+	log.Printf("[DEBUG] Will reboot vps %s", d.Id())
+
+	task := &VpsTask{}
+	endpoint := fmt.Sprintf("/vps/%s/reboot", d.Id())
+	if err := c.Post(endpoint, nil, task); err != nil {
+		return fmt.Errorf("calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	return vpsTaskWait(c, d.Id(), task.Id)
+}
+
+func vpsExists(serviceName string, c *ovh.Client) error {
+	r := &Vps{}
+	endpoint := fmt.Sprintf("/vps/%s", serviceName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read vps: %s", r)
+
+	return nil
+}
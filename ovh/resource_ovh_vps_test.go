@@ -0,0 +1,59 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccVpsConfig = `
+resource "ovh_vps" "vps" {
+  service_name = "%s"
+  display_name = "%s"
+}
+`
+
+func TestAccVps_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_VPS_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckVpsPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccVpsConfig, serviceName, test_prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpsExists("ovh_vps.vps", t),
+					resource.TestCheckResourceAttr("ovh_vps.vps", "display_name", test_prefix),
+					resource.TestCheckResourceAttrSet("ovh_vps.vps", "netboot_mode"),
+				),
+			},
+			{
+				ResourceName:            "ovh_vps.vps",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image_id", "ssh_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckVpsExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No vps service name is set")
+		}
+
+		return vpsExists(rs.Primary.ID, config.OVHClient)
+	}
+}
@@ -0,0 +1,152 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceVRack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVRackCreate,
+		Read:   resourceVRackRead,
+		Update: resourceVRackUpdate,
+		Delete: resourceVRackDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("service_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: orderCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"ovh_subsidiary": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"order_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVRackCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	item := &OrderCartItemCreateOpts{
+		PlanCode:    "vrack",
+		Duration:    "P1M",
+		PricingMode: "default",
+		Quantity:    1,
+	}
+
+	log.Printf("[DEBUG] Will order a new vrack")
+
+	order, err := orderProduct(config.OVHClient, d.Get("ovh_subsidiary").(string), "vrack", item, nil, 30*time.Minute)
+	if order != nil {
+		d.Set("order_id", order.OrderId)
+	}
+	if err != nil {
+		return fmt.Errorf("Error ordering vrack: %s", err)
+	}
+
+	serviceName, err := orderServiceName(config.OVHClient, order.OrderId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving vrack from order %d: %s", order.OrderId, err)
+	}
+
+	log.Printf("[DEBUG] Ordered vrack %s with order %d", serviceName, order.OrderId)
+
+	d.SetId(serviceName)
+	d.Set("service_name", serviceName)
+
+	if d.Get("name").(string) != "" || d.Get("description").(string) != "" {
+		return resourceVRackUpdate(d, meta)
+	}
+
+	return resourceVRackRead(d, meta)
+}
+
+func resourceVRackRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &VRack{}
+	endpoint := fmt.Sprintf("/vrack/%s", d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read VRack %s: %s", d.Id(), r)
+
+	d.Set("service_name", d.Id())
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+
+	return nil
+}
+
+func resourceVRackUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &VRackUpdateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] Will update vrack %s: %v", d.Id(), params)
+
+	endpoint := fmt.Sprintf("/vrack/%s", d.Id())
+
+	err := config.OVHClient.Put(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourceVRackRead(d, meta)
+}
+
+func resourceVRackDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	return serviceTerminate(d, config.OVHClient, fmt.Sprintf("/vrack/%s", d.Id()))
+}
+
+func vrackExists(serviceName string, c *ovh.Client) error {
+	r := &VRack{}
+	endpoint := fmt.Sprintf("/vrack/%s", serviceName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read VRack %s: %s", serviceName, r)
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceVRackDedicatedServerInterfaceImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not VRACK_ID/interface_id formatted")
+	}
+	vrackId := splitId[0]
+	interfaceId := splitId[1]
+	d.SetId(fmt.Sprintf("vrack_%s-dedicatedserverinterface_%s-attach", vrackId, interfaceId))
+	d.Set("vrack_id", vrackId)
+	d.Set("interface_id", interfaceId)
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceVRackDedicatedServerInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVRackDedicatedServerInterfaceCreate,
+		Read:   resourceVRackDedicatedServerInterfaceRead,
+		Update: resourceVRackDedicatedServerInterfaceUpdate,
+		Delete: resourceVRackDedicatedServerInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVRackDedicatedServerInterfaceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vrack_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_VRACK_ID", ""),
+			},
+			"interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"prevent_detach": vrackPreventDetachSchema(),
+
+			// Computed
+			"dedicated_server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func resourceVRackDedicatedServerInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	interfaceId := d.Get("interface_id").(string)
+
+	params := &VRackDedicatedServerInterfaceAttachOpts{DedicatedServerInterface: interfaceId}
+	r := VRackAttachTaskResponse{}
+
+	log.Printf("[DEBUG] Will Attach VRack %s -> DedicatedServerInterface %s", vrackId, interfaceId)
+	endpoint := fmt.Sprintf("/vrack/%s/dedicatedServerInterface", vrackId)
+
+	err := config.OVHClient.Post(endpoint, params, &r)
+	if err != nil {
+		return fmt.Errorf("Error calling %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Attachement Task id %d: VRack %s -> DedicatedServerInterface %s", r.Id, vrackId, interfaceId)
+
+	task, err := vrackTaskWait(config.OVHClient, vrackId, r.Id, vrackTaskDefaultTimeout)
+	if err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to attach dedicated server interface (%s): %s", vrackId, interfaceId, err)
+	}
+	log.Printf("[DEBUG] Created Attachement Task id %d: VRack %s -> DedicatedServerInterface %s", r.Id, vrackId, interfaceId)
+
+	//set id
+	d.SetId(fmt.Sprintf("vrack_%s-dedicatedserverinterface_%s-attach", vrackId, interfaceId))
+	taskSetComputed(d, r.Id, task)
+
+	return resourceVRackDedicatedServerInterfaceRead(d, meta)
+}
+
+func resourceVRackDedicatedServerInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	interfaceId := d.Get("interface_id").(string)
+
+	r := &VRackDedicatedServerInterface{}
+	endpoint := fmt.Sprintf("/vrack/%s/dedicatedServerInterface/%s", vrackId, interfaceId)
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+	log.Printf("[DEBUG] Read VRack %s -> DedicatedServerInterface %s", vrackId, r)
+
+	d.Set("dedicated_server", r.DedicatedServer)
+
+	return nil
+}
+
+func resourceVRackDedicatedServerInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceVRackDedicatedServerInterfaceRead(d, meta)
+}
+
+func resourceVRackDedicatedServerInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := vrackPreventDetachCheck(d, fmt.Sprintf("dedicated server interface %s", d.Get("interface_id").(string))); err != nil {
+		return err
+	}
+
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	interfaceId := d.Get("interface_id").(string)
+
+	r := VRackAttachTaskResponse{}
+	endpoint := fmt.Sprintf("/vrack/%s/dedicatedServerInterface/%s", vrackId, interfaceId)
+
+	err := config.OVHClient.Delete(endpoint, &r)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Attachment Deletion Task id %d: VRack %s -> DedicatedServerInterface %s", r.Id, vrackId, interfaceId)
+
+	_, err = vrackTaskWait(config.OVHClient, vrackId, r.Id, vrackTaskDefaultTimeout)
+	if err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to detach dedicated server interface (%s): %s", vrackId, interfaceId, err)
+	}
+	log.Printf("[DEBUG] Removed Attachement id %d: VRack %s -> DedicatedServerInterface %s", r.Id, vrackId, interfaceId)
+
+	d.SetId("")
+	return nil
+}
+
+func vrackDedicatedServerInterfaceExists(vrackId, interfaceId string, c *ovh.Client) error {
+	r := &VRackDedicatedServerInterface{}
+	endpoint := fmt.Sprintf("/vrack/%s/dedicatedServerInterface/%s", vrackId, interfaceId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("Error while querying %s: %q\n", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read Attachment %s -> %s", endpoint, r)
+
+	return nil
+}
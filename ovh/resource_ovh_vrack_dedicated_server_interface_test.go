@@ -0,0 +1,84 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var testAccVRackDedicatedServerInterfaceConfig = fmt.Sprintf(`
+resource "ovh_vrack_dedicated_server_interface" "attach" {
+  vrack_id     = "%s"
+  interface_id = "%s"
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_DEDICATED_SERVER_INTERFACE"))
+
+func TestAccVRackDedicatedServerInterface_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckVRackDedicatedServerInterfacePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVRackDedicatedServerInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVRackDedicatedServerInterfaceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVRackDedicatedServerInterfaceExists("ovh_vrack_dedicated_server_interface.attach", t),
+					resource.TestCheckResourceAttrSet(
+						"ovh_vrack_dedicated_server_interface.attach", "dedicated_server"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVRackDedicatedServerInterfacePreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccCheckVRackExists(t)
+
+	// a dedicated server with a vrack compatible interface is not mandatory
+	// this resource is tested only if env var `OVH_DEDICATED_SERVER_INTERFACE`
+	// is set
+	if os.Getenv("OVH_DEDICATED_SERVER_INTERFACE") == "" {
+		t.Skip("OVH_DEDICATED_SERVER_INTERFACE must be set to test vrack dedicated server interface attachments")
+	}
+}
+
+func testAccCheckVRackDedicatedServerInterfaceExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["vrack_id"] == "" {
+			return fmt.Errorf("No VRack ID is set")
+		}
+
+		if rs.Primary.Attributes["interface_id"] == "" {
+			return fmt.Errorf("No Interface ID is set")
+		}
+
+		return vrackDedicatedServerInterfaceExists(rs.Primary.Attributes["vrack_id"], rs.Primary.Attributes["interface_id"], config.OVHClient)
+	}
+}
+
+func testAccCheckVRackDedicatedServerInterfaceDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_vrack_dedicated_server_interface" {
+			continue
+		}
+
+		err := vrackDedicatedServerInterfaceExists(rs.Primary.Attributes["vrack_id"], rs.Primary.Attributes["interface_id"], config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("VRack > Dedicated Server Interface Attachment still exists")
+		}
+
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceVRackIpImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, ",", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not VRACK_ID,ip_block formatted")
+	}
+	vrackId := splitId[0]
+	block := splitId[1]
+	d.SetId(fmt.Sprintf("vrack_%s-block_%s-attach", vrackId, block))
+	d.Set("vrack_id", vrackId)
+	d.Set("block", block)
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceVRackIp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVRackIpCreate,
+		Read:   resourceVRackIpRead,
+		Update: resourceVRackIpUpdate,
+		Delete: resourceVRackIpDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVRackIpImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vrack_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_VRACK_ID", ""),
+			},
+			"block": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := validateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			"prevent_detach": vrackPreventDetachSchema(),
+
+			// Computed
+			"gateway": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func resourceVRackIpCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	block := d.Get("block").(string)
+
+	params := &VRackIpAttachOpts{Block: block}
+	r := VRackAttachTaskResponse{}
+
+	log.Printf("[DEBUG] Will Attach VRack %s -> IP block %s", vrackId, block)
+	endpoint := fmt.Sprintf("/vrack/%s/ip", vrackId)
+
+	err := config.OVHClient.Post(endpoint, params, &r)
+	if err != nil {
+		return fmt.Errorf("Error calling %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Attachement Task id %d: VRack %s -> IP block %s", r.Id, vrackId, block)
+
+	task, err := vrackTaskWait(config.OVHClient, vrackId, r.Id, vrackTaskDefaultTimeout)
+	if err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to attach ip block (%s): %s", vrackId, block, err)
+	}
+	log.Printf("[DEBUG] Created Attachement Task id %d: VRack %s -> IP block %s", r.Id, vrackId, block)
+
+	//set id
+	d.SetId(fmt.Sprintf("vrack_%s-block_%s-attach", vrackId, block))
+	taskSetComputed(d, r.Id, task)
+
+	return resourceVRackIpRead(d, meta)
+}
+
+func resourceVRackIpRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	block := d.Get("block").(string)
+
+	r := &VRackIp{}
+	endpoint := fmt.Sprintf("/vrack/%s/ip/%s", vrackId, strings.Replace(block, "/", "%2F", 1))
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+	log.Printf("[DEBUG] Read VRack %s -> IP %s", vrackId, r)
+
+	d.Set("gateway", r.Gateway)
+	d.Set("zone", r.Zone)
+
+	return nil
+}
+
+func resourceVRackIpUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceVRackIpRead(d, meta)
+}
+
+func resourceVRackIpDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := vrackPreventDetachCheck(d, fmt.Sprintf("IP block %s", d.Get("block").(string))); err != nil {
+		return err
+	}
+
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	block := d.Get("block").(string)
+
+	r := VRackAttachTaskResponse{}
+	endpoint := fmt.Sprintf("/vrack/%s/ip/%s", vrackId, strings.Replace(block, "/", "%2F", 1))
+
+	err := config.OVHClient.Delete(endpoint, &r)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Attachment Deletion Task id %d: VRack %s -> IP block %s", r.Id, vrackId, block)
+
+	_, err = vrackTaskWait(config.OVHClient, vrackId, r.Id, vrackTaskDefaultTimeout)
+	if err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to detach ip block (%s): %s", vrackId, block, err)
+	}
+	log.Printf("[DEBUG] Removed Attachement id %d: VRack %s -> IP block %s", r.Id, vrackId, block)
+
+	d.SetId("")
+	return nil
+}
+
+func vrackIpExists(vrackId, block string, c *ovh.Client) error {
+	r := &VRackIp{}
+	endpoint := fmt.Sprintf("/vrack/%s/ip/%s", vrackId, strings.Replace(block, "/", "%2F", 1))
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("Error while querying %s: %q\n", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read Attachment %s -> %s", endpoint, r)
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var testAccVRackIpConfig = fmt.Sprintf(`
+resource "ovh_vrack_ip" "attach" {
+  vrack_id = "%s"
+  block    = "%s"
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_VRACK_IP_BLOCK"))
+
+func TestAccVRackIp_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckVRackIpPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVRackIpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVRackIpConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVRackIpExists("ovh_vrack_ip.attach", t),
+					resource.TestCheckResourceAttrSet("ovh_vrack_ip.attach", "gateway"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVRackIpPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccCheckVRackExists(t)
+
+	// attaching an ip block to a vrack changes its routing
+	// this resource is tested only if env var `OVH_VRACK_IP_BLOCK`
+	// is set
+	if os.Getenv("OVH_VRACK_IP_BLOCK") == "" {
+		t.Skip("OVH_VRACK_IP_BLOCK must be set to test vrack ip attachments")
+	}
+}
+
+func testAccCheckVRackIpExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["vrack_id"] == "" {
+			return fmt.Errorf("No VRack ID is set")
+		}
+
+		if rs.Primary.Attributes["block"] == "" {
+			return fmt.Errorf("No IP block is set")
+		}
+
+		return vrackIpExists(rs.Primary.Attributes["vrack_id"], rs.Primary.Attributes["block"], config.OVHClient)
+	}
+}
+
+func testAccCheckVRackIpDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_vrack_ip" {
+			continue
+		}
+
+		err := vrackIpExists(rs.Primary.Attributes["vrack_id"], rs.Primary.Attributes["block"], config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("VRack > IP Attachment still exists")
+		}
+
+	}
+	return nil
+}
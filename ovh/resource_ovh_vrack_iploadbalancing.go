@@ -0,0 +1,157 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceVRackIpLoadbalancingImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not VRACK_ID/ip_loadbalancing_id formatted")
+	}
+	vrackId := splitId[0]
+	iplbId := splitId[1]
+	d.SetId(fmt.Sprintf("vrack_%s-iploadbalancing_%s-attach", vrackId, iplbId))
+	d.Set("vrack_id", vrackId)
+	d.Set("ip_loadbalancing_id", iplbId)
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceVRackIpLoadbalancing() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVRackIpLoadbalancingCreate,
+		Read:   resourceVRackIpLoadbalancingRead,
+		Update: resourceVRackIpLoadbalancingUpdate,
+		Delete: resourceVRackIpLoadbalancingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVRackIpLoadbalancingImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vrack_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_VRACK_ID", ""),
+			},
+			"ip_loadbalancing_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"prevent_detach": vrackPreventDetachSchema(),
+
+			// Computed
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
+		},
+	}
+}
+
+func resourceVRackIpLoadbalancingCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	iplbId := d.Get("ip_loadbalancing_id").(string)
+
+	params := &VRackIpLoadbalancingAttachOpts{IpLoadbalancing: iplbId}
+	r := VRackAttachTaskResponse{}
+
+	log.Printf("[DEBUG] Will Attach VRack %s -> IpLoadbalancing %s", vrackId, iplbId)
+	endpoint := fmt.Sprintf("/vrack/%s/ipLoadbalancing", vrackId)
+
+	err := config.OVHClient.Post(endpoint, params, &r)
+	if err != nil {
+		return fmt.Errorf("Error calling %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Attachement Task id %d: VRack %s -> IpLoadbalancing %s", r.Id, vrackId, iplbId)
+
+	task, err := vrackTaskWait(config.OVHClient, vrackId, r.Id, vrackTaskDefaultTimeout)
+	if err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to attach ip loadbalancing (%s): %s", vrackId, iplbId, err)
+	}
+	log.Printf("[DEBUG] Created Attachement Task id %d: VRack %s -> IpLoadbalancing %s", r.Id, vrackId, iplbId)
+
+	//set id
+	d.SetId(fmt.Sprintf("vrack_%s-iploadbalancing_%s-attach", vrackId, iplbId))
+	taskSetComputed(d, r.Id, task)
+
+	return resourceVRackIpLoadbalancingRead(d, meta)
+}
+
+func resourceVRackIpLoadbalancingRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	iplbId := d.Get("ip_loadbalancing_id").(string)
+
+	r := &VRackIpLoadbalancing{}
+	endpoint := fmt.Sprintf("/vrack/%s/ipLoadbalancing/%s", vrackId, iplbId)
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+	log.Printf("[DEBUG] Read VRack %s -> IpLoadbalancing %s", vrackId, r)
+
+	return nil
+}
+
+func resourceVRackIpLoadbalancingUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceVRackIpLoadbalancingRead(d, meta)
+}
+
+func resourceVRackIpLoadbalancingDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := vrackPreventDetachCheck(d, fmt.Sprintf("IP load balancer %s", d.Get("ip_loadbalancing_id").(string))); err != nil {
+		return err
+	}
+
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	iplbId := d.Get("ip_loadbalancing_id").(string)
+
+	r := VRackAttachTaskResponse{}
+	endpoint := fmt.Sprintf("/vrack/%s/ipLoadbalancing/%s", vrackId, iplbId)
+
+	err := config.OVHClient.Delete(endpoint, &r)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Attachment Deletion Task id %d: VRack %s -> IpLoadbalancing %s", r.Id, vrackId, iplbId)
+
+	_, err = vrackTaskWait(config.OVHClient, vrackId, r.Id, vrackTaskDefaultTimeout)
+	if err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to detach ip loadbalancing (%s): %s", vrackId, iplbId, err)
+	}
+	log.Printf("[DEBUG] Removed Attachement id %d: VRack %s -> IpLoadbalancing %s", r.Id, vrackId, iplbId)
+
+	d.SetId("")
+	return nil
+}
+
+func vrackIpLoadbalancingExists(vrackId, iplbId string, c *ovh.Client) error {
+	r := &VRackIpLoadbalancing{}
+	endpoint := fmt.Sprintf("/vrack/%s/ipLoadbalancing/%s", vrackId, iplbId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("Error while querying %s: %q\n", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read Attachment %s -> %s", endpoint, r)
+
+	return nil
+}
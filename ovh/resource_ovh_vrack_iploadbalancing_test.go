@@ -0,0 +1,76 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var testAccVRackIpLoadbalancingConfig = fmt.Sprintf(`
+resource "ovh_vrack_iploadbalancing" "attach" {
+  vrack_id            = "%s"
+  ip_loadbalancing_id = "%s"
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_IPLB_SERVICE"))
+
+func TestAccVRackIpLoadbalancing_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckVRackIpLoadbalancingPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVRackIpLoadbalancingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVRackIpLoadbalancingConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVRackIpLoadbalancingExists("ovh_vrack_iploadbalancing.attach", t),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVRackIpLoadbalancingPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccCheckVRackExists(t)
+	testAccCheckIpLoadbalancingExists(t)
+}
+
+func testAccCheckVRackIpLoadbalancingExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["vrack_id"] == "" {
+			return fmt.Errorf("No VRack ID is set")
+		}
+
+		if rs.Primary.Attributes["ip_loadbalancing_id"] == "" {
+			return fmt.Errorf("No IpLoadbalancing ID is set")
+		}
+
+		return vrackIpLoadbalancingExists(rs.Primary.Attributes["vrack_id"], rs.Primary.Attributes["ip_loadbalancing_id"], config.OVHClient)
+	}
+}
+
+func testAccCheckVRackIpLoadbalancingDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_vrack_iploadbalancing" {
+			continue
+		}
+
+		err := vrackIpLoadbalancingExists(rs.Primary.Attributes["vrack_id"], rs.Primary.Attributes["ip_loadbalancing_id"], config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("VRack > IpLoadbalancing Attachment still exists")
+		}
+
+	}
+	return nil
+}
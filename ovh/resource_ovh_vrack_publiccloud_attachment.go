@@ -5,7 +5,6 @@ import (
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/ovh/go-ovh/ovh"
 )
@@ -14,8 +13,19 @@ func resourceVRackPublicCloudAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVRackPublicCloudAttachmentCreate,
 		Read:   resourceVRackPublicCloudAttachmentRead,
+		Update: resourceVRackPublicCloudAttachmentUpdate,
 		Delete: resourceVRackPublicCloudAttachmentDelete,
 
+		SchemaVersion: 1,
+		MigrateState:  resourceVRackPublicCloudAttachmentMigrateState,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(vrackTaskDefaultTimeout),
+			Delete: schema.DefaultTimeout(vrackTaskDefaultTimeout),
+		},
+
+		CustomizeDiff: serviceNameCustomizeDiff("project_id", serviceNameCloudProject),
+
 		Schema: map[string]*schema.Schema{
 			"vrack_id": {
 				Type:        schema.TypeString,
@@ -29,6 +39,17 @@ func resourceVRackPublicCloudAttachment() *schema.Resource {
 				ForceNew:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
 			},
+
+			"prevent_detach": vrackPreventDetachSchema(),
+
+			// Computed
+			"allowed_services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"task_id":     taskIdSchema(),
+			"task_status": taskStatusSchema(),
 		},
 	}
 }
@@ -39,10 +60,29 @@ func resourceVRackPublicCloudAttachmentCreate(d *schema.ResourceData, meta inter
 	vrackId := d.Get("vrack_id").(string)
 	projectId := d.Get("project_id").(string)
 
-	if err := vrackPublicCloudAttachmentExists(vrackId, projectId, config.OVHClient); err == nil {
-		//set id
+	// the allowed services are only looked up on creation, as listing them
+	// on every refresh would slow down the plans for no gain
+	allowed, err := vrackAllowedServicesGet(config.OVHClient, vrackId)
+	if err != nil {
+		return err
+	}
+	d.Set("allowed_services", allowed.CloudProject)
+
+	// an existing attachment, e.g. made by hand or by a previous apply whose
+	// state was lost, is adopted rather than attached again
+	adopt := func() error {
+		log.Printf("[DEBUG] VRack %s is already attached to PublicCloud %s", vrackId, projectId)
 		d.SetId(fmt.Sprintf("vrack_%s-cloudproject_%s-attach", vrackId, projectId))
-		return nil
+		taskSetComputed(d, 0, nil)
+		return resourceVRackPublicCloudAttachmentRead(d, meta)
+	}
+	if err := vrackPublicCloudAttachmentExists(vrackId, projectId, config.OVHClient); err == nil {
+		return adopt()
+	}
+
+	if !vrackServiceAllowed(allowed.CloudProject, projectId) {
+		return fmt.Errorf("public cloud project %s can't be attached to vrack %s: it isn't one of its allowed services %v",
+			projectId, vrackId, allowed.CloudProject)
 	}
 
 	params := &VRackAttachOpts{Project: projectId}
@@ -51,32 +91,27 @@ func resourceVRackPublicCloudAttachmentCreate(d *schema.ResourceData, meta inter
 	log.Printf("[DEBUG] Will Attach VRack %s -> PublicCloud %s", vrackId, params.Project)
 	endpoint := fmt.Sprintf("/vrack/%s/cloudProject", vrackId)
 
-	err := config.OVHClient.Post(endpoint, params, &r)
-	if err != nil {
+	if err := config.OVHClient.Post(endpoint, params, &r); err != nil {
+		// the project may have been attached concurrently since the check above
+		if vrackPublicCloudAttachmentExists(vrackId, projectId, config.OVHClient) == nil {
+			return adopt()
+		}
 		return fmt.Errorf("Error calling %s with params %s:\n\t %q", endpoint, params, err)
 	}
 
 	log.Printf("[DEBUG] Waiting for Attachement Task id %d: VRack %s ->  PublicCloud %s", r.Id, vrackId, params.Project)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"init", "todo", "doing"},
-		Target:     []string{"completed"},
-		Refresh:    waitForVRackTaskCompleted(config.OVHClient, vrackId, r.Id),
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
+	task, err := vrackTaskWait(config.OVHClient, vrackId, r.Id, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return fmt.Errorf("Error waiting for vrack (%s) to attach to public cloud (%s): %s", vrackId, params.Project, err)
+		return fmt.Errorf("Error waiting for vrack (%s) to attach to public cloud (%s) with task %d: %s", vrackId, params.Project, r.Id, err)
 	}
 	log.Printf("[DEBUG] Created Attachement Task id %d: VRack %s ->  PublicCloud %s", r.Id, vrackId, params.Project)
 
 	//set id
 	d.SetId(fmt.Sprintf("vrack_%s-cloudproject_%s-attach", vrackId, params.Project))
+	taskSetComputed(d, r.Id, task)
 
-	return nil
+	return resourceVRackPublicCloudAttachmentRead(d, meta)
 }
 
 func resourceVRackPublicCloudAttachmentRead(d *schema.ResourceData, meta interface{}) error {
@@ -89,14 +124,24 @@ func resourceVRackPublicCloudAttachmentRead(d *schema.ResourceData, meta interfa
 
 	err := config.OVHClient.Get(endpoint, &r)
 	if err != nil {
-		return err
+		return CheckDeleted(d, err, endpoint)
 	}
 	log.Printf("[DEBUG] Read VRack %s ->  PublicCloud %s", vrackId, params.Project)
 
 	return nil
 }
 
+// resourceVRackPublicCloudAttachmentUpdate only handles prevent_detach, which doesn't
+// need any API call: all the other attributes force a new resource.
+func resourceVRackPublicCloudAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceVRackPublicCloudAttachmentRead(d, meta)
+}
+
 func resourceVRackPublicCloudAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := vrackPreventDetachCheck(d, fmt.Sprintf("public cloud project %s", d.Get("project_id").(string))); err != nil {
+		return err
+	}
+
 	config := meta.(*Config)
 
 	vrackId := d.Get("vrack_id").(string)
@@ -112,18 +157,9 @@ func resourceVRackPublicCloudAttachmentDelete(d *schema.ResourceData, meta inter
 
 	log.Printf("[DEBUG] Waiting for Attachment Deletion Task id %d: VRack %s ->  PublicCloud %s", r.Id, vrackId, params.Project)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"init", "todo", "doing"},
-		Target:     []string{"completed"},
-		Refresh:    waitForVRackTaskCompleted(config.OVHClient, vrackId, r.Id),
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
+	_, err = vrackTaskWait(config.OVHClient, vrackId, r.Id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return fmt.Errorf("Error waiting for vrack (%s) to attach to public cloud (%s): %s", vrackId, params.Project, err)
+		return fmt.Errorf("Error waiting for vrack (%s) to detach from public cloud (%s) with task %d: %s", vrackId, params.Project, r.Id, err)
 	}
 	log.Printf("[DEBUG] Removed Attachement id %d: VRack %s ->  PublicCloud %s", r.Id, vrackId, params.Project)
 
@@ -150,23 +186,66 @@ func vrackPublicCloudAttachmentExists(vrackId, projectId string, c *ovh.Client)
 	return nil
 }
 
-// AttachmentStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
-// an Attachment Task.
-func waitForVRackTaskCompleted(c *ovh.Client, serviceName string, taskId int) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		r := VRackAttachTaskResponse{}
-		endpoint := fmt.Sprintf("/vrack/%s/task/%d", serviceName, taskId)
-		err := c.Get(endpoint, &r)
-		if err != nil {
-			if err.(*ovh.APIError).Code == 404 {
-				log.Printf("[DEBUG] Task id %d on VRack %s completed", taskId, serviceName)
-				return taskId, "completed", nil
-			} else {
-				return taskId, "", err
-			}
+// vrackAllowedServicesGet returns the services which may be attached to the
+// vrack.
+func vrackAllowedServicesGet(c *ovh.Client, vrackId string) (*VRackAllowedServices, error) {
+	r := &VRackAllowedServices{}
+	endpoint := fmt.Sprintf("/vrack/%s/allowedServices", vrackId)
+	if err := c.Get(endpoint, r); err != nil {
+		return nil, fmt.Errorf("Error calling Get %s:\n\t %q", endpoint, err)
+	}
+	return r, nil
+}
+
+// vrackServiceAllowed tells whether service is one of the allowed services.
+func vrackServiceAllowed(allowed []string, service string) bool {
+	for _, s := range allowed {
+		if s == service {
+			return true
 		}
+	}
+	return false
+}
+
+// vrackPreventDetachSchema returns the schema of the prevent_detach attribute
+// shared by the vrack attachments.
+func vrackPreventDetachSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
 
-		log.Printf("[DEBUG] Pending Task id %d on VRack %s status: %s", r.Id, serviceName, r.Status)
-		return taskId, r.Status, nil
+// vrackPreventDetachCheck returns an error when the attachment is protected
+// by prevent_detach, so that it isn't detached from its vrack on destroy.
+func vrackPreventDetachCheck(d *schema.ResourceData, attachment string) error {
+	if !d.Get("prevent_detach").(bool) {
+		return nil
 	}
+
+	return fmt.Errorf("%s can't be detached from vrack %s as prevent_detach is set: "+
+		"set it to false and apply before destroying the attachment, "+
+		"or remove it from the state with terraform state rm to keep it attached",
+		attachment, d.Get("vrack_id").(string))
+}
+
+// vrackTaskDefaultTimeout is the default time given to a vrack task to
+// complete.
+const vrackTaskDefaultTimeout = 10 * time.Minute
+
+// vrackTaskWait blocks until the vrack task is completed. The task is polled
+// with an exponential backoff, from 1 to 10 seconds: most tasks complete
+// within a few seconds.
+func vrackTaskWait(c *ovh.Client, serviceName string, taskId int, timeout time.Duration) (apiTaskStatus, error) {
+	return (&taskPoller{
+		Endpoint:    fmt.Sprintf("/vrack/%s/task/%d", serviceName, taskId),
+		Description: fmt.Sprintf("task %d on vrack %s", taskId, serviceName),
+		Task:        &VRackAttachTaskResponse{},
+		Target:      []string{"completed"},
+		Purged:      "completed",
+		Timeout:     timeout,
+		Delay:       1 * time.Second,
+		MinTimeout:  1 * time.Second,
+	}).Wait(c)
 }
@@ -2,10 +2,14 @@ package ovh
 
 import (
 	"fmt"
+	"net/http"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -16,6 +20,14 @@ resource "ovh_vrack_publiccloud_attachment" "attach" {
 }
 `, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
 
+var testAccVRackPublicCloudAttachmentConfigPreventDetach = fmt.Sprintf(`
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id       = "%s"
+  project_id     = "%s"
+  prevent_detach = true
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
+
 func TestAccVRackPublicCloudAttachment_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccCheckVRackPublicCloudAttachmentPreCheck(t) },
@@ -26,12 +38,125 @@ func TestAccVRackPublicCloudAttachment_basic(t *testing.T) {
 				Config: testAccVRackPublicCloudAttachmentConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVRackPublicCloudAttachmentExists("ovh_vrack_publiccloud_attachment.attach", t),
+					resource.TestCheckResourceAttrSet("ovh_vrack_publiccloud_attachment.attach", "task_id"),
+					resource.TestCheckResourceAttr("ovh_vrack_publiccloud_attachment.attach", "task_status", "completed"),
+					resource.TestCheckResourceAttrSet("ovh_vrack_publiccloud_attachment.attach", "allowed_services.#"),
+				),
+			},
+			{
+				Config: testAccVRackPublicCloudAttachmentConfigPreventDetach,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVRackPublicCloudAttachmentExists("ovh_vrack_publiccloud_attachment.attach", t),
+					resource.TestCheckResourceAttr("ovh_vrack_publiccloud_attachment.attach", "prevent_detach", "true"),
 				),
 			},
+			{
+				Config:      testAccVRackPublicCloudAttachmentConfigPreventDetach,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("prevent_detach is set"),
+			},
+			{
+				// the protection has to be lifted for the test to clean up
+				Config: testAccVRackPublicCloudAttachmentConfig,
+			},
 		},
 	})
 }
 
+func TestVRackPublicCloudAttachmentCreate(t *testing.T) {
+	// attached tells whether the project is attached to the vrack, and
+	// attachedOnPost whether the POST races with a concurrent attachment
+	var attached, attachedOnPost bool
+	posts, allowedGets := 0, 0
+	client := newTestOVHClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/vrack/pn-1/allowedServices":
+			allowedGets++
+			w.Write([]byte(`{"cloudProject":["allowed"]}`))
+		case r.Method == "POST" && r.URL.Path == "/vrack/pn-1/cloudProject":
+			posts++
+			if attachedOnPost {
+				attached = true
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"message":"already attached"}`))
+				return
+			}
+			w.Write([]byte(`{"id":42,"status":"init"}`))
+		case r.URL.Path == "/vrack/pn-1/task/42":
+			attached = true
+			w.Write([]byte(`{"id":42,"status":"completed"}`))
+		case r.URL.Path == "/vrack/pn-1/cloudProject/allowed":
+			if !attached {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"message":"not found"}`))
+				return
+			}
+			w.Write([]byte(`{"vrack":"pn-1","project":"allowed"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+		}
+	})
+	config := &Config{OVHClient: client}
+
+	create := func(project string) (*schema.ResourceData, error) {
+		r := resourceVRackPublicCloudAttachment()
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"vrack_id":   "pn-1",
+			"project_id": project,
+		})
+		return d, r.Create(d, config)
+	}
+
+	// a project which isn't allowed is rejected before any attach attempt
+	if _, err := create("other"); err == nil || !strings.Contains(err.Error(), "allowed services") {
+		t.Errorf("expected the attachment to be rejected, got %v", err)
+	}
+	if posts != 0 {
+		t.Errorf("expected no attach call, got %d", posts)
+	}
+
+	// the attachment is created and waited for
+	d, err := create("allowed")
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	if d.Id() == "" || d.Get("task_status").(string) != "completed" {
+		t.Errorf("unexpected attachment %q with task status %q", d.Id(), d.Get("task_status"))
+	}
+
+	// an existing attachment is adopted without being attached again
+	d, err = create("allowed")
+	if err != nil {
+		t.Fatalf("adopt failed: %s", err)
+	}
+	if d.Id() == "" || posts != 1 {
+		t.Errorf("expected the attachment %q to be adopted, got %d attach calls", d.Id(), posts)
+	}
+
+	// an attachment made concurrently with ours is adopted as well
+	attached, attachedOnPost = false, true
+	d, err = create("allowed")
+	if err != nil {
+		t.Fatalf("concurrent adopt failed: %s", err)
+	}
+	if d.Id() == "" || posts != 2 {
+		t.Errorf("expected the attachment %q to be adopted, got %d attach calls", d.Id(), posts)
+	}
+	if v := d.Get("allowed_services").([]interface{}); len(v) != 1 || v[0] != "allowed" {
+		t.Errorf("unexpected allowed services %v", v)
+	}
+
+	// the allowed services aren't looked up again on refresh
+	n := allowedGets
+	if err := resourceVRackPublicCloudAttachmentRead(d, config); err != nil {
+		t.Fatalf("read failed: %s", err)
+	}
+	if allowedGets != n {
+		t.Errorf("expected no allowed services call on read, got %d", allowedGets-n)
+	}
+}
+
 func testAccCheckVRackPublicCloudAttachmentPreCheck(t *testing.T) {
 	testAccPreCheck(t)
 	testAccCheckVRackExists(t)
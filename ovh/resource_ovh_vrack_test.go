@@ -0,0 +1,69 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccVRackConfig = `
+resource "ovh_vrack" "vrack" {
+  name        = "%s"
+  description = "%s"
+}
+`
+
+func TestAccVRack_basic(t *testing.T) {
+	// ordering a vrack is not free of side effects on the account
+	// this resource is tested only if env var `OVH_TEST_VRACK_ORDER`
+	// is set to "1"
+	v := os.Getenv("OVH_TEST_VRACK_ORDER")
+	if v != "1" {
+		t.Skip("OVH_TEST_VRACK_ORDER must be set to 1 to test vrack ordering")
+	}
+
+	name := fmt.Sprintf("%s-vrack", test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccVRackConfig, name, "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVRackResourceExists("ovh_vrack.vrack"),
+					resource.TestCheckResourceAttr("ovh_vrack.vrack", "name", name),
+					resource.TestCheckResourceAttr("ovh_vrack.vrack", "description", "created by terraform"),
+					resource.TestCheckResourceAttrSet("ovh_vrack.vrack", "service_name"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccVRackConfig, name, "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVRackResourceExists("ovh_vrack.vrack"),
+					resource.TestCheckResourceAttr("ovh_vrack.vrack", "description", "updated by terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVRackResourceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VRack service name is set")
+		}
+
+		return vrackExists(rs.Primary.ID, config.OVHClient)
+	}
+}
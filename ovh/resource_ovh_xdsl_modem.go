@@ -0,0 +1,584 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceXdslModem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceXdslModemCreate,
+		Read:   resourceXdslModemRead,
+		Update: resourceXdslModemUpdate,
+		Delete: resourceXdslModemDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("service_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dhcp": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lan_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "defaultLAN",
+						},
+						"dhcp_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "defaultDHCP",
+						},
+						"start_address": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := validateIpV4(v.(string))
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"end_address": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := validateIpV4(v.(string))
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"default_gateway": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"subnet_mask": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"lease_time": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"primary_dns": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"secondary_dns": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"domain_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"server_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"port_mapping": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := validateStringEnum(v.(string), []string{"TCP", "UDP"})
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"external_port_start": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"external_port_end": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"internal_port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"internal_client": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := validateIpV4(v.(string))
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"allowed_remote_ip": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"wifi": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"wifi_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ssid": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"security_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := validateStringEnum(v.(string), []string{"None", "WEP", "WPA", "WPA2", "WPAandWPA2"})
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"key": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"ssid_advertisement_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"channel_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := validateStringEnum(v.(string), []string{"Auto", "Manual"})
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"channel": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			// Computed
+			"brand_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mac_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"managed_by_ovh": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceXdslModemCreate(d *schema.ResourceData, meta interface{}) error {
+	// The modem comes with the xDSL access. Its settings are managed
+	// here, the modem itself can't be created or deleted.
+	d.SetId(d.Get("service_name").(string))
+
+	return resourceXdslModemUpdate(d, meta)
+}
+
+func resourceXdslModemRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &XdslModem{}
+	endpoint := fmt.Sprintf("/xdsl/%s/modem", d.Id())
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read xdsl modem %s", r)
+
+	d.Set("service_name", d.Id())
+	d.Set("brand_name", r.BrandName)
+	d.Set("model", r.Model)
+	d.Set("mac_address", r.MacAddress)
+	d.Set("managed_by_ovh", r.ManagedByOvh)
+
+	if err := xdslModemReadDhcp(d, config.OVHClient); err != nil {
+		return err
+	}
+	if err := xdslModemReadPortMappings(d, config.OVHClient); err != nil {
+		return err
+	}
+	if err := xdslModemReadWifi(d, config.OVHClient); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceXdslModemUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("dhcp") {
+		if err := xdslModemUpdateDhcp(d, config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("port_mapping") {
+		o, n := d.GetChange("port_mapping")
+		if err := xdslModemUpdatePortMappings(d, config.OVHClient, o.(*schema.Set), n.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("wifi") {
+		if err := xdslModemUpdateWifi(d, config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceXdslModemRead(d, meta)
+}
+
+func resourceXdslModemDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	// The port mappings managed by the resource are removed. DHCP and
+	// WiFi settings are left as is.
+	empty := &schema.Set{F: d.Get("port_mapping").(*schema.Set).F}
+	if err := xdslModemUpdatePortMappings(d, config.OVHClient, d.Get("port_mapping").(*schema.Set), empty); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Will remove xdsl modem %s from state", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+// xdslModemReadDhcp reads the DHCP server configured in the resource, if any.
+func xdslModemReadDhcp(d *schema.ResourceData, c *ovh.Client) error {
+	dhcps := d.Get("dhcp").([]interface{})
+	if len(dhcps) == 0 {
+		return nil
+	}
+
+	dhcp := dhcps[0].(map[string]interface{})
+	lanName := dhcp["lan_name"].(string)
+
+	r := &XdslModemDhcp{}
+	endpoint := fmt.Sprintf("/xdsl/%s/modem/lan/%s/dhcp/%s", d.Id(), lanName, dhcp["dhcp_name"].(string))
+	if err := c.Get(endpoint, r); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Read xdsl modem dhcp %s", r)
+
+	d.Set("dhcp", []interface{}{map[string]interface{}{
+		"lan_name":        lanName,
+		"dhcp_name":       r.DhcpName,
+		"start_address":   r.StartAddress,
+		"end_address":     r.EndAddress,
+		"default_gateway": r.DefaultGateway,
+		"subnet_mask":     r.SubnetMask,
+		"lease_time":      r.LeaseTime,
+		"primary_dns":     r.PrimaryDNS,
+		"secondary_dns":   r.SecondaryDNS,
+		"domain_name":     r.DomainName,
+		"server_enabled":  r.ServerEnabled,
+	}})
+
+	return nil
+}
+
+func xdslModemUpdateDhcp(d *schema.ResourceData, c *ovh.Client) error {
+	dhcps := d.Get("dhcp").([]interface{})
+	if len(dhcps) == 0 {
+		return nil
+	}
+
+	dhcp := dhcps[0].(map[string]interface{})
+	params := &XdslModemDhcpUpdateOpts{
+		StartAddress:   dhcp["start_address"].(string),
+		EndAddress:     dhcp["end_address"].(string),
+		DefaultGateway: dhcp["default_gateway"].(string),
+		SubnetMask:     dhcp["subnet_mask"].(string),
+		LeaseTime:      dhcp["lease_time"].(int),
+		PrimaryDNS:     dhcp["primary_dns"].(string),
+		SecondaryDNS:   dhcp["secondary_dns"].(string),
+		DomainName:     dhcp["domain_name"].(string),
+		ServerEnabled:  dhcp["server_enabled"].(bool),
+	}
+
+	log.Printf("[DEBUG] Will update xdsl modem dhcp %s: %v", d.Id(), params)
+
+	task := &XdslTask{}
+	endpoint := fmt.Sprintf("/xdsl/%s/modem/lan/%s/dhcp/%s", d.Id(), dhcp["lan_name"].(string), dhcp["dhcp_name"].(string))
+	if err := c.Put(endpoint, params, task); err != nil {
+		return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	if task.Id == 0 {
+		return nil
+	}
+	return xdslTaskWait(c, d.Id(), task.Id)
+}
+
+// xdslModemReadPortMappings reads the port mappings configured in the
+// resource. Port mappings created outside of terraform are ignored.
+func xdslModemReadPortMappings(d *schema.ResourceData, c *ovh.Client) error {
+	mappings := []interface{}{}
+
+	for _, v := range d.Get("port_mapping").(*schema.Set).List() {
+		name := v.(map[string]interface{})["name"].(string)
+
+		r := &XdslModemPortMapping{}
+		endpoint := fmt.Sprintf("/xdsl/%s/modem/portMappings/%s", d.Id(), name)
+		if err := c.Get(endpoint, r); err != nil {
+			if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+				log.Printf("[WARN] Port mapping %s of xdsl modem %s not found", name, d.Id())
+				continue
+			}
+			return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+		}
+
+		log.Printf("[DEBUG] Read xdsl modem port mapping %s", r)
+
+		mapping := map[string]interface{}{
+			"name":                r.Name,
+			"protocol":            r.Protocol,
+			"external_port_start": r.ExternalPortStart,
+			"internal_port":       r.InternalPort,
+			"internal_client":     r.InternalClient,
+			"description":         r.Description,
+			"allowed_remote_ip":   r.AllowedRemoteIp,
+		}
+		if r.ExternalPortEnd != nil {
+			mapping["external_port_end"] = *r.ExternalPortEnd
+		}
+
+		mappings = append(mappings, mapping)
+	}
+
+	d.Set("port_mapping", mappings)
+	return nil
+}
+
+// xdslModemUpdatePortMappings applies the difference between the old and
+// the new port mappings. Port mappings are identified by their name.
+func xdslModemUpdatePortMappings(d *schema.ResourceData, c *ovh.Client, o, n *schema.Set) error {
+	oldNames := map[string]bool{}
+	for _, v := range o.List() {
+		oldNames[v.(map[string]interface{})["name"].(string)] = true
+	}
+	newNames := map[string]bool{}
+	for _, v := range n.List() {
+		newNames[v.(map[string]interface{})["name"].(string)] = true
+	}
+
+	for name := range oldNames {
+		if newNames[name] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Will delete xdsl modem port mapping %s on %s", name, d.Id())
+
+		task := &XdslTask{}
+		endpoint := fmt.Sprintf("/xdsl/%s/modem/portMappings/%s", d.Id(), name)
+		if err := c.Delete(endpoint, task); err != nil {
+			if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+				continue
+			}
+			return fmt.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+		}
+		if task.Id != 0 {
+			if err := xdslTaskWait(c, d.Id(), task.Id); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, v := range n.Difference(o).List() {
+		mapping := v.(map[string]interface{})
+		params := &XdslModemPortMappingOpts{
+			Protocol:          mapping["protocol"].(string),
+			ExternalPortStart: mapping["external_port_start"].(int),
+			ExternalPortEnd:   mapping["external_port_end"].(int),
+			InternalPort:      mapping["internal_port"].(int),
+			InternalClient:    mapping["internal_client"].(string),
+			Description:       mapping["description"].(string),
+			AllowedRemoteIp:   mapping["allowed_remote_ip"].(string),
+		}
+		name := mapping["name"].(string)
+
+		var taskId int64
+		if oldNames[name] {
+			log.Printf("[DEBUG] Will update xdsl modem port mapping %s on %s: %v", name, d.Id(), params)
+
+			task := &XdslTask{}
+			endpoint := fmt.Sprintf("/xdsl/%s/modem/portMappings/%s", d.Id(), name)
+			if err := c.Put(endpoint, params, task); err != nil {
+				return fmt.Errorf("calling Put %s with params %v:\n\t %q", endpoint, params, err)
+			}
+			taskId = task.Id
+		} else {
+			params.Name = name
+
+			log.Printf("[DEBUG] Will create xdsl modem port mapping on %s: %v", d.Id(), params)
+
+			r := &XdslModemPortMapping{}
+			endpoint := fmt.Sprintf("/xdsl/%s/modem/portMappings", d.Id())
+			if err := c.Post(endpoint, params, r); err != nil {
+				return fmt.Errorf("calling Post %s with params %v:\n\t %q", endpoint, params, err)
+			}
+			taskId = r.TaskId
+		}
+
+		if taskId != 0 {
+			if err := xdslTaskWait(c, d.Id(), taskId); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// xdslModemReadWifi reads the WiFi networks configured in the resource.
+// Keys are never returned by the API and are kept as configured.
+func xdslModemReadWifi(d *schema.ResourceData, c *ovh.Client) error {
+	wifis := []interface{}{}
+
+	for _, v := range d.Get("wifi").([]interface{}) {
+		wifi := v.(map[string]interface{})
+
+		r := &XdslModemWifi{}
+		endpoint := fmt.Sprintf("/xdsl/%s/modem/wifi/%s", d.Id(), wifi["wifi_name"].(string))
+		if err := c.Get(endpoint, r); err != nil {
+			return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+		}
+
+		log.Printf("[DEBUG] Read xdsl modem wifi %s", r)
+
+		wifis = append(wifis, map[string]interface{}{
+			"wifi_name":                  r.WifiName,
+			"ssid":                       r.SSID,
+			"enabled":                    r.Enabled,
+			"security_type":              r.SecurityType,
+			"key":                        wifi["key"],
+			"ssid_advertisement_enabled": r.SSIDAdvertisementEnabled,
+			"channel_mode":               r.ChannelMode,
+			"channel":                    r.Channel,
+		})
+	}
+
+	d.Set("wifi", wifis)
+	return nil
+}
+
+func xdslModemUpdateWifi(d *schema.ResourceData, c *ovh.Client) error {
+	for _, v := range d.Get("wifi").([]interface{}) {
+		wifi := v.(map[string]interface{})
+		params := &XdslModemWifiUpdateOpts{
+			SSID:                     wifi["ssid"].(string),
+			Enabled:                  wifi["enabled"].(bool),
+			SecurityType:             wifi["security_type"].(string),
+			Key:                      wifi["key"].(string),
+			SSIDAdvertisementEnabled: wifi["ssid_advertisement_enabled"].(bool),
+			ChannelMode:              wifi["channel_mode"].(string),
+			Channel:                  wifi["channel"].(int),
+		}
+		name := wifi["wifi_name"].(string)
+
+		// params are not logged as they hold the key of the network
+		log.Printf("[DEBUG] Will update xdsl modem wifi %s on %s", name, d.Id())
+
+		task := &XdslTask{}
+		endpoint := fmt.Sprintf("/xdsl/%s/modem/wifi/%s", d.Id(), name)
+		if err := c.Put(endpoint, params, task); err != nil {
+			return fmt.Errorf("calling Put %s:\n\t %q", endpoint, err)
+		}
+
+		if task.Id != 0 {
+			if err := xdslTaskWait(c, d.Id(), task.Id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func xdslModemExists(serviceName string, c *ovh.Client) error {
+	r := &XdslModem{}
+	endpoint := fmt.Sprintf("/xdsl/%s/modem", serviceName)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read xdsl modem: %s", r)
+
+	return nil
+}
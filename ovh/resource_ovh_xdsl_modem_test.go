@@ -0,0 +1,69 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccXdslModemConfig = `
+resource "ovh_xdsl_modem" "modem" {
+  service_name = "%s"
+
+  dhcp {
+    start_address = "192.168.1.20"
+    end_address   = "192.168.1.100"
+  }
+
+  port_mapping {
+    name                = "%s"
+    protocol            = "TCP"
+    external_port_start = 8022
+    internal_port       = 22
+    internal_client     = "192.168.1.10"
+  }
+}
+`
+
+func TestAccXdslModem_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_XDSL_SERVICE")
+	name := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckXdslPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccXdslModemConfig, serviceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXdslModemExists("ovh_xdsl_modem.modem", t),
+					resource.TestCheckResourceAttr("ovh_xdsl_modem.modem", "dhcp.0.start_address", "192.168.1.20"),
+					resource.TestCheckResourceAttr("ovh_xdsl_modem.modem", "dhcp.0.end_address", "192.168.1.100"),
+					resource.TestCheckResourceAttr("ovh_xdsl_modem.modem", "port_mapping.#", "1"),
+					resource.TestCheckResourceAttrSet("ovh_xdsl_modem.modem", "mac_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckXdslModemExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No xdsl service name is set")
+		}
+
+		return xdslModemExists(rs.Primary.ID, config.OVHClient)
+	}
+}
@@ -0,0 +1,58 @@
+package ovh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type ServiceInfosRenew struct {
+	Automatic          bool `json:"automatic"`
+	DeleteAtExpiration bool `json:"deleteAtExpiration"`
+	Forced             bool `json:"forced"`
+	ManualPayment      bool `json:"manualPayment"`
+	Period             *int `json:"period"`
+}
+
+type ServiceInfos struct {
+	Domain                string             `json:"domain"`
+	ServiceId             int64              `json:"serviceId"`
+	Status                string             `json:"status"`
+	Creation              string             `json:"creation"`
+	Expiration            string             `json:"expiration"`
+	EngagedUpTo           string             `json:"engagedUpTo"`
+	RenewalType           string             `json:"renewalType"`
+	CanDeleteAtExpiration bool               `json:"canDeleteAtExpiration"`
+	PossibleRenewPeriod   []int              `json:"possibleRenewPeriod"`
+	ContactAdmin          string             `json:"contactAdmin"`
+	ContactBilling        string             `json:"contactBilling"`
+	ContactTech           string             `json:"contactTech"`
+	Renew                 *ServiceInfosRenew `json:"renew"`
+}
+
+type ServiceInfosUpdateOpts struct {
+	Renew *ServiceInfosRenew `json:"renew"`
+}
+
+func (s *ServiceInfos) String() string {
+	return fmt.Sprintf("ServiceInfos[domain: %s, status: %s, expiration: %s, renewalType: %s]", s.Domain, s.Status, s.Expiration, s.RenewalType)
+}
+
+// serviceInfosEndpoint builds the serviceInfos endpoint of a service from
+// its API route (ex: "/vrack" or "dedicated/server") and its name.
+func serviceInfosEndpoint(route, serviceName string) string {
+	route = strings.Trim(route, "/")
+	return fmt.Sprintf("/%s/%s/serviceInfos", route, serviceName)
+}
+
+// serviceInfosGet returns the serviceInfos of a service.
+func serviceInfosGet(c *ovh.Client, route, serviceName string) (*ServiceInfos, error) {
+	r := &ServiceInfos{}
+	endpoint := serviceInfosEndpoint(route, serviceName)
+	if err := c.Get(endpoint, r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
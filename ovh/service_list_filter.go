@@ -0,0 +1,95 @@
+package ovh
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// serviceListFilter selects services of a list by their display name,
+// reverse or datacenter. Unset criteria match every service.
+type serviceListFilter struct {
+	DisplayName *regexp.Regexp
+	Reverse     *regexp.Regexp
+	Datacenter  string
+}
+
+func (f *serviceListFilter) String() string {
+	return fmt.Sprintf("Filter[displayName: %v, reverse: %v, datacenter: %s]", f.DisplayName, f.Reverse, f.Datacenter)
+}
+
+// serviceListFilterSchema returns the filters shared by the data sources
+// listing services.
+func serviceListFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"display_name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateServiceListFilterRegex,
+		},
+		"reverse_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateServiceListFilterRegex,
+		},
+		"datacenter": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func validateServiceListFilterRegex(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := regexp.Compile(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %q is not a valid regular expression: %s", k, v.(string), err))
+	}
+	return
+}
+
+func serviceListFilterFromSchema(d *schema.ResourceData) (*serviceListFilter, error) {
+	f := &serviceListFilter{
+		Datacenter: strings.ToLower(d.Get("datacenter").(string)),
+	}
+
+	if v, ok := d.GetOk("display_name_regex"); ok {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		f.DisplayName = r
+	}
+	if v, ok := d.GetOk("reverse_regex"); ok {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		f.Reverse = r
+	}
+
+	return f, nil
+}
+
+// MatchDatacenter returns whether a datacenter is the filtered one, or one
+// of the datacenters of the filtered location, e.g. gra2 for gra.
+func (f *serviceListFilter) MatchDatacenter(datacenter string) bool {
+	return f.Datacenter == "" || strings.HasPrefix(strings.ToLower(datacenter), f.Datacenter)
+}
+
+func (f *serviceListFilter) MatchDisplayName(displayName string) bool {
+	return f.DisplayName == nil || f.DisplayName.MatchString(displayName)
+}
+
+// MatchReverse returns whether one of the reverses of a service matches.
+func (f *serviceListFilter) MatchReverse(reverses []string) bool {
+	if f.Reverse == nil {
+		return true
+	}
+	for _, reverse := range reverses {
+		if f.Reverse.MatchString(strings.TrimSuffix(reverse, ".")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package ovh
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestServiceListFilter(t *testing.T) {
+	f := &serviceListFilter{
+		DisplayName: regexp.MustCompile("^web-"),
+		Reverse:     regexp.MustCompile(`\.example\.com$`),
+		Datacenter:  "gra",
+	}
+
+	if !f.MatchDatacenter("gra2") || !f.MatchDatacenter("GRA") || f.MatchDatacenter("rbx8") {
+		t.Errorf("unexpected datacenter match for %s", f)
+	}
+	if !f.MatchDisplayName("web-01") || f.MatchDisplayName("db-01") {
+		t.Errorf("unexpected display name match for %s", f)
+	}
+	if !f.MatchReverse([]string{"ns123.ip-1-2-3.eu.", "web01.example.com."}) || f.MatchReverse([]string{"ns123.ip-1-2-3.eu"}) {
+		t.Errorf("unexpected reverse match for %s", f)
+	}
+
+	all := &serviceListFilter{}
+	if !all.MatchDatacenter("bhs1") || !all.MatchDisplayName("") || !all.MatchReverse(nil) {
+		t.Errorf("an empty filter must match every service")
+	}
+}
@@ -0,0 +1,84 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// Routes of the services checked by serviceNameCustomizeDiff.
+const (
+	serviceNameCloudProject    = "/cloud/project/%s"
+	serviceNameIpLoadbalancing = "/ipLoadbalancing/%s"
+	serviceNameDomainZone      = "/domain/zone/%s"
+)
+
+// serviceNameCustomizeDiff returns a CustomizeDiffFunc which checks, when the
+// validate_service_names provider option is enabled, that the service
+// referenced by the key attribute exists and is accessible with the current
+// credentials. endpointFormat is the route of the service, e.g.
+// "/cloud/project/%s".
+func serviceNameCustomizeDiff(key, endpointFormat string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		config := meta.(*Config)
+		if !config.ValidateServiceNames {
+			return nil
+		}
+
+		if d.Id() != "" && !d.HasChange(key) {
+			return nil
+		}
+
+		// the service may be created in the same operation
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+
+		serviceName := d.Get(key).(string)
+		if serviceName == "" {
+			return nil
+		}
+
+		// a zone may be written in its fully qualified form, e.g. "example.com."
+		endpoint := fmt.Sprintf(endpointFormat, url.PathEscape(normalizeDomain(serviceName)))
+		return serviceNameCheck(config.OVHClient, key, serviceName, endpoint)
+	}
+}
+
+// serviceNameCheck returns an explicit error when the service behind endpoint
+// is missing or can't be read with the current credentials.
+func serviceNameCheck(c *ovh.Client, key, serviceName, endpoint string) error {
+	log.Printf("[DEBUG] Will check %s %s exists", key, serviceName)
+
+	r := map[string]interface{}{}
+	err := c.Get(endpoint, &r)
+	if err == nil {
+		return nil
+	}
+
+	if apiErr, ok := err.(*ovh.APIError); ok {
+		switch apiErr.Code {
+		case 404:
+			return fmt.Errorf("%s %q doesn't exist or doesn't belong to the account of the current credentials", key, serviceName)
+		case 403:
+			return fmt.Errorf("%s %q isn't accessible with the current credentials: check the access rules of the consumer key", key, serviceName)
+		}
+	}
+
+	return fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+}
+
+// customizeDiffs chains several CustomizeDiffFunc, stopping at the first error.
+func customizeDiffs(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		for _, f := range funcs {
+			if err := f(d, meta); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
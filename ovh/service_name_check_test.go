@@ -0,0 +1,39 @@
+package ovh
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccServiceNameCheckConfig = `
+provider "ovh" {
+  validate_service_names = true
+}
+
+resource "ovh_domain_zone_record" "record" {
+  zone      = "%s.com"
+  subdomain = "terraform"
+  fieldtype = "A"
+  target    = "192.168.0.10"
+}
+`
+
+func TestAccServiceNameCheck_missingZone(t *testing.T) {
+	zone := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccServiceNameCheckConfig, zone),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(fmt.Sprintf("zone \"%s.com\" doesn't exist", zone)),
+			},
+		},
+	})
+}
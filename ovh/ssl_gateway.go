@@ -0,0 +1,85 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type SslGateway struct {
+	ServiceName   string   `json:"serviceName"`
+	DisplayName   string   `json:"displayName"`
+	AllowedSource []string `json:"allowedSource"`
+	Hsts          bool     `json:"hsts"`
+	HttpsRedirect bool     `json:"httpsRedirect"`
+	ServerHttps   bool     `json:"serverHttps"`
+	Reverse       string   `json:"reverse"`
+	Ipv4          string   `json:"ipv4"`
+	Ipv6          string   `json:"ipv6"`
+	Offer         string   `json:"offer"`
+	State         string   `json:"state"`
+	Zones         []string `json:"zones"`
+}
+
+func (g *SslGateway) String() string {
+	return fmt.Sprintf("SslGateway[serviceName: %s, displayName: %s, ipv4: %s, state: %s]", g.ServiceName, g.DisplayName, g.Ipv4, g.State)
+}
+
+type SslGatewayUpdateOpts struct {
+	DisplayName   string   `json:"displayName,omitempty"`
+	AllowedSource []string `json:"allowedSource"`
+	Hsts          bool     `json:"hsts"`
+	HttpsRedirect bool     `json:"httpsRedirect"`
+	ServerHttps   bool     `json:"serverHttps"`
+	Reverse       string   `json:"reverse,omitempty"`
+}
+
+type SslGatewayDomain struct {
+	Id     int64  `json:"id"`
+	Domain string `json:"domain"`
+	State  string `json:"state"`
+}
+
+func (d *SslGatewayDomain) String() string {
+	return fmt.Sprintf("SslGatewayDomain[id: %d, domain: %s, state: %s]", d.Id, d.Domain, d.State)
+}
+
+type SslGatewayDomainCreateOpts struct {
+	Domain string `json:"domain"`
+}
+
+type SslGatewayServer struct {
+	Id      int64  `json:"id"`
+	Address string `json:"address"`
+	Port    int    `json:"port"`
+	State   string `json:"state"`
+}
+
+func (s *SslGatewayServer) String() string {
+	return fmt.Sprintf("SslGatewayServer[id: %d, address: %s, port: %d, state: %s]", s.Id, s.Address, s.Port, s.State)
+}
+
+type SslGatewayServerOpts struct {
+	Address string `json:"address"`
+	Port    int    `json:"port"`
+}
+
+func (s *SslGatewayServer) TaskStatus() string {
+	return s.State
+}
+
+// sslGatewayServerWait waits for a SSL gateway server to leave its
+// transient states.
+func sslGatewayServerWait(c *ovh.Client, serviceName string, id int64) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/sslGateway/%s/server/%d", serviceName, id),
+		Description: fmt.Sprintf("server %d on ssl gateway %s", id, serviceName),
+		Task:        &SslGatewayServer{},
+		Pending:     []string{"creating", "updating"},
+		Target:      []string{"ok"},
+		Timeout:     10 * time.Minute,
+		Delay:       5 * time.Second,
+	}).Wait(c)
+	return err
+}
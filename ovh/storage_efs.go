@@ -0,0 +1,137 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type StorageEfsShare struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Protocol    string `json:"protocol"`
+	Size        int64  `json:"size"`
+	Status      string `json:"status"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+func (s *StorageEfsShare) String() string {
+	return fmt.Sprintf("EfsShare[id: %s, name: %s, protocol: %s, size: %d, status: %s]", s.Id, s.Name, s.Protocol, s.Size, s.Status)
+}
+
+type StorageEfsShareCreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Protocol    string `json:"protocol"`
+	Size        int64  `json:"size"`
+}
+
+type StorageEfsShareUpdateOpts struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type StorageEfsShareSizeOpts struct {
+	Size int64 `json:"size"`
+}
+
+type StorageEfsShareSnapshotPolicyOpts struct {
+	SnapshotPolicyId string `json:"snapshotPolicyID"`
+}
+
+type StorageEfsShareAccessPath struct {
+	Id        string `json:"id"`
+	Path      string `json:"path"`
+	Preferred bool   `json:"preferred"`
+}
+
+type StorageEfsShareAcl struct {
+	Id          string `json:"id"`
+	AccessTo    string `json:"accessTo"`
+	AccessLevel string `json:"accessLevel"`
+	AccessType  string `json:"accessType"`
+	Status      string `json:"status"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+func (a *StorageEfsShareAcl) String() string {
+	return fmt.Sprintf("EfsShareAcl[id: %s, accessTo: %s, accessLevel: %s, status: %s]", a.Id, a.AccessTo, a.AccessLevel, a.Status)
+}
+
+type StorageEfsShareAclCreateOpts struct {
+	AccessTo    string `json:"accessTo"`
+	AccessLevel string `json:"accessLevel"`
+}
+
+type StorageEfsSnapshotPolicySchedule struct {
+	Minutes  []int `json:"minutes"`
+	Hours    []int `json:"hours"`
+	Days     []int `json:"days"`
+	Months   []int `json:"months"`
+	Weekdays []int `json:"weekdays"`
+}
+
+type StorageEfsSnapshotPolicyRule struct {
+	Copies   int                               `json:"copies"`
+	Prefix   string                            `json:"prefix"`
+	Schedule *StorageEfsSnapshotPolicySchedule `json:"schedule"`
+}
+
+type StorageEfsSnapshotPolicy struct {
+	Id          string                          `json:"id"`
+	Name        string                          `json:"name"`
+	Description string                          `json:"description"`
+	IsDefault   bool                            `json:"isDefault"`
+	Rules       []*StorageEfsSnapshotPolicyRule `json:"rules"`
+	Status      string                          `json:"status"`
+}
+
+func (p *StorageEfsSnapshotPolicy) String() string {
+	return fmt.Sprintf("EfsSnapshotPolicy[id: %s, name: %s, rules: %d, status: %s]", p.Id, p.Name, len(p.Rules), p.Status)
+}
+
+type StorageEfsSnapshotPolicyOpts struct {
+	Name        string                          `json:"name"`
+	Description string                          `json:"description"`
+	Rules       []*StorageEfsSnapshotPolicyRule `json:"rules"`
+}
+
+func (s *StorageEfsShare) TaskStatus() string {
+	return s.Status
+}
+
+func (a *StorageEfsShareAcl) TaskStatus() string {
+	return a.Status
+}
+
+// storageEfsShareWait waits for an EFS share to reach one of the target
+// statuses. A share which can't be found anymore is reported as "deleted".
+func storageEfsShareWait(c *ovh.Client, serviceName, shareId string, pending, target []string) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/storage/netapp/%s/share/%s", serviceName, shareId),
+		Description: fmt.Sprintf("share %s on efs %s", shareId, serviceName),
+		Task:        &StorageEfsShare{},
+		Pending:     pending,
+		Target:      target,
+		Purged:      "deleted",
+		Delay:       5 * time.Second,
+	}).Wait(c)
+	return err
+}
+
+// storageEfsShareAclWait waits for an access rule of an EFS share to be
+// applied.
+func storageEfsShareAclWait(c *ovh.Client, serviceName, shareId, aclId string) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/storage/netapp/%s/share/%s/acl/%s", serviceName, shareId, aclId),
+		Description: fmt.Sprintf("acl %s of share %s on efs %s", aclId, shareId, serviceName),
+		Task:        &StorageEfsShareAcl{},
+		Pending:     []string{"queued_to_apply", "applying"},
+		Target:      []string{"active"},
+		Timeout:     10 * time.Minute,
+		Delay:       5 * time.Second,
+	}).Wait(c)
+	return err
+}
@@ -0,0 +1,187 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// apiTaskStatus is implemented by the tasks and operations polled by a
+// taskPoller.
+type apiTaskStatus interface {
+	TaskStatus() string
+}
+
+// ApiTask holds the fields shared by the tasks of most products. The products
+// don't agree on the name of the status and of the reason of a failure: the
+// poller reads whichever is set.
+type ApiTask struct {
+	Id       int64  `json:"id"`
+	Function string `json:"function"`
+	Type     string `json:"type"`
+	Status   string `json:"status"`
+	State    string `json:"state"`
+	Comment  string `json:"comment"`
+	Message  string `json:"message"`
+}
+
+func (t *ApiTask) String() string {
+	return fmt.Sprintf("ApiTask[id: %d, status: %s]", t.Id, t.TaskStatus())
+}
+
+func (t *ApiTask) TaskStatus() string {
+	if t.Status != "" {
+		return t.Status
+	}
+	return t.State
+}
+
+// apiTaskPurged is the task returned once the API purged it, known only by
+// its status.
+type apiTaskPurged string
+
+func (s apiTaskPurged) TaskStatus() string {
+	return string(s)
+}
+
+func (t *ApiTask) taskComment() string {
+	if t.Comment != "" {
+		return t.Comment
+	}
+	return t.Message
+}
+
+func (o *PublicCloudOperation) TaskStatus() string {
+	return o.Status
+}
+
+func (r *VRackAttachTaskResponse) TaskStatus() string {
+	return r.Status
+}
+
+// taskPoller polls an API task until it ends. Between two polls, it waits
+// from MinTimeout up to 10 seconds, doubling the wait after each poll: most
+// tasks end within a few seconds, some take minutes.
+type taskPoller struct {
+	// Endpoint is the URL of the task.
+	Endpoint string
+	// Description names the task in the logs and errors, e.g. "task 42 on
+	// vps vps-1234".
+	Description string
+	// Task receives the task read from the API. It defaults to an *ApiTask.
+	Task apiTaskStatus
+
+	// Pending are the statuses of a running task, Target the ones of a
+	// successful task and Failed the ones of a failed task: the wait fails
+	// on any other status. They default to the statuses used by most
+	// products.
+	Pending []string
+	Target  []string
+	Failed  []string
+	// Purged is the status of the task once the API doesn't know it anymore,
+	// for the products purging their done tasks. When empty, the task not
+	// being found is an error.
+	Purged string
+
+	// Timeout defaults to 20 minutes, Delay to 10 seconds and MinTimeout to
+	// 3 seconds.
+	Timeout    time.Duration
+	Delay      time.Duration
+	MinTimeout time.Duration
+}
+
+// Wait blocks until the task ends, and returns its last state. A purged task
+// is returned with the Purged status.
+func (p *taskPoller) Wait(c *ovh.Client) (apiTaskStatus, error) {
+	if p.Task == nil {
+		p.Task = &ApiTask{}
+	}
+	if p.Pending == nil {
+		p.Pending = []string{"init", "todo", "doing"}
+	}
+	if p.Target == nil {
+		p.Target = []string{"done"}
+	}
+	if p.Timeout == 0 {
+		p.Timeout = 20 * time.Minute
+	}
+	if p.Delay == 0 {
+		p.Delay = 10 * time.Second
+	}
+	if p.MinTimeout == 0 {
+		p.MinTimeout = 3 * time.Second
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: p.Pending,
+		Target:  p.Target,
+		Refresh: func() (interface{}, string, error) {
+			if err := c.Get(p.Endpoint, p.Task); err != nil {
+				if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 && p.Purged != "" {
+					log.Printf("[DEBUG] %s purged", p.Description)
+					return apiTaskPurged(p.Purged), p.Purged, nil
+				}
+				return p.Task, "", err
+			}
+
+			status := p.Task.TaskStatus()
+			log.Printf("[DEBUG] Pending %s: %s", p.Description, status)
+			for _, f := range p.Failed {
+				if status == f {
+					return p.Task, status, p.failure(status)
+				}
+			}
+			return p.Task, status, nil
+		},
+		Timeout:    p.Timeout,
+		Delay:      p.Delay,
+		MinTimeout: p.MinTimeout,
+	}
+
+	r, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("waiting for %s: %s", p.Description, err)
+	}
+
+	return r.(apiTaskStatus), nil
+}
+
+func (p *taskPoller) failure(status string) error {
+	if t, ok := p.Task.(interface{ taskComment() string }); ok && t.taskComment() != "" {
+		return fmt.Errorf("%s ended with status %s: %s", p.Description, status, t.taskComment())
+	}
+	return fmt.Errorf("%s ended with status %s", p.Description, status)
+}
+
+// taskIdSchema and taskStatusSchema return the schemas of the task_id and
+// task_status attributes, exposing the last task run by a resource.
+func taskIdSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+func taskStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+// taskSetComputed sets the attributes of the last task run by a resource. A
+// nil task means no task was needed.
+func taskSetComputed(d *schema.ResourceData, id interface{}, task apiTaskStatus) {
+	if task == nil {
+		d.Set("task_id", "")
+		d.Set("task_status", "")
+		return
+	}
+	d.Set("task_id", fmt.Sprint(id))
+	d.Set("task_status", task.TaskStatus())
+}
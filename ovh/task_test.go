@@ -0,0 +1,129 @@
+package ovh
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTaskPoller(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	client := newTestOVHClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls[r.URL.Path]++
+		n := calls[r.URL.Path]
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/vps/vps-1/tasks/1":
+			// the status of the VPS tasks is their state
+			states := []string{"todo", "doing", "done"}
+			if n > len(states) {
+				n = len(states)
+			}
+			fmt.Fprintf(w, `{"id":1,"state":"%s"}`, states[n-1])
+		case "/vrack/pn-1/task/2":
+			if n == 1 {
+				w.Write([]byte(`{"id":2,"status":"doing"}`))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+		case "/dedicated/server/ns1/task/3":
+			w.Write([]byte(`{"id":3,"status":"ovhError","comment":"disk failure"}`))
+		case "/cloud/project/p1/operation/op1":
+			w.Write([]byte(`{"id":"op1","status":"completed","resourceId":"net1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+		}
+	})
+
+	task, err := (&taskPoller{
+		Endpoint:    "/vps/vps-1/tasks/1",
+		Description: "task 1 on vps vps-1",
+		Pending:     []string{"todo", "doing"},
+		Delay:       time.Millisecond,
+		MinTimeout:  time.Millisecond,
+	}).Wait(client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if task.TaskStatus() != "done" || calls["/vps/vps-1/tasks/1"] != 3 {
+		t.Errorf("expected the task to be done after 3 polls, got %s after %d", task.TaskStatus(), calls["/vps/vps-1/tasks/1"])
+	}
+
+	// a purged task is returned with the purged status
+	task, err = (&taskPoller{
+		Endpoint:    "/vrack/pn-1/task/2",
+		Description: "task 2 on vrack pn-1",
+		Task:        &VRackAttachTaskResponse{},
+		Target:      []string{"completed"},
+		Purged:      "completed",
+		Delay:       time.Millisecond,
+		MinTimeout:  time.Millisecond,
+	}).Wait(client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if task.TaskStatus() != "completed" {
+		t.Errorf("expected the purged task to be completed, got %s", task.TaskStatus())
+	}
+
+	// a failed task reports its comment
+	_, err = (&taskPoller{
+		Endpoint:    "/dedicated/server/ns1/task/3",
+		Description: "task 3 on dedicated server ns1",
+		Failed:      []string{"customerError", "ovhError", "cancelled"},
+		Delay:       time.Millisecond,
+		MinTimeout:  time.Millisecond,
+	}).Wait(client)
+	if err == nil || !strings.Contains(err.Error(), "ended with status ovhError: disk failure") {
+		t.Errorf("expected the failure of the task, got %v", err)
+	}
+
+	// a task which is not found is an error unless the tasks are purged
+	_, err = (&taskPoller{
+		Endpoint:    "/ip/1.2.3.4/task/4",
+		Description: "task 4 on ip 1.2.3.4",
+		Delay:       time.Millisecond,
+		MinTimeout:  time.Millisecond,
+	}).Wait(client)
+	if err == nil {
+		t.Errorf("expected an error for a task not found")
+	}
+
+	op, err := cloudProjectOperationWait(client, "p1", "op1", time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if op.createdResourceId() != "net1" {
+		t.Errorf("expected the operation to have created net1, got %s", op.createdResourceId())
+	}
+}
+
+func TestDedicatedCephTaskStepsStatus(t *testing.T) {
+	cases := []struct {
+		steps    DedicatedCephTaskSteps
+		expected string
+	}{
+		{DedicatedCephTaskSteps{{Name: "a", State: "DONE"}, {Name: "b", State: "DONE"}}, "DONE"},
+		{DedicatedCephTaskSteps{{Name: "a", State: "DONE"}, {Name: "b", State: "TODO"}}, "PENDING"},
+		{DedicatedCephTaskSteps{{Name: "a", State: "TODO"}, {Name: "b", State: "FAILED"}}, "FAILED"},
+	}
+
+	for _, c := range cases {
+		if got := c.steps.TaskStatus(); got != c.expected {
+			t.Errorf("expected %s, got %s", c.expected, got)
+		}
+	}
+
+	failed := DedicatedCephTaskSteps{{Name: "a", State: "DONE"}, {Name: "b", State: "CANCELED"}}
+	if got := failed.taskComment(); got != "step b is CANCELED" {
+		t.Errorf("expected the canceled step to be reported, got %q", got)
+	}
+}
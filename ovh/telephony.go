@@ -0,0 +1,123 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type TelephonyPrice struct {
+	CurrencyCode string  `json:"currencyCode"`
+	Text         string  `json:"text"`
+	Value        float64 `json:"value"`
+}
+
+type TelephonyBillingAccount struct {
+	BillingAccount          string          `json:"billingAccount"`
+	Description             string          `json:"description"`
+	Status                  string          `json:"status"`
+	Trusted                 bool            `json:"trusted"`
+	HiddenExternalNumber    bool            `json:"hiddenExternalNumber"`
+	OverrideDisplayedNumber bool            `json:"overrideDisplayedNumber"`
+	CurrentOutplan          *TelephonyPrice `json:"currentOutplan"`
+	AllowedOutplan          *TelephonyPrice `json:"allowedOutplan"`
+}
+
+func (t *TelephonyBillingAccount) String() string {
+	return fmt.Sprintf("TelephonyBillingAccount[billingAccount: %s, status: %s]", t.BillingAccount, t.Status)
+}
+
+type TelephonyLine struct {
+	ServiceName       string   `json:"serviceName"`
+	Description       string   `json:"description"`
+	ServiceType       string   `json:"serviceType"`
+	SimultaneousLines int      `json:"simultaneousLines"`
+	Offers            []string `json:"offers"`
+}
+
+func (t *TelephonyLine) String() string {
+	return fmt.Sprintf("TelephonyLine[serviceName: %s, serviceType: %s]", t.ServiceName, t.ServiceType)
+}
+
+type TelephonyLineOptions struct {
+	DisplayNumber              string `json:"displayNumber"`
+	DoNotDisturb               bool   `json:"doNotDisturb"`
+	AnonymousRejection         bool   `json:"anonymousRejection"`
+	CallWaiting                bool   `json:"callWaiting"`
+	Intercom                   string `json:"intercom"`
+	IdentificationRestriction  bool   `json:"identificationRestriction"`
+	DefaultVoicemail           string `json:"defaultVoicemail"`
+	ForwardUnconditional       bool   `json:"forwardUnconditional"`
+	ForwardUnconditionalNumber string `json:"forwardUnconditionalNumber"`
+	ForwardBusy                bool   `json:"forwardBusy"`
+	ForwardBusyNumber          string `json:"forwardBusyNumber"`
+	ForwardNoReply             bool   `json:"forwardNoReply"`
+	ForwardNoReplyNumber       string `json:"forwardNoReplyNumber"`
+	ForwardNoReplyDelay        int    `json:"forwardNoReplyDelay"`
+	ForwardBackup              bool   `json:"forwardBackup"`
+	ForwardBackupNumber        string `json:"forwardBackupNumber"`
+	Codecs                     string `json:"codecs"`
+}
+
+func (t *TelephonyLineOptions) String() string {
+	return fmt.Sprintf("TelephonyLineOptions[displayNumber: %s, doNotDisturb: %t, forwardUnconditional: %t]", t.DisplayNumber, t.DoNotDisturb, t.ForwardUnconditional)
+}
+
+type TelephonyNumber struct {
+	ServiceName string `json:"serviceName"`
+	Description string `json:"description"`
+	FeatureType string `json:"featureType"`
+	ServiceType string `json:"serviceType"`
+	PartOfPool  *int64 `json:"partOfPool"`
+}
+
+func (t *TelephonyNumber) String() string {
+	return fmt.Sprintf("TelephonyNumber[serviceName: %s, featureType: %s]", t.ServiceName, t.FeatureType)
+}
+
+type TelephonyNumberChangeFeatureTypeOpts struct {
+	FeatureType string `json:"featureType"`
+}
+
+type TelephonyTask struct {
+	TaskId  int64  `json:"taskId"`
+	Action  string `json:"action"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+func (t *TelephonyTask) String() string {
+	return fmt.Sprintf("TelephonyTask[id: %d, action: %s, status: %s]", t.TaskId, t.Action, t.Status)
+}
+
+// telephonyFeatureTypes are the feature types a number can be configured with
+var telephonyFeatureTypes = []string{
+	"cloudHunting",
+	"cloudIvr",
+	"conference",
+	"contactCenterSolution",
+	"contactCenterSolutionExpert",
+	"ddi",
+	"easyHunting",
+	"easyPabx",
+	"empty",
+	"fax",
+	"miniPabx",
+	"redirect",
+	"svi",
+	"voicemail",
+}
+
+// telephonyTaskWait waits for a task on a telephony service to be done.
+func telephonyTaskWait(c *ovh.Client, billingAccount, serviceName string, taskId int64) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/telephony/%s/service/%s/task/%d", billingAccount, serviceName, taskId),
+		Description: fmt.Sprintf("task %d on telephony %s/%s", taskId, billingAccount, serviceName),
+		Pending:     []string{"todo", "doing"},
+		Failed:      []string{"error"},
+		Purged:      "done",
+		Delay:       5 * time.Second,
+	}).Wait(c)
+	return err
+}
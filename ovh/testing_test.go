@@ -0,0 +1,31 @@
+package ovh
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// newTestOVHClient returns a client of a fake OVH API answering the calls
+// with the given handler. The server time used to sign the calls is answered
+// by the fake API itself, and the server is closed at the end of the test.
+func newTestOVHClient(t *testing.T, handler http.HandlerFunc) *ovh.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			fmt.Fprintf(w, "%d", time.Now().Unix())
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := ovh.NewClient(server.URL, "ak", "as", "ck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
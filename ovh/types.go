@@ -22,13 +22,18 @@ type PublicCloudPrivateNetworkUpdateOpts struct {
 	Name string `json:"name"`
 }
 
+func (p *PublicCloudPrivateNetworkUpdateOpts) String() string {
+	return fmt.Sprintf("name: %s", p.Name)
+}
+
 type PublicCloudPrivateNetworkRegion struct {
-	Status string `json:"status"`
-	Region string `json:"region"`
+	Status      string `json:"status"`
+	Region      string `json:"region"`
+	OpenstackId string `json:"openstackId"`
 }
 
 func (p *PublicCloudPrivateNetworkRegion) String() string {
-	return fmt.Sprintf("Status:%s, Region: %s", p.Status, p.Region)
+	return fmt.Sprintf("Status:%s, Region: %s, OpenstackId: %s", p.Status, p.Region, p.OpenstackId)
 }
 
 type PublicCloudPrivateNetworkResponse struct {
@@ -61,6 +66,17 @@ func (p *PublicCloudPrivateNetworksCreateOpts) String() string {
 		p.ProjectId, p.NetworkId, p.Dhcp, p.NoGateway, p.Network, p.Start, p.End, p.Region)
 }
 
+// PublicCloudPrivateNetworkSubnetUpdateOpts holds the attributes of a
+// subnet which can be changed in place.
+type PublicCloudPrivateNetworkSubnetUpdateOpts struct {
+	EnableDhcp      bool `json:"enableDhcp"`
+	EnableGatewayIp bool `json:"enableGatewayIp"`
+}
+
+func (p *PublicCloudPrivateNetworkSubnetUpdateOpts) String() string {
+	return fmt.Sprintf("PCPNSUpdateOpts[enableDhcp: %v, enableGatewayIp: %v]", p.EnableDhcp, p.EnableGatewayIp)
+}
+
 type IPPool struct {
 	Network string `json:"network"`
 	Region  string `json:"region"`
@@ -95,18 +111,26 @@ func (p *PublicCloudUserCreateOpts) String() string {
 }
 
 type PublicCloudUserResponse struct {
-	Id           int    `json:"id"`
-	Username     string `json:"username"`
-	Status       string `json:"status"`
-	Description  string `json:"description"`
-	Password     string `json:"password"`
-	CreationDate string `json:"creationDate"`
+	Id           int                    `json:"id"`
+	Username     string                 `json:"username"`
+	Status       string                 `json:"status"`
+	Description  string                 `json:"description"`
+	Password     string                 `json:"password"`
+	CreationDate string                 `json:"creationDate"`
+	Roles        []*PublicCloudUserRole `json:"roles"`
 }
 
 func (p *PublicCloudUserResponse) String() string {
 	return fmt.Sprintf("UserResponse[Id: %v, Username: %s, Status: %s, Description: %s, CreationDate: %s]", p.Id, p.Username, p.Status, p.Description, p.CreationDate)
 }
 
+type PublicCloudUserRole struct {
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
 type PublicCloudUserOpenstackRC struct {
 	Content string `json:"content"`
 }
@@ -116,6 +140,76 @@ type VRackAttachOpts struct {
 	Project string `json:"project"`
 }
 
+// VRackAllowedServices lists the services which may be attached to a vrack,
+// by type.
+type VRackAllowedServices struct {
+	CloudProject             []string `json:"cloudProject"`
+	DedicatedServer          []string `json:"dedicatedServer"`
+	DedicatedServerInterface []string `json:"dedicatedServerInterface"`
+	Ip                       []string `json:"ip"`
+	IpLoadbalancing          []string `json:"ipLoadbalancing"`
+}
+
+// Opts
+type VRackDedicatedServerInterfaceAttachOpts struct {
+	DedicatedServerInterface string `json:"dedicatedServerInterface"`
+}
+
+type VRackDedicatedServerInterface struct {
+	VRack                    string `json:"vrack"`
+	DedicatedServer          string `json:"dedicatedServer"`
+	DedicatedServerInterface string `json:"dedicatedServerInterface"`
+}
+
+func (v *VRackDedicatedServerInterface) String() string {
+	return fmt.Sprintf("vrack: %s, dedicatedServer: %s, dedicatedServerInterface: %s", v.VRack, v.DedicatedServer, v.DedicatedServerInterface)
+}
+
+type VRack struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (v *VRack) String() string {
+	return fmt.Sprintf("VRack[name: %s, description: %s]", v.Name, v.Description)
+}
+
+// Opts
+type VRackUpdateOpts struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Opts
+type VRackIpAttachOpts struct {
+	Block string `json:"block"`
+}
+
+type VRackIp struct {
+	VRack   string `json:"vrack"`
+	Ip      string `json:"ip"`
+	Gateway string `json:"gateway"`
+	Zone    string `json:"zone"`
+}
+
+func (v *VRackIp) String() string {
+	return fmt.Sprintf("vrack: %s, ip: %s, gateway: %s, zone: %s", v.VRack, v.Ip, v.Gateway, v.Zone)
+}
+
+// Opts
+type VRackIpLoadbalancingAttachOpts struct {
+	IpLoadbalancing string `json:"ipLoadbalancing"`
+}
+
+type VRackIpLoadbalancing struct {
+	VRack           string `json:"vrack"`
+	IpLoadbalancing string `json:"ipLoadbalancing"`
+}
+
+func (v *VRackIpLoadbalancing) String() string {
+	return fmt.Sprintf("vrack: %s, ipLoadbalancing: %s", v.VRack, v.IpLoadbalancing)
+}
+
 // Task Opts
 type TaskOpts struct {
 	ServiceName string `json:"serviceName"`
@@ -152,3 +246,177 @@ type PublicCloudServiceStatusResponse struct {
 func (s *PublicCloudServiceStatusResponse) String() string {
 	return fmt.Sprintf("%s: %s", s.Name, s.Status)
 }
+
+type PublicCloudFailoverIpResponse struct {
+	Id            string `json:"id"`
+	Ip            string `json:"ip"`
+	Block         string `json:"block"`
+	RoutedTo      string `json:"routedTo"`
+	Status        string `json:"status"`
+	ContinentCode string `json:"continentCode"`
+	Geoloc        string `json:"geoloc"`
+	SubType       string `json:"subType"`
+	Progress      int    `json:"progress"`
+}
+
+func (p *PublicCloudFailoverIpResponse) String() string {
+	return fmt.Sprintf("FailoverIp[id: %s, ip: %s, block: %s, routedTo: %s, status: %s]", p.Id, p.Ip, p.Block, p.RoutedTo, p.Status)
+}
+
+type PublicCloudRegionNetworkAllocationPool struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// PublicCloudRegionNetworkSubnetCreateOpts are the parameters of a subnet
+// of the region scoped network API.
+type PublicCloudRegionNetworkSubnetCreateOpts struct {
+	Name            string                                    `json:"name,omitempty"`
+	Cidr            string                                    `json:"cidr"`
+	IpVersion       int                                       `json:"ipVersion"`
+	EnableDhcp      bool                                      `json:"enableDhcp"`
+	EnableGatewayIp bool                                      `json:"enableGatewayIp"`
+	GatewayIp       string                                    `json:"gatewayIp,omitempty"`
+	DnsNameServers  []string                                  `json:"dnsNameServers,omitempty"`
+	AllocationPools []*PublicCloudRegionNetworkAllocationPool `json:"allocationPools,omitempty"`
+}
+
+func (p *PublicCloudRegionNetworkSubnetCreateOpts) String() string {
+	return fmt.Sprintf("PCRNSCreateOpts[name: %s, cidr: %s, ipVersion: %d, enableDhcp: %v, enableGatewayIp: %v, gatewayIp: %s]",
+		p.Name, p.Cidr, p.IpVersion, p.EnableDhcp, p.EnableGatewayIp, p.GatewayIp)
+}
+
+type PublicCloudRegionNetworkCreateOpts struct {
+	Name   string                                    `json:"name"`
+	VlanId *int                                      `json:"vlanId,omitempty"`
+	Subnet *PublicCloudRegionNetworkSubnetCreateOpts `json:"subnet"`
+}
+
+func (p *PublicCloudRegionNetworkCreateOpts) String() string {
+	return fmt.Sprintf("PCRNCreateOpts[name: %s, subnet: %s]", p.Name, p.Subnet)
+}
+
+type PublicCloudRegionNetworkResponse struct {
+	Id         string `json:"id"`
+	Name       string `json:"name"`
+	Region     string `json:"region"`
+	Visibility string `json:"visibility"`
+	VlanId     *int   `json:"vlanId"`
+}
+
+func (p *PublicCloudRegionNetworkResponse) String() string {
+	return fmt.Sprintf("PCRNResponse[id: %s, name: %s, region: %s, visibility: %s]", p.Id, p.Name, p.Region, p.Visibility)
+}
+
+type PublicCloudRegionNetworkSubnetResponse struct {
+	Id              string                                    `json:"id"`
+	Name            string                                    `json:"name"`
+	Cidr            string                                    `json:"cidr"`
+	IpVersion       int                                       `json:"ipVersion"`
+	DhcpEnabled     bool                                      `json:"dhcpEnabled"`
+	GatewayIp       *string                                   `json:"gatewayIp"`
+	DnsNameServers  []string                                  `json:"dnsNameServers"`
+	AllocationPools []*PublicCloudRegionNetworkAllocationPool `json:"allocationPools"`
+}
+
+func (p *PublicCloudRegionNetworkSubnetResponse) String() string {
+	return fmt.Sprintf("PCRNSResponse[id: %s, name: %s, cidr: %s, dhcpEnabled: %v]", p.Id, p.Name, p.Cidr, p.DhcpEnabled)
+}
+
+type PublicCloudInstanceNetworkOpts struct {
+	NetworkId string `json:"networkId"`
+}
+
+type PublicCloudInstanceCreateOpts struct {
+	Name           string                            `json:"name"`
+	Region         string                            `json:"region"`
+	FlavorId       string                            `json:"flavorId"`
+	ImageId        string                            `json:"imageId"`
+	SshKeyId       string                            `json:"sshKeyId,omitempty"`
+	MonthlyBilling bool                              `json:"monthlyBilling"`
+	UserData       string                            `json:"userData,omitempty"`
+	GroupId        string                            `json:"groupId,omitempty"`
+	Networks       []*PublicCloudInstanceNetworkOpts `json:"networks,omitempty"`
+}
+
+// String doesn't print the user data, which may hold secrets.
+func (p *PublicCloudInstanceCreateOpts) String() string {
+	return fmt.Sprintf("InstanceOpts[name: %s, region: %s, flavorId: %s, imageId: %s, sshKeyId: %s, monthlyBilling: %v, groupId: %s]",
+		p.Name, p.Region, p.FlavorId, p.ImageId, p.SshKeyId, p.MonthlyBilling, p.GroupId)
+}
+
+type PublicCloudInstanceUpdateOpts struct {
+	InstanceName string `json:"instanceName"`
+}
+
+type PublicCloudInstanceIpAddress struct {
+	Ip        string `json:"ip"`
+	Type      string `json:"type"`
+	Version   int    `json:"version"`
+	NetworkId string `json:"networkId"`
+}
+
+type PublicCloudInstanceResponse struct {
+	Id          string                          `json:"id"`
+	Name        string                          `json:"name"`
+	Region      string                          `json:"region"`
+	Status      string                          `json:"status"`
+	FlavorId    string                          `json:"flavorId"`
+	ImageId     string                          `json:"imageId"`
+	SshKeyId    *string                         `json:"sshKeyId"`
+	Created     string                          `json:"created"`
+	IpAddresses []*PublicCloudInstanceIpAddress `json:"ipAddresses"`
+}
+
+func (p *PublicCloudInstanceResponse) String() string {
+	return fmt.Sprintf("Instance[id: %s, name: %s, region: %s, status: %s]", p.Id, p.Name, p.Region, p.Status)
+}
+
+type PublicCloudInstanceInterfaceCreateOpts struct {
+	NetworkId string `json:"networkId"`
+	Ip        string `json:"ip,omitempty"`
+}
+
+func (p *PublicCloudInstanceInterfaceCreateOpts) String() string {
+	return fmt.Sprintf("InterfaceOpts[networkId: %s, ip: %s]", p.NetworkId, p.Ip)
+}
+
+type PublicCloudInstanceInterfaceFixedIp struct {
+	Ip       string `json:"ip"`
+	SubnetId string `json:"subnetId"`
+}
+
+type PublicCloudInstanceInterfaceResponse struct {
+	Id         string                                 `json:"id"`
+	MacAddress string                                 `json:"macAddress"`
+	NetworkId  string                                 `json:"networkId"`
+	State      string                                 `json:"state"`
+	Type       string                                 `json:"type"`
+	FixedIps   []*PublicCloudInstanceInterfaceFixedIp `json:"fixedIps"`
+}
+
+func (p *PublicCloudInstanceInterfaceResponse) String() string {
+	return fmt.Sprintf("Interface[id: %s, mac: %s, networkId: %s, state: %s, type: %s]", p.Id, p.MacAddress, p.NetworkId, p.State, p.Type)
+}
+
+type PublicCloudServerGroupCreateOpts struct {
+	Name   string `json:"name"`
+	Policy string `json:"policy"`
+	Region string `json:"region"`
+}
+
+func (p *PublicCloudServerGroupCreateOpts) String() string {
+	return fmt.Sprintf("ServerGroupOpts[name: %s, policy: %s, region: %s]", p.Name, p.Policy, p.Region)
+}
+
+type PublicCloudServerGroupResponse struct {
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Policy      string   `json:"policy"`
+	Region      string   `json:"region"`
+	InstanceIds []string `json:"instance_ids"`
+}
+
+func (p *PublicCloudServerGroupResponse) String() string {
+	return fmt.Sprintf("ServerGroup[id: %s, name: %s, policy: %s, region: %s, instances: %v]", p.Id, p.Name, p.Policy, p.Region, p.InstanceIds)
+}
@@ -0,0 +1,42 @@
+package ovh
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-ovh/version"
+)
+
+// userAgent returns the User-Agent sent on the API calls: the version of the
+// provider, then the extra_user_agent given in the provider configuration, if
+// any. The version of terraform isn't known by the provider with this SDK:
+// the one of the terraform library it's built with would be misleading.
+func userAgent(extra string) string {
+	ua := fmt.Sprintf("terraform-provider-ovh/%s", version.ProviderVersion)
+	if extra = strings.TrimSpace(extra); extra != "" {
+		ua += " " + extra
+	}
+	return ua
+}
+
+// userAgentTransport sets the User-Agent of the requests going through it,
+// for both the v1 and the v2 API clients which share the http client.
+type userAgentTransport struct {
+	transport http.RoundTripper
+	userAgent string
+}
+
+func newUserAgentTransport(transport http.RoundTripper, userAgent string) *userAgentTransport {
+	return &userAgentTransport{
+		transport: transport,
+		userAgent: userAgent,
+	}
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// a RoundTripper must not modify the request it is given
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgent)
+	return t.transport.RoundTrip(req)
+}
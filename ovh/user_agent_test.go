@@ -0,0 +1,41 @@
+package ovh
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestUserAgent(t *testing.T) {
+	ua := userAgent("")
+	if ua != "terraform-provider-ovh/dev" {
+		t.Errorf("unexpected user agent %q", ua)
+	}
+
+	if ua := userAgent(" pipeline/deploy-42 "); ua != "terraform-provider-ovh/dev pipeline/deploy-42" {
+		t.Errorf("unexpected user agent %q", ua)
+	}
+}
+
+func TestUserAgentTransport(t *testing.T) {
+	var host string
+	userAgents := map[string]string{}
+	client := newTestOVHClient(t, func(w http.ResponseWriter, r *http.Request) {
+		host = r.Host
+		userAgents[r.URL.Path] = r.Header.Get("User-Agent")
+		w.Write([]byte(`{}`))
+	})
+	client.Client.Transport = newUserAgentTransport(http.DefaultTransport, "terraform-provider-ovh/test")
+
+	if err := client.Get("/me", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := newOVHClientV2(client, "http://"+host).Get("/iam/policy", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, path := range []string{"/me", "/v2/iam/policy"} {
+		if userAgents[path] != "terraform-provider-ovh/test" {
+			t.Errorf("unexpected user agent %q on %s", userAgents[path], path)
+		}
+	}
+}
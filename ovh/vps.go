@@ -0,0 +1,168 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type VpsModel struct {
+	Name    string `json:"name"`
+	Offer   string `json:"offer"`
+	Version string `json:"version"`
+	Vcore   int    `json:"vcore"`
+	Memory  int    `json:"memory"`
+	Disk    int    `json:"disk"`
+}
+
+type Vps struct {
+	Name        string    `json:"name"`
+	DisplayName string    `json:"displayName"`
+	NetbootMode string    `json:"netbootMode"`
+	State       string    `json:"state"`
+	Zone        string    `json:"zone"`
+	OfferType   string    `json:"offerType"`
+	Vcore       int       `json:"vcore"`
+	MemoryLimit int       `json:"memoryLimit"`
+	Model       *VpsModel `json:"model"`
+}
+
+func (v *Vps) String() string {
+	return fmt.Sprintf("Vps[name: %s, displayName: %s, netbootMode: %s, state: %s]", v.Name, v.DisplayName, v.NetbootMode, v.State)
+}
+
+type VpsDatacenter struct {
+	Name     string `json:"name"`
+	LongName string `json:"longName"`
+	Country  string `json:"country"`
+}
+
+type VpsIp struct {
+	IpAddress string `json:"ipAddress"`
+	Reverse   string `json:"reverse"`
+	Type      string `json:"type"`
+	Version   string `json:"version"`
+}
+
+type VpsUpdateOpts struct {
+	DisplayName string `json:"displayName,omitempty"`
+	NetbootMode string `json:"netbootMode,omitempty"`
+}
+
+type VpsRebuildOpts struct {
+	ImageId           string `json:"imageId"`
+	PublicSshKey      string `json:"publicSshKey,omitempty"`
+	DoNotSendPassword bool   `json:"doNotSendPassword"`
+}
+
+func (o *VpsRebuildOpts) String() string {
+	return fmt.Sprintf("VpsRebuildOpts[imageId: %s, doNotSendPassword: %t]", o.ImageId, o.DoNotSendPassword)
+}
+
+type VpsTask struct {
+	Id       int64  `json:"id"`
+	Type     string `json:"type"`
+	State    string `json:"state"`
+	Progress int    `json:"progress"`
+}
+
+func (t *VpsTask) String() string {
+	return fmt.Sprintf("VpsTask[id: %d, type: %s, state: %s, progress: %d]", t.Id, t.Type, t.State, t.Progress)
+}
+
+// vpsTaskWait waits for a VPS task to be done.
+func vpsTaskWait(c *ovh.Client, serviceName string, taskId int64) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/vps/%s/tasks/%d", serviceName, taskId),
+		Description: fmt.Sprintf("task %d on vps %s", taskId, serviceName),
+		Pending:     []string{"todo", "doing", "waitingAck", "paused"},
+		Purged:      "done",
+		Timeout:     30 * time.Minute,
+	}).Wait(c)
+	return err
+}
+
+// vpsDetails returns a VPS along with its datacenter and ips.
+func vpsDetails(c *ovh.Client, serviceName string) (*Vps, *VpsDatacenter, []string, error) {
+	vps := &Vps{}
+	endpoint := fmt.Sprintf("/vps/%s", serviceName)
+	if err := c.Get(endpoint, vps); err != nil {
+		return nil, nil, nil, err
+	}
+
+	datacenter := &VpsDatacenter{}
+	endpoint = fmt.Sprintf("/vps/%s/datacenter", serviceName)
+	if err := c.Get(endpoint, datacenter); err != nil {
+		return nil, nil, nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	ips := []string{}
+	endpoint = fmt.Sprintf("/vps/%s/ips", serviceName)
+	if err := c.Get(endpoint, &ips); err != nil {
+		return nil, nil, nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	return vps, datacenter, ips, nil
+}
+
+// vpsComputedSchema returns the attributes read from a VPS, shared by the
+// ovh_vps resource and data source.
+func vpsComputedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"model": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"offer": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"vcore": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"memory": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"disk": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"datacenter": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"zone": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ips": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      schema.HashString,
+		},
+	}
+}
+
+func vpsSetComputed(d *schema.ResourceData, vps *Vps, datacenter *VpsDatacenter, ips []string) {
+	if vps.Model != nil {
+		d.Set("model", vps.Model.Name)
+		d.Set("offer", vps.Model.Offer)
+		d.Set("memory", vps.Model.Memory)
+		d.Set("disk", vps.Model.Disk)
+	}
+	d.Set("vcore", vps.Vcore)
+	d.Set("datacenter", datacenter.Name)
+	d.Set("zone", vps.Zone)
+	d.Set("state", vps.State)
+	d.Set("ips", ips)
+}
@@ -0,0 +1,137 @@
+package ovh
+
+import (
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+type Xdsl struct {
+	AccessName   string `json:"accessName"`
+	AccessType   string `json:"accessType"`
+	Description  string `json:"description"`
+	Ipv6Enabled  bool   `json:"ipv6Enabled"`
+	LnsRateLimit int    `json:"lnsRateLimit"`
+	NbIp         int    `json:"nbIp"`
+	PairsNumber  int    `json:"pairsNumber"`
+	Role         string `json:"role"`
+	Status       string `json:"status"`
+}
+
+func (x *Xdsl) String() string {
+	return fmt.Sprintf("Xdsl[accessName: %s, accessType: %s, status: %s]", x.AccessName, x.AccessType, x.Status)
+}
+
+type XdslModem struct {
+	BrandName    string `json:"brandName"`
+	Model        string `json:"model"`
+	MacAddress   string `json:"macAddress"`
+	ManagedByOvh bool   `json:"managedByOvh"`
+	IsBridged    bool   `json:"isBridged"`
+}
+
+func (m *XdslModem) String() string {
+	return fmt.Sprintf("XdslModem[brand: %s, model: %s, mac: %s, managedByOvh: %t]", m.BrandName, m.Model, m.MacAddress, m.ManagedByOvh)
+}
+
+type XdslModemDhcp struct {
+	DhcpName       string `json:"dhcpName"`
+	StartAddress   string `json:"startAddress"`
+	EndAddress     string `json:"endAddress"`
+	DefaultGateway string `json:"defaultGateway"`
+	SubnetMask     string `json:"subnetMask"`
+	LeaseTime      int    `json:"leaseTime"`
+	PrimaryDNS     string `json:"primaryDNS"`
+	SecondaryDNS   string `json:"secondaryDNS"`
+	DomainName     string `json:"domainName"`
+	ServerEnabled  bool   `json:"serverEnabled"`
+}
+
+func (d *XdslModemDhcp) String() string {
+	return fmt.Sprintf("XdslModemDhcp[name: %s, start: %s, end: %s, enabled: %t]", d.DhcpName, d.StartAddress, d.EndAddress, d.ServerEnabled)
+}
+
+type XdslModemDhcpUpdateOpts struct {
+	StartAddress   string `json:"startAddress"`
+	EndAddress     string `json:"endAddress"`
+	DefaultGateway string `json:"defaultGateway,omitempty"`
+	SubnetMask     string `json:"subnetMask,omitempty"`
+	LeaseTime      int    `json:"leaseTime,omitempty"`
+	PrimaryDNS     string `json:"primaryDNS,omitempty"`
+	SecondaryDNS   string `json:"secondaryDNS,omitempty"`
+	DomainName     string `json:"domainName"`
+	ServerEnabled  bool   `json:"serverEnabled"`
+}
+
+type XdslModemPortMapping struct {
+	Id                int64  `json:"id"`
+	Name              string `json:"name"`
+	Protocol          string `json:"protocol"`
+	ExternalPortStart int    `json:"externalPortStart"`
+	ExternalPortEnd   *int   `json:"externalPortEnd"`
+	InternalPort      int    `json:"internalPort"`
+	InternalClient    string `json:"internalClient"`
+	Description       string `json:"description"`
+	AllowedRemoteIp   string `json:"allowedRemoteIp"`
+	TaskId            int64  `json:"taskId"`
+}
+
+func (p *XdslModemPortMapping) String() string {
+	return fmt.Sprintf("XdslModemPortMapping[name: %s, protocol: %s, externalPortStart: %d, internal: %s:%d]", p.Name, p.Protocol, p.ExternalPortStart, p.InternalClient, p.InternalPort)
+}
+
+type XdslModemPortMappingOpts struct {
+	Name              string `json:"name,omitempty"`
+	Protocol          string `json:"protocol"`
+	ExternalPortStart int    `json:"externalPortStart"`
+	ExternalPortEnd   int    `json:"externalPortEnd,omitempty"`
+	InternalPort      int    `json:"internalPort"`
+	InternalClient    string `json:"internalClient"`
+	Description       string `json:"description,omitempty"`
+	AllowedRemoteIp   string `json:"allowedRemoteIp,omitempty"`
+}
+
+type XdslModemWifi struct {
+	WifiName                 string `json:"wifiName"`
+	SSID                     string `json:"SSID"`
+	Enabled                  bool   `json:"enabled"`
+	SecurityType             string `json:"securityType"`
+	SSIDAdvertisementEnabled bool   `json:"SSIDAdvertisementEnabled"`
+	ChannelMode              string `json:"channelMode"`
+	Channel                  int    `json:"channel"`
+}
+
+func (w *XdslModemWifi) String() string {
+	return fmt.Sprintf("XdslModemWifi[name: %s, ssid: %s, enabled: %t, security: %s]", w.WifiName, w.SSID, w.Enabled, w.SecurityType)
+}
+
+type XdslModemWifiUpdateOpts struct {
+	SSID                     string `json:"SSID"`
+	Enabled                  bool   `json:"enabled"`
+	SecurityType             string `json:"securityType"`
+	Key                      string `json:"key,omitempty"`
+	SSIDAdvertisementEnabled bool   `json:"SSIDAdvertisementEnabled"`
+	ChannelMode              string `json:"channelMode,omitempty"`
+	Channel                  int    `json:"channel,omitempty"`
+}
+
+type XdslTask struct {
+	Id       int64  `json:"id"`
+	Function string `json:"function"`
+	Status   string `json:"status"`
+}
+
+func (t *XdslTask) String() string {
+	return fmt.Sprintf("XdslTask[id: %d, function: %s, status: %s]", t.Id, t.Function, t.Status)
+}
+
+// xdslTaskWait waits for a xDSL task to be done.
+func xdslTaskWait(c *ovh.Client, serviceName string, taskId int64) error {
+	_, err := (&taskPoller{
+		Endpoint:    fmt.Sprintf("/xdsl/%s/tasks/%d", serviceName, taskId),
+		Description: fmt.Sprintf("task %d on xdsl %s", taskId, serviceName),
+		Pending:     []string{"todo", "doing"},
+		Purged:      "done",
+	}).Wait(c)
+	return err
+}
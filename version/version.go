@@ -0,0 +1,5 @@
+package version
+
+// ProviderVersion is the version of the provider, set at build time with
+// -ldflags "-X github.com/terraform-providers/terraform-provider-ovh/version.ProviderVersion=x.y.z".
+var ProviderVersion = "dev"